@@ -0,0 +1,426 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: apiKey.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type APIKey struct {
+	// ID of the API key (set by the server).
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Name of the API key.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// ID of the organization (for an organization key).
+	OrganizationID int64 `protobuf:"varint,3,opt,name=organizationID" json:"organizationID,omitempty"`
+	// ID of the application (for an application key).
+	ApplicationID int64 `protobuf:"varint,4,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Timestamp after which the key expires (optional).
+	ExpiresAt string `protobuf:"bytes,5,opt,name=expiresAt" json:"expiresAt,omitempty"`
+}
+
+func (m *APIKey) Reset()                    { *m = APIKey{} }
+func (m *APIKey) String() string            { return proto.CompactTextString(m) }
+func (*APIKey) ProtoMessage()               {}
+func (*APIKey) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{0} }
+
+func (m *APIKey) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *APIKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *APIKey) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *APIKey) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *APIKey) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+type CreateAPIKeyRequest struct {
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=apiKey" json:"apiKey,omitempty"`
+}
+
+func (m *CreateAPIKeyRequest) Reset()                    { *m = CreateAPIKeyRequest{} }
+func (m *CreateAPIKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateAPIKeyRequest) ProtoMessage()               {}
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{1} }
+
+func (m *CreateAPIKeyRequest) GetApiKey() *APIKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	// ID of the API key.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The API key, to be used as bearer token.
+	Key string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+}
+
+func (m *CreateAPIKeyResponse) Reset()                    { *m = CreateAPIKeyResponse{} }
+func (m *CreateAPIKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateAPIKeyResponse) ProtoMessage()               {}
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{2} }
+
+func (m *CreateAPIKeyResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CreateAPIKeyResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListAPIKeyRequest struct {
+	// ID of the organization.
+	OrganizationID int64 `protobuf:"varint,1,opt,name=organizationID" json:"organizationID,omitempty"`
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,2,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,3,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,4,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListAPIKeyRequest) Reset()                    { *m = ListAPIKeyRequest{} }
+func (m *ListAPIKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAPIKeyRequest) ProtoMessage()               {}
+func (*ListAPIKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{3} }
+
+func (m *ListAPIKeyRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *ListAPIKeyRequest) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *ListAPIKeyRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListAPIKeyRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type APIKeyListItem struct {
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=apiKey" json:"apiKey,omitempty"`
+	// Timestamp when the API key was created.
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+}
+
+func (m *APIKeyListItem) Reset()                    { *m = APIKeyListItem{} }
+func (m *APIKeyListItem) String() string            { return proto.CompactTextString(m) }
+func (*APIKeyListItem) ProtoMessage()               {}
+func (*APIKeyListItem) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{4} }
+
+func (m *APIKeyListItem) GetApiKey() *APIKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+func (m *APIKeyListItem) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+type ListAPIKeyResponse struct {
+	// Total number of API keys.
+	TotalCount int64             `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*APIKeyListItem `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListAPIKeyResponse) Reset()                    { *m = ListAPIKeyResponse{} }
+func (m *ListAPIKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListAPIKeyResponse) ProtoMessage()               {}
+func (*ListAPIKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{5} }
+
+func (m *ListAPIKeyResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListAPIKeyResponse) GetResult() []*APIKeyListItem {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteAPIKeyRequest struct {
+	// ID of the API key.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteAPIKeyRequest) Reset()                    { *m = DeleteAPIKeyRequest{} }
+func (m *DeleteAPIKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteAPIKeyRequest) ProtoMessage()               {}
+func (*DeleteAPIKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{6} }
+
+func (m *DeleteAPIKeyRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteAPIKeyResponse struct {
+}
+
+func (m *DeleteAPIKeyResponse) Reset()                    { *m = DeleteAPIKeyResponse{} }
+func (m *DeleteAPIKeyResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteAPIKeyResponse) ProtoMessage()               {}
+func (*DeleteAPIKeyResponse) Descriptor() ([]byte, []int) { return fileDescriptor14, []int{7} }
+
+func init() {
+	proto.RegisterType((*APIKey)(nil), "api.APIKey")
+	proto.RegisterType((*CreateAPIKeyRequest)(nil), "api.CreateAPIKeyRequest")
+	proto.RegisterType((*CreateAPIKeyResponse)(nil), "api.CreateAPIKeyResponse")
+	proto.RegisterType((*ListAPIKeyRequest)(nil), "api.ListAPIKeyRequest")
+	proto.RegisterType((*APIKeyListItem)(nil), "api.APIKeyListItem")
+	proto.RegisterType((*ListAPIKeyResponse)(nil), "api.ListAPIKeyResponse")
+	proto.RegisterType((*DeleteAPIKeyRequest)(nil), "api.DeleteAPIKeyRequest")
+	proto.RegisterType((*DeleteAPIKeyResponse)(nil), "api.DeleteAPIKeyResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for APIKeyService service
+
+type APIKeyServiceClient interface {
+	// Create creates the given API key. The returned key is only available
+	// in this response.
+	Create(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// List lists the API keys of the given organization or application.
+	List(ctx context.Context, in *ListAPIKeyRequest, opts ...grpc.CallOption) (*ListAPIKeyResponse, error)
+	// Delete revokes the API key matching the given id.
+	Delete(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*DeleteAPIKeyResponse, error)
+}
+
+type aPIKeyServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIKeyServiceClient(cc *grpc.ClientConn) APIKeyServiceClient {
+	return &aPIKeyServiceClient{cc}
+}
+
+func (c *aPIKeyServiceClient) Create(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := grpc.Invoke(ctx, "/api.APIKeyService/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) List(ctx context.Context, in *ListAPIKeyRequest, opts ...grpc.CallOption) (*ListAPIKeyResponse, error) {
+	out := new(ListAPIKeyResponse)
+	err := grpc.Invoke(ctx, "/api.APIKeyService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIKeyServiceClient) Delete(ctx context.Context, in *DeleteAPIKeyRequest, opts ...grpc.CallOption) (*DeleteAPIKeyResponse, error) {
+	out := new(DeleteAPIKeyResponse)
+	err := grpc.Invoke(ctx, "/api.APIKeyService/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for APIKeyService service
+
+type APIKeyServiceServer interface {
+	// Create creates the given API key. The returned key is only available
+	// in this response.
+	Create(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// List lists the API keys of the given organization or application.
+	List(context.Context, *ListAPIKeyRequest) (*ListAPIKeyResponse, error)
+	// Delete revokes the API key matching the given id.
+	Delete(context.Context, *DeleteAPIKeyRequest) (*DeleteAPIKeyResponse, error)
+}
+
+func RegisterAPIKeyServiceServer(s *grpc.Server, srv APIKeyServiceServer) {
+	s.RegisterService(&_APIKeyService_serviceDesc, srv)
+}
+
+func _APIKeyService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIKeyService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).Create(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIKeyService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).List(ctx, req.(*ListAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIKeyService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIKeyServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.APIKeyService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIKeyServiceServer).Delete(ctx, req.(*DeleteAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _APIKeyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.APIKeyService",
+	HandlerType: (*APIKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _APIKeyService_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _APIKeyService_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _APIKeyService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "apiKey.proto",
+}
+
+func init() { proto.RegisterFile("apiKey.proto", fileDescriptor14) }
+
+var fileDescriptor14 = []byte{
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x14, 0x94, 0xed, 0xd4, 0x52, 0x5f, 0x48, 0x80, 0x97, 0x10, 0x5c, 0xab, 0x42, 0xd5, 0xf2, 0xa1,
+	0xa8, 0x88, 0x44, 0x0a, 0x17, 0xd4, 0x5b, 0xd4, 0x5e, 0xa2, 0x22, 0x84, 0xd2, 0x03, 0xe2, 0xb8,
+	0x24, 0xaf, 0xd1, 0xaa, 0x8e, 0x77, 0xf1, 0x6e, 0x10, 0x01, 0x71, 0xe1, 0x0f, 0x70, 0xe8, 0x85,
+	0xff, 0xc5, 0x5f, 0xe0, 0x87, 0x54, 0xde, 0xdd, 0xaa, 0x71, 0xe2, 0x43, 0x6f, 0xf6, 0xec, 0x78,
+	0xde, 0xcc, 0x3c, 0x2f, 0x3c, 0xe0, 0x4a, 0x9c, 0xd3, 0x7a, 0xa0, 0x0a, 0x69, 0x24, 0x46, 0x5c,
+	0x89, 0xf4, 0x70, 0x21, 0xe5, 0x22, 0xa3, 0x21, 0x57, 0x62, 0xc8, 0xf3, 0x5c, 0x1a, 0x6e, 0x84,
+	0xcc, 0xb5, 0xa3, 0xb0, 0xbf, 0x01, 0xc4, 0xe3, 0x8f, 0x93, 0x73, 0x5a, 0x63, 0x1b, 0x42, 0x31,
+	0x4f, 0x82, 0xa3, 0xa0, 0x1f, 0x4d, 0x43, 0x31, 0x47, 0x84, 0x46, 0xce, 0x97, 0x94, 0x84, 0x47,
+	0x41, 0x7f, 0x7f, 0x6a, 0x9f, 0xf1, 0x15, 0xb4, 0x65, 0xb1, 0xe0, 0xb9, 0xf8, 0x61, 0x55, 0x26,
+	0x67, 0x49, 0x64, 0xf9, 0x5b, 0x28, 0xbe, 0x80, 0x16, 0x57, 0x2a, 0x13, 0xb3, 0x5b, 0x5a, 0xc3,
+	0xd2, 0xaa, 0x20, 0x1e, 0xc2, 0x3e, 0x7d, 0x57, 0xa2, 0x20, 0x3d, 0x36, 0xc9, 0x9e, 0x1d, 0x73,
+	0x07, 0xb0, 0x13, 0xe8, 0x9c, 0x16, 0xc4, 0x0d, 0x39, 0x7f, 0x53, 0xfa, 0xba, 0x22, 0x6d, 0xf0,
+	0x39, 0xc4, 0x2e, 0xa4, 0xb5, 0xda, 0x1c, 0x35, 0x07, 0x5c, 0x89, 0x81, 0xe7, 0xf8, 0x23, 0xf6,
+	0x0e, 0xba, 0xd5, 0x6f, 0xb5, 0x92, 0xb9, 0xa6, 0x9d, 0x8c, 0x8f, 0x20, 0xba, 0xa2, 0xb5, 0x8f,
+	0x58, 0x3e, 0xb2, 0x3f, 0x01, 0x3c, 0x7e, 0x2f, 0xb4, 0xa9, 0x0e, 0xdd, 0xcd, 0x1d, 0xdc, 0x2f,
+	0x77, 0x58, 0x97, 0xbb, 0x0b, 0x7b, 0x99, 0x58, 0x0a, 0xe3, 0xcb, 0x73, 0x2f, 0xd8, 0x83, 0x58,
+	0x5e, 0x5e, 0x6a, 0x32, 0xbe, 0x2c, 0xff, 0xc6, 0x2e, 0xa0, 0xed, 0xcc, 0x94, 0xb6, 0x26, 0x86,
+	0x96, 0xf7, 0xaa, 0xa0, 0x2c, 0x77, 0x66, 0x2b, 0x98, 0x8f, 0x8d, 0x0f, 0x78, 0x07, 0x30, 0x0e,
+	0xb8, 0x99, 0xd2, 0xd7, 0xf3, 0x0c, 0xc0, 0x48, 0xc3, 0xb3, 0x53, 0xb9, 0xca, 0x8d, 0x8f, 0xb8,
+	0x81, 0xe0, 0x6b, 0x88, 0x0b, 0xd2, 0xab, 0xac, 0x14, 0x8c, 0xfa, 0xcd, 0x51, 0x67, 0x63, 0xf0,
+	0xad, 0xbb, 0xa9, 0xa7, 0xb0, 0x97, 0xd0, 0x39, 0xa3, 0x8c, 0xb6, 0xf7, 0xb7, 0xb5, 0x02, 0xd6,
+	0x83, 0x6e, 0x95, 0xe6, 0xbc, 0x8c, 0xae, 0x43, 0x68, 0x39, 0xe8, 0x82, 0x8a, 0x6f, 0x62, 0x46,
+	0xf8, 0x09, 0x62, 0xb7, 0x54, 0x4c, 0xec, 0xdc, 0x9a, 0xbf, 0x23, 0x3d, 0xa8, 0x39, 0x71, 0x82,
+	0x2c, 0xf9, 0xfd, 0xef, 0xff, 0x75, 0x88, 0xac, 0xe5, 0xae, 0x82, 0x12, 0x6f, 0xae, 0x68, 0xad,
+	0x4f, 0x82, 0x63, 0xfc, 0x00, 0x8d, 0xd2, 0x3d, 0xf6, 0xec, 0xc7, 0x3b, 0xdb, 0x4f, 0x9f, 0xee,
+	0xe0, 0x5e, 0xf2, 0x89, 0x95, 0x7c, 0x88, 0x55, 0x49, 0xfc, 0x0c, 0xb1, 0x8b, 0xe4, 0x8d, 0xd6,
+	0xd4, 0x90, 0x1e, 0xd4, 0x9c, 0x78, 0xd5, 0xd4, 0xaa, 0x76, 0x8f, 0xb1, 0xa2, 0x3a, 0xfc, 0x29,
+	0xe6, 0xbf, 0xbe, 0xc4, 0xf6, 0xda, 0xbe, 0xbd, 0x19, 0x00, 0x59, 0xf8, 0x8b, 0x19, 0xe9, 0x03,
+	0x00, 0x00,
+}
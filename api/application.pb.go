@@ -51,6 +51,8 @@ type CreateApplicationRequest struct {
 	PayloadEncoderScript string `protobuf:"bytes,17,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
 	// Payload decoder script.
 	PayloadDecoderScript string `protobuf:"bytes,18,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// Disable the integrations of the organization for this application.
+	DisableOrganizationIntegrations bool `protobuf:"varint,19,opt,name=disableOrganizationIntegrations" json:"disableOrganizationIntegrations,omitempty"`
 }
 
 func (m *CreateApplicationRequest) Reset()                    { *m = CreateApplicationRequest{} }
@@ -107,6 +109,13 @@ func (m *CreateApplicationRequest) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *CreateApplicationRequest) GetDisableOrganizationIntegrations() bool {
+	if m != nil {
+		return m.DisableOrganizationIntegrations
+	}
+	return false
+}
+
 type CreateApplicationResponse struct {
 	// ID of the application that was created.
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -158,6 +167,8 @@ type GetApplicationResponse struct {
 	PayloadEncoderScript string `protobuf:"bytes,17,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
 	// Payload decoder script.
 	PayloadDecoderScript string `protobuf:"bytes,18,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// Disable the integrations of the organization for this application.
+	DisableOrganizationIntegrations bool `protobuf:"varint,19,opt,name=disableOrganizationIntegrations" json:"disableOrganizationIntegrations,omitempty"`
 }
 
 func (m *GetApplicationResponse) Reset()                    { *m = GetApplicationResponse{} }
@@ -221,6 +232,13 @@ func (m *GetApplicationResponse) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *GetApplicationResponse) GetDisableOrganizationIntegrations() bool {
+	if m != nil {
+		return m.DisableOrganizationIntegrations
+	}
+	return false
+}
+
 type UpdateApplicationRequest struct {
 	// ID of the application to update.
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -236,6 +254,8 @@ type UpdateApplicationRequest struct {
 	PayloadEncoderScript string `protobuf:"bytes,17,opt,name=payloadEncoderScript" json:"payloadEncoderScript,omitempty"`
 	// Payload decoder script.
 	PayloadDecoderScript string `protobuf:"bytes,18,opt,name=payloadDecoderScript" json:"payloadDecoderScript,omitempty"`
+	// Disable the integrations of the organization for this application.
+	DisableOrganizationIntegrations bool `protobuf:"varint,19,opt,name=disableOrganizationIntegrations" json:"disableOrganizationIntegrations,omitempty"`
 }
 
 func (m *UpdateApplicationRequest) Reset()                    { *m = UpdateApplicationRequest{} }
@@ -292,6 +312,13 @@ func (m *UpdateApplicationRequest) GetPayloadDecoderScript() string {
 	return ""
 }
 
+func (m *UpdateApplicationRequest) GetDisableOrganizationIntegrations() bool {
+	if m != nil {
+		return m.DisableOrganizationIntegrations
+	}
+	return false
+}
+
 type UpdateApplicationResponse struct {
 }
 
@@ -483,7 +510,8 @@ func (m *HTTPIntegrationHeader) GetValue() string {
 }
 
 type HTTPIntegration struct {
-	// The id of the application.
+	// The id of the application (or organization for the organization
+	// integrations).
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 	// The headers to use when making HTTP callbacks.
 	Headers []*HTTPIntegrationHeader `protobuf:"bytes,2,rep,name=headers" json:"headers,omitempty"`
@@ -545,7 +573,8 @@ func (m *HTTPIntegration) GetErrorNotificationURL() string {
 }
 
 type GetHTTPIntegrationRequest struct {
-	// The id of the application.
+	// The id of the application (or organization for the organization
+	// integrations).
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 }
 
@@ -562,7 +591,8 @@ func (m *GetHTTPIntegrationRequest) GetId() int64 {
 }
 
 type DeleteIntegrationRequest struct {
-	// The id of the application.
+	// The id of the application (or organization for the organization
+	// integrations).
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 }
 
@@ -579,7 +609,8 @@ func (m *DeleteIntegrationRequest) GetId() int64 {
 }
 
 type ListIntegrationRequest struct {
-	// The id of the application.
+	// The id of the application (or organization for the organization
+	// integrations).
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
 }
 
@@ -596,7 +627,7 @@ func (m *ListIntegrationRequest) GetId() int64 {
 }
 
 type ListIntegrationResponse struct {
-	// The integration kinds associated with the application.
+	// The integration kinds associated with the application (or organization).
 	Kinds []IntegrationKind `protobuf:"varint,1,rep,packed,name=kinds,enum=api.IntegrationKind" json:"kinds,omitempty"`
 }
 
@@ -612,6 +643,325 @@ func (m *ListIntegrationResponse) GetKinds() []IntegrationKind {
 	return nil
 }
 
+type StreamApplicationEventsRequest struct {
+	// The id of the application.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Hex encoded DevEUI (optional). When set, only the events of this
+	// device are streamed.
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *StreamApplicationEventsRequest) Reset()                    { *m = StreamApplicationEventsRequest{} }
+func (m *StreamApplicationEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*StreamApplicationEventsRequest) ProtoMessage()               {}
+func (*StreamApplicationEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{18} }
+
+func (m *StreamApplicationEventsRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StreamApplicationEventsRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type StreamApplicationEventsResponse struct {
+	// Type of the event (uplink, join, ack, error or status).
+	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+	// JSON encoded payload of the event (as published by the integrations).
+	PayloadJSON string `protobuf:"bytes,3,opt,name=payloadJSON" json:"payloadJSON,omitempty"`
+}
+
+func (m *StreamApplicationEventsResponse) Reset()         { *m = StreamApplicationEventsResponse{} }
+func (m *StreamApplicationEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamApplicationEventsResponse) ProtoMessage()    {}
+func (*StreamApplicationEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor1, []int{19}
+}
+
+func (m *StreamApplicationEventsResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StreamApplicationEventsResponse) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *StreamApplicationEventsResponse) GetPayloadJSON() string {
+	if m != nil {
+		return m.PayloadJSON
+	}
+	return ""
+}
+
+type ApplicationUserRequest struct {
+	// The application id.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The user's id.
+	UserID int64 `protobuf:"varint,2,opt,name=userID" json:"userID,omitempty"`
+	// The user can administrate the application.
+	IsAdmin bool `protobuf:"varint,3,opt,name=isAdmin" json:"isAdmin,omitempty"`
+	// The user can manage the devices (and device keys) of the application.
+	IsDeviceAdmin bool `protobuf:"varint,4,opt,name=isDeviceAdmin" json:"isDeviceAdmin,omitempty"`
+	// The user can manage the integrations of the application.
+	IsIntegrationAdmin bool `protobuf:"varint,5,opt,name=isIntegrationAdmin" json:"isIntegrationAdmin,omitempty"`
+	// The user has read-only access (without access to device keys).
+	IsViewer bool `protobuf:"varint,6,opt,name=isViewer" json:"isViewer,omitempty"`
+}
+
+func (m *ApplicationUserRequest) Reset()                    { *m = ApplicationUserRequest{} }
+func (m *ApplicationUserRequest) String() string            { return proto.CompactTextString(m) }
+func (*ApplicationUserRequest) ProtoMessage()               {}
+func (*ApplicationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{20} }
+
+func (m *ApplicationUserRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ApplicationUserRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *ApplicationUserRequest) GetIsAdmin() bool {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return false
+}
+
+func (m *ApplicationUserRequest) GetIsDeviceAdmin() bool {
+	if m != nil {
+		return m.IsDeviceAdmin
+	}
+	return false
+}
+
+func (m *ApplicationUserRequest) GetIsIntegrationAdmin() bool {
+	if m != nil {
+		return m.IsIntegrationAdmin
+	}
+	return false
+}
+
+func (m *ApplicationUserRequest) GetIsViewer() bool {
+	if m != nil {
+		return m.IsViewer
+	}
+	return false
+}
+
+type DeleteApplicationUserRequest struct {
+	// The application id.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The user's id.
+	UserID int64 `protobuf:"varint,2,opt,name=userID" json:"userID,omitempty"`
+}
+
+func (m *DeleteApplicationUserRequest) Reset()                    { *m = DeleteApplicationUserRequest{} }
+func (m *DeleteApplicationUserRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteApplicationUserRequest) ProtoMessage()               {}
+func (*DeleteApplicationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{21} }
+
+func (m *DeleteApplicationUserRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeleteApplicationUserRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+type ListApplicationUsersRequest struct {
+	// The application id.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Max number of users to return in the result-set.
+	Limit int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int32 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListApplicationUsersRequest) Reset()                    { *m = ListApplicationUsersRequest{} }
+func (m *ListApplicationUsersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListApplicationUsersRequest) ProtoMessage()               {}
+func (*ListApplicationUsersRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{22} }
+
+func (m *ListApplicationUsersRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ListApplicationUsersRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListApplicationUsersRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type GetApplicationUserRequest struct {
+	// The application id.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The user's id.
+	UserID int64 `protobuf:"varint,2,opt,name=userID" json:"userID,omitempty"`
+}
+
+func (m *GetApplicationUserRequest) Reset()                    { *m = GetApplicationUserRequest{} }
+func (m *GetApplicationUserRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetApplicationUserRequest) ProtoMessage()               {}
+func (*GetApplicationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{23} }
+
+func (m *GetApplicationUserRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetApplicationUserRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+type GetApplicationUserResponse struct {
+	// ID of the user.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Username of the user.
+	Username string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	// The user can administrate the application.
+	IsAdmin bool `protobuf:"varint,3,opt,name=isAdmin" json:"isAdmin,omitempty"`
+	// The user can manage the devices (and device keys) of the application.
+	IsDeviceAdmin bool `protobuf:"varint,4,opt,name=isDeviceAdmin" json:"isDeviceAdmin,omitempty"`
+	// The user can manage the integrations of the application.
+	IsIntegrationAdmin bool `protobuf:"varint,5,opt,name=isIntegrationAdmin" json:"isIntegrationAdmin,omitempty"`
+	// The user has read-only access (without access to device keys).
+	IsViewer bool `protobuf:"varint,6,opt,name=isViewer" json:"isViewer,omitempty"`
+	// When the user was added to the application.
+	CreatedAt string `protobuf:"bytes,7,opt,name=createdAt" json:"createdAt,omitempty"`
+	// When the user was last updated.
+	UpdatedAt string `protobuf:"bytes,8,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *GetApplicationUserResponse) Reset()                    { *m = GetApplicationUserResponse{} }
+func (m *GetApplicationUserResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetApplicationUserResponse) ProtoMessage()               {}
+func (*GetApplicationUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{24} }
+
+func (m *GetApplicationUserResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetApplicationUserResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetApplicationUserResponse) GetIsAdmin() bool {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return false
+}
+
+func (m *GetApplicationUserResponse) GetIsDeviceAdmin() bool {
+	if m != nil {
+		return m.IsDeviceAdmin
+	}
+	return false
+}
+
+func (m *GetApplicationUserResponse) GetIsIntegrationAdmin() bool {
+	if m != nil {
+		return m.IsIntegrationAdmin
+	}
+	return false
+}
+
+func (m *GetApplicationUserResponse) GetIsViewer() bool {
+	if m != nil {
+		return m.IsViewer
+	}
+	return false
+}
+
+func (m *GetApplicationUserResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetApplicationUserResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ListApplicationUsersResponse struct {
+	// The total number of users of the application.
+	TotalCount int32 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	// The users in the requested limit, offset range.
+	Result []*GetApplicationUserResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListApplicationUsersResponse) Reset()                    { *m = ListApplicationUsersResponse{} }
+func (m *ListApplicationUsersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListApplicationUsersResponse) ProtoMessage()               {}
+func (*ListApplicationUsersResponse) Descriptor() ([]byte, []int) { return fileDescriptor1, []int{25} }
+
+func (m *ListApplicationUsersResponse) GetTotalCount() int32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListApplicationUsersResponse) GetResult() []*GetApplicationUserResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CreateApplicationRequest)(nil), "api.CreateApplicationRequest")
 	proto.RegisterType((*CreateApplicationResponse)(nil), "api.CreateApplicationResponse")
@@ -631,6 +981,14 @@ func init() {
 	proto.RegisterType((*DeleteIntegrationRequest)(nil), "api.DeleteIntegrationRequest")
 	proto.RegisterType((*ListIntegrationRequest)(nil), "api.ListIntegrationRequest")
 	proto.RegisterType((*ListIntegrationResponse)(nil), "api.ListIntegrationResponse")
+	proto.RegisterType((*StreamApplicationEventsRequest)(nil), "api.StreamApplicationEventsRequest")
+	proto.RegisterType((*StreamApplicationEventsResponse)(nil), "api.StreamApplicationEventsResponse")
+	proto.RegisterType((*ApplicationUserRequest)(nil), "api.ApplicationUserRequest")
+	proto.RegisterType((*DeleteApplicationUserRequest)(nil), "api.DeleteApplicationUserRequest")
+	proto.RegisterType((*ListApplicationUsersRequest)(nil), "api.ListApplicationUsersRequest")
+	proto.RegisterType((*GetApplicationUserRequest)(nil), "api.GetApplicationUserRequest")
+	proto.RegisterType((*GetApplicationUserResponse)(nil), "api.GetApplicationUserResponse")
+	proto.RegisterType((*ListApplicationUsersResponse)(nil), "api.ListApplicationUsersResponse")
 	proto.RegisterEnum("api.IntegrationKind", IntegrationKind_name, IntegrationKind_value)
 }
 
@@ -665,6 +1023,19 @@ type ApplicationClient interface {
 	DeleteHTTPIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// ListIntegrations lists all configured integrations.
 	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
+	// StreamEvents streams the uplink, join, ack, error and status events of
+	// the given application (or a single device of the application).
+	StreamEvents(ctx context.Context, in *StreamApplicationEventsRequest, opts ...grpc.CallOption) (Application_StreamEventsClient, error)
+	// ListUsers lists the users of the application.
+	ListUsers(ctx context.Context, in *ListApplicationUsersRequest, opts ...grpc.CallOption) (*ListApplicationUsersResponse, error)
+	// GetUser returns the given application user.
+	GetUser(ctx context.Context, in *GetApplicationUserRequest, opts ...grpc.CallOption) (*GetApplicationUserResponse, error)
+	// AddUser adds the given user to the application.
+	AddUser(ctx context.Context, in *ApplicationUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateUser updates the given application user.
+	UpdateUser(ctx context.Context, in *ApplicationUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// DeleteUser deletes the given user from the application.
+	DeleteUser(ctx context.Context, in *DeleteApplicationUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 }
 
 type applicationClient struct {
@@ -765,6 +1136,83 @@ func (c *applicationClient) ListIntegrations(ctx context.Context, in *ListIntegr
 	return out, nil
 }
 
+func (c *applicationClient) StreamEvents(ctx context.Context, in *StreamApplicationEventsRequest, opts ...grpc.CallOption) (Application_StreamEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Application_serviceDesc.Streams[0], c.cc, "/api.Application/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Application_StreamEventsClient interface {
+	Recv() (*StreamApplicationEventsResponse, error)
+	grpc.ClientStream
+}
+
+type applicationStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *applicationStreamEventsClient) Recv() (*StreamApplicationEventsResponse, error) {
+	m := new(StreamApplicationEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *applicationClient) ListUsers(ctx context.Context, in *ListApplicationUsersRequest, opts ...grpc.CallOption) (*ListApplicationUsersResponse, error) {
+	out := new(ListApplicationUsersResponse)
+	err := grpc.Invoke(ctx, "/api.Application/ListUsers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) GetUser(ctx context.Context, in *GetApplicationUserRequest, opts ...grpc.CallOption) (*GetApplicationUserResponse, error) {
+	out := new(GetApplicationUserResponse)
+	err := grpc.Invoke(ctx, "/api.Application/GetUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) AddUser(ctx context.Context, in *ApplicationUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Application/AddUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) UpdateUser(ctx context.Context, in *ApplicationUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Application/UpdateUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationClient) DeleteUser(ctx context.Context, in *DeleteApplicationUserRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Application/DeleteUser", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Application service
 
 type ApplicationServer interface {
@@ -788,6 +1236,19 @@ type ApplicationServer interface {
 	DeleteHTTPIntegration(context.Context, *DeleteIntegrationRequest) (*EmptyResponse, error)
 	// ListIntegrations lists all configured integrations.
 	ListIntegrations(context.Context, *ListIntegrationRequest) (*ListIntegrationResponse, error)
+	// StreamEvents streams the uplink, join, ack, error and status events of
+	// the given application (or a single device of the application).
+	StreamEvents(*StreamApplicationEventsRequest, Application_StreamEventsServer) error
+	// ListUsers lists the users of the application.
+	ListUsers(context.Context, *ListApplicationUsersRequest) (*ListApplicationUsersResponse, error)
+	// GetUser returns the given application user.
+	GetUser(context.Context, *GetApplicationUserRequest) (*GetApplicationUserResponse, error)
+	// AddUser adds the given user to the application.
+	AddUser(context.Context, *ApplicationUserRequest) (*EmptyResponse, error)
+	// UpdateUser updates the given application user.
+	UpdateUser(context.Context, *ApplicationUserRequest) (*EmptyResponse, error)
+	// DeleteUser deletes the given user from the application.
+	DeleteUser(context.Context, *DeleteApplicationUserRequest) (*EmptyResponse, error)
 }
 
 func RegisterApplicationServer(s *grpc.Server, srv ApplicationServer) {
@@ -974,6 +1435,117 @@ func _Application_ListIntegrations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Application_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamApplicationEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServer).StreamEvents(m, &applicationStreamEventsServer{stream})
+}
+
+type Application_StreamEventsServer interface {
+	Send(*StreamApplicationEventsResponse) error
+	grpc.ServerStream
+}
+
+type applicationStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationStreamEventsServer) Send(m *StreamApplicationEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Application_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).ListUsers(ctx, req.(*ListApplicationUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).GetUser(ctx, req.(*GetApplicationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).AddUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/AddUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).AddUser(ctx, req.(*ApplicationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).UpdateUser(ctx, req.(*ApplicationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Application_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Application/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServer).DeleteUser(ctx, req.(*DeleteApplicationUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Application_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Application",
 	HandlerType: (*ApplicationServer)(nil),
@@ -1018,69 +1590,121 @@ var _Application_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListIntegrations",
 			Handler:    _Application_ListIntegrations_Handler,
 		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _Application_ListUsers_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _Application_GetUser_Handler,
+		},
+		{
+			MethodName: "AddUser",
+			Handler:    _Application_AddUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _Application_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _Application_DeleteUser_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _Application_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "application.proto",
 }
 
 func init() { proto.RegisterFile("application.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x27, 0x76, 0x1b, 0x76, 0x5f, 0xa1, 0x49, 0xa7, 0x49, 0x70, 0x9d, 0x10, 0x05, 0x23, 0x20,
-	0xf2, 0x8a, 0xa4, 0xca, 0x72, 0xe2, 0x82, 0x50, 0x53, 0x75, 0x23, 0x56, 0xab, 0x95, 0xd9, 0xde,
-	0x10, 0xd2, 0x10, 0x4f, 0xb2, 0xb3, 0x71, 0x3c, 0xc6, 0x9e, 0x54, 0x6a, 0x81, 0x0b, 0x57, 0x8e,
-	0x5c, 0xf8, 0x18, 0x7c, 0x02, 0xbe, 0x04, 0x1f, 0x80, 0x0b, 0x77, 0xbe, 0x02, 0x9a, 0x3f, 0x69,
-	0x1c, 0x67, 0x2c, 0x05, 0xc1, 0x01, 0xc4, 0x2d, 0x33, 0xef, 0xf7, 0xfe, 0xfe, 0xde, 0x7b, 0xe3,
-	0xc0, 0x09, 0x4e, 0x92, 0x88, 0x4e, 0x31, 0xa7, 0x2c, 0x1e, 0x24, 0x29, 0xe3, 0x0c, 0xd9, 0x38,
-	0xa1, 0x6e, 0x67, 0xce, 0xd8, 0x3c, 0x22, 0x43, 0x9c, 0xd0, 0x21, 0x8e, 0x63, 0xc6, 0x25, 0x22,
-	0x53, 0x10, 0xef, 0x67, 0x0b, 0x9c, 0x8b, 0x94, 0x60, 0x4e, 0x3e, 0xdd, 0xa8, 0x07, 0xe4, 0xeb,
-	0x15, 0xc9, 0x38, 0x42, 0x70, 0x10, 0xe3, 0x25, 0x71, 0x2a, 0xbd, 0x4a, 0xff, 0x61, 0x20, 0x7f,
-	0xa3, 0x1e, 0x1c, 0x85, 0x24, 0x9b, 0xa6, 0x34, 0x11, 0x48, 0xc7, 0x92, 0xa2, 0xfc, 0x15, 0x7a,
-	0x1f, 0x8e, 0x59, 0x3a, 0xc7, 0x31, 0xbd, 0x93, 0xc6, 0x26, 0x63, 0xe7, 0xb8, 0x57, 0xe9, 0xdb,
-	0x41, 0xe1, 0x16, 0xf9, 0x50, 0xcf, 0x48, 0x7a, 0x43, 0xa7, 0xe4, 0x79, 0xca, 0x66, 0x34, 0x22,
-	0x93, 0xb1, 0x53, 0x93, 0xe6, 0x76, 0xee, 0x91, 0x07, 0x6f, 0x24, 0xf8, 0x36, 0x62, 0x38, 0xbc,
-	0x60, 0x21, 0x99, 0x3a, 0x75, 0x89, 0xdb, 0xba, 0x43, 0x23, 0x68, 0xe8, 0xf3, 0x65, 0x3c, 0x65,
-	0x21, 0x49, 0x3f, 0x97, 0x21, 0x39, 0x27, 0x12, 0x6b, 0x94, 0xe5, 0x74, 0xc6, 0x24, 0xaf, 0x83,
-	0xb6, 0x74, 0xb6, 0x64, 0xde, 0x23, 0x38, 0x33, 0x54, 0x2c, 0x4b, 0x58, 0x9c, 0x11, 0x74, 0x0c,
-	0x16, 0x0d, 0x65, 0xc1, 0xec, 0xc0, 0xa2, 0xa1, 0xf7, 0x01, 0x34, 0xaf, 0x08, 0x37, 0xd4, 0xb6,
-	0x08, 0xfc, 0xc5, 0x82, 0x56, 0x11, 0x69, 0xb6, 0x79, 0x4f, 0x8b, 0x55, 0x4e, 0x8b, 0xfd, 0xff,
-	0xa3, 0xe5, 0x27, 0x0b, 0x9c, 0xeb, 0x24, 0x34, 0x77, 0xf2, 0x3f, 0x53, 0xc2, 0xff, 0x6a, 0x69,
-	0xda, 0x70, 0x66, 0xa8, 0x8c, 0xea, 0x2e, 0xcf, 0x07, 0x67, 0x4c, 0x22, 0xb2, 0x4f, 0xd9, 0x84,
-	0x21, 0x03, 0x56, 0x1b, 0x8a, 0xa1, 0xf5, 0x94, 0x66, 0xa6, 0x5e, 0x6f, 0xc0, 0x61, 0x44, 0x97,
-	0x94, 0x6b, 0x4b, 0xea, 0x80, 0x5a, 0x50, 0x65, 0xb3, 0x59, 0x46, 0xb8, 0x64, 0xc1, 0x0e, 0xf4,
-	0xc9, 0xd0, 0xa8, 0xb6, 0xa9, 0x51, 0xbd, 0xdf, 0x2a, 0x70, 0x9a, 0x73, 0x26, 0x7c, 0x4f, 0x38,
-	0x59, 0xfe, 0x8b, 0xc7, 0x65, 0x00, 0x68, 0xfb, 0xee, 0x99, 0x88, 0x4b, 0x75, 0x86, 0x41, 0xe2,
-	0x2d, 0xe0, 0xad, 0x9d, 0x8a, 0xea, 0x9d, 0xd0, 0x05, 0xe0, 0x8c, 0xe3, 0xe8, 0x82, 0xad, 0xe2,
-	0x75, 0x5d, 0x73, 0x37, 0xe8, 0x1c, 0xaa, 0x29, 0xc9, 0x56, 0x91, 0x28, 0xae, 0xdd, 0x3f, 0x1a,
-	0x39, 0x03, 0x9c, 0xd0, 0x81, 0xa1, 0x5c, 0x81, 0xc6, 0x79, 0x35, 0x78, 0xf3, 0x72, 0x99, 0xf0,
-	0xdb, 0x7b, 0x3e, 0x3f, 0x81, 0xe6, 0x93, 0x17, 0x2f, 0x9e, 0x4f, 0x62, 0x4e, 0xe6, 0xa9, 0xd4,
-	0x79, 0x42, 0x70, 0x48, 0x52, 0x54, 0x07, 0x7b, 0x41, 0x6e, 0xf5, 0xab, 0x20, 0x7e, 0x0a, 0x82,
-	0x6f, 0x70, 0xb4, 0x5a, 0xd7, 0x58, 0x1d, 0xbc, 0x1f, 0x2c, 0xa8, 0x15, 0x2c, 0xec, 0x90, 0xf3,
-	0x11, 0xbc, 0xfe, 0x52, 0x5a, 0xcd, 0x74, 0xa0, 0xae, 0x0c, 0xd4, 0xe8, 0x38, 0x58, 0x43, 0x51,
-	0x07, 0x1e, 0x86, 0x98, 0xe3, 0xeb, 0xe4, 0x3a, 0x78, 0xaa, 0xc9, 0xdb, 0x5c, 0xa0, 0x73, 0x38,
-	0x7d, 0xc5, 0x68, 0xfc, 0x8c, 0x71, 0x3a, 0xd3, 0xd9, 0x0a, 0xdc, 0x81, 0xc4, 0x99, 0x44, 0x82,
-	0x18, 0x3c, 0x5d, 0x14, 0x15, 0x0e, 0x15, 0x31, 0xbb, 0x12, 0x31, 0x84, 0x24, 0x4d, 0x59, 0x5a,
-	0xd4, 0xa8, 0xaa, 0x21, 0x34, 0xc9, 0xc4, 0xb3, 0x71, 0x45, 0x78, 0x21, 0xb1, 0xb2, 0x41, 0xbb,
-	0x1f, 0xca, 0x3d, 0xb0, 0x7d, 0x35, 0x77, 0x7b, 0x20, 0x2f, 0x55, 0x3f, 0x6d, 0x21, 0x75, 0x3f,
-	0xf9, 0x70, 0xb8, 0xa0, 0x71, 0x98, 0x39, 0x95, 0x9e, 0xdd, 0x3f, 0x1e, 0x35, 0x24, 0x0b, 0x39,
-	0xe0, 0x67, 0x34, 0x0e, 0x03, 0x05, 0xf1, 0xdb, 0x50, 0x2b, 0x48, 0xd0, 0x03, 0x38, 0x10, 0x99,
-	0xd5, 0x5f, 0x1b, 0xfd, 0xf1, 0x00, 0x8e, 0x72, 0x6d, 0x86, 0x08, 0x54, 0xd5, 0x6b, 0x89, 0xde,
-	0x96, 0x36, 0xcb, 0x3e, 0x36, 0xdc, 0x6e, 0x99, 0x58, 0xb7, 0x63, 0xe7, 0xfb, 0x5f, 0x7f, 0xff,
-	0xd1, 0x6a, 0x79, 0x27, 0xea, 0x4b, 0x66, 0x83, 0xc8, 0x3e, 0xae, 0xf8, 0xe8, 0x4b, 0xb0, 0xaf,
-	0x08, 0x47, 0xaa, 0x7b, 0x8c, 0x2f, 0xae, 0xdb, 0x36, 0xca, 0xb4, 0xf5, 0xae, 0xb4, 0xee, 0xa0,
-	0xd6, 0x8e, 0xf5, 0xe1, 0x37, 0x34, 0xfc, 0x0e, 0xbd, 0x82, 0xaa, 0x5a, 0xa1, 0x3a, 0x8d, 0xb2,
-	0x97, 0x46, 0xa7, 0x51, 0xbe, 0x6e, 0xdf, 0x91, 0x8e, 0xda, 0x6e, 0x89, 0x23, 0x91, 0xcb, 0x1c,
-	0xaa, 0x8a, 0x7c, 0xed, 0xab, 0x6c, 0x3d, 0x6b, 0x5f, 0xe5, 0x1b, 0x59, 0x27, 0xe5, 0x97, 0x25,
-	0xf5, 0x05, 0x1c, 0x88, 0x7e, 0x40, 0xaa, 0x32, 0xe6, 0xe5, 0xed, 0x76, 0xcc, 0x42, 0xed, 0xe2,
-	0x4c, 0xba, 0x38, 0x45, 0xbb, 0xac, 0xa0, 0x1b, 0x68, 0x2a, 0x36, 0x8b, 0x3b, 0xa0, 0x61, 0x1a,
-	0x71, 0x17, 0xc9, 0xdb, 0xed, 0x15, 0xf4, 0x58, 0x5a, 0xff, 0xd0, 0xeb, 0x9b, 0x13, 0x18, 0xd2,
-	0x8d, 0x7e, 0x36, 0x7c, 0xc9, 0x79, 0x22, 0xca, 0xf7, 0x2d, 0xa0, 0xdd, 0x41, 0x43, 0xdd, 0x35,
-	0xfb, 0xe6, 0x09, 0x74, 0x8d, 0x41, 0x79, 0xe7, 0x32, 0x00, 0x1f, 0xed, 0x1d, 0x80, 0xc8, 0x5a,
-	0x91, 0xff, 0xb7, 0xb3, 0x76, 0xff, 0x62, 0xd6, 0x4d, 0xd5, 0x08, 0x45, 0xbf, 0xf9, 0x1e, 0x32,
-	0xe4, 0x6d, 0x0a, 0x40, 0x67, 0xed, 0xef, 0x9f, 0xf5, 0x1d, 0xd4, 0x0b, 0x9b, 0x25, 0xcb, 0x75,
-	0x95, 0xc1, 0x6d, 0xc7, 0x2c, 0xd4, 0x01, 0x3c, 0x92, 0x01, 0xbc, 0x87, 0xde, 0xdd, 0x23, 0x80,
-	0xaf, 0xaa, 0xf2, 0x9f, 0xcc, 0xe3, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x18, 0x63, 0xc2,
-	0x01, 0x0d, 0x00, 0x00,
+	// 1301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0xff, 0xda, 0x6b, 0x1b, 0xe7, 0x25, 0x01, 0x32, 0x80, 0xb3, 0xac, 0x1d, 0x63, 0x36, 0xf0,
+	0xc5, 0x72, 0x14, 0x8c, 0x48, 0xa5, 0x4a, 0xbd, 0x54, 0x08, 0x53, 0xa0, 0x8d, 0x48, 0xb4, 0x84,
+	0x5e, 0x5a, 0x55, 0xda, 0x78, 0x07, 0x32, 0xc1, 0xde, 0xdd, 0xee, 0x8c, 0x1d, 0x91, 0x34, 0x97,
+	0x9c, 0x2a, 0xf5, 0x52, 0xa9, 0x7f, 0x49, 0xff, 0x8f, 0xde, 0xfa, 0x07, 0x54, 0xaa, 0xfa, 0x57,
+	0xf4, 0x54, 0xcd, 0x0f, 0x9b, 0xf5, 0x7a, 0xd6, 0xb8, 0xa4, 0x87, 0x1c, 0x72, 0xdb, 0x99, 0xf7,
+	0xe6, 0x7d, 0xde, 0xef, 0xf7, 0x6c, 0xb8, 0xe3, 0x86, 0x61, 0x87, 0xb4, 0x5d, 0x46, 0x02, 0x7f,
+	0x33, 0x8c, 0x02, 0x16, 0x20, 0xc3, 0x0d, 0x89, 0x55, 0x39, 0x0b, 0x82, 0xb3, 0x0e, 0x6e, 0xba,
+	0x21, 0x69, 0xba, 0xbe, 0x1f, 0x30, 0xc1, 0x41, 0x25, 0x8b, 0xfd, 0x77, 0x16, 0xcc, 0xdd, 0x08,
+	0xbb, 0x0c, 0xef, 0x5c, 0x3e, 0x77, 0xf0, 0xf7, 0x3d, 0x4c, 0x19, 0x42, 0x90, 0xf3, 0xdd, 0x2e,
+	0x36, 0x33, 0xb5, 0x4c, 0xfd, 0x86, 0x23, 0xbe, 0x51, 0x0d, 0x6e, 0x7a, 0x98, 0xb6, 0x23, 0x12,
+	0x72, 0x4e, 0x33, 0x2b, 0x48, 0xf1, 0x2b, 0xf4, 0x7f, 0x98, 0x0d, 0xa2, 0x33, 0xd7, 0x27, 0xaf,
+	0x85, 0xb0, 0xc3, 0x96, 0x39, 0x5b, 0xcb, 0xd4, 0x0d, 0x27, 0x71, 0x8b, 0x1a, 0x30, 0x4f, 0x71,
+	0xd4, 0x27, 0x6d, 0xfc, 0x34, 0x0a, 0x4e, 0x49, 0x07, 0x1f, 0xb6, 0xcc, 0x39, 0x21, 0x6e, 0xec,
+	0x1e, 0xd9, 0x70, 0x2b, 0x74, 0x2f, 0x3a, 0x81, 0xeb, 0xed, 0x06, 0x1e, 0x6e, 0x9b, 0xf3, 0x82,
+	0x6f, 0xe4, 0x0e, 0x6d, 0xc3, 0xa2, 0x3a, 0xef, 0xf9, 0xed, 0xc0, 0xc3, 0xd1, 0xb1, 0x50, 0xc9,
+	0xbc, 0x23, 0x78, 0xb5, 0xb4, 0xd8, 0x9b, 0x16, 0x8e, 0xbf, 0x41, 0x23, 0x6f, 0x46, 0x68, 0xe8,
+	0x00, 0x56, 0x3c, 0x42, 0xdd, 0xe7, 0x1d, 0xfc, 0x24, 0x6e, 0x90, 0xcf, 0xf0, 0x59, 0x24, 0x3e,
+	0xa9, 0xb9, 0x50, 0xcb, 0xd4, 0x8b, 0xce, 0x55, 0x6c, 0xf6, 0x03, 0x58, 0xd6, 0xf8, 0x9e, 0x86,
+	0x81, 0x4f, 0x31, 0x9a, 0x85, 0x2c, 0xf1, 0x84, 0xeb, 0x0d, 0x27, 0x4b, 0x3c, 0x7b, 0x03, 0x96,
+	0xf6, 0x31, 0xd3, 0x44, 0x29, 0xc9, 0xf8, 0xa3, 0x01, 0xa5, 0x24, 0xa7, 0x5e, 0xe6, 0x30, 0xc0,
+	0xd9, 0xf4, 0x00, 0x1b, 0x1f, 0x03, 0x7c, 0xfd, 0x00, 0xff, 0x99, 0x05, 0xf3, 0x24, 0xf4, 0xf4,
+	0xd5, 0xf5, 0xdf, 0x04, 0xe3, 0xa3, 0x93, 0xcb, 0xb0, 0xac, 0xf1, 0xb1, 0xcc, 0x78, 0xbb, 0x01,
+	0x66, 0x0b, 0x77, 0xf0, 0x34, 0x01, 0xe0, 0x82, 0x34, 0xbc, 0x4a, 0x90, 0x0f, 0xa5, 0xc7, 0x84,
+	0xea, 0xea, 0x6f, 0x11, 0xf2, 0x1d, 0xd2, 0x25, 0x4c, 0x49, 0x92, 0x07, 0x54, 0x82, 0x42, 0x70,
+	0x7a, 0x4a, 0x31, 0x13, 0xf1, 0x34, 0x1c, 0x75, 0xd2, 0x14, 0x8f, 0xa1, 0x2b, 0x1e, 0xfb, 0x8f,
+	0x0c, 0x2c, 0xc4, 0xc0, 0x38, 0xf6, 0x21, 0xc3, 0xdd, 0x0f, 0xb8, 0x84, 0x37, 0x01, 0x8d, 0xde,
+	0x1d, 0x71, 0xbd, 0x64, 0x8e, 0x69, 0x28, 0xf6, 0x39, 0xdc, 0x1d, 0xf3, 0xa8, 0xea, 0x53, 0x55,
+	0x00, 0x16, 0x30, 0xb7, 0xb3, 0x1b, 0xf4, 0xfc, 0x81, 0x5f, 0x63, 0x37, 0x68, 0x0b, 0x0a, 0x11,
+	0xa6, 0xbd, 0x0e, 0x77, 0xae, 0x51, 0xbf, 0xb9, 0x6d, 0x6e, 0xba, 0x21, 0xd9, 0xd4, 0xb8, 0xcb,
+	0x51, 0x7c, 0xf6, 0x1c, 0xdc, 0xde, 0xeb, 0x86, 0xec, 0x62, 0x18, 0xcf, 0xcf, 0x61, 0xe9, 0xe0,
+	0xd9, 0xb3, 0xa7, 0xb1, 0x4c, 0x3a, 0xc0, 0xae, 0x87, 0x23, 0x34, 0x0f, 0xc6, 0x39, 0xbe, 0x50,
+	0x33, 0x8f, 0x7f, 0xf2, 0x00, 0xf7, 0xdd, 0x4e, 0x6f, 0xe0, 0x63, 0x79, 0xb0, 0x7f, 0xca, 0xc2,
+	0x5c, 0x42, 0xc2, 0x58, 0x70, 0x3e, 0x81, 0x99, 0x17, 0x42, 0x2a, 0x55, 0x8a, 0x5a, 0x42, 0x51,
+	0x2d, 0xb0, 0x33, 0x60, 0x45, 0x15, 0xb8, 0xe1, 0xb9, 0xcc, 0x3d, 0x09, 0x4f, 0x9c, 0xc7, 0x2a,
+	0x78, 0x97, 0x17, 0x68, 0x0b, 0x16, 0x5e, 0x06, 0xc4, 0x3f, 0x0a, 0x18, 0x39, 0x55, 0xd6, 0x72,
+	0xbe, 0x9c, 0xe0, 0xd3, 0x91, 0x78, 0x60, 0xdc, 0xf6, 0x79, 0xf2, 0x41, 0x5e, 0x06, 0x66, 0x9c,
+	0xc2, 0xcb, 0x19, 0x47, 0x51, 0x10, 0x25, 0x5f, 0x14, 0x64, 0x39, 0xeb, 0x68, 0x7c, 0x94, 0xed,
+	0x63, 0x96, 0x30, 0x2c, 0xad, 0xd0, 0x86, 0x45, 0x39, 0x05, 0x6f, 0x5d, 0xd6, 0xdd, 0x14, 0x9c,
+	0x7b, 0x70, 0x77, 0x8c, 0x53, 0xe5, 0x53, 0x03, 0xf2, 0xe7, 0xc4, 0xf7, 0xa8, 0x99, 0xa9, 0x19,
+	0xf5, 0xd9, 0xed, 0x45, 0x11, 0x85, 0x18, 0xe3, 0x57, 0xc4, 0xf7, 0x1c, 0xc9, 0x62, 0x1f, 0x40,
+	0xf5, 0x98, 0x45, 0xd8, 0xed, 0xc6, 0xd2, 0x69, 0xaf, 0x8f, 0x7d, 0x46, 0xd3, 0x1a, 0x77, 0x09,
+	0x0a, 0x1e, 0xee, 0xef, 0x9d, 0x1c, 0xaa, 0x04, 0x51, 0x27, 0x3b, 0x80, 0x95, 0x54, 0x49, 0x4a,
+	0x31, 0x04, 0x39, 0x76, 0x11, 0x0e, 0x37, 0x2c, 0xfe, 0x9d, 0x26, 0x8e, 0x57, 0xb5, 0xea, 0xa4,
+	0x5f, 0x1e, 0x3f, 0x39, 0x1a, 0x54, 0x75, 0xec, 0xca, 0xfe, 0x2d, 0x03, 0xa5, 0x18, 0xd6, 0x09,
+	0xc5, 0xd1, 0x04, 0x9d, 0x7b, 0x14, 0x47, 0x87, 0xad, 0x41, 0x7b, 0x92, 0x27, 0x64, 0xc2, 0x0c,
+	0xa1, 0x3b, 0x5e, 0x97, 0xc8, 0xb6, 0x51, 0x74, 0x06, 0x47, 0xb4, 0x06, 0xb7, 0x09, 0x6d, 0x61,
+	0x5e, 0xc5, 0x92, 0x9e, 0x13, 0xf4, 0xd1, 0x4b, 0x9e, 0x6b, 0x84, 0xc6, 0x3c, 0x2b, 0x59, 0xf3,
+	0x82, 0x55, 0x43, 0x41, 0x16, 0x14, 0x09, 0xfd, 0x9a, 0xe0, 0x57, 0x38, 0x12, 0xf9, 0x55, 0x74,
+	0x86, 0x67, 0xfb, 0x0b, 0xa8, 0x8c, 0xf5, 0xe3, 0x6b, 0xd8, 0x64, 0x7f, 0x03, 0xe5, 0x44, 0xa3,
+	0xe1, 0x52, 0x52, 0xc3, 0x39, 0xec, 0xe7, 0x5c, 0x4a, 0x7e, 0xbc, 0x9f, 0x1b, 0xe2, 0x5a, 0x9d,
+	0xec, 0x5d, 0x91, 0xf8, 0xef, 0xa9, 0xe1, 0xcf, 0x59, 0xb0, 0x74, 0x52, 0x52, 0xd6, 0x36, 0x0b,
+	0x8a, 0xfc, 0x61, 0xac, 0xef, 0x0f, 0xcf, 0x1f, 0x52, 0x00, 0x79, 0x23, 0x6b, 0x8b, 0xfd, 0xd6,
+	0xdb, 0x61, 0xe6, 0x8c, 0x6c, 0x64, 0xc3, 0x0b, 0x4e, 0xed, 0x85, 0x9e, 0xa2, 0x16, 0x25, 0x75,
+	0x78, 0x61, 0xbf, 0x82, 0x8a, 0x3e, 0x68, 0xa9, 0x23, 0x22, 0x3f, 0x32, 0x22, 0x3e, 0x4d, 0x8c,
+	0x88, 0x15, 0x51, 0xf3, 0xe9, 0x4e, 0x1e, 0x4c, 0x8a, 0x46, 0x19, 0xe6, 0x12, 0x9d, 0x01, 0x15,
+	0x21, 0xc7, 0x3b, 0xdb, 0xfc, 0xff, 0xb6, 0x7f, 0x9d, 0x85, 0x9b, 0x31, 0x01, 0x08, 0x43, 0x41,
+	0x6e, 0xf0, 0xe8, 0x9e, 0x90, 0x9f, 0xf6, 0x53, 0xca, 0xaa, 0xa6, 0x91, 0xd5, 0x38, 0xaa, 0xbc,
+	0xfb, 0xfd, 0xaf, 0x5f, 0xb2, 0x25, 0xfb, 0x8e, 0xfc, 0x9d, 0x76, 0xc9, 0x41, 0x3f, 0xcb, 0x34,
+	0xd0, 0x77, 0x60, 0xec, 0x63, 0x86, 0x2c, 0x8d, 0x0d, 0x03, 0x80, 0xb2, 0x96, 0xa6, 0xa4, 0x57,
+	0x85, 0x74, 0x13, 0x95, 0xc6, 0xa4, 0x37, 0xdf, 0x10, 0xef, 0x2d, 0x7a, 0x09, 0x05, 0xb9, 0x42,
+	0x29, 0x33, 0xd2, 0x76, 0x56, 0xab, 0x9a, 0x46, 0x56, 0x40, 0xab, 0x02, 0xa8, 0x6c, 0xa5, 0x00,
+	0x71, 0x5b, 0xce, 0xa0, 0x20, 0xab, 0x5a, 0x61, 0xa5, 0xad, 0x67, 0x56, 0x35, 0x8d, 0x3c, 0x6a,
+	0x54, 0x23, 0xcd, 0xa8, 0x6f, 0x21, 0xc7, 0x33, 0x08, 0x49, 0xcf, 0xe8, 0x97, 0x37, 0xab, 0xa2,
+	0x27, 0x2a, 0x88, 0x65, 0x01, 0xb1, 0x80, 0xc6, 0xa3, 0x82, 0xfa, 0xb0, 0x24, 0xa3, 0x99, 0xdc,
+	0x01, 0x16, 0x75, 0x23, 0xde, 0x42, 0xe2, 0x76, 0x74, 0x05, 0x79, 0x24, 0xa4, 0x3f, 0xb4, 0xeb,
+	0x7a, 0x03, 0x9a, 0xe4, 0xf2, 0x3d, 0x6d, 0xbe, 0x60, 0x2c, 0xe4, 0xee, 0xfb, 0x01, 0xd0, 0xf8,
+	0xa0, 0x45, 0xd5, 0x41, 0xf4, 0xf5, 0x13, 0xd8, 0xd2, 0x2a, 0x65, 0x6f, 0x09, 0x05, 0x1a, 0x68,
+	0x6a, 0x05, 0xb8, 0xd5, 0x32, 0xf8, 0xef, 0x6d, 0xb5, 0xf5, 0x2f, 0xad, 0x5e, 0x92, 0x89, 0x90,
+	0xc4, 0x8d, 0xe7, 0x90, 0xc6, 0x6e, 0x9d, 0x02, 0xca, 0xea, 0xc6, 0xf4, 0x56, 0xbf, 0x86, 0xf9,
+	0xc4, 0x66, 0x41, 0x63, 0x59, 0xa5, 0x81, 0xad, 0xe8, 0x89, 0x4a, 0x81, 0x07, 0x42, 0x81, 0x75,
+	0x74, 0x7f, 0x0a, 0x05, 0xd0, 0xbb, 0x0c, 0xdc, 0x92, 0x5b, 0x84, 0x5c, 0x1d, 0xd0, 0x7d, 0x21,
+	0x7b, 0xf2, 0x8a, 0x62, 0xad, 0x4d, 0x66, 0x52, 0x8a, 0xac, 0x0b, 0x45, 0x56, 0xd0, 0xbd, 0x14,
+	0x45, 0xb0, 0x60, 0xdf, 0xca, 0xa0, 0x3e, 0xdc, 0xe0, 0xc6, 0x88, 0x0e, 0x8c, 0x6a, 0xba, 0x92,
+	0x89, 0x4f, 0x54, 0x6b, 0x75, 0x02, 0x87, 0x82, 0x5e, 0x13, 0xd0, 0x55, 0x54, 0x49, 0x81, 0xee,
+	0x09, 0xa8, 0x0b, 0x98, 0xd9, 0xc7, 0x02, 0xf6, 0x32, 0xc3, 0xf5, 0xa3, 0xd6, 0xba, 0xaa, 0xbf,
+	0xdb, 0x0f, 0x05, 0xe2, 0x06, 0x5a, 0x9f, 0x84, 0xd8, 0x7c, 0x23, 0x27, 0xf2, 0x5b, 0xd4, 0x86,
+	0x99, 0x1d, 0xcf, 0x13, 0xd0, 0xe5, 0xe4, 0xaf, 0x8b, 0x38, 0xae, 0x2e, 0xc3, 0x36, 0x04, 0xd4,
+	0xaa, 0x3d, 0xd1, 0x38, 0x9e, 0xd6, 0x01, 0x80, 0x2c, 0xa7, 0xeb, 0xe1, 0xa8, 0x4c, 0xb6, 0xa6,
+	0x33, 0x89, 0x03, 0x46, 0x00, 0xb2, 0x56, 0x04, 0xe0, 0xaa, 0xbe, 0xc3, 0x5e, 0x05, 0xab, 0x3c,
+	0xd9, 0x98, 0x0e, 0xf6, 0x79, 0x41, 0xfc, 0xd3, 0xf8, 0xe8, 0x9f, 0x01, 0x00, 0x19, 0xad, 0x21,
+	0x77, 0xa1, 0x14, 0x00, 0x00,
 }
@@ -0,0 +1,294 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: audit.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type AuditLogEntry struct {
+	// ID of the audit log entry.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Timestamp of the API call.
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Username of the user performing the API call (empty when an API key
+	// was used).
+	Username string `protobuf:"bytes,3,opt,name=username" json:"username,omitempty"`
+	// ID of the API key used for the API call.
+	ApiKeyID int64 `protobuf:"varint,4,opt,name=apiKeyID" json:"apiKeyID,omitempty"`
+	// ID of the organization to which the targeted objects belong.
+	OrganizationID int64 `protobuf:"varint,5,opt,name=organizationID" json:"organizationID,omitempty"`
+	// Full RPC method name (e.g. /api.Gateway/Delete).
+	Method string `protobuf:"bytes,6,opt,name=method" json:"method,omitempty"`
+	// IDs of the targeted objects (e.g. applicationID, devEUI).
+	Target map[string]string `protobuf:"bytes,7,rep,name=target" json:"target,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// IP address of the client.
+	RemoteAddr string `protobuf:"bytes,8,opt,name=remoteAddr" json:"remoteAddr,omitempty"`
+	// JSON encoded request, with secrets (passwords, keys, ...) redacted.
+	Request string `protobuf:"bytes,9,opt,name=request" json:"request,omitempty"`
+	// JSON encoded changes made by an Update call, as field => old and new
+	// value (e.g. {"device.name": {"old": "a", "new": "b"}}). The values of
+	// secrets are redacted. Empty for the other calls.
+	Changes string `protobuf:"bytes,10,opt,name=changes" json:"changes,omitempty"`
+}
+
+func (m *AuditLogEntry) Reset()                    { *m = AuditLogEntry{} }
+func (m *AuditLogEntry) String() string            { return proto.CompactTextString(m) }
+func (*AuditLogEntry) ProtoMessage()               {}
+func (*AuditLogEntry) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{0} }
+
+func (m *AuditLogEntry) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetApiKeyID() int64 {
+	if m != nil {
+		return m.ApiKeyID
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *AuditLogEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetTarget() map[string]string {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *AuditLogEntry) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *AuditLogEntry) GetChanges() string {
+	if m != nil {
+		return m.Changes
+	}
+	return ""
+}
+
+type ListAuditLogRequest struct {
+	// ID of the organization. When not set, the entries of all
+	// organizations are returned (global admin only).
+	OrganizationID int64 `protobuf:"varint,1,opt,name=organizationID" json:"organizationID,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListAuditLogRequest) Reset()                    { *m = ListAuditLogRequest{} }
+func (m *ListAuditLogRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListAuditLogRequest) ProtoMessage()               {}
+func (*ListAuditLogRequest) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{1} }
+
+func (m *ListAuditLogRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *ListAuditLogRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListAuditLogRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListAuditLogResponse struct {
+	// Total number of audit log entries.
+	TotalCount int64            `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*AuditLogEntry `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListAuditLogResponse) Reset()                    { *m = ListAuditLogResponse{} }
+func (m *ListAuditLogResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListAuditLogResponse) ProtoMessage()               {}
+func (*ListAuditLogResponse) Descriptor() ([]byte, []int) { return fileDescriptor15, []int{2} }
+
+func (m *ListAuditLogResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListAuditLogResponse) GetResult() []*AuditLogEntry {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AuditLogEntry)(nil), "api.AuditLogEntry")
+	proto.RegisterType((*ListAuditLogRequest)(nil), "api.ListAuditLogRequest")
+	proto.RegisterType((*ListAuditLogResponse)(nil), "api.ListAuditLogResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Audit service
+
+type AuditClient interface {
+	// List lists the audit log entries, most recent first.
+	List(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error)
+}
+
+type auditClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditClient(cc *grpc.ClientConn) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) List(ctx context.Context, in *ListAuditLogRequest, opts ...grpc.CallOption) (*ListAuditLogResponse, error) {
+	out := new(ListAuditLogResponse)
+	err := grpc.Invoke(ctx, "/api.Audit/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Audit service
+
+type AuditServer interface {
+	// List lists the audit log entries, most recent first.
+	List(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+}
+
+func RegisterAuditServer(s *grpc.Server, srv AuditServer) {
+	s.RegisterService(&_Audit_serviceDesc, srv)
+}
+
+func _Audit_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Audit/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).List(ctx, req.(*ListAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Audit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Audit_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "audit.proto",
+}
+
+func init() { proto.RegisterFile("audit.proto", fileDescriptor15) }
+
+var fileDescriptor15 = []byte{
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0xd5, 0xee, 0x36, 0xdb, 0x66, 0x22, 0x0a, 0x0c, 0x11, 0x98, 0xa8, 0xaa, 0xa2, 0x1c, 0x50,
+	0x84, 0x44, 0x2a, 0x15, 0x09, 0x01, 0xb7, 0x88, 0x72, 0xa8, 0xe8, 0x69, 0xd5, 0x2b, 0x07, 0xb7,
+	0x3b, 0xdd, 0x5a, 0xdd, 0xb5, 0x17, 0x7b, 0x16, 0x29, 0x1c, 0xf9, 0x05, 0x3e, 0x8d, 0x5f, 0xe0,
+	0x33, 0x38, 0x20, 0x7b, 0xdd, 0x92, 0x36, 0xb9, 0xf9, 0xcd, 0x7b, 0x9e, 0x37, 0x7e, 0x1e, 0x18,
+	0xc9, 0xae, 0x54, 0xbc, 0x68, 0xad, 0x61, 0x83, 0x99, 0x6c, 0xd5, 0xe4, 0xa0, 0x32, 0xa6, 0xaa,
+	0xe9, 0x48, 0xb6, 0xea, 0x48, 0x6a, 0x6d, 0x58, 0xb2, 0x32, 0xda, 0xf5, 0x92, 0xd9, 0xdf, 0x14,
+	0x1e, 0x2d, 0xfd, 0x95, 0x33, 0x53, 0x7d, 0xd6, 0x6c, 0x57, 0xb8, 0x0f, 0xa9, 0x2a, 0x45, 0x32,
+	0x4d, 0xe6, 0x59, 0x91, 0xaa, 0x12, 0x0f, 0x60, 0x78, 0x69, 0x49, 0x32, 0x95, 0x4b, 0x16, 0xe9,
+	0x34, 0x99, 0x0f, 0x8b, 0xff, 0x05, 0x9c, 0xc0, 0x5e, 0xe7, 0xc8, 0x6a, 0xd9, 0x90, 0xc8, 0x02,
+	0x79, 0x87, 0x3d, 0x27, 0x5b, 0xf5, 0x85, 0x56, 0xa7, 0x27, 0x62, 0x27, 0xf4, 0xbb, 0xc3, 0xf8,
+	0x0a, 0xf6, 0x8d, 0xad, 0xa4, 0x56, 0x3f, 0xc2, 0x38, 0xa7, 0x27, 0x62, 0x10, 0x14, 0x0f, 0xaa,
+	0xf8, 0x1c, 0xf2, 0x86, 0xf8, 0xda, 0x94, 0x22, 0x0f, 0xdd, 0x23, 0xc2, 0x77, 0x90, 0xb3, 0xb4,
+	0x15, 0xb1, 0xd8, 0x9d, 0x66, 0xf3, 0xd1, 0xf1, 0xe1, 0x42, 0xb6, 0x6a, 0x71, 0xef, 0x25, 0x8b,
+	0xf3, 0x20, 0x08, 0xe7, 0x22, 0xaa, 0xf1, 0x10, 0xc0, 0x52, 0x63, 0x98, 0x96, 0x65, 0x69, 0xc5,
+	0x5e, 0xe8, 0xb9, 0x56, 0x41, 0x01, 0xbb, 0x96, 0xbe, 0x75, 0xe4, 0x58, 0x0c, 0x03, 0x79, 0x0b,
+	0x3d, 0x73, 0x79, 0x2d, 0x75, 0x45, 0x4e, 0x40, 0xcf, 0x44, 0x38, 0xf9, 0x00, 0xa3, 0x35, 0x2b,
+	0x7c, 0x02, 0xd9, 0x0d, 0xad, 0x42, 0x82, 0xc3, 0xc2, 0x1f, 0x71, 0x0c, 0x83, 0xef, 0xb2, 0xee,
+	0x28, 0xc6, 0xd7, 0x83, 0x8f, 0xe9, 0xfb, 0x64, 0x76, 0x03, 0xcf, 0xce, 0x94, 0xe3, 0xdb, 0xb9,
+	0x8b, 0xe8, 0xb5, 0x99, 0x4e, 0xb2, 0x35, 0x9d, 0x31, 0x0c, 0x6a, 0xd5, 0xa8, 0xfe, 0x5f, 0xb2,
+	0xa2, 0x07, 0x3e, 0x33, 0x73, 0x75, 0xe5, 0x88, 0xc3, 0x8f, 0x64, 0x45, 0x44, 0xb3, 0x0b, 0x18,
+	0xdf, 0x37, 0x73, 0xad, 0xd1, 0x8e, 0x7c, 0x26, 0x6c, 0x58, 0xd6, 0x9f, 0x4c, 0xa7, 0x39, 0x3a,
+	0xad, 0x55, 0xf0, 0x35, 0xe4, 0x96, 0x5c, 0x57, 0x7b, 0x1b, 0x9f, 0x35, 0x6e, 0x66, 0x5d, 0x44,
+	0xc5, 0xf1, 0x57, 0x18, 0x04, 0x02, 0xcf, 0x61, 0xc7, 0x9b, 0xa1, 0x08, 0xe2, 0x2d, 0x8f, 0x9c,
+	0xbc, 0xdc, 0xc2, 0xf4, 0x13, 0xcd, 0x5e, 0xfc, 0xfc, 0xfd, 0xe7, 0x57, 0xfa, 0x14, 0x1f, 0xf7,
+	0x5b, 0xeb, 0xe9, 0x37, 0xb5, 0xa9, 0xdc, 0x45, 0x1e, 0xb6, 0xf6, 0xed, 0xbf, 0x01, 0x00, 0x12,
+	0x8e, 0xcc, 0x17, 0xe7, 0x02, 0x00, 0x00,
+}
@@ -16,6 +16,12 @@ It is generated from these files:
 	networkServer.proto
 	serviceProfile.proto
 	deviceProfile.proto
+	downlinkSchedule.proto
+	multicastGroup.proto
+	fuotaDeployment.proto
+	apiKey.proto
+	audit.proto
+	webhook.proto
 
 It has these top-level messages:
 	DeviceKeys
@@ -64,6 +70,14 @@ It has these top-level messages:
 	DeleteIntegrationRequest
 	ListIntegrationRequest
 	ListIntegrationResponse
+	StreamApplicationEventsRequest
+	StreamApplicationEventsResponse
+	ApplicationUserRequest
+	DeleteApplicationUserRequest
+	ListApplicationUsersRequest
+	GetApplicationUserRequest
+	GetApplicationUserResponse
+	ListApplicationUsersResponse
 	EnqueueDeviceQueueItemRequest
 	EnqueueDeviceQueueItemResponse
 	FlushDeviceQueueRequest
@@ -71,6 +85,11 @@ It has these top-level messages:
 	DeviceQueueItem
 	ListDeviceQueueItemsRequest
 	ListDeviceQueueItemsResponse
+	EnqueueBulkDeviceQueueItemRequest
+	EnqueueBulkDeviceQueueItemResponse
+	GetDeviceQueueBulkJobRequest
+	DeviceQueueBulkJobDevice
+	GetDeviceQueueBulkJobResponse
 	DataRate
 	UplinkTXInfo
 	UplinkRXInfo
@@ -83,6 +102,8 @@ It has these top-level messages:
 	ProfileSettings
 	LoginRequest
 	LoginResponse
+	LoginSecondFactorRequest
+	LoginSecondFactorResponse
 	ListUserRequest
 	UserRequest
 	AddUserResponse
@@ -96,6 +117,38 @@ It has these top-level messages:
 	UpdateUserPasswordRequest
 	BrandingRequest
 	BrandingResponse
+	OpenIDConnectSettingsRequest
+	OpenIDConnectSettingsResponse
+	OpenIDConnectLoginRequest
+	TOTPEnrollment
+	GetTOTPRequest
+	GetTOTPResponse
+	CreateTOTPRequest
+	EnableTOTPRequest
+	EnableTOTPResponse
+	DisableTOTPRequest
+	DisableTOTPResponse
+	LoginLockout
+	ListLoginLockoutsRequest
+	ListLoginLockoutsResponse
+	DeleteLoginLockoutRequest
+	RefreshTokenRequest
+	RefreshTokenResponse
+	LogoutRequest
+	LogoutResponse
+	UserSession
+	ListUserSessionsRequest
+	ListUserSessionsResponse
+	DeleteUserSessionRequest
+	DeleteUserSessionsRequest
+	RequestPasswordResetRequest
+	RequestPasswordResetResponse
+	ResetPasswordRequest
+	ResetPasswordResponse
+	VerifyEmailRequest
+	VerifyEmailResponse
+	AcceptOrganizationInvitationRequest
+	AcceptOrganizationInvitationResponse
 	CreateGatewayRequest
 	CreateGatewayResponse
 	GetGatewayRequest
@@ -139,6 +192,8 @@ It has these top-level messages:
 	ListOrganizationRequest
 	OrganizationRequest
 	GetOrganizationResponse
+	OrganizationQuota
+	OrganizationUsage
 	CreateOrganizationRequest
 	CreateOrganizationResponse
 	UpdateOrganizationRequest
@@ -150,6 +205,15 @@ It has these top-level messages:
 	GetOrganizationUserRequest
 	GetOrganizationUserResponse
 	ListOrganizationUsersResponse
+	CreateOrganizationInvitationRequest
+	CreateOrganizationInvitationResponse
+	OrganizationInvitationRequest
+	ListOrganizationInvitationsRequest
+	OrganizationInvitation
+	ListOrganizationInvitationsResponse
+	GetOrganizationUsageRequest
+	OrganizationUsageRecord
+	GetOrganizationUsageResponse
 	ServiceProfile
 	DeviceProfile
 	CreateNetworkServerRequest
@@ -184,6 +248,69 @@ It has these top-level messages:
 	ListDeviceProfileRequest
 	DeviceProfileMeta
 	ListDeviceProfileResponse
+	DownlinkScheduleItem
+	CreateDownlinkScheduleRequest
+	CreateDownlinkScheduleResponse
+	GetDownlinkScheduleRequest
+	GetDownlinkScheduleResponse
+	UpdateDownlinkScheduleRequest
+	UpdateDownlinkScheduleResponse
+	DeleteDownlinkScheduleRequest
+	DeleteDownlinkScheduleResponse
+	ListDownlinkScheduleRequest
+	ListDownlinkScheduleResponse
+	MulticastGroup
+	CreateMulticastGroupRequest
+	CreateMulticastGroupResponse
+	GetMulticastGroupRequest
+	GetMulticastGroupResponse
+	UpdateMulticastGroupRequest
+	UpdateMulticastGroupResponse
+	DeleteMulticastGroupRequest
+	DeleteMulticastGroupResponse
+	ListMulticastGroupRequest
+	ListMulticastGroupResponse
+	AddDeviceToMulticastGroupRequest
+	AddDeviceToMulticastGroupResponse
+	RemoveDeviceFromMulticastGroupRequest
+	RemoveDeviceFromMulticastGroupResponse
+	ListMulticastGroupDevicesRequest
+	MulticastGroupDevice
+	ListMulticastGroupDevicesResponse
+	EnqueueMulticastQueueItemRequest
+	EnqueueMulticastQueueItemResponse
+	FUOTADeployment
+	CreateFUOTADeploymentRequest
+	CreateFUOTADeploymentResponse
+	GetFUOTADeploymentRequest
+	GetFUOTADeploymentResponse
+	ListFUOTADeploymentRequest
+	ListFUOTADeploymentResponse
+	ListFUOTADeploymentDevicesRequest
+	FUOTADeploymentDevice
+	ListFUOTADeploymentDevicesResponse
+	APIKey
+	CreateAPIKeyRequest
+	CreateAPIKeyResponse
+	ListAPIKeyRequest
+	APIKeyListItem
+	ListAPIKeyResponse
+	DeleteAPIKeyRequest
+	DeleteAPIKeyResponse
+	AuditLogEntry
+	ListAuditLogRequest
+	ListAuditLogResponse
+	Webhook
+	CreateWebhookRequest
+	CreateWebhookResponse
+	GetWebhookRequest
+	GetWebhookResponse
+	UpdateWebhookRequest
+	UpdateWebhookResponse
+	DeleteWebhookRequest
+	DeleteWebhookResponse
+	ListWebhookRequest
+	ListWebhookResponse
 */
 package api
 
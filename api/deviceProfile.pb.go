@@ -26,6 +26,11 @@ type CreateDeviceProfileRequest struct {
 	OrganizationID int64 `protobuf:"varint,3,opt,name=organizationID" json:"organizationID,omitempty"`
 	// Network-server id of the device-profile.
 	NetworkServerID int64 `protobuf:"varint,4,opt,name=networkServerID" json:"networkServerID,omitempty"`
+	// Handle the Application Layer Clock Synchronization package (fPort 202)
+	// for the devices using this device-profile.
+	ClockSyncEnabled bool `protobuf:"varint,5,opt,name=clockSyncEnabled" json:"clockSyncEnabled,omitempty"`
+	// Forward the handled clock synchronization uplinks to the integrations.
+	ClockSyncForwardUplinks bool `protobuf:"varint,6,opt,name=clockSyncForwardUplinks" json:"clockSyncForwardUplinks,omitempty"`
 }
 
 func (m *CreateDeviceProfileRequest) Reset()                    { *m = CreateDeviceProfileRequest{} }
@@ -61,6 +66,20 @@ func (m *CreateDeviceProfileRequest) GetNetworkServerID() int64 {
 	return 0
 }
 
+func (m *CreateDeviceProfileRequest) GetClockSyncEnabled() bool {
+	if m != nil {
+		return m.ClockSyncEnabled
+	}
+	return false
+}
+
+func (m *CreateDeviceProfileRequest) GetClockSyncForwardUplinks() bool {
+	if m != nil {
+		return m.ClockSyncForwardUplinks
+	}
+	return false
+}
+
 type CreateDeviceProfileResponse struct {
 	// ID of the device-profile.
 	DeviceProfileID string `protobuf:"bytes,1,opt,name=deviceProfileID" json:"deviceProfileID,omitempty"`
@@ -107,6 +126,11 @@ type GetDeviceProfileResponse struct {
 	CreatedAt string `protobuf:"bytes,5,opt,name=createdAt" json:"createdAt,omitempty"`
 	// Timestamp when the record was last updated.
 	UpdatedAt string `protobuf:"bytes,6,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// Handle the Application Layer Clock Synchronization package (fPort 202)
+	// for the devices using this device-profile.
+	ClockSyncEnabled bool `protobuf:"varint,7,opt,name=clockSyncEnabled" json:"clockSyncEnabled,omitempty"`
+	// Forward the handled clock synchronization uplinks to the integrations.
+	ClockSyncForwardUplinks bool `protobuf:"varint,8,opt,name=clockSyncForwardUplinks" json:"clockSyncForwardUplinks,omitempty"`
 }
 
 func (m *GetDeviceProfileResponse) Reset()                    { *m = GetDeviceProfileResponse{} }
@@ -156,10 +180,29 @@ func (m *GetDeviceProfileResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (m *GetDeviceProfileResponse) GetClockSyncEnabled() bool {
+	if m != nil {
+		return m.ClockSyncEnabled
+	}
+	return false
+}
+
+func (m *GetDeviceProfileResponse) GetClockSyncForwardUplinks() bool {
+	if m != nil {
+		return m.ClockSyncForwardUplinks
+	}
+	return false
+}
+
 type UpdateDeviceProfileRequest struct {
 	DeviceProfile *DeviceProfile `protobuf:"bytes,1,opt,name=deviceProfile" json:"deviceProfile,omitempty"`
 	// Name of the device-profile.
 	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Handle the Application Layer Clock Synchronization package (fPort 202)
+	// for the devices using this device-profile.
+	ClockSyncEnabled bool `protobuf:"varint,3,opt,name=clockSyncEnabled" json:"clockSyncEnabled,omitempty"`
+	// Forward the handled clock synchronization uplinks to the integrations.
+	ClockSyncForwardUplinks bool `protobuf:"varint,4,opt,name=clockSyncForwardUplinks" json:"clockSyncForwardUplinks,omitempty"`
 }
 
 func (m *UpdateDeviceProfileRequest) Reset()                    { *m = UpdateDeviceProfileRequest{} }
@@ -181,6 +224,20 @@ func (m *UpdateDeviceProfileRequest) GetName() string {
 	return ""
 }
 
+func (m *UpdateDeviceProfileRequest) GetClockSyncEnabled() bool {
+	if m != nil {
+		return m.ClockSyncEnabled
+	}
+	return false
+}
+
+func (m *UpdateDeviceProfileRequest) GetClockSyncForwardUplinks() bool {
+	if m != nil {
+		return m.ClockSyncForwardUplinks
+	}
+	return false
+}
+
 type UpdateDeviceProfileResponse struct {
 }
 
@@ -576,42 +633,46 @@ var _DeviceProfileService_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("deviceProfile.proto", fileDescriptor10) }
 
 var fileDescriptor10 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0x67, 0x36, 0xdd, 0x40, 0xdf, 0xb2, 0x2b, 0x8e, 0xa5, 0x66, 0xd3, 0x76, 0xb7, 0x04, 0x59,
-	0xc2, 0x82, 0x29, 0x54, 0x0f, 0xea, 0x45, 0xa4, 0xc5, 0x52, 0x50, 0x90, 0x88, 0x1f, 0x60, 0x6c,
-	0x5f, 0xcb, 0xb8, 0xd9, 0x4c, 0x4c, 0xa6, 0x2b, 0xb8, 0x78, 0xf1, 0xec, 0x4d, 0xf0, 0xd3, 0xf8,
-	0x2d, 0xfc, 0x00, 0x5e, 0xbc, 0xf8, 0x05, 0x3c, 0x4b, 0x66, 0xa6, 0xba, 0xe9, 0x26, 0x4b, 0x2e,
-	0x82, 0x7b, 0xeb, 0xbc, 0xf7, 0xf2, 0x7e, 0xbf, 0xf7, 0x7b, 0x7f, 0x0a, 0xb7, 0xe6, 0x78, 0xc6,
-	0x67, 0xf8, 0x22, 0x15, 0x0b, 0x1e, 0x61, 0x90, 0xa4, 0x42, 0x0a, 0x6a, 0xb1, 0x84, 0xbb, 0xdd,
-	0xa5, 0x10, 0xcb, 0x08, 0x07, 0x2c, 0xe1, 0x03, 0x16, 0xc7, 0x42, 0x32, 0xc9, 0x45, 0x9c, 0xe9,
-	0x10, 0x77, 0x2f, 0xd1, 0x5f, 0x98, 0xb7, 0xf7, 0x95, 0x80, 0x3b, 0x4a, 0x91, 0x49, 0x1c, 0x5f,
-	0x4c, 0x18, 0xe2, 0xdb, 0x15, 0x66, 0x92, 0x3e, 0x80, 0xdd, 0x02, 0x90, 0x43, 0xfa, 0xc4, 0xdf,
-	0x19, 0xd2, 0x80, 0x25, 0x3c, 0x28, 0x7e, 0x51, 0x0c, 0xa4, 0x14, 0x1a, 0x31, 0x3b, 0x45, 0x67,
-	0xab, 0x4f, 0xfc, 0x66, 0xa8, 0x7e, 0xd3, 0x23, 0xd8, 0x13, 0xe9, 0x92, 0xc5, 0xfc, 0xbd, 0xe2,
-	0x34, 0x1d, 0x3b, 0x56, 0x9f, 0xf8, 0x56, 0xb8, 0x61, 0xa5, 0x3e, 0xdc, 0x88, 0x51, 0xbe, 0x13,
-	0xe9, 0xc9, 0x4b, 0x4c, 0xcf, 0x30, 0x9d, 0x8e, 0x9d, 0x86, 0x0a, 0xdc, 0x34, 0x7b, 0x13, 0xe8,
-	0x94, 0xb2, 0xcf, 0x12, 0x11, 0x67, 0x98, 0x27, 0x2a, 0xb0, 0x9a, 0x8e, 0x55, 0x01, 0xcd, 0x70,
-	0xd3, 0xec, 0x8d, 0xe0, 0xf6, 0x04, 0x65, 0xa9, 0x06, 0xf5, 0x93, 0xfc, 0x22, 0xe0, 0x5c, 0xce,
-	0x62, 0xb8, 0xfc, 0xe7, 0x52, 0xd2, 0x2e, 0x34, 0x67, 0x4a, 0xca, 0xf9, 0x13, 0xe9, 0x6c, 0x2b,
-	0xa8, 0xbf, 0x86, 0xdc, 0xbb, 0x4a, 0xe6, 0xc6, 0x6b, 0x6b, 0xef, 0x1f, 0x83, 0xf7, 0x06, 0xdc,
-	0x57, 0xea, 0xf1, 0xef, 0x87, 0xc8, 0xeb, 0x41, 0xa7, 0x14, 0x4b, 0xcb, 0xec, 0x3d, 0x05, 0x77,
-	0x8c, 0x11, 0x56, 0x50, 0xa9, 0xdf, 0xcb, 0x1e, 0x74, 0x4a, 0xf3, 0x18, 0x98, 0x2f, 0x04, 0x9c,
-	0x67, 0x3c, 0x2b, 0x9f, 0x98, 0x16, 0x6c, 0x47, 0xfc, 0x94, 0x4b, 0x95, 0xdb, 0x0a, 0xf5, 0x83,
-	0xb6, 0xc1, 0x16, 0x8b, 0x45, 0x86, 0x52, 0x95, 0x63, 0x85, 0xe6, 0x55, 0xbb, 0x95, 0x77, 0x60,
-	0x97, 0x25, 0x49, 0xc4, 0x67, 0xeb, 0x30, 0xdd, 0xc8, 0xa2, 0xd1, 0xfb, 0x4e, 0xe0, 0x66, 0x81,
-	0xd4, 0x73, 0x94, 0xac, 0x7e, 0xdd, 0xd7, 0x60, 0xd8, 0x4e, 0x60, 0xbf, 0x44, 0x79, 0xb3, 0x65,
-	0x07, 0x00, 0x52, 0x48, 0x16, 0x8d, 0xc4, 0x2a, 0x5e, 0xeb, 0x7f, 0xc1, 0x42, 0x03, 0xb0, 0x53,
-	0xcc, 0x56, 0x51, 0xde, 0x04, 0xcb, 0xdf, 0x19, 0xb6, 0x2f, 0x0f, 0x61, 0x2e, 0x58, 0x68, 0xa2,
-	0x86, 0x3f, 0x1b, 0xd0, 0x2a, 0x78, 0xf3, 0x12, 0xf8, 0x0c, 0x69, 0x04, 0xb6, 0xbe, 0x3c, 0xf4,
-	0x50, 0xa5, 0xa8, 0x3e, 0xa2, 0x6e, 0xbf, 0x3a, 0xc0, 0x4c, 0xd3, 0xe1, 0xc7, 0x6f, 0x3f, 0x3e,
-	0x6f, 0xed, 0x7b, 0x2d, 0x75, 0xb5, 0x75, 0x4b, 0xee, 0xae, 0x2f, 0xf5, 0x23, 0x72, 0x4c, 0x53,
-	0xb0, 0x26, 0x28, 0x69, 0x57, 0x65, 0xaa, 0x38, 0x54, 0x6e, 0xaf, 0xc2, 0x6b, 0x40, 0x02, 0x05,
-	0xe2, 0xd3, 0xa3, 0x32, 0x90, 0xc1, 0xf9, 0xc6, 0x20, 0x7c, 0xa0, 0x9f, 0x08, 0xd8, 0x7a, 0xd3,
-	0x4c, 0x89, 0xd5, 0x2b, 0x6e, 0x4a, 0xbc, 0x6a, 0x2f, 0x1f, 0x2b, 0xf4, 0x87, 0xee, 0xfd, 0x1a,
-	0xe8, 0xc1, 0x26, 0x97, 0x5c, 0x82, 0x73, 0xb0, 0xf5, 0x42, 0x1a, 0x36, 0xd5, 0x5b, 0x6e, 0xd8,
-	0x5c, 0xb5, 0xbe, 0x46, 0x8b, 0xe3, 0xba, 0x5a, 0xcc, 0xa0, 0x91, 0xcf, 0x1c, 0xd5, 0x12, 0x57,
-	0x2d, 0xbe, 0x7b, 0x50, 0xe5, 0x36, 0xb0, 0x5d, 0x05, 0xdb, 0xa6, 0xa5, 0x7d, 0x7e, 0x6d, 0xab,
-	0xbf, 0xe4, 0x7b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x63, 0xb6, 0x83, 0xdc, 0x07, 0x00,
-	0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xc1, 0x6a, 0xdb, 0x4c,
+	0x10, 0x46, 0x96, 0xa3, 0x3f, 0x9e, 0x90, 0xfc, 0xed, 0x36, 0x24, 0x8a, 0x62, 0x27, 0x42, 0x94,
+	0x20, 0x0c, 0x95, 0xc1, 0xed, 0x21, 0xed, 0xa5, 0x14, 0xbb, 0x31, 0x86, 0x16, 0x8a, 0x42, 0x1e,
+	0x60, 0x23, 0xaf, 0xcd, 0x62, 0x45, 0xab, 0x4a, 0xeb, 0x84, 0x36, 0xf4, 0xd2, 0x73, 0x6f, 0x85,
+	0x3e, 0x40, 0x9f, 0xa8, 0xd0, 0x6b, 0xa1, 0x97, 0x5e, 0xfa, 0x16, 0x45, 0xab, 0x75, 0x1a, 0xd9,
+	0xda, 0xa0, 0x5c, 0x4a, 0x6e, 0xd6, 0xcc, 0xec, 0x7c, 0x33, 0xdf, 0x7c, 0xb3, 0x6b, 0x78, 0x30,
+	0x22, 0xe7, 0x34, 0x20, 0x6f, 0x12, 0x36, 0xa6, 0x21, 0xf1, 0xe2, 0x84, 0x71, 0x86, 0x74, 0x1c,
+	0x53, 0xab, 0x39, 0x61, 0x6c, 0x12, 0x92, 0x0e, 0x8e, 0x69, 0x07, 0x47, 0x11, 0xe3, 0x98, 0x53,
+	0x16, 0xa5, 0x79, 0x88, 0xb5, 0x11, 0xe7, 0x27, 0xe4, 0xb7, 0xf3, 0xb5, 0x06, 0x56, 0x2f, 0x21,
+	0x98, 0x93, 0xfe, 0xf5, 0x84, 0x3e, 0x79, 0x3b, 0x23, 0x29, 0x47, 0x87, 0xb0, 0x5e, 0x00, 0x32,
+	0x35, 0x5b, 0x73, 0xd7, 0xba, 0xc8, 0xc3, 0x31, 0xf5, 0x8a, 0x27, 0x8a, 0x81, 0x08, 0x41, 0x3d,
+	0xc2, 0x67, 0xc4, 0xac, 0xd9, 0x9a, 0xdb, 0xf0, 0xc5, 0x6f, 0x74, 0x00, 0x1b, 0x2c, 0x99, 0xe0,
+	0x88, 0xbe, 0x17, 0x35, 0x0d, 0xfb, 0xa6, 0x6e, 0x6b, 0xae, 0xee, 0x2f, 0x58, 0x91, 0x0b, 0xff,
+	0x47, 0x84, 0x5f, 0xb0, 0x64, 0x7a, 0x4c, 0x92, 0x73, 0x92, 0x0c, 0xfb, 0x66, 0x5d, 0x04, 0x2e,
+	0x9a, 0x51, 0x1b, 0xee, 0x05, 0x21, 0x0b, 0xa6, 0xc7, 0xef, 0xa2, 0xe0, 0x65, 0x84, 0x4f, 0x43,
+	0x32, 0x32, 0x57, 0x6c, 0xcd, 0x5d, 0xf5, 0x97, 0xec, 0xe8, 0x10, 0xb6, 0xaf, 0x6c, 0x47, 0x2c,
+	0xb9, 0xc0, 0xc9, 0xe8, 0x24, 0x0e, 0x69, 0x34, 0x4d, 0x4d, 0x43, 0x1c, 0x51, 0xb9, 0x9d, 0x01,
+	0xec, 0x96, 0x72, 0x94, 0xc6, 0x2c, 0x4a, 0x49, 0x56, 0x6e, 0xa1, 0xf7, 0x61, 0x5f, 0xd0, 0xd4,
+	0xf0, 0x17, 0xcd, 0x4e, 0x0f, 0xb6, 0x07, 0x84, 0x97, 0x32, 0x5d, 0x3d, 0xc9, 0x8f, 0x1a, 0x98,
+	0xcb, 0x59, 0x64, 0x2d, 0x77, 0x7d, 0x60, 0x4d, 0x68, 0x04, 0x82, 0xca, 0xd1, 0x0b, 0x2e, 0x26,
+	0xd5, 0xf0, 0xff, 0x1a, 0x32, 0xef, 0x2c, 0x1e, 0x49, 0xaf, 0x91, 0x7b, 0xaf, 0x0c, 0xa5, 0xc3,
+	0xfe, 0xef, 0xf6, 0xc3, 0x5e, 0xbd, 0x79, 0xd8, 0xdf, 0x34, 0xb0, 0x4e, 0x04, 0xe6, 0x3f, 0xd8,
+	0x88, 0xb2, 0x96, 0xf4, 0xdb, 0xb7, 0x54, 0xbf, 0xb9, 0xa5, 0x16, 0xec, 0x96, 0x76, 0x94, 0x6b,
+	0xc6, 0x39, 0x02, 0xab, 0x4f, 0x42, 0xa2, 0x68, 0xb8, 0xba, 0x30, 0x5b, 0xb0, 0x5b, 0x9a, 0x47,
+	0xc2, 0x7c, 0xd1, 0xc0, 0x7c, 0x45, 0xd3, 0x72, 0xf9, 0x6f, 0xc2, 0x4a, 0x48, 0xcf, 0x28, 0x17,
+	0xb9, 0x75, 0x3f, 0xff, 0x40, 0x5b, 0x60, 0xb0, 0xf1, 0x38, 0x25, 0x5c, 0x90, 0xa6, 0xfb, 0xf2,
+	0xab, 0xb2, 0x2e, 0x1f, 0xc2, 0x3a, 0x8e, 0xe3, 0x90, 0x06, 0xf3, 0xb0, 0x5c, 0x95, 0x45, 0xa3,
+	0xf3, 0x53, 0x83, 0xfb, 0x85, 0xa2, 0x5e, 0x13, 0x8e, 0xab, 0xf7, 0x7d, 0xf7, 0x37, 0xc7, 0x99,
+	0xc2, 0x4e, 0x09, 0xf3, 0xf2, 0xca, 0xd8, 0x03, 0xe0, 0x8c, 0xe3, 0xb0, 0xc7, 0x66, 0xd1, 0x9c,
+	0xff, 0x6b, 0x16, 0xe4, 0x81, 0x91, 0x90, 0x74, 0x16, 0x66, 0x43, 0xd0, 0xdd, 0xb5, 0xee, 0xd6,
+	0xb2, 0xd4, 0x33, 0xc2, 0x7c, 0x19, 0xd5, 0xfd, 0x5d, 0x87, 0xcd, 0x82, 0x37, 0x6b, 0x81, 0x06,
+	0x04, 0x85, 0x60, 0xe4, 0xd7, 0x28, 0xda, 0x17, 0x29, 0xd4, 0xef, 0x8e, 0x65, 0xab, 0x03, 0xa4,
+	0x9a, 0xf6, 0x3f, 0x7e, 0xff, 0xf5, 0xb9, 0xb6, 0xe3, 0x6c, 0x8a, 0x87, 0x2e, 0x1f, 0xc9, 0xa3,
+	0xf9, 0xe3, 0xf6, 0x4c, 0x6b, 0xa3, 0x04, 0xf4, 0x01, 0xe1, 0xa8, 0x29, 0x32, 0x29, 0x6e, 0x5d,
+	0xab, 0xa5, 0xf0, 0x4a, 0x10, 0x4f, 0x80, 0xb8, 0xe8, 0xa0, 0x0c, 0xa4, 0x73, 0xb9, 0x20, 0x84,
+	0x0f, 0xe8, 0x93, 0x06, 0x46, 0xbe, 0x69, 0xb2, 0x45, 0xf5, 0x45, 0x62, 0xd9, 0xea, 0x00, 0x89,
+	0xfe, 0x5c, 0xa0, 0x3f, 0xb5, 0x9e, 0x54, 0x40, 0xf7, 0x16, 0x6b, 0xc9, 0x28, 0xb8, 0x04, 0x23,
+	0x5f, 0x48, 0x59, 0x8d, 0x7a, 0xcb, 0x2d, 0x5b, 0x1d, 0x50, 0xe4, 0xa2, 0x5d, 0x95, 0x8b, 0x00,
+	0xea, 0x99, 0xe6, 0x50, 0x4e, 0xb1, 0x6a, 0xf1, 0xad, 0x3d, 0x95, 0x5b, 0xc2, 0x36, 0x05, 0xec,
+	0x16, 0x2a, 0x9d, 0xf3, 0xa9, 0x21, 0xfe, 0xc5, 0x3c, 0xfe, 0x33, 0x00, 0x03, 0xa9, 0x41, 0x49,
+	0x0f, 0x09, 0x00, 0x00,
 }
@@ -18,6 +18,35 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type DeviceQueueBulkJobDeviceStatus int32
+
+const (
+	// The payload has not yet been enqueued.
+	DeviceQueueBulkJobDeviceStatus_PENDING DeviceQueueBulkJobDeviceStatus = 0
+	// The payload has been enqueued.
+	DeviceQueueBulkJobDeviceStatus_QUEUED DeviceQueueBulkJobDeviceStatus = 1
+	// Enqueueing the payload failed.
+	DeviceQueueBulkJobDeviceStatus_FAILED DeviceQueueBulkJobDeviceStatus = 2
+)
+
+var DeviceQueueBulkJobDeviceStatus_name = map[int32]string{
+	0: "PENDING",
+	1: "QUEUED",
+	2: "FAILED",
+}
+var DeviceQueueBulkJobDeviceStatus_value = map[string]int32{
+	"PENDING": 0,
+	"QUEUED":  1,
+	"FAILED":  2,
+}
+
+func (x DeviceQueueBulkJobDeviceStatus) String() string {
+	return proto.EnumName(DeviceQueueBulkJobDeviceStatus_name, int32(x))
+}
+func (DeviceQueueBulkJobDeviceStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor2, []int{0}
+}
+
 type EnqueueDeviceQueueItemRequest struct {
 	// Hex encoded DevEUI of the node.
 	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
@@ -31,6 +60,15 @@ type EnqueueDeviceQueueItemRequest struct {
 	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 	// String containing a JSON object (to be enqueued by the application codec).
 	JsonObject string `protobuf:"bytes,6,opt,name=jsonObject" json:"jsonObject,omitempty"`
+	// Expiration time of the item (RFC3339, optional). When the item has
+	// not been sent by then, it is removed from the queue and a
+	// DOWNLINK_EXPIRED error notification is sent.
+	ExpiresAt string `protobuf:"bytes,7,opt,name=expiresAt" json:"expiresAt,omitempty"`
+	// Priority of the item. Items with a higher priority are sent first.
+	Priority uint32 `protobuf:"varint,8,opt,name=priority" json:"priority,omitempty"`
+	// Dedup key (optional). A pending item with the same key is replaced
+	// by this item.
+	DedupKey string `protobuf:"bytes,9,opt,name=dedupKey" json:"dedupKey,omitempty"`
 }
 
 func (m *EnqueueDeviceQueueItemRequest) Reset()                    { *m = EnqueueDeviceQueueItemRequest{} }
@@ -80,6 +118,27 @@ func (m *EnqueueDeviceQueueItemRequest) GetJsonObject() string {
 	return ""
 }
 
+func (m *EnqueueDeviceQueueItemRequest) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+func (m *EnqueueDeviceQueueItemRequest) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *EnqueueDeviceQueueItemRequest) GetDedupKey() string {
+	if m != nil {
+		return m.DedupKey
+	}
+	return ""
+}
+
 type EnqueueDeviceQueueItemResponse struct {
 }
 
@@ -126,6 +185,12 @@ type DeviceQueueItem struct {
 	Data []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
 	// FCnt of the queue item.
 	FCnt uint32 `protobuf:"varint,8,opt,name=fCnt" json:"fCnt,omitempty"`
+	// Expiration time of the item (RFC3339, when set).
+	ExpiresAt string `protobuf:"bytes,9,opt,name=expiresAt" json:"expiresAt,omitempty"`
+	// Priority of the item.
+	Priority uint32 `protobuf:"varint,10,opt,name=priority" json:"priority,omitempty"`
+	// Dedup key of the item.
+	DedupKey string `protobuf:"bytes,11,opt,name=dedupKey" json:"dedupKey,omitempty"`
 }
 
 func (m *DeviceQueueItem) Reset()                    { *m = DeviceQueueItem{} }
@@ -175,6 +240,27 @@ func (m *DeviceQueueItem) GetFCnt() uint32 {
 	return 0
 }
 
+func (m *DeviceQueueItem) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+func (m *DeviceQueueItem) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *DeviceQueueItem) GetDedupKey() string {
+	if m != nil {
+		return m.DedupKey
+	}
+	return ""
+}
+
 type ListDeviceQueueItemsRequest struct {
 	// Hex encoded DevEUI of the node.
 	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
@@ -208,6 +294,268 @@ func (m *ListDeviceQueueItemsResponse) GetItems() []*DeviceQueueItem {
 	return nil
 }
 
+type EnqueueBulkDeviceQueueItemRequest struct {
+	// ID of the application (all devices of the application are selected).
+	ApplicationID int64 `protobuf:"varint,1,opt,name=applicationID" json:"applicationID,omitempty"`
+	// ID of the device-profile (optional).
+	// When set, only the devices of the application using this device-profile
+	// are selected.
+	DeviceProfileID string `protobuf:"bytes,2,opt,name=deviceProfileID" json:"deviceProfileID,omitempty"`
+	// Random reference (used on ack notification).
+	Reference string `protobuf:"bytes,3,opt,name=reference" json:"reference,omitempty"`
+	// Is an ACK required from the devices.
+	Confirmed bool `protobuf:"varint,4,opt,name=confirmed" json:"confirmed,omitempty"`
+	// FPort used (must be >0)
+	FPort uint32 `protobuf:"varint,5,opt,name=fPort" json:"fPort,omitempty"`
+	// Base64 encoded data (or use the jsonObject when an application codec has been configured).
+	Data []byte `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// String containing a JSON object (to be enqueued by the application codec).
+	JsonObject string `protobuf:"bytes,7,opt,name=jsonObject" json:"jsonObject,omitempty"`
+}
+
+func (m *EnqueueBulkDeviceQueueItemRequest) Reset()         { *m = EnqueueBulkDeviceQueueItemRequest{} }
+func (m *EnqueueBulkDeviceQueueItemRequest) String() string { return proto.CompactTextString(m) }
+func (*EnqueueBulkDeviceQueueItemRequest) ProtoMessage()    {}
+func (*EnqueueBulkDeviceQueueItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor2, []int{7}
+}
+
+func (m *EnqueueBulkDeviceQueueItemRequest) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *EnqueueBulkDeviceQueueItemRequest) GetDeviceProfileID() string {
+	if m != nil {
+		return m.DeviceProfileID
+	}
+	return ""
+}
+
+func (m *EnqueueBulkDeviceQueueItemRequest) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *EnqueueBulkDeviceQueueItemRequest) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *EnqueueBulkDeviceQueueItemRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *EnqueueBulkDeviceQueueItemRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *EnqueueBulkDeviceQueueItemRequest) GetJsonObject() string {
+	if m != nil {
+		return m.JsonObject
+	}
+	return ""
+}
+
+type EnqueueBulkDeviceQueueItemResponse struct {
+	// ID of the bulk job.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *EnqueueBulkDeviceQueueItemResponse) Reset()         { *m = EnqueueBulkDeviceQueueItemResponse{} }
+func (m *EnqueueBulkDeviceQueueItemResponse) String() string { return proto.CompactTextString(m) }
+func (*EnqueueBulkDeviceQueueItemResponse) ProtoMessage()    {}
+func (*EnqueueBulkDeviceQueueItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor2, []int{8}
+}
+
+func (m *EnqueueBulkDeviceQueueItemResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDeviceQueueBulkJobRequest struct {
+	// ID of the bulk job.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Max number of devices to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the devices result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *GetDeviceQueueBulkJobRequest) Reset()                    { *m = GetDeviceQueueBulkJobRequest{} }
+func (m *GetDeviceQueueBulkJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDeviceQueueBulkJobRequest) ProtoMessage()               {}
+func (*GetDeviceQueueBulkJobRequest) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{9} }
+
+func (m *GetDeviceQueueBulkJobRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetDeviceQueueBulkJobRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *GetDeviceQueueBulkJobRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type DeviceQueueBulkJobDevice struct {
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Status of the device.
+	Status DeviceQueueBulkJobDeviceStatus `protobuf:"varint,2,opt,name=status,enum=api.DeviceQueueBulkJobDeviceStatus" json:"status,omitempty"`
+	// Error (in case of status FAILED).
+	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	// Timestamp when the status was last updated.
+	UpdatedAt string `protobuf:"bytes,4,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *DeviceQueueBulkJobDevice) Reset()                    { *m = DeviceQueueBulkJobDevice{} }
+func (m *DeviceQueueBulkJobDevice) String() string            { return proto.CompactTextString(m) }
+func (*DeviceQueueBulkJobDevice) ProtoMessage()               {}
+func (*DeviceQueueBulkJobDevice) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{10} }
+
+func (m *DeviceQueueBulkJobDevice) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *DeviceQueueBulkJobDevice) GetStatus() DeviceQueueBulkJobDeviceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DeviceQueueBulkJobDeviceStatus_PENDING
+}
+
+func (m *DeviceQueueBulkJobDevice) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DeviceQueueBulkJobDevice) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type GetDeviceQueueBulkJobResponse struct {
+	// ID of the bulk job.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,2,opt,name=applicationID" json:"applicationID,omitempty"`
+	// ID of the device-profile (when set).
+	DeviceProfileID string `protobuf:"bytes,3,opt,name=deviceProfileID" json:"deviceProfileID,omitempty"`
+	// Timestamp when the job was created.
+	CreatedAt string `protobuf:"bytes,4,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Total number of devices selected by the job.
+	TotalCount int64 `protobuf:"varint,5,opt,name=totalCount" json:"totalCount,omitempty"`
+	// Number of devices for which the payload has not yet been enqueued.
+	PendingCount int64 `protobuf:"varint,6,opt,name=pendingCount" json:"pendingCount,omitempty"`
+	// Number of devices for which the payload has been enqueued.
+	QueuedCount int64 `protobuf:"varint,7,opt,name=queuedCount" json:"queuedCount,omitempty"`
+	// Number of devices for which enqueueing the payload failed.
+	FailedCount int64 `protobuf:"varint,8,opt,name=failedCount" json:"failedCount,omitempty"`
+	// Devices and their status (see limit and offset).
+	Devices []*DeviceQueueBulkJobDevice `protobuf:"bytes,9,rep,name=devices" json:"devices,omitempty"`
+}
+
+func (m *GetDeviceQueueBulkJobResponse) Reset()                    { *m = GetDeviceQueueBulkJobResponse{} }
+func (m *GetDeviceQueueBulkJobResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDeviceQueueBulkJobResponse) ProtoMessage()               {}
+func (*GetDeviceQueueBulkJobResponse) Descriptor() ([]byte, []int) { return fileDescriptor2, []int{11} }
+
+func (m *GetDeviceQueueBulkJobResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetDeviceQueueBulkJobResponse) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *GetDeviceQueueBulkJobResponse) GetDeviceProfileID() string {
+	if m != nil {
+		return m.DeviceProfileID
+	}
+	return ""
+}
+
+func (m *GetDeviceQueueBulkJobResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetDeviceQueueBulkJobResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *GetDeviceQueueBulkJobResponse) GetPendingCount() int64 {
+	if m != nil {
+		return m.PendingCount
+	}
+	return 0
+}
+
+func (m *GetDeviceQueueBulkJobResponse) GetQueuedCount() int64 {
+	if m != nil {
+		return m.QueuedCount
+	}
+	return 0
+}
+
+func (m *GetDeviceQueueBulkJobResponse) GetFailedCount() int64 {
+	if m != nil {
+		return m.FailedCount
+	}
+	return 0
+}
+
+func (m *GetDeviceQueueBulkJobResponse) GetDevices() []*DeviceQueueBulkJobDevice {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EnqueueDeviceQueueItemRequest)(nil), "api.EnqueueDeviceQueueItemRequest")
 	proto.RegisterType((*EnqueueDeviceQueueItemResponse)(nil), "api.EnqueueDeviceQueueItemResponse")
@@ -216,6 +564,12 @@ func init() {
 	proto.RegisterType((*DeviceQueueItem)(nil), "api.DeviceQueueItem")
 	proto.RegisterType((*ListDeviceQueueItemsRequest)(nil), "api.ListDeviceQueueItemsRequest")
 	proto.RegisterType((*ListDeviceQueueItemsResponse)(nil), "api.ListDeviceQueueItemsResponse")
+	proto.RegisterType((*EnqueueBulkDeviceQueueItemRequest)(nil), "api.EnqueueBulkDeviceQueueItemRequest")
+	proto.RegisterType((*EnqueueBulkDeviceQueueItemResponse)(nil), "api.EnqueueBulkDeviceQueueItemResponse")
+	proto.RegisterType((*GetDeviceQueueBulkJobRequest)(nil), "api.GetDeviceQueueBulkJobRequest")
+	proto.RegisterType((*DeviceQueueBulkJobDevice)(nil), "api.DeviceQueueBulkJobDevice")
+	proto.RegisterType((*GetDeviceQueueBulkJobResponse)(nil), "api.GetDeviceQueueBulkJobResponse")
+	proto.RegisterEnum("api.DeviceQueueBulkJobDeviceStatus", DeviceQueueBulkJobDeviceStatus_name, DeviceQueueBulkJobDeviceStatus_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -235,6 +589,12 @@ type DeviceQueueClient interface {
 	Flush(ctx context.Context, in *FlushDeviceQueueRequest, opts ...grpc.CallOption) (*FlushDeviceQueueResponse, error)
 	// List lists the items in the device-queue.
 	List(ctx context.Context, in *ListDeviceQueueItemsRequest, opts ...grpc.CallOption) (*ListDeviceQueueItemsResponse, error)
+	// EnqueueBulk creates a job which adds the given item to the device-queue
+	// of all the devices matching the given selector. The job is processed
+	// in the background, use GetBulkJob to poll its progress.
+	EnqueueBulk(ctx context.Context, in *EnqueueBulkDeviceQueueItemRequest, opts ...grpc.CallOption) (*EnqueueBulkDeviceQueueItemResponse, error)
+	// GetBulkJob returns the progress of the given bulk job.
+	GetBulkJob(ctx context.Context, in *GetDeviceQueueBulkJobRequest, opts ...grpc.CallOption) (*GetDeviceQueueBulkJobResponse, error)
 }
 
 type deviceQueueClient struct {
@@ -272,6 +632,24 @@ func (c *deviceQueueClient) List(ctx context.Context, in *ListDeviceQueueItemsRe
 	return out, nil
 }
 
+func (c *deviceQueueClient) EnqueueBulk(ctx context.Context, in *EnqueueBulkDeviceQueueItemRequest, opts ...grpc.CallOption) (*EnqueueBulkDeviceQueueItemResponse, error) {
+	out := new(EnqueueBulkDeviceQueueItemResponse)
+	err := grpc.Invoke(ctx, "/api.DeviceQueue/EnqueueBulk", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceQueueClient) GetBulkJob(ctx context.Context, in *GetDeviceQueueBulkJobRequest, opts ...grpc.CallOption) (*GetDeviceQueueBulkJobResponse, error) {
+	out := new(GetDeviceQueueBulkJobResponse)
+	err := grpc.Invoke(ctx, "/api.DeviceQueue/GetBulkJob", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for DeviceQueue service
 
 type DeviceQueueServer interface {
@@ -281,6 +659,12 @@ type DeviceQueueServer interface {
 	Flush(context.Context, *FlushDeviceQueueRequest) (*FlushDeviceQueueResponse, error)
 	// List lists the items in the device-queue.
 	List(context.Context, *ListDeviceQueueItemsRequest) (*ListDeviceQueueItemsResponse, error)
+	// EnqueueBulk creates a job which adds the given item to the device-queue
+	// of all the devices matching the given selector. The job is processed
+	// in the background, use GetBulkJob to poll its progress.
+	EnqueueBulk(context.Context, *EnqueueBulkDeviceQueueItemRequest) (*EnqueueBulkDeviceQueueItemResponse, error)
+	// GetBulkJob returns the progress of the given bulk job.
+	GetBulkJob(context.Context, *GetDeviceQueueBulkJobRequest) (*GetDeviceQueueBulkJobResponse, error)
 }
 
 func RegisterDeviceQueueServer(s *grpc.Server, srv DeviceQueueServer) {
@@ -341,6 +725,42 @@ func _DeviceQueue_List_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceQueue_EnqueueBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueBulkDeviceQueueItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceQueueServer).EnqueueBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceQueue/EnqueueBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceQueueServer).EnqueueBulk(ctx, req.(*EnqueueBulkDeviceQueueItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceQueue_GetBulkJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceQueueBulkJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceQueueServer).GetBulkJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DeviceQueue/GetBulkJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceQueueServer).GetBulkJob(ctx, req.(*GetDeviceQueueBulkJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DeviceQueue_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.DeviceQueue",
 	HandlerType: (*DeviceQueueServer)(nil),
@@ -357,6 +777,14 @@ var _DeviceQueue_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _DeviceQueue_List_Handler,
 		},
+		{
+			MethodName: "EnqueueBulk",
+			Handler:    _DeviceQueue_EnqueueBulk_Handler,
+		},
+		{
+			MethodName: "GetBulkJob",
+			Handler:    _DeviceQueue_GetBulkJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deviceQueue.proto",
@@ -365,32 +793,59 @@ var _DeviceQueue_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("deviceQueue.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x51, 0x6e, 0xd4, 0x30,
-	0x10, 0x95, 0x77, 0xb3, 0xdb, 0x76, 0x0a, 0x42, 0x8c, 0x2a, 0xb0, 0xd2, 0x6c, 0x65, 0x52, 0x09,
-	0x45, 0xfb, 0xb1, 0x11, 0x45, 0xfc, 0xf0, 0x0b, 0x45, 0x2a, 0x42, 0x02, 0x22, 0x71, 0x80, 0x34,
-	0x99, 0x14, 0x57, 0xad, 0x9d, 0xc6, 0x4e, 0x3f, 0x40, 0xfc, 0x70, 0x05, 0x8e, 0xc0, 0x39, 0x38,
-	0x05, 0x57, 0x40, 0x9c, 0x03, 0xc5, 0x89, 0xb4, 0x61, 0xdb, 0xa4, 0x7f, 0xf6, 0xcc, 0x7b, 0xf3,
-	0x3c, 0x6f, 0xc6, 0xf0, 0x30, 0xa7, 0x6b, 0x99, 0xd1, 0xc7, 0x9a, 0x6a, 0x5a, 0x95, 0x95, 0xb6,
-	0x1a, 0xa7, 0x69, 0x29, 0xfd, 0xe0, 0x4c, 0xeb, 0xb3, 0x0b, 0x8a, 0xd3, 0x52, 0xc6, 0xa9, 0x52,
-	0xda, 0xa6, 0x56, 0x6a, 0x65, 0x5a, 0x48, 0xf8, 0x8b, 0xc1, 0xe2, 0x58, 0x5d, 0x35, 0xa4, 0xd7,
-	0x6b, 0xfe, 0x89, 0xa5, 0xcb, 0x84, 0xae, 0x6a, 0x32, 0x16, 0x1f, 0xc1, 0x3c, 0xa7, 0xeb, 0xe3,
-	0x4f, 0x27, 0x9c, 0x09, 0x16, 0xed, 0x24, 0xdd, 0x0d, 0x03, 0xd8, 0xa9, 0xa8, 0xa0, 0x8a, 0x54,
-	0x46, 0x7c, 0xe2, 0x52, 0xeb, 0x40, 0x93, 0xcd, 0xb4, 0x2a, 0x64, 0x75, 0x49, 0x39, 0x9f, 0x0a,
-	0x16, 0x6d, 0x27, 0xeb, 0x00, 0xee, 0xc1, 0xac, 0xf8, 0xa0, 0x2b, 0xcb, 0x3d, 0xc1, 0xa2, 0xfb,
-	0x49, 0x7b, 0x41, 0x04, 0x2f, 0x4f, 0x6d, 0xca, 0x67, 0x82, 0x45, 0xf7, 0x12, 0x77, 0xc6, 0x03,
-	0x80, 0x73, 0xa3, 0xd5, 0xfb, 0xd3, 0x73, 0xca, 0x2c, 0x9f, 0x3b, 0x99, 0x5e, 0x24, 0x14, 0x70,
-	0x30, 0xf4, 0x7c, 0x53, 0x6a, 0x65, 0x28, 0x7c, 0x06, 0x8f, 0xdf, 0x5c, 0xd4, 0xe6, 0x73, 0x2f,
-	0x7f, 0x47, 0x6b, 0xa1, 0x0f, 0xfc, 0x26, 0xa5, 0x2b, 0xf7, 0x93, 0xc1, 0x83, 0x0d, 0xa9, 0x5e,
-	0x9d, 0xc9, 0xb0, 0x45, 0xd3, 0x51, 0x8b, 0xbc, 0x41, 0x8b, 0xe6, 0xb7, 0x59, 0xb4, 0xd5, 0xb3,
-	0x08, 0xc1, 0x2b, 0x5e, 0x29, 0xcb, 0xb7, 0x1d, 0xd0, 0x9d, 0xc3, 0x17, 0xb0, 0xff, 0x4e, 0x1a,
-	0xbb, 0xf1, 0x50, 0x73, 0x57, 0xe3, 0x6f, 0x21, 0xb8, 0x9d, 0xd6, 0x36, 0x8f, 0x4b, 0x98, 0xc9,
-	0x26, 0xc0, 0x99, 0x98, 0x46, 0xbb, 0x47, 0x7b, 0xab, 0xb4, 0x94, 0xab, 0x4d, 0xe3, 0x5b, 0xc8,
-	0xd1, 0xdf, 0x09, 0xec, 0xf6, 0x52, 0xf8, 0x05, 0xb6, 0xba, 0x49, 0x61, 0xe8, 0x78, 0xa3, 0x6b,
-	0xe7, 0x1f, 0x8e, 0x62, 0xba, 0x61, 0x3c, 0xfd, 0xfe, 0xfb, 0xcf, 0x8f, 0x89, 0x08, 0xf7, 0xdd,
-	0x76, 0xb7, 0x1f, 0xc0, 0xc4, 0x5f, 0xdb, 0x6e, 0xbe, 0xc5, 0x8e, 0xfb, 0x92, 0x2d, 0x51, 0xc2,
-	0xcc, 0x0d, 0x14, 0x03, 0x57, 0x75, 0x60, 0x1f, 0xfc, 0xc5, 0x40, 0xb6, 0x53, 0x3b, 0x74, 0x6a,
-	0x8b, 0xe5, 0x98, 0x1a, 0x96, 0xe0, 0x35, 0x16, 0xa2, 0x70, 0xb5, 0x46, 0x86, 0xe0, 0x3f, 0x19,
-	0x41, 0xfc, 0xaf, 0x88, 0x63, 0x8a, 0xa7, 0x73, 0xf7, 0x93, 0x9f, 0xff, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0xbf, 0xca, 0x4f, 0x78, 0x01, 0x04, 0x00, 0x00,
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
+	0x14, 0xc6, 0x76, 0xe2, 0x24, 0x27, 0xf7, 0xa7, 0x8c, 0xae, 0xc0, 0xf2, 0x4d, 0x2a, 0xd7, 0x45,
+	0x6d, 0x14, 0xd1, 0x46, 0x04, 0x10, 0x12, 0xac, 0x4a, 0x93, 0x56, 0x29, 0x55, 0x69, 0x8d, 0xba,
+	0x63, 0xe3, 0xc4, 0xe3, 0x32, 0xad, 0xeb, 0x71, 0xed, 0x71, 0x45, 0xa9, 0xba, 0xe1, 0x11, 0xe8,
+	0x23, 0xf0, 0x48, 0xec, 0x59, 0xf1, 0x12, 0xb0, 0x42, 0x9e, 0x99, 0x26, 0x8e, 0x1b, 0xbb, 0x2c,
+	0xd8, 0x79, 0xbe, 0xf9, 0xce, 0x9c, 0x33, 0xdf, 0xf9, 0x19, 0xc3, 0x87, 0x1e, 0xbe, 0x25, 0x33,
+	0x7c, 0x96, 0xe2, 0x14, 0xef, 0x46, 0x31, 0x65, 0x14, 0x69, 0x6e, 0x44, 0xcc, 0xce, 0x05, 0xa5,
+	0x17, 0x01, 0x1e, 0xb8, 0x11, 0x19, 0xb8, 0x61, 0x48, 0x99, 0xcb, 0x08, 0x0d, 0x13, 0x41, 0xb1,
+	0x1f, 0x55, 0xe8, 0x8e, 0xc3, 0x9b, 0xcc, 0x68, 0xb4, 0xb0, 0x9f, 0x30, 0x7c, 0xed, 0xe0, 0x9b,
+	0x14, 0x27, 0x0c, 0x7d, 0x04, 0xba, 0x87, 0x6f, 0xc7, 0xe7, 0x13, 0x43, 0xb1, 0x94, 0x5e, 0xcb,
+	0x91, 0x2b, 0xd4, 0x81, 0x56, 0x8c, 0x7d, 0x1c, 0xe3, 0x70, 0x86, 0x0d, 0x95, 0x6f, 0x2d, 0x80,
+	0x6c, 0x77, 0x46, 0x43, 0x9f, 0xc4, 0xd7, 0xd8, 0x33, 0x34, 0x4b, 0xe9, 0x35, 0x9d, 0x05, 0x80,
+	0xde, 0x41, 0xdd, 0x3f, 0xa5, 0x31, 0x33, 0x6a, 0x96, 0xd2, 0x7b, 0xed, 0x88, 0x05, 0x42, 0x50,
+	0xf3, 0x5c, 0xe6, 0x1a, 0x75, 0x4b, 0xe9, 0xbd, 0x72, 0xf8, 0x37, 0x5a, 0x07, 0xb8, 0x4c, 0x68,
+	0xf8, 0xfd, 0xf4, 0x12, 0xcf, 0x98, 0xa1, 0x73, 0x37, 0x39, 0x24, 0xf3, 0x83, 0x7f, 0x8e, 0x48,
+	0x8c, 0x93, 0x3d, 0x66, 0x34, 0x44, 0x14, 0x73, 0x00, 0x99, 0xd0, 0x8c, 0x62, 0x42, 0x63, 0xc2,
+	0xee, 0x8c, 0x26, 0x77, 0x35, 0x5f, 0x67, 0x7b, 0x1e, 0xf6, 0xd2, 0xe8, 0x3b, 0x7c, 0x67, 0xb4,
+	0xb8, 0xe1, 0x7c, 0x6d, 0x5b, 0xb0, 0x5e, 0x26, 0x4a, 0x12, 0xd1, 0x30, 0xc1, 0xf6, 0x67, 0xf0,
+	0xf1, 0x41, 0x90, 0x26, 0x3f, 0xe5, 0xf6, 0x5f, 0x10, 0xcc, 0x36, 0xc1, 0x78, 0x6e, 0x22, 0x8f,
+	0xfb, 0x5b, 0x81, 0xb7, 0x05, 0x57, 0xb9, 0x73, 0xd4, 0x72, 0xe1, 0xb5, 0x4a, 0xe1, 0x6b, 0xa5,
+	0xc2, 0xeb, 0xab, 0x84, 0x6f, 0xe4, 0x84, 0x47, 0x50, 0xf3, 0xf7, 0x43, 0x26, 0x65, 0xe3, 0xdf,
+	0xcb, 0x62, 0xb7, 0xaa, 0xc4, 0x86, 0x0a, 0xb1, 0xdb, 0x05, 0xb1, 0xbf, 0x84, 0xf7, 0xc7, 0x24,
+	0x61, 0x85, 0xeb, 0x27, 0x2f, 0xc9, 0x79, 0x04, 0x9d, 0xd5, 0x66, 0x42, 0x52, 0xd4, 0x87, 0x3a,
+	0xc9, 0x00, 0x43, 0xb1, 0xb4, 0x5e, 0x7b, 0xf8, 0x6e, 0xd7, 0x8d, 0xc8, 0x6e, 0x31, 0x9d, 0x82,
+	0x92, 0xc9, 0xbf, 0x21, 0x13, 0xfe, 0x6d, 0x1a, 0x5c, 0x95, 0x74, 0xc2, 0x27, 0xf0, 0xda, 0x8d,
+	0xa2, 0x80, 0xcc, 0x78, 0x07, 0x4d, 0x46, 0x3c, 0x20, 0xcd, 0x59, 0x06, 0x51, 0x0f, 0xde, 0x8a,
+	0x4e, 0x3c, 0x8d, 0xa9, 0x4f, 0x02, 0x3c, 0x19, 0xc9, 0xfc, 0x15, 0xe1, 0xff, 0x27, 0x91, 0xf5,
+	0x55, 0x89, 0xd4, 0x4b, 0x3b, 0xa8, 0x51, 0xec, 0x20, 0xfb, 0x0b, 0xb0, 0xab, 0xae, 0x2e, 0xd5,
+	0x7c, 0x03, 0x2a, 0xf1, 0xe4, 0x85, 0x55, 0xe2, 0xd9, 0x3f, 0x42, 0xe7, 0x10, 0xe7, 0xc5, 0xcf,
+	0x8c, 0x8f, 0xe8, 0xf4, 0x49, 0xab, 0x02, 0x3f, 0x8b, 0x37, 0x20, 0xd7, 0x84, 0x71, 0x2d, 0x34,
+	0x47, 0x2c, 0xb2, 0xdc, 0x52, 0xdf, 0x4f, 0x30, 0xe3, 0xd7, 0xd7, 0x1c, 0xb9, 0xb2, 0x7f, 0x57,
+	0xc0, 0x78, 0x7e, 0xb6, 0x40, 0x4a, 0x07, 0xd2, 0x37, 0xa0, 0x27, 0xcc, 0x65, 0x69, 0xc2, 0x7d,
+	0xbc, 0x19, 0x6e, 0x16, 0x33, 0xbe, 0x74, 0xcc, 0x0f, 0x9c, 0xea, 0x48, 0x93, 0x2c, 0x3e, 0x1c,
+	0xc7, 0x34, 0x96, 0x79, 0x10, 0x8b, 0x2c, 0x07, 0x69, 0xe4, 0xb9, 0x0c, 0x7b, 0x7b, 0x62, 0x56,
+	0xb5, 0x9c, 0x05, 0x60, 0xff, 0xa9, 0x42, 0xb7, 0x44, 0x84, 0xd5, 0xaa, 0x3d, 0xaf, 0x20, 0xf5,
+	0x3f, 0x56, 0x90, 0x56, 0x5a, 0x41, 0xb3, 0x18, 0x2f, 0xc7, 0x37, 0x07, 0xb2, 0xcc, 0x33, 0xca,
+	0xdc, 0x60, 0x9f, 0xa6, 0xa1, 0x28, 0x14, 0xcd, 0xc9, 0x21, 0xc8, 0x86, 0x57, 0x11, 0x0e, 0x3d,
+	0x12, 0x5e, 0x08, 0x86, 0xce, 0x19, 0x4b, 0x18, 0xb2, 0xa0, 0xcd, 0x6b, 0xc3, 0x13, 0x94, 0x06,
+	0xa7, 0xe4, 0xa1, 0x8c, 0xe1, 0xbb, 0x24, 0x78, 0x62, 0x34, 0x05, 0x23, 0x07, 0xa1, 0xaf, 0xa0,
+	0x21, 0x02, 0x4f, 0x8c, 0x16, 0xef, 0xc5, 0x6e, 0x65, 0x66, 0x9c, 0x27, 0x76, 0x7f, 0x0c, 0xeb,
+	0xd5, 0xe9, 0x43, 0x6d, 0x68, 0x9c, 0x8e, 0x4f, 0x46, 0x93, 0x93, 0xc3, 0xb5, 0x0f, 0x10, 0x80,
+	0x7e, 0x76, 0x3e, 0x3e, 0x1f, 0x8f, 0xd6, 0x94, 0xec, 0xfb, 0x60, 0x6f, 0x72, 0x3c, 0x1e, 0xad,
+	0xa9, 0xc3, 0x7f, 0x6a, 0xd0, 0xce, 0x9d, 0x83, 0x7e, 0x81, 0x86, 0xac, 0x78, 0x64, 0xf3, 0x48,
+	0x2a, 0x1f, 0x40, 0x73, 0xb3, 0x92, 0x23, 0x07, 0xf8, 0xd6, 0xaf, 0x7f, 0xfc, 0xf5, 0xa8, 0x5a,
+	0xf6, 0x7b, 0xfe, 0xce, 0xca, 0x0b, 0x0c, 0xee, 0x45, 0x69, 0x3e, 0x0c, 0xb8, 0xed, 0xd7, 0x4a,
+	0x1f, 0x11, 0xa8, 0xf3, 0x47, 0x00, 0x75, 0xf8, 0xa9, 0x25, 0x6f, 0x88, 0xd9, 0x2d, 0xd9, 0x95,
+	0xde, 0x36, 0xb9, 0xb7, 0x6e, 0xbf, 0xca, 0x1b, 0x8a, 0xa0, 0x96, 0x0d, 0x48, 0x64, 0xf1, 0xb3,
+	0x2a, 0x46, 0xac, 0xb9, 0x51, 0xc1, 0x58, 0xf6, 0x88, 0x2a, 0x3d, 0xfe, 0xa6, 0x40, 0x3b, 0x37,
+	0x4b, 0xd0, 0x56, 0x5e, 0xb9, 0xf2, 0xc1, 0x6a, 0x6e, 0xbf, 0xc8, 0x93, 0x51, 0x0c, 0x79, 0x14,
+	0x9f, 0xda, 0xdb, 0xe2, 0x6f, 0x66, 0xd1, 0x35, 0xc9, 0xe0, 0x7e, 0xa9, 0x87, 0x72, 0x8a, 0x3f,
+	0x00, 0x1c, 0x62, 0x26, 0x8b, 0x07, 0x89, 0xab, 0x56, 0x8d, 0x2e, 0xd3, 0xae, 0xa2, 0xc8, 0x40,
+	0x7a, 0x3c, 0x10, 0x1b, 0x59, 0x39, 0x39, 0x76, 0xb8, 0xcb, 0x9d, 0x69, 0x1a, 0x5c, 0xed, 0x5c,
+	0xd2, 0x69, 0x32, 0xb8, 0x27, 0xde, 0xc3, 0x54, 0xe7, 0xff, 0x59, 0x9f, 0xff, 0x3b, 0x00, 0x5a,
+	0x12, 0xd1, 0x59, 0x9f, 0x09, 0x00, 0x00,
 }
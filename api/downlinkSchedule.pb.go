@@ -0,0 +1,610 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: downlinkSchedule.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type DownlinkScheduleItem struct {
+	// ID of the downlink schedule.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,2,opt,name=applicationID" json:"applicationID,omitempty"`
+	// ID of the device-profile (optional).
+	// When set, only the devices of the application using this
+	// device-profile are targeted.
+	DeviceProfileID string `protobuf:"bytes,3,opt,name=deviceProfileID" json:"deviceProfileID,omitempty"`
+	// Hex encoded DevEUI of the device (optional).
+	// When set, only this device is targeted.
+	DevEUI string `protobuf:"bytes,4,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Name of the schedule.
+	Name string `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	// Cron expression (UTC), e.g. "0 3 * * *" or "@daily".
+	Cron string `protobuf:"bytes,6,opt,name=cron" json:"cron,omitempty"`
+	// Is an ACK required from the device.
+	Confirmed bool `protobuf:"varint,7,opt,name=confirmed" json:"confirmed,omitempty"`
+	// FPort used (must be > 0).
+	FPort uint32 `protobuf:"varint,8,opt,name=fPort" json:"fPort,omitempty"`
+	// Base64 encoded data.
+	// Or use the json_object field when an application codec has been configured.
+	Data []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	// JSON object (string).
+	// Only use this when an application codec has been configured that can convert
+	// this object into binary form.
+	JsonObject string `protobuf:"bytes,10,opt,name=jsonObject" json:"jsonObject,omitempty"`
+}
+
+func (m *DownlinkScheduleItem) Reset()                    { *m = DownlinkScheduleItem{} }
+func (m *DownlinkScheduleItem) String() string            { return proto.CompactTextString(m) }
+func (*DownlinkScheduleItem) ProtoMessage()               {}
+func (*DownlinkScheduleItem) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{0} }
+
+func (m *DownlinkScheduleItem) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DownlinkScheduleItem) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *DownlinkScheduleItem) GetDeviceProfileID() string {
+	if m != nil {
+		return m.DeviceProfileID
+	}
+	return ""
+}
+
+func (m *DownlinkScheduleItem) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *DownlinkScheduleItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DownlinkScheduleItem) GetCron() string {
+	if m != nil {
+		return m.Cron
+	}
+	return ""
+}
+
+func (m *DownlinkScheduleItem) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *DownlinkScheduleItem) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *DownlinkScheduleItem) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DownlinkScheduleItem) GetJsonObject() string {
+	if m != nil {
+		return m.JsonObject
+	}
+	return ""
+}
+
+type CreateDownlinkScheduleRequest struct {
+	DownlinkSchedule *DownlinkScheduleItem `protobuf:"bytes,1,opt,name=downlinkSchedule" json:"downlinkSchedule,omitempty"`
+}
+
+func (m *CreateDownlinkScheduleRequest) Reset()                    { *m = CreateDownlinkScheduleRequest{} }
+func (m *CreateDownlinkScheduleRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateDownlinkScheduleRequest) ProtoMessage()               {}
+func (*CreateDownlinkScheduleRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{1} }
+
+func (m *CreateDownlinkScheduleRequest) GetDownlinkSchedule() *DownlinkScheduleItem {
+	if m != nil {
+		return m.DownlinkSchedule
+	}
+	return nil
+}
+
+type CreateDownlinkScheduleResponse struct {
+	// ID of the downlink schedule.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateDownlinkScheduleResponse) Reset()                    { *m = CreateDownlinkScheduleResponse{} }
+func (m *CreateDownlinkScheduleResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateDownlinkScheduleResponse) ProtoMessage()               {}
+func (*CreateDownlinkScheduleResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{2} }
+
+func (m *CreateDownlinkScheduleResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDownlinkScheduleRequest struct {
+	// ID of the downlink schedule.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetDownlinkScheduleRequest) Reset()                    { *m = GetDownlinkScheduleRequest{} }
+func (m *GetDownlinkScheduleRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDownlinkScheduleRequest) ProtoMessage()               {}
+func (*GetDownlinkScheduleRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{3} }
+
+func (m *GetDownlinkScheduleRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetDownlinkScheduleResponse struct {
+	DownlinkSchedule *DownlinkScheduleItem `protobuf:"bytes,1,opt,name=downlinkSchedule" json:"downlinkSchedule,omitempty"`
+	// Timestamp when the record was created.
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Timestamp when the record was last updated.
+	UpdatedAt string `protobuf:"bytes,3,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// Timestamp of the next run.
+	NextRunAt string `protobuf:"bytes,4,opt,name=nextRunAt" json:"nextRunAt,omitempty"`
+	// Timestamp of the last run (empty when not yet run).
+	LastRunAt string `protobuf:"bytes,5,opt,name=lastRunAt" json:"lastRunAt,omitempty"`
+}
+
+func (m *GetDownlinkScheduleResponse) Reset()                    { *m = GetDownlinkScheduleResponse{} }
+func (m *GetDownlinkScheduleResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDownlinkScheduleResponse) ProtoMessage()               {}
+func (*GetDownlinkScheduleResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{4} }
+
+func (m *GetDownlinkScheduleResponse) GetDownlinkSchedule() *DownlinkScheduleItem {
+	if m != nil {
+		return m.DownlinkSchedule
+	}
+	return nil
+}
+
+func (m *GetDownlinkScheduleResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetDownlinkScheduleResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *GetDownlinkScheduleResponse) GetNextRunAt() string {
+	if m != nil {
+		return m.NextRunAt
+	}
+	return ""
+}
+
+func (m *GetDownlinkScheduleResponse) GetLastRunAt() string {
+	if m != nil {
+		return m.LastRunAt
+	}
+	return ""
+}
+
+type UpdateDownlinkScheduleRequest struct {
+	DownlinkSchedule *DownlinkScheduleItem `protobuf:"bytes,1,opt,name=downlinkSchedule" json:"downlinkSchedule,omitempty"`
+}
+
+func (m *UpdateDownlinkScheduleRequest) Reset()                    { *m = UpdateDownlinkScheduleRequest{} }
+func (m *UpdateDownlinkScheduleRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateDownlinkScheduleRequest) ProtoMessage()               {}
+func (*UpdateDownlinkScheduleRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{5} }
+
+func (m *UpdateDownlinkScheduleRequest) GetDownlinkSchedule() *DownlinkScheduleItem {
+	if m != nil {
+		return m.DownlinkSchedule
+	}
+	return nil
+}
+
+type UpdateDownlinkScheduleResponse struct {
+}
+
+func (m *UpdateDownlinkScheduleResponse) Reset()                    { *m = UpdateDownlinkScheduleResponse{} }
+func (m *UpdateDownlinkScheduleResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateDownlinkScheduleResponse) ProtoMessage()               {}
+func (*UpdateDownlinkScheduleResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{6} }
+
+type DeleteDownlinkScheduleRequest struct {
+	// ID of the downlink schedule.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteDownlinkScheduleRequest) Reset()                    { *m = DeleteDownlinkScheduleRequest{} }
+func (m *DeleteDownlinkScheduleRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDownlinkScheduleRequest) ProtoMessage()               {}
+func (*DeleteDownlinkScheduleRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{7} }
+
+func (m *DeleteDownlinkScheduleRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteDownlinkScheduleResponse struct {
+}
+
+func (m *DeleteDownlinkScheduleResponse) Reset()                    { *m = DeleteDownlinkScheduleResponse{} }
+func (m *DeleteDownlinkScheduleResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteDownlinkScheduleResponse) ProtoMessage()               {}
+func (*DeleteDownlinkScheduleResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{8} }
+
+type ListDownlinkScheduleRequest struct {
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,1,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListDownlinkScheduleRequest) Reset()                    { *m = ListDownlinkScheduleRequest{} }
+func (m *ListDownlinkScheduleRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListDownlinkScheduleRequest) ProtoMessage()               {}
+func (*ListDownlinkScheduleRequest) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{9} }
+
+func (m *ListDownlinkScheduleRequest) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *ListDownlinkScheduleRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListDownlinkScheduleRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListDownlinkScheduleResponse struct {
+	// Total number of downlink schedules.
+	TotalCount int64                          `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*GetDownlinkScheduleResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListDownlinkScheduleResponse) Reset()                    { *m = ListDownlinkScheduleResponse{} }
+func (m *ListDownlinkScheduleResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListDownlinkScheduleResponse) ProtoMessage()               {}
+func (*ListDownlinkScheduleResponse) Descriptor() ([]byte, []int) { return fileDescriptor11, []int{10} }
+
+func (m *ListDownlinkScheduleResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListDownlinkScheduleResponse) GetResult() []*GetDownlinkScheduleResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DownlinkScheduleItem)(nil), "api.DownlinkScheduleItem")
+	proto.RegisterType((*CreateDownlinkScheduleRequest)(nil), "api.CreateDownlinkScheduleRequest")
+	proto.RegisterType((*CreateDownlinkScheduleResponse)(nil), "api.CreateDownlinkScheduleResponse")
+	proto.RegisterType((*GetDownlinkScheduleRequest)(nil), "api.GetDownlinkScheduleRequest")
+	proto.RegisterType((*GetDownlinkScheduleResponse)(nil), "api.GetDownlinkScheduleResponse")
+	proto.RegisterType((*UpdateDownlinkScheduleRequest)(nil), "api.UpdateDownlinkScheduleRequest")
+	proto.RegisterType((*UpdateDownlinkScheduleResponse)(nil), "api.UpdateDownlinkScheduleResponse")
+	proto.RegisterType((*DeleteDownlinkScheduleRequest)(nil), "api.DeleteDownlinkScheduleRequest")
+	proto.RegisterType((*DeleteDownlinkScheduleResponse)(nil), "api.DeleteDownlinkScheduleResponse")
+	proto.RegisterType((*ListDownlinkScheduleRequest)(nil), "api.ListDownlinkScheduleRequest")
+	proto.RegisterType((*ListDownlinkScheduleResponse)(nil), "api.ListDownlinkScheduleResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for DownlinkSchedule service
+
+type DownlinkScheduleClient interface {
+	// Create creates the given downlink schedule.
+	Create(ctx context.Context, in *CreateDownlinkScheduleRequest, opts ...grpc.CallOption) (*CreateDownlinkScheduleResponse, error)
+	// Get returns the downlink schedule matching the given id.
+	Get(ctx context.Context, in *GetDownlinkScheduleRequest, opts ...grpc.CallOption) (*GetDownlinkScheduleResponse, error)
+	// Update updates the given downlink schedule.
+	Update(ctx context.Context, in *UpdateDownlinkScheduleRequest, opts ...grpc.CallOption) (*UpdateDownlinkScheduleResponse, error)
+	// Delete deletes the downlink schedule matching the given id.
+	Delete(ctx context.Context, in *DeleteDownlinkScheduleRequest, opts ...grpc.CallOption) (*DeleteDownlinkScheduleResponse, error)
+	// List lists the downlink schedules of the given application.
+	List(ctx context.Context, in *ListDownlinkScheduleRequest, opts ...grpc.CallOption) (*ListDownlinkScheduleResponse, error)
+}
+
+type downlinkScheduleClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDownlinkScheduleClient(cc *grpc.ClientConn) DownlinkScheduleClient {
+	return &downlinkScheduleClient{cc}
+}
+
+func (c *downlinkScheduleClient) Create(ctx context.Context, in *CreateDownlinkScheduleRequest, opts ...grpc.CallOption) (*CreateDownlinkScheduleResponse, error) {
+	out := new(CreateDownlinkScheduleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkSchedule/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkScheduleClient) Get(ctx context.Context, in *GetDownlinkScheduleRequest, opts ...grpc.CallOption) (*GetDownlinkScheduleResponse, error) {
+	out := new(GetDownlinkScheduleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkSchedule/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkScheduleClient) Update(ctx context.Context, in *UpdateDownlinkScheduleRequest, opts ...grpc.CallOption) (*UpdateDownlinkScheduleResponse, error) {
+	out := new(UpdateDownlinkScheduleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkSchedule/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkScheduleClient) Delete(ctx context.Context, in *DeleteDownlinkScheduleRequest, opts ...grpc.CallOption) (*DeleteDownlinkScheduleResponse, error) {
+	out := new(DeleteDownlinkScheduleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkSchedule/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *downlinkScheduleClient) List(ctx context.Context, in *ListDownlinkScheduleRequest, opts ...grpc.CallOption) (*ListDownlinkScheduleResponse, error) {
+	out := new(ListDownlinkScheduleResponse)
+	err := grpc.Invoke(ctx, "/api.DownlinkSchedule/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DownlinkSchedule service
+
+type DownlinkScheduleServer interface {
+	// Create creates the given downlink schedule.
+	Create(context.Context, *CreateDownlinkScheduleRequest) (*CreateDownlinkScheduleResponse, error)
+	// Get returns the downlink schedule matching the given id.
+	Get(context.Context, *GetDownlinkScheduleRequest) (*GetDownlinkScheduleResponse, error)
+	// Update updates the given downlink schedule.
+	Update(context.Context, *UpdateDownlinkScheduleRequest) (*UpdateDownlinkScheduleResponse, error)
+	// Delete deletes the downlink schedule matching the given id.
+	Delete(context.Context, *DeleteDownlinkScheduleRequest) (*DeleteDownlinkScheduleResponse, error)
+	// List lists the downlink schedules of the given application.
+	List(context.Context, *ListDownlinkScheduleRequest) (*ListDownlinkScheduleResponse, error)
+}
+
+func RegisterDownlinkScheduleServer(s *grpc.Server, srv DownlinkScheduleServer) {
+	s.RegisterService(&_DownlinkSchedule_serviceDesc, srv)
+}
+
+func _DownlinkSchedule_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownlinkScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkScheduleServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkSchedule/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkScheduleServer).Create(ctx, req.(*CreateDownlinkScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkSchedule_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownlinkScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkScheduleServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkSchedule/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkScheduleServer).Get(ctx, req.(*GetDownlinkScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkSchedule_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDownlinkScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkScheduleServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkSchedule/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkScheduleServer).Update(ctx, req.(*UpdateDownlinkScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkSchedule_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDownlinkScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkScheduleServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkSchedule/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkScheduleServer).Delete(ctx, req.(*DeleteDownlinkScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DownlinkSchedule_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDownlinkScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DownlinkScheduleServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.DownlinkSchedule/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DownlinkScheduleServer).List(ctx, req.(*ListDownlinkScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DownlinkSchedule_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.DownlinkSchedule",
+	HandlerType: (*DownlinkScheduleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _DownlinkSchedule_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _DownlinkSchedule_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _DownlinkSchedule_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _DownlinkSchedule_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _DownlinkSchedule_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "downlinkSchedule.proto",
+}
+
+func init() { proto.RegisterFile("downlinkSchedule.proto", fileDescriptor11) }
+
+var fileDescriptor11 = []byte{
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0x95, 0x93, 0xc6, 0xaf, 0xb9, 0x7d, 0x85, 0x6a, 0x54, 0x55, 0xc6, 0x4d, 0x83, 0x71, 0xbb,
+	0xb0, 0x2a, 0xda, 0xa0, 0x80, 0x50, 0x85, 0xd8, 0x54, 0x4d, 0x55, 0x05, 0x21, 0x51, 0x19, 0xf5,
+	0x03, 0xdc, 0xf8, 0xa6, 0x4c, 0x71, 0x66, 0x5c, 0xcf, 0xa4, 0xad, 0x54, 0x75, 0xc3, 0x0e, 0xb1,
+	0x64, 0xcf, 0x86, 0xcf, 0xe0, 0x33, 0xd8, 0xb2, 0xe4, 0x43, 0x90, 0x67, 0x06, 0xa5, 0x75, 0x62,
+	0x97, 0x05, 0xec, 0x3c, 0xe7, 0x9e, 0x99, 0x33, 0x73, 0xee, 0xb9, 0x09, 0xac, 0xc4, 0xfc, 0x82,
+	0x25, 0x94, 0xbd, 0x7f, 0x3b, 0x78, 0x87, 0xf1, 0x38, 0xc1, 0xed, 0x34, 0xe3, 0x92, 0x93, 0x7a,
+	0x94, 0x52, 0xb7, 0x75, 0xc2, 0xf9, 0x49, 0x82, 0x9d, 0x28, 0xa5, 0x9d, 0x88, 0x31, 0x2e, 0x23,
+	0x49, 0x39, 0x13, 0x9a, 0xe2, 0x7f, 0xa9, 0xc1, 0x72, 0xaf, 0xb0, 0xbb, 0x2f, 0x71, 0x44, 0xee,
+	0x41, 0x8d, 0xc6, 0x8e, 0xe5, 0x59, 0x41, 0x3d, 0xac, 0xd1, 0x98, 0x6c, 0xc0, 0x62, 0x94, 0xa6,
+	0x09, 0x1d, 0xa8, 0xed, 0xfd, 0x9e, 0x53, 0x53, 0xa5, 0xdb, 0x20, 0x09, 0xe0, 0x7e, 0x8c, 0xe7,
+	0x74, 0x80, 0x87, 0x19, 0x1f, 0xd2, 0x04, 0xfb, 0x3d, 0xa7, 0xee, 0x59, 0x41, 0x33, 0x2c, 0xc2,
+	0x64, 0x05, 0xec, 0x18, 0xcf, 0xf7, 0x8f, 0xfa, 0xce, 0x9c, 0x22, 0x98, 0x15, 0x21, 0x30, 0xc7,
+	0xa2, 0x11, 0x3a, 0x0d, 0x85, 0xaa, 0xef, 0x1c, 0x1b, 0x64, 0x9c, 0x39, 0xb6, 0xc6, 0xf2, 0x6f,
+	0xd2, 0x82, 0xe6, 0x80, 0xb3, 0x21, 0xcd, 0x46, 0x18, 0x3b, 0xff, 0x79, 0x56, 0x30, 0x1f, 0x4e,
+	0x00, 0xb2, 0x0c, 0x8d, 0xe1, 0x21, 0xcf, 0xa4, 0x33, 0xef, 0x59, 0xc1, 0x62, 0xa8, 0x17, 0xf9,
+	0x39, 0x71, 0x24, 0x23, 0xa7, 0xe9, 0x59, 0xc1, 0xff, 0xa1, 0xfa, 0x26, 0x6d, 0x80, 0x53, 0xc1,
+	0xd9, 0x9b, 0xe3, 0x53, 0x1c, 0x48, 0x07, 0x94, 0xc2, 0x0d, 0xc4, 0x1f, 0xc2, 0xda, 0x5e, 0x86,
+	0x91, 0xc4, 0xa2, 0x4b, 0x21, 0x9e, 0x8d, 0x51, 0x48, 0xb2, 0x0f, 0x4b, 0x45, 0xfb, 0x95, 0x6d,
+	0x0b, 0xdd, 0x07, 0xdb, 0x51, 0x4a, 0xb7, 0x67, 0xb9, 0x1b, 0x4e, 0x6d, 0xf1, 0x9f, 0x40, 0xbb,
+	0x4c, 0x47, 0xa4, 0x9c, 0x09, 0x2c, 0x76, 0xc4, 0x7f, 0x0c, 0xee, 0x01, 0xca, 0xb2, 0x6b, 0x15,
+	0xd9, 0x3f, 0x2c, 0x58, 0x9d, 0x49, 0x37, 0xa7, 0xff, 0x9d, 0x67, 0xa8, 0xb6, 0xa8, 0x67, 0xc4,
+	0xbb, 0x52, 0x45, 0xa4, 0x19, 0x4e, 0x80, 0xbc, 0x3a, 0x4e, 0x63, 0x53, 0xd5, 0xc1, 0x98, 0x00,
+	0x79, 0x95, 0xe1, 0xa5, 0x0c, 0xc7, 0x6c, 0x57, 0x9a, 0x54, 0x4c, 0x80, 0xbc, 0x9a, 0x44, 0xc2,
+	0x54, 0x75, 0x3a, 0x26, 0x40, 0xde, 0xa6, 0x23, 0x75, 0xd0, 0x3f, 0x6e, 0x93, 0x07, 0xed, 0x32,
+	0x1d, 0x6d, 0xa4, 0xdf, 0x81, 0xb5, 0x1e, 0x26, 0x28, 0xf1, 0x4f, 0x3b, 0xe3, 0x41, 0xbb, 0x6c,
+	0x83, 0x39, 0xf2, 0x0c, 0x56, 0x5f, 0x53, 0x51, 0xda, 0xea, 0xa9, 0xd1, 0xb4, 0x66, 0x8d, 0xe6,
+	0x32, 0x34, 0x12, 0x3a, 0xa2, 0xd2, 0x0c, 0xae, 0x5e, 0xe4, 0x63, 0xc8, 0x87, 0x43, 0x81, 0xba,
+	0x1d, 0xf5, 0xd0, 0xac, 0xfc, 0x4b, 0x68, 0xcd, 0x96, 0x34, 0x71, 0x69, 0x03, 0x48, 0x2e, 0xa3,
+	0x64, 0x8f, 0x8f, 0x99, 0x34, 0x82, 0x37, 0x10, 0xb2, 0x03, 0x76, 0x86, 0x62, 0x9c, 0xe4, 0x72,
+	0xf5, 0x60, 0xa1, 0xeb, 0x29, 0x93, 0x2b, 0x02, 0x18, 0x1a, 0x7e, 0xf7, 0x5b, 0x03, 0x96, 0x8a,
+	0x24, 0xf2, 0xd5, 0x02, 0x5b, 0x8f, 0x07, 0xf1, 0xd5, 0x49, 0x95, 0x33, 0xe9, 0xae, 0x57, 0x72,
+	0x8c, 0xab, 0x87, 0x1f, 0xbe, 0xff, 0xfc, 0x5c, 0x7b, 0xe5, 0xef, 0xeb, 0x9f, 0xc6, 0x89, 0x59,
+	0xa2, 0x73, 0x35, 0xf5, 0x83, 0x7a, 0xcb, 0xcb, 0xeb, 0xce, 0xef, 0xfa, 0x96, 0x30, 0x04, 0xf1,
+	0xc2, 0xda, 0x24, 0x0c, 0xea, 0x07, 0x28, 0xc9, 0xc3, 0xf2, 0xb7, 0xea, 0xeb, 0xdd, 0x69, 0x86,
+	0xbf, 0xa1, 0xee, 0xd6, 0x26, 0x2d, 0x75, 0xb7, 0x69, 0xb5, 0xce, 0x15, 0x8d, 0xaf, 0xc9, 0x27,
+	0x0b, 0x6c, 0x9d, 0x46, 0xe3, 0x4a, 0xe5, 0x08, 0xb8, 0xeb, 0x95, 0x1c, 0xa3, 0xbc, 0xa3, 0x94,
+	0xbb, 0xee, 0x56, 0xa9, 0xf2, 0x94, 0x37, 0x34, 0xbe, 0xce, 0x5f, 0x7f, 0x01, 0xb6, 0xce, 0xb1,
+	0xb9, 0x4c, 0xe5, 0x14, 0xb8, 0xeb, 0x95, 0x9c, 0xdb, 0x36, 0x6c, 0x56, 0xdb, 0xf0, 0xd1, 0x82,
+	0xb9, 0x3c, 0xac, 0x44, 0xfb, 0x5a, 0x31, 0x2a, 0xee, 0xa3, 0x0a, 0x86, 0xd1, 0x7c, 0xa9, 0x34,
+	0x9f, 0x93, 0x67, 0x33, 0x62, 0x71, 0x67, 0x0a, 0x8e, 0x6d, 0xf5, 0xb7, 0xfa, 0xf4, 0xd7, 0x00,
+	0xf6, 0xff, 0x50, 0x42, 0x93, 0x07, 0x00, 0x00,
+}
@@ -0,0 +1,805 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: fuotaDeployment.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type FUOTADeploymentState int32
+
+const (
+	// The McGroupSetupReq is sent to the devices.
+	FUOTADeploymentState_MC_GROUP_SETUP FUOTADeploymentState = 0
+	// The FragSessionSetupReq is sent to the devices.
+	FUOTADeploymentState_FRAG_SESSION_SETUP FUOTADeploymentState = 1
+	// The McClassCSessionReq is sent to the devices.
+	FUOTADeploymentState_MC_SESSION_SETUP FUOTADeploymentState = 2
+	// The fragments are sent over the multicast group.
+	FUOTADeploymentState_ENQUEUE FUOTADeploymentState = 3
+	// The FragSessionStatusReq is sent to the devices.
+	FUOTADeploymentState_STATUS_REQUEST FUOTADeploymentState = 4
+	// The final status of the devices is set.
+	FUOTADeploymentState_SET_DEVICE_STATUS FUOTADeploymentState = 5
+	// The deployment has been completed.
+	FUOTADeploymentState_DONE FUOTADeploymentState = 6
+)
+
+var FUOTADeploymentState_name = map[int32]string{
+	0: "MC_GROUP_SETUP",
+	1: "FRAG_SESSION_SETUP",
+	2: "MC_SESSION_SETUP",
+	3: "ENQUEUE",
+	4: "STATUS_REQUEST",
+	5: "SET_DEVICE_STATUS",
+	6: "DONE",
+}
+var FUOTADeploymentState_value = map[string]int32{
+	"MC_GROUP_SETUP":     0,
+	"FRAG_SESSION_SETUP": 1,
+	"MC_SESSION_SETUP":   2,
+	"ENQUEUE":            3,
+	"STATUS_REQUEST":     4,
+	"SET_DEVICE_STATUS":  5,
+	"DONE":               6,
+}
+
+func (x FUOTADeploymentState) String() string {
+	return proto.EnumName(FUOTADeploymentState_name, int32(x))
+}
+func (FUOTADeploymentState) EnumDescriptor() ([]byte, []int) { return fileDescriptor13, []int{0} }
+
+type FUOTADeploymentDeviceState int32
+
+const (
+	// The deployment is in progress for the device.
+	FUOTADeploymentDeviceState_DEVICE_PENDING FUOTADeploymentDeviceState = 0
+	// The device has received the complete payload.
+	FUOTADeploymentDeviceState_DEVICE_SUCCESS FUOTADeploymentDeviceState = 1
+	// The deployment failed for the device (see errorMessage).
+	FUOTADeploymentDeviceState_DEVICE_ERROR FUOTADeploymentDeviceState = 2
+)
+
+var FUOTADeploymentDeviceState_name = map[int32]string{
+	0: "DEVICE_PENDING",
+	1: "DEVICE_SUCCESS",
+	2: "DEVICE_ERROR",
+}
+var FUOTADeploymentDeviceState_value = map[string]int32{
+	"DEVICE_PENDING": 0,
+	"DEVICE_SUCCESS": 1,
+	"DEVICE_ERROR":   2,
+}
+
+func (x FUOTADeploymentDeviceState) String() string {
+	return proto.EnumName(FUOTADeploymentDeviceState_name, int32(x))
+}
+func (FUOTADeploymentDeviceState) EnumDescriptor() ([]byte, []int) { return fileDescriptor13, []int{1} }
+
+type FUOTADeployment struct {
+	// ID of the deployment.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,2,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Name of the deployment.
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// Base64 encoded payload (e.g. firmware image).
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Fragment size (bytes).
+	FragSize uint32 `protobuf:"varint,5,opt,name=fragSize" json:"fragSize,omitempty"`
+	// Number of redundancy fragments to send for forward error correction.
+	Redundancy uint32 `protobuf:"varint,6,opt,name=redundancy" json:"redundancy,omitempty"`
+	// Multicast session timeout (2^multicastTimeout seconds).
+	MulticastTimeout uint32 `protobuf:"varint,7,opt,name=multicastTimeout" json:"multicastTimeout,omitempty"`
+	// Time (in seconds) to wait for the devices to answer the unicast
+	// setup and status requests.
+	UnicastTimeout uint32 `protobuf:"varint,8,opt,name=unicastTimeout" json:"unicastTimeout,omitempty"`
+	// Frequency (Hz) used for the multicast session.
+	Frequency uint32 `protobuf:"varint,9,opt,name=frequency" json:"frequency,omitempty"`
+	// Data-rate used for the multicast session.
+	Dr uint32 `protobuf:"varint,10,opt,name=dr" json:"dr,omitempty"`
+	// ID of the multicast group (set on create).
+	MulticastGroupID int64 `protobuf:"varint,11,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+}
+
+func (m *FUOTADeployment) Reset()                    { *m = FUOTADeployment{} }
+func (m *FUOTADeployment) String() string            { return proto.CompactTextString(m) }
+func (*FUOTADeployment) ProtoMessage()               {}
+func (*FUOTADeployment) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{0} }
+
+func (m *FUOTADeployment) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FUOTADeployment) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *FUOTADeployment) GetFragSize() uint32 {
+	if m != nil {
+		return m.FragSize
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetRedundancy() uint32 {
+	if m != nil {
+		return m.Redundancy
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetMulticastTimeout() uint32 {
+	if m != nil {
+		return m.MulticastTimeout
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetUnicastTimeout() uint32 {
+	if m != nil {
+		return m.UnicastTimeout
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetFrequency() uint32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetDr() uint32 {
+	if m != nil {
+		return m.Dr
+	}
+	return 0
+}
+
+func (m *FUOTADeployment) GetMulticastGroupID() int64 {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return 0
+}
+
+type CreateFUOTADeploymentRequest struct {
+	FuotaDeployment *FUOTADeployment `protobuf:"bytes,1,opt,name=fuotaDeployment" json:"fuotaDeployment,omitempty"`
+	// Hex encoded DevEUIs of the devices to update.
+	DevEUIs []string `protobuf:"bytes,2,rep,name=devEUIs" json:"devEUIs,omitempty"`
+}
+
+func (m *CreateFUOTADeploymentRequest) Reset()                    { *m = CreateFUOTADeploymentRequest{} }
+func (m *CreateFUOTADeploymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateFUOTADeploymentRequest) ProtoMessage()               {}
+func (*CreateFUOTADeploymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{1} }
+
+func (m *CreateFUOTADeploymentRequest) GetFuotaDeployment() *FUOTADeployment {
+	if m != nil {
+		return m.FuotaDeployment
+	}
+	return nil
+}
+
+func (m *CreateFUOTADeploymentRequest) GetDevEUIs() []string {
+	if m != nil {
+		return m.DevEUIs
+	}
+	return nil
+}
+
+type CreateFUOTADeploymentResponse struct {
+	// ID of the deployment.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateFUOTADeploymentResponse) Reset()                    { *m = CreateFUOTADeploymentResponse{} }
+func (m *CreateFUOTADeploymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateFUOTADeploymentResponse) ProtoMessage()               {}
+func (*CreateFUOTADeploymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{2} }
+
+func (m *CreateFUOTADeploymentResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetFUOTADeploymentRequest struct {
+	// ID of the deployment.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetFUOTADeploymentRequest) Reset()                    { *m = GetFUOTADeploymentRequest{} }
+func (m *GetFUOTADeploymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetFUOTADeploymentRequest) ProtoMessage()               {}
+func (*GetFUOTADeploymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{3} }
+
+func (m *GetFUOTADeploymentRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetFUOTADeploymentResponse struct {
+	FuotaDeployment *FUOTADeployment `protobuf:"bytes,1,opt,name=fuotaDeployment" json:"fuotaDeployment,omitempty"`
+	// Timestamp when the record was created.
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Timestamp when the record was last updated.
+	UpdatedAt string `protobuf:"bytes,3,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// State of the deployment.
+	State FUOTADeploymentState `protobuf:"varint,4,opt,name=state,enum=api.FUOTADeploymentState" json:"state,omitempty"`
+	// Timestamp after which the next step will be executed.
+	NextStepAfter string `protobuf:"bytes,5,opt,name=nextStepAfter" json:"nextStepAfter,omitempty"`
+}
+
+func (m *GetFUOTADeploymentResponse) Reset()                    { *m = GetFUOTADeploymentResponse{} }
+func (m *GetFUOTADeploymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetFUOTADeploymentResponse) ProtoMessage()               {}
+func (*GetFUOTADeploymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{4} }
+
+func (m *GetFUOTADeploymentResponse) GetFuotaDeployment() *FUOTADeployment {
+	if m != nil {
+		return m.FuotaDeployment
+	}
+	return nil
+}
+
+func (m *GetFUOTADeploymentResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetFUOTADeploymentResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *GetFUOTADeploymentResponse) GetState() FUOTADeploymentState {
+	if m != nil {
+		return m.State
+	}
+	return FUOTADeploymentState_MC_GROUP_SETUP
+}
+
+func (m *GetFUOTADeploymentResponse) GetNextStepAfter() string {
+	if m != nil {
+		return m.NextStepAfter
+	}
+	return ""
+}
+
+type ListFUOTADeploymentRequest struct {
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,1,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListFUOTADeploymentRequest) Reset()                    { *m = ListFUOTADeploymentRequest{} }
+func (m *ListFUOTADeploymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentRequest) ProtoMessage()               {}
+func (*ListFUOTADeploymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{5} }
+
+func (m *ListFUOTADeploymentRequest) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListFUOTADeploymentResponse struct {
+	// Total number of deployments.
+	TotalCount int64                         `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*GetFUOTADeploymentResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListFUOTADeploymentResponse) Reset()                    { *m = ListFUOTADeploymentResponse{} }
+func (m *ListFUOTADeploymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentResponse) ProtoMessage()               {}
+func (*ListFUOTADeploymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{6} }
+
+func (m *ListFUOTADeploymentResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentResponse) GetResult() []*GetFUOTADeploymentResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type ListFUOTADeploymentDevicesRequest struct {
+	// ID of the deployment.
+	FuotaDeploymentID int64 `protobuf:"varint,1,opt,name=fuotaDeploymentID" json:"fuotaDeploymentID,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListFUOTADeploymentDevicesRequest) Reset()         { *m = ListFUOTADeploymentDevicesRequest{} }
+func (m *ListFUOTADeploymentDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentDevicesRequest) ProtoMessage()    {}
+func (*ListFUOTADeploymentDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor13, []int{7}
+}
+
+func (m *ListFUOTADeploymentDevicesRequest) GetFuotaDeploymentID() int64 {
+	if m != nil {
+		return m.FuotaDeploymentID
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentDevicesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentDevicesRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type FUOTADeploymentDevice struct {
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Name of the device.
+	DeviceName string `protobuf:"bytes,2,opt,name=deviceName" json:"deviceName,omitempty"`
+	// State of the device.
+	State FUOTADeploymentDeviceState `protobuf:"varint,3,opt,name=state,enum=api.FUOTADeploymentDeviceState" json:"state,omitempty"`
+	// Error message (in case of an error state).
+	ErrorMessage string `protobuf:"bytes,4,opt,name=errorMessage" json:"errorMessage,omitempty"`
+	// Timestamp when the McGroupSetupAns was received.
+	McGroupSetupCompletedAt string `protobuf:"bytes,5,opt,name=mcGroupSetupCompletedAt" json:"mcGroupSetupCompletedAt,omitempty"`
+	// Timestamp when the FragSessionSetupAns was received.
+	FragSessionSetupCompletedAt string `protobuf:"bytes,6,opt,name=fragSessionSetupCompletedAt" json:"fragSessionSetupCompletedAt,omitempty"`
+	// Timestamp when the McClassCSessionAns was received.
+	McSessionCompletedAt string `protobuf:"bytes,7,opt,name=mcSessionCompletedAt" json:"mcSessionCompletedAt,omitempty"`
+	// Timestamp when the FragSessionStatusAns was received.
+	FragStatusCompletedAt string `protobuf:"bytes,8,opt,name=fragStatusCompletedAt" json:"fragStatusCompletedAt,omitempty"`
+	// Number of fragments received by the device.
+	NbFragReceived uint32 `protobuf:"varint,9,opt,name=nbFragReceived" json:"nbFragReceived,omitempty"`
+	// Number of fragments missing (after error correction).
+	MissingFrag uint32 `protobuf:"varint,10,opt,name=missingFrag" json:"missingFrag,omitempty"`
+	// Timestamp when the record was last updated.
+	UpdatedAt string `protobuf:"bytes,11,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *FUOTADeploymentDevice) Reset()                    { *m = FUOTADeploymentDevice{} }
+func (m *FUOTADeploymentDevice) String() string            { return proto.CompactTextString(m) }
+func (*FUOTADeploymentDevice) ProtoMessage()               {}
+func (*FUOTADeploymentDevice) Descriptor() ([]byte, []int) { return fileDescriptor13, []int{8} }
+
+func (m *FUOTADeploymentDevice) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDevice) GetDeviceName() string {
+	if m != nil {
+		return m.DeviceName
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDevice) GetState() FUOTADeploymentDeviceState {
+	if m != nil {
+		return m.State
+	}
+	return FUOTADeploymentDeviceState_DEVICE_PENDING
+}
+
+func (m *FUOTADeploymentDevice) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDevice) GetMcGroupSetupCompletedAt() string {
+	if m != nil {
+		return m.McGroupSetupCompletedAt
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDevice) GetFragSessionSetupCompletedAt() string {
+	if m != nil {
+		return m.FragSessionSetupCompletedAt
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDevice) GetMcSessionCompletedAt() string {
+	if m != nil {
+		return m.McSessionCompletedAt
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDevice) GetFragStatusCompletedAt() string {
+	if m != nil {
+		return m.FragStatusCompletedAt
+	}
+	return ""
+}
+
+func (m *FUOTADeploymentDevice) GetNbFragReceived() uint32 {
+	if m != nil {
+		return m.NbFragReceived
+	}
+	return 0
+}
+
+func (m *FUOTADeploymentDevice) GetMissingFrag() uint32 {
+	if m != nil {
+		return m.MissingFrag
+	}
+	return 0
+}
+
+func (m *FUOTADeploymentDevice) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type ListFUOTADeploymentDevicesResponse struct {
+	// Total number of devices.
+	TotalCount int64                    `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*FUOTADeploymentDevice `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListFUOTADeploymentDevicesResponse) Reset()         { *m = ListFUOTADeploymentDevicesResponse{} }
+func (m *ListFUOTADeploymentDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFUOTADeploymentDevicesResponse) ProtoMessage()    {}
+func (*ListFUOTADeploymentDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor13, []int{9}
+}
+
+func (m *ListFUOTADeploymentDevicesResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListFUOTADeploymentDevicesResponse) GetResult() []*FUOTADeploymentDevice {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FUOTADeployment)(nil), "api.FUOTADeployment")
+	proto.RegisterType((*CreateFUOTADeploymentRequest)(nil), "api.CreateFUOTADeploymentRequest")
+	proto.RegisterType((*CreateFUOTADeploymentResponse)(nil), "api.CreateFUOTADeploymentResponse")
+	proto.RegisterType((*GetFUOTADeploymentRequest)(nil), "api.GetFUOTADeploymentRequest")
+	proto.RegisterType((*GetFUOTADeploymentResponse)(nil), "api.GetFUOTADeploymentResponse")
+	proto.RegisterType((*ListFUOTADeploymentRequest)(nil), "api.ListFUOTADeploymentRequest")
+	proto.RegisterType((*ListFUOTADeploymentResponse)(nil), "api.ListFUOTADeploymentResponse")
+	proto.RegisterType((*ListFUOTADeploymentDevicesRequest)(nil), "api.ListFUOTADeploymentDevicesRequest")
+	proto.RegisterType((*FUOTADeploymentDevice)(nil), "api.FUOTADeploymentDevice")
+	proto.RegisterType((*ListFUOTADeploymentDevicesResponse)(nil), "api.ListFUOTADeploymentDevicesResponse")
+	proto.RegisterEnum("api.FUOTADeploymentState", FUOTADeploymentState_name, FUOTADeploymentState_value)
+	proto.RegisterEnum("api.FUOTADeploymentDeviceState", FUOTADeploymentDeviceState_name, FUOTADeploymentDeviceState_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for FUOTADeploymentService service
+
+type FUOTADeploymentServiceClient interface {
+	// Create creates the given FUOTA deployment.
+	Create(ctx context.Context, in *CreateFUOTADeploymentRequest, opts ...grpc.CallOption) (*CreateFUOTADeploymentResponse, error)
+	// Get returns the FUOTA deployment matching the given id.
+	Get(ctx context.Context, in *GetFUOTADeploymentRequest, opts ...grpc.CallOption) (*GetFUOTADeploymentResponse, error)
+	// List lists the FUOTA deployments of the given application.
+	List(ctx context.Context, in *ListFUOTADeploymentRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentResponse, error)
+	// ListDevices lists the devices (and their progress) of the given
+	// FUOTA deployment.
+	ListDevices(ctx context.Context, in *ListFUOTADeploymentDevicesRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentDevicesResponse, error)
+}
+
+type fUOTADeploymentServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFUOTADeploymentServiceClient(cc *grpc.ClientConn) FUOTADeploymentServiceClient {
+	return &fUOTADeploymentServiceClient{cc}
+}
+
+func (c *fUOTADeploymentServiceClient) Create(ctx context.Context, in *CreateFUOTADeploymentRequest, opts ...grpc.CallOption) (*CreateFUOTADeploymentResponse, error) {
+	out := new(CreateFUOTADeploymentResponse)
+	err := grpc.Invoke(ctx, "/api.FUOTADeploymentService/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentServiceClient) Get(ctx context.Context, in *GetFUOTADeploymentRequest, opts ...grpc.CallOption) (*GetFUOTADeploymentResponse, error) {
+	out := new(GetFUOTADeploymentResponse)
+	err := grpc.Invoke(ctx, "/api.FUOTADeploymentService/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentServiceClient) List(ctx context.Context, in *ListFUOTADeploymentRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentResponse, error) {
+	out := new(ListFUOTADeploymentResponse)
+	err := grpc.Invoke(ctx, "/api.FUOTADeploymentService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fUOTADeploymentServiceClient) ListDevices(ctx context.Context, in *ListFUOTADeploymentDevicesRequest, opts ...grpc.CallOption) (*ListFUOTADeploymentDevicesResponse, error) {
+	out := new(ListFUOTADeploymentDevicesResponse)
+	err := grpc.Invoke(ctx, "/api.FUOTADeploymentService/ListDevices", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for FUOTADeploymentService service
+
+type FUOTADeploymentServiceServer interface {
+	// Create creates the given FUOTA deployment.
+	Create(context.Context, *CreateFUOTADeploymentRequest) (*CreateFUOTADeploymentResponse, error)
+	// Get returns the FUOTA deployment matching the given id.
+	Get(context.Context, *GetFUOTADeploymentRequest) (*GetFUOTADeploymentResponse, error)
+	// List lists the FUOTA deployments of the given application.
+	List(context.Context, *ListFUOTADeploymentRequest) (*ListFUOTADeploymentResponse, error)
+	// ListDevices lists the devices (and their progress) of the given
+	// FUOTA deployment.
+	ListDevices(context.Context, *ListFUOTADeploymentDevicesRequest) (*ListFUOTADeploymentDevicesResponse, error)
+}
+
+func RegisterFUOTADeploymentServiceServer(s *grpc.Server, srv FUOTADeploymentServiceServer) {
+	s.RegisterService(&_FUOTADeploymentService_serviceDesc, srv)
+}
+
+func _FUOTADeploymentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFUOTADeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeploymentService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServiceServer).Create(ctx, req.(*CreateFUOTADeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeploymentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFUOTADeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeploymentService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServiceServer).Get(ctx, req.(*GetFUOTADeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeploymentService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFUOTADeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeploymentService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServiceServer).List(ctx, req.(*ListFUOTADeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FUOTADeploymentService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFUOTADeploymentDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FUOTADeploymentServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FUOTADeploymentService/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FUOTADeploymentServiceServer).ListDevices(ctx, req.(*ListFUOTADeploymentDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _FUOTADeploymentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.FUOTADeploymentService",
+	HandlerType: (*FUOTADeploymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _FUOTADeploymentService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _FUOTADeploymentService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _FUOTADeploymentService_List_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _FUOTADeploymentService_ListDevices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fuotaDeployment.proto",
+}
+
+func init() { proto.RegisterFile("fuotaDeployment.proto", fileDescriptor13) }
+
+var fileDescriptor13 = []byte{
+	// 1021 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0x9f, 0xe3, 0x34, 0x6d, 0x4e, 0xba, 0xce, 0x3b, 0x6a, 0x8b, 0x97, 0x76, 0x5d, 0x66, 0xd0,
+	0x88, 0x0a, 0x34, 0x52, 0xb6, 0x09, 0x34, 0x24, 0x44, 0x94, 0xb8, 0x21, 0x12, 0x4d, 0xba, 0xeb,
+	0x64, 0xaf, 0x91, 0x17, 0xdf, 0x44, 0x16, 0x89, 0x6d, 0x7c, 0xaf, 0xab, 0x96, 0xa9, 0x42, 0xe2,
+	0x81, 0x2f, 0xc0, 0xdb, 0x5e, 0xf8, 0x44, 0x3c, 0xf1, 0x01, 0x78, 0xe1, 0x9d, 0x57, 0x1e, 0x91,
+	0xaf, 0x9d, 0x26, 0x71, 0xec, 0x16, 0xc4, 0x5b, 0xee, 0xef, 0xfc, 0xce, 0x3d, 0x7f, 0xee, 0xef,
+	0x9c, 0x18, 0xf6, 0xc6, 0x81, 0xcb, 0xcd, 0x16, 0xf5, 0xa6, 0xee, 0xd5, 0x8c, 0x3a, 0xfc, 0xc4,
+	0xf3, 0x5d, 0xee, 0xa2, 0x6c, 0x7a, 0x76, 0xf9, 0x70, 0xe2, 0xba, 0x93, 0x29, 0xad, 0x99, 0x9e,
+	0x5d, 0x33, 0x1d, 0xc7, 0xe5, 0x26, 0xb7, 0x5d, 0x87, 0x45, 0x14, 0xed, 0x8f, 0x1c, 0x3c, 0x38,
+	0x1d, 0xf4, 0xfa, 0x8d, 0x85, 0x33, 0xee, 0x40, 0xce, 0xb6, 0x54, 0xa9, 0x22, 0x55, 0x65, 0x92,
+	0xb3, 0x2d, 0xfc, 0x08, 0xee, 0x9b, 0x9e, 0x37, 0xb5, 0x47, 0xc2, 0xb3, 0xd3, 0x52, 0x73, 0xc2,
+	0xb4, 0x0a, 0x22, 0x42, 0xde, 0x31, 0x67, 0x54, 0x95, 0x2b, 0x52, 0xb5, 0x48, 0xc4, 0x6f, 0x54,
+	0x61, 0xd3, 0x33, 0xaf, 0xa6, 0xae, 0x69, 0xa9, 0xf9, 0x8a, 0x54, 0xdd, 0x26, 0xf3, 0x23, 0x96,
+	0x61, 0x6b, 0xec, 0x9b, 0x13, 0xc3, 0xfe, 0x81, 0xaa, 0x1b, 0x15, 0xa9, 0x7a, 0x9f, 0xdc, 0x9c,
+	0xf1, 0x08, 0xc0, 0xa7, 0x56, 0xe0, 0x58, 0xa6, 0x33, 0xba, 0x52, 0x0b, 0xc2, 0xba, 0x84, 0xe0,
+	0x31, 0x28, 0xb3, 0x60, 0xca, 0xed, 0x91, 0xc9, 0x78, 0xdf, 0x9e, 0x51, 0x37, 0xe0, 0xea, 0xa6,
+	0x60, 0xad, 0xe1, 0xf8, 0x0c, 0x76, 0x02, 0x67, 0x85, 0xb9, 0x25, 0x98, 0x09, 0x14, 0x0f, 0xa1,
+	0x38, 0xf6, 0xe9, 0xf7, 0x01, 0x0d, 0x43, 0x16, 0x05, 0x65, 0x01, 0x84, 0x1d, 0xb1, 0x7c, 0x15,
+	0x04, 0x9c, 0xb3, 0xfc, 0x95, 0x0c, 0xda, 0xbe, 0x1b, 0x78, 0x9d, 0x96, 0x5a, 0x12, 0x4d, 0x59,
+	0xc3, 0xb5, 0x4b, 0x38, 0x6c, 0xfa, 0xd4, 0xe4, 0x34, 0xd1, 0x66, 0x12, 0x5e, 0xce, 0x38, 0x7e,
+	0x05, 0x0f, 0x12, 0xaf, 0x27, 0x5a, 0x5f, 0xaa, 0xef, 0x9e, 0x98, 0x9e, 0x7d, 0x92, 0xf4, 0x4a,
+	0x92, 0xc3, 0x1e, 0x5b, 0xf4, 0x42, 0x1f, 0x74, 0x98, 0x9a, 0xab, 0xc8, 0xd5, 0x22, 0x99, 0x1f,
+	0xb5, 0x1a, 0x3c, 0xce, 0x88, 0xcc, 0x3c, 0xd7, 0x61, 0x34, 0xf9, 0xd0, 0xda, 0x27, 0xf0, 0xa8,
+	0x4d, 0x79, 0x46, 0x9e, 0x49, 0xf2, 0x5f, 0x12, 0x94, 0xd3, 0xd8, 0xf1, 0xdd, 0xff, 0xb7, 0xac,
+	0x43, 0x28, 0x8e, 0x44, 0xf2, 0x56, 0x83, 0x0b, 0xc1, 0x15, 0xc9, 0x02, 0x08, 0xad, 0x81, 0x67,
+	0xc5, 0xd6, 0x48, 0x71, 0x0b, 0x00, 0x6b, 0xb0, 0xc1, 0xb8, 0xc9, 0xa9, 0x10, 0xdd, 0x4e, 0xfd,
+	0x51, 0x5a, 0x44, 0x23, 0x24, 0x90, 0x88, 0x17, 0x2a, 0xdc, 0xa1, 0x97, 0xdc, 0xe0, 0xd4, 0x6b,
+	0x8c, 0x39, 0xf5, 0x85, 0x24, 0x8b, 0x64, 0x15, 0xd4, 0x3c, 0x28, 0x7f, 0x6b, 0xb3, 0xac, 0xfe,
+	0xac, 0x4d, 0x89, 0x94, 0x36, 0x25, 0xbb, 0xb0, 0x31, 0xb5, 0x67, 0x36, 0x8f, 0x67, 0x28, 0x3a,
+	0xe0, 0x3e, 0x14, 0xdc, 0xf1, 0x98, 0xd1, 0xa8, 0x16, 0x99, 0xc4, 0x27, 0xed, 0x02, 0x0e, 0x52,
+	0x23, 0xc6, 0x3d, 0x3e, 0x02, 0xe0, 0x2e, 0x37, 0xa7, 0x4d, 0x37, 0x88, 0xdb, 0x2b, 0x93, 0x25,
+	0x04, 0x3f, 0x87, 0x82, 0x4f, 0x59, 0x30, 0xe5, 0x42, 0x19, 0xa5, 0xfa, 0x13, 0xd1, 0x88, 0xec,
+	0x47, 0x23, 0x31, 0x5d, 0xfb, 0x11, 0x9e, 0xa6, 0xc4, 0x6d, 0xd1, 0x0b, 0x7b, 0x44, 0xd9, 0xbc,
+	0xe0, 0x4f, 0xe1, 0x61, 0xe2, 0xd1, 0x6e, 0x8a, 0x5e, 0x37, 0xfc, 0xc7, 0xc2, 0xff, 0x96, 0x61,
+	0x2f, 0x35, 0x7a, 0xe8, 0x11, 0xe9, 0x5b, 0x84, 0x2a, 0x92, 0xf8, 0x14, 0xf6, 0xc2, 0x12, 0x8c,
+	0x6e, 0xb8, 0x84, 0x22, 0xc1, 0x2c, 0x21, 0xf8, 0x72, 0xae, 0x09, 0x59, 0x68, 0xe2, 0x49, 0x9a,
+	0x26, 0xa2, 0x10, 0x2b, 0xca, 0xd0, 0x60, 0x9b, 0xfa, 0xbe, 0xeb, 0x9f, 0x51, 0xc6, 0xcc, 0x49,
+	0xa4, 0xa8, 0x22, 0x59, 0xc1, 0xf0, 0x0b, 0xf8, 0x60, 0x36, 0x12, 0xe3, 0x6e, 0x50, 0x1e, 0x78,
+	0x4d, 0x77, 0xe6, 0x4d, 0x69, 0x24, 0xcd, 0x48, 0x47, 0x59, 0x66, 0xfc, 0x1a, 0x0e, 0xc4, 0xd6,
+	0xa3, 0x8c, 0xd9, 0xae, 0xb3, 0xe6, 0x5d, 0x10, 0xde, 0xb7, 0x51, 0xb0, 0x0e, 0xbb, 0xb3, 0x51,
+	0x6c, 0x5c, 0x76, 0xdd, 0x14, 0xae, 0xa9, 0x36, 0x7c, 0x01, 0x7b, 0xe2, 0x4a, 0x6e, 0xf2, 0x80,
+	0x2d, 0x3b, 0x6d, 0x09, 0xa7, 0x74, 0x63, 0xb8, 0x49, 0x9d, 0xb7, 0xa7, 0xbe, 0x39, 0x21, 0x74,
+	0x44, 0xed, 0x0b, 0x6a, 0xc5, 0x6b, 0x32, 0x81, 0x62, 0x05, 0x4a, 0x33, 0x9b, 0x31, 0xdb, 0x99,
+	0x84, 0x70, 0xbc, 0x34, 0x97, 0xa1, 0xd5, 0xe1, 0x2d, 0x25, 0x86, 0x57, 0xbb, 0x04, 0xed, 0x36,
+	0xed, 0xfd, 0x4b, 0xe9, 0xd7, 0x13, 0xd2, 0x2f, 0x67, 0xbf, 0xf7, 0x5c, 0xf5, 0xc7, 0xef, 0x25,
+	0xd8, 0x4d, 0xdb, 0x12, 0x88, 0xb0, 0x73, 0xd6, 0x1c, 0xb6, 0x49, 0x6f, 0x70, 0x3e, 0x34, 0xf4,
+	0xfe, 0xe0, 0x5c, 0xb9, 0x87, 0xfb, 0x80, 0xa7, 0xa4, 0xd1, 0x1e, 0x1a, 0xba, 0x61, 0x74, 0x7a,
+	0xdd, 0x18, 0x97, 0x70, 0x17, 0x94, 0xb3, 0x66, 0x02, 0xcd, 0x61, 0x09, 0x36, 0xf5, 0xee, 0xeb,
+	0x81, 0x3e, 0xd0, 0x15, 0x39, 0xbc, 0xce, 0xe8, 0x37, 0xfa, 0x03, 0x63, 0x48, 0xf4, 0xd7, 0x03,
+	0xdd, 0xe8, 0x2b, 0x79, 0xdc, 0x83, 0x87, 0x86, 0xde, 0x1f, 0xb6, 0xf4, 0x37, 0x9d, 0xa6, 0x3e,
+	0x8c, 0xcc, 0xca, 0x06, 0x6e, 0x41, 0xbe, 0xd5, 0xeb, 0xea, 0x4a, 0xe1, 0xf8, 0x0d, 0x94, 0xb3,
+	0xd5, 0x1a, 0x5e, 0x19, 0xbb, 0x9e, 0xeb, 0xdd, 0x56, 0xa7, 0xdb, 0x56, 0xee, 0x2d, 0x61, 0xc6,
+	0xa0, 0xd9, 0xd4, 0x0d, 0x43, 0x91, 0x50, 0x81, 0xed, 0x18, 0xd3, 0x09, 0xe9, 0x11, 0x25, 0x57,
+	0xff, 0x2d, 0x0f, 0xfb, 0xc9, 0xa2, 0xa9, 0x2f, 0x46, 0xed, 0x57, 0x09, 0x0a, 0xd1, 0x1f, 0x08,
+	0x3e, 0x15, 0xed, 0xbb, 0xed, 0x7f, 0xac, 0xac, 0xdd, 0x46, 0x89, 0x5e, 0x4d, 0xeb, 0xfe, 0xf4,
+	0xfb, 0x9f, 0xbf, 0xe4, 0xbe, 0xd1, 0x9a, 0xd1, 0xd7, 0xc8, 0x62, 0x33, 0xb2, 0xda, 0xbb, 0xe4,
+	0x27, 0xcc, 0xca, 0xde, 0xbc, 0xae, 0x09, 0xf3, 0x67, 0xd6, 0x8d, 0x9d, 0xbd, 0x92, 0x8e, 0xf1,
+	0x3b, 0x90, 0xdb, 0x94, 0xe3, 0x51, 0xe6, 0x5e, 0x8b, 0x52, 0xbb, 0x6b, 0xef, 0x69, 0x1f, 0x8a,
+	0xbc, 0x1e, 0xe3, 0x81, 0xc8, 0x6b, 0x2d, 0x54, 0xed, 0x9d, 0x6d, 0x5d, 0xe3, 0xcf, 0x12, 0xe4,
+	0x43, 0x65, 0x62, 0x74, 0x5d, 0xf6, 0x5f, 0x41, 0xb9, 0x92, 0x4d, 0x88, 0x03, 0x7e, 0x29, 0x02,
+	0xbe, 0xc4, 0xe7, 0x29, 0x8d, 0xb8, 0xab, 0x70, 0x7c, 0x2f, 0x41, 0x29, 0xbc, 0x3c, 0x9e, 0x09,
+	0x7c, 0x96, 0x15, 0x6e, 0x75, 0x61, 0x97, 0x3f, 0xbe, 0x93, 0x17, 0x67, 0xf7, 0x4a, 0x64, 0xf7,
+	0x02, 0xeb, 0x59, 0xed, 0x58, 0x5b, 0xef, 0xd7, 0xb5, 0x68, 0xd5, 0xb2, 0xb7, 0x05, 0xf1, 0x5d,
+	0xf9, 0xfc, 0x9f, 0x01, 0x00, 0x68, 0x82, 0x2f, 0x3d, 0x93, 0x0a, 0x00, 0x00,
+}
@@ -27,7 +27,13 @@ protoc  -I/usr/local/include -I. ${GOPATHLIST} --go_out=plugins=grpc:. \
     profiles.proto \
     networkServer.proto \
     serviceProfile.proto \
-    deviceProfile.proto
+    deviceProfile.proto \
+    downlinkSchedule.proto \
+    multicastGroup.proto \
+    fuotaDeployment.proto \
+    apiKey.proto \
+    audit.proto \
+    webhook.proto
 
 # generate the JSON interface code
 protoc -I/usr/local/include -I. ${GOPATHLIST} --grpc-gateway_out=logtostderr=true:. \
@@ -41,7 +47,13 @@ protoc -I/usr/local/include -I. ${GOPATHLIST} --grpc-gateway_out=logtostderr=tru
     profiles.proto \
     networkServer.proto \
     serviceProfile.proto \
-    deviceProfile.proto
+    deviceProfile.proto \
+    downlinkSchedule.proto \
+    multicastGroup.proto \
+    fuotaDeployment.proto \
+    apiKey.proto \
+    audit.proto \
+    webhook.proto
 
 # generate the swagger definitions
 protoc -I/usr/local/include -I. ${GOPATHLIST} --swagger_out=logtostderr=true:./swagger \
@@ -55,7 +67,13 @@ protoc -I/usr/local/include -I. ${GOPATHLIST} --swagger_out=logtostderr=true:./s
     profiles.proto \
     networkServer.proto \
     serviceProfile.proto \
-    deviceProfile.proto
+    deviceProfile.proto \
+    downlinkSchedule.proto \
+    multicastGroup.proto \
+    fuotaDeployment.proto \
+    apiKey.proto \
+    audit.proto \
+    webhook.proto
 
 # merge the swagger code into one file
 go run swagger/main.go swagger > ../static/swagger/api.swagger.json
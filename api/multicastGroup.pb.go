@@ -0,0 +1,976 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: multicastGroup.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type MulticastGroup struct {
+	// ID of the multicast group.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,2,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Name of the multicast group.
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// Hex encoded multicast address (4 bytes).
+	McAddr string `protobuf:"bytes,4,opt,name=mcAddr" json:"mcAddr,omitempty"`
+	// Hex encoded multicast application session key (16 bytes).
+	McAppSKey string `protobuf:"bytes,5,opt,name=mcAppSKey" json:"mcAppSKey,omitempty"`
+	// Hex encoded multicast network session key (16 bytes).
+	McNwkSKey string `protobuf:"bytes,6,opt,name=mcNwkSKey" json:"mcNwkSKey,omitempty"`
+	// Frame-counter which will be used for the next multicast downlink.
+	FCnt uint32 `protobuf:"varint,7,opt,name=fCnt" json:"fCnt,omitempty"`
+	// Frequency (Hz) used for the multicast downlinks.
+	Frequency uint32 `protobuf:"varint,8,opt,name=frequency" json:"frequency,omitempty"`
+	// Data-rate used for the multicast downlinks.
+	Dr uint32 `protobuf:"varint,9,opt,name=dr" json:"dr,omitempty"`
+}
+
+func (m *MulticastGroup) Reset()                    { *m = MulticastGroup{} }
+func (m *MulticastGroup) String() string            { return proto.CompactTextString(m) }
+func (*MulticastGroup) ProtoMessage()               {}
+func (*MulticastGroup) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{0} }
+
+func (m *MulticastGroup) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MulticastGroup) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *MulticastGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MulticastGroup) GetMcAddr() string {
+	if m != nil {
+		return m.McAddr
+	}
+	return ""
+}
+
+func (m *MulticastGroup) GetMcAppSKey() string {
+	if m != nil {
+		return m.McAppSKey
+	}
+	return ""
+}
+
+func (m *MulticastGroup) GetMcNwkSKey() string {
+	if m != nil {
+		return m.McNwkSKey
+	}
+	return ""
+}
+
+func (m *MulticastGroup) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *MulticastGroup) GetFrequency() uint32 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
+}
+
+func (m *MulticastGroup) GetDr() uint32 {
+	if m != nil {
+		return m.Dr
+	}
+	return 0
+}
+
+type CreateMulticastGroupRequest struct {
+	MulticastGroup *MulticastGroup `protobuf:"bytes,1,opt,name=multicastGroup" json:"multicastGroup,omitempty"`
+}
+
+func (m *CreateMulticastGroupRequest) Reset()                    { *m = CreateMulticastGroupRequest{} }
+func (m *CreateMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateMulticastGroupRequest) ProtoMessage()               {}
+func (*CreateMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{1} }
+
+func (m *CreateMulticastGroupRequest) GetMulticastGroup() *MulticastGroup {
+	if m != nil {
+		return m.MulticastGroup
+	}
+	return nil
+}
+
+type CreateMulticastGroupResponse struct {
+	// ID of the multicast group.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateMulticastGroupResponse) Reset()                    { *m = CreateMulticastGroupResponse{} }
+func (m *CreateMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateMulticastGroupResponse) ProtoMessage()               {}
+func (*CreateMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{2} }
+
+func (m *CreateMulticastGroupResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetMulticastGroupRequest struct {
+	// ID of the multicast group.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetMulticastGroupRequest) Reset()                    { *m = GetMulticastGroupRequest{} }
+func (m *GetMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetMulticastGroupRequest) ProtoMessage()               {}
+func (*GetMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{3} }
+
+func (m *GetMulticastGroupRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetMulticastGroupResponse struct {
+	MulticastGroup *MulticastGroup `protobuf:"bytes,1,opt,name=multicastGroup" json:"multicastGroup,omitempty"`
+	// Timestamp when the record was created.
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Timestamp when the record was last updated.
+	UpdatedAt string `protobuf:"bytes,3,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *GetMulticastGroupResponse) Reset()                    { *m = GetMulticastGroupResponse{} }
+func (m *GetMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetMulticastGroupResponse) ProtoMessage()               {}
+func (*GetMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{4} }
+
+func (m *GetMulticastGroupResponse) GetMulticastGroup() *MulticastGroup {
+	if m != nil {
+		return m.MulticastGroup
+	}
+	return nil
+}
+
+func (m *GetMulticastGroupResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetMulticastGroupResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type UpdateMulticastGroupRequest struct {
+	MulticastGroup *MulticastGroup `protobuf:"bytes,1,opt,name=multicastGroup" json:"multicastGroup,omitempty"`
+}
+
+func (m *UpdateMulticastGroupRequest) Reset()                    { *m = UpdateMulticastGroupRequest{} }
+func (m *UpdateMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateMulticastGroupRequest) ProtoMessage()               {}
+func (*UpdateMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{5} }
+
+func (m *UpdateMulticastGroupRequest) GetMulticastGroup() *MulticastGroup {
+	if m != nil {
+		return m.MulticastGroup
+	}
+	return nil
+}
+
+type UpdateMulticastGroupResponse struct {
+}
+
+func (m *UpdateMulticastGroupResponse) Reset()                    { *m = UpdateMulticastGroupResponse{} }
+func (m *UpdateMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateMulticastGroupResponse) ProtoMessage()               {}
+func (*UpdateMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{6} }
+
+type DeleteMulticastGroupRequest struct {
+	// ID of the multicast group.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteMulticastGroupRequest) Reset()                    { *m = DeleteMulticastGroupRequest{} }
+func (m *DeleteMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMulticastGroupRequest) ProtoMessage()               {}
+func (*DeleteMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{7} }
+
+func (m *DeleteMulticastGroupRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteMulticastGroupResponse struct {
+}
+
+func (m *DeleteMulticastGroupResponse) Reset()                    { *m = DeleteMulticastGroupResponse{} }
+func (m *DeleteMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMulticastGroupResponse) ProtoMessage()               {}
+func (*DeleteMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{8} }
+
+type ListMulticastGroupRequest struct {
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,1,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListMulticastGroupRequest) Reset()                    { *m = ListMulticastGroupRequest{} }
+func (m *ListMulticastGroupRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListMulticastGroupRequest) ProtoMessage()               {}
+func (*ListMulticastGroupRequest) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{9} }
+
+func (m *ListMulticastGroupRequest) GetApplicationID() int64 {
+	if m != nil {
+		return m.ApplicationID
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListMulticastGroupResponse struct {
+	// Total number of multicast groups.
+	TotalCount int64                        `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*GetMulticastGroupResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListMulticastGroupResponse) Reset()                    { *m = ListMulticastGroupResponse{} }
+func (m *ListMulticastGroupResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListMulticastGroupResponse) ProtoMessage()               {}
+func (*ListMulticastGroupResponse) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{10} }
+
+func (m *ListMulticastGroupResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupResponse) GetResult() []*GetMulticastGroupResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type AddDeviceToMulticastGroupRequest struct {
+	// ID of the multicast group.
+	MulticastGroupID int64 `protobuf:"varint,1,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *AddDeviceToMulticastGroupRequest) Reset()         { *m = AddDeviceToMulticastGroupRequest{} }
+func (m *AddDeviceToMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*AddDeviceToMulticastGroupRequest) ProtoMessage()    {}
+func (*AddDeviceToMulticastGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{11}
+}
+
+func (m *AddDeviceToMulticastGroupRequest) GetMulticastGroupID() int64 {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return 0
+}
+
+func (m *AddDeviceToMulticastGroupRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type AddDeviceToMulticastGroupResponse struct {
+}
+
+func (m *AddDeviceToMulticastGroupResponse) Reset()         { *m = AddDeviceToMulticastGroupResponse{} }
+func (m *AddDeviceToMulticastGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*AddDeviceToMulticastGroupResponse) ProtoMessage()    {}
+func (*AddDeviceToMulticastGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{12}
+}
+
+type RemoveDeviceFromMulticastGroupRequest struct {
+	// ID of the multicast group.
+	MulticastGroupID int64 `protobuf:"varint,1,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,2,opt,name=devEUI" json:"devEUI,omitempty"`
+}
+
+func (m *RemoveDeviceFromMulticastGroupRequest) Reset()         { *m = RemoveDeviceFromMulticastGroupRequest{} }
+func (m *RemoveDeviceFromMulticastGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeviceFromMulticastGroupRequest) ProtoMessage()    {}
+func (*RemoveDeviceFromMulticastGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{13}
+}
+
+func (m *RemoveDeviceFromMulticastGroupRequest) GetMulticastGroupID() int64 {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return 0
+}
+
+func (m *RemoveDeviceFromMulticastGroupRequest) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+type RemoveDeviceFromMulticastGroupResponse struct {
+}
+
+func (m *RemoveDeviceFromMulticastGroupResponse) Reset() {
+	*m = RemoveDeviceFromMulticastGroupResponse{}
+}
+func (m *RemoveDeviceFromMulticastGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeviceFromMulticastGroupResponse) ProtoMessage()    {}
+func (*RemoveDeviceFromMulticastGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{14}
+}
+
+type ListMulticastGroupDevicesRequest struct {
+	// ID of the multicast group.
+	MulticastGroupID int64 `protobuf:"varint,1,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListMulticastGroupDevicesRequest) Reset()         { *m = ListMulticastGroupDevicesRequest{} }
+func (m *ListMulticastGroupDevicesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMulticastGroupDevicesRequest) ProtoMessage()    {}
+func (*ListMulticastGroupDevicesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{15}
+}
+
+func (m *ListMulticastGroupDevicesRequest) GetMulticastGroupID() int64 {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupDevicesRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupDevicesRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type MulticastGroupDevice struct {
+	// Hex encoded DevEUI of the device.
+	DevEUI string `protobuf:"bytes,1,opt,name=devEUI" json:"devEUI,omitempty"`
+	// Name of the device.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Timestamp when the device was added to the multicast group.
+	CreatedAt string `protobuf:"bytes,3,opt,name=createdAt" json:"createdAt,omitempty"`
+}
+
+func (m *MulticastGroupDevice) Reset()                    { *m = MulticastGroupDevice{} }
+func (m *MulticastGroupDevice) String() string            { return proto.CompactTextString(m) }
+func (*MulticastGroupDevice) ProtoMessage()               {}
+func (*MulticastGroupDevice) Descriptor() ([]byte, []int) { return fileDescriptor12, []int{16} }
+
+func (m *MulticastGroupDevice) GetDevEUI() string {
+	if m != nil {
+		return m.DevEUI
+	}
+	return ""
+}
+
+func (m *MulticastGroupDevice) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MulticastGroupDevice) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+type ListMulticastGroupDevicesResponse struct {
+	// Total number of devices in the multicast group.
+	TotalCount int64                   `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*MulticastGroupDevice `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListMulticastGroupDevicesResponse) Reset()         { *m = ListMulticastGroupDevicesResponse{} }
+func (m *ListMulticastGroupDevicesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMulticastGroupDevicesResponse) ProtoMessage()    {}
+func (*ListMulticastGroupDevicesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{17}
+}
+
+func (m *ListMulticastGroupDevicesResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListMulticastGroupDevicesResponse) GetResult() []*MulticastGroupDevice {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type EnqueueMulticastQueueItemRequest struct {
+	// ID of the multicast group.
+	MulticastGroupID int64 `protobuf:"varint,1,opt,name=multicastGroupID" json:"multicastGroupID,omitempty"`
+	// FPort used (must be > 0).
+	FPort uint32 `protobuf:"varint,2,opt,name=fPort" json:"fPort,omitempty"`
+	// Base64 encoded data.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *EnqueueMulticastQueueItemRequest) Reset()         { *m = EnqueueMulticastQueueItemRequest{} }
+func (m *EnqueueMulticastQueueItemRequest) String() string { return proto.CompactTextString(m) }
+func (*EnqueueMulticastQueueItemRequest) ProtoMessage()    {}
+func (*EnqueueMulticastQueueItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{18}
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetMulticastGroupID() int64 {
+	if m != nil {
+		return m.MulticastGroupID
+	}
+	return 0
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *EnqueueMulticastQueueItemRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type EnqueueMulticastQueueItemResponse struct {
+	// Frame-counter used for the multicast downlink.
+	FCnt uint32 `protobuf:"varint,1,opt,name=fCnt" json:"fCnt,omitempty"`
+}
+
+func (m *EnqueueMulticastQueueItemResponse) Reset()         { *m = EnqueueMulticastQueueItemResponse{} }
+func (m *EnqueueMulticastQueueItemResponse) String() string { return proto.CompactTextString(m) }
+func (*EnqueueMulticastQueueItemResponse) ProtoMessage()    {}
+func (*EnqueueMulticastQueueItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor12, []int{19}
+}
+
+func (m *EnqueueMulticastQueueItemResponse) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MulticastGroup)(nil), "api.MulticastGroup")
+	proto.RegisterType((*CreateMulticastGroupRequest)(nil), "api.CreateMulticastGroupRequest")
+	proto.RegisterType((*CreateMulticastGroupResponse)(nil), "api.CreateMulticastGroupResponse")
+	proto.RegisterType((*GetMulticastGroupRequest)(nil), "api.GetMulticastGroupRequest")
+	proto.RegisterType((*GetMulticastGroupResponse)(nil), "api.GetMulticastGroupResponse")
+	proto.RegisterType((*UpdateMulticastGroupRequest)(nil), "api.UpdateMulticastGroupRequest")
+	proto.RegisterType((*UpdateMulticastGroupResponse)(nil), "api.UpdateMulticastGroupResponse")
+	proto.RegisterType((*DeleteMulticastGroupRequest)(nil), "api.DeleteMulticastGroupRequest")
+	proto.RegisterType((*DeleteMulticastGroupResponse)(nil), "api.DeleteMulticastGroupResponse")
+	proto.RegisterType((*ListMulticastGroupRequest)(nil), "api.ListMulticastGroupRequest")
+	proto.RegisterType((*ListMulticastGroupResponse)(nil), "api.ListMulticastGroupResponse")
+	proto.RegisterType((*AddDeviceToMulticastGroupRequest)(nil), "api.AddDeviceToMulticastGroupRequest")
+	proto.RegisterType((*AddDeviceToMulticastGroupResponse)(nil), "api.AddDeviceToMulticastGroupResponse")
+	proto.RegisterType((*RemoveDeviceFromMulticastGroupRequest)(nil), "api.RemoveDeviceFromMulticastGroupRequest")
+	proto.RegisterType((*RemoveDeviceFromMulticastGroupResponse)(nil), "api.RemoveDeviceFromMulticastGroupResponse")
+	proto.RegisterType((*ListMulticastGroupDevicesRequest)(nil), "api.ListMulticastGroupDevicesRequest")
+	proto.RegisterType((*MulticastGroupDevice)(nil), "api.MulticastGroupDevice")
+	proto.RegisterType((*ListMulticastGroupDevicesResponse)(nil), "api.ListMulticastGroupDevicesResponse")
+	proto.RegisterType((*EnqueueMulticastQueueItemRequest)(nil), "api.EnqueueMulticastQueueItemRequest")
+	proto.RegisterType((*EnqueueMulticastQueueItemResponse)(nil), "api.EnqueueMulticastQueueItemResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for MulticastGroupService service
+
+type MulticastGroupServiceClient interface {
+	// Create creates the given multicast group.
+	Create(ctx context.Context, in *CreateMulticastGroupRequest, opts ...grpc.CallOption) (*CreateMulticastGroupResponse, error)
+	// Get returns the multicast group matching the given id.
+	Get(ctx context.Context, in *GetMulticastGroupRequest, opts ...grpc.CallOption) (*GetMulticastGroupResponse, error)
+	// Update updates the given multicast group.
+	Update(ctx context.Context, in *UpdateMulticastGroupRequest, opts ...grpc.CallOption) (*UpdateMulticastGroupResponse, error)
+	// Delete deletes the multicast group matching the given id.
+	Delete(ctx context.Context, in *DeleteMulticastGroupRequest, opts ...grpc.CallOption) (*DeleteMulticastGroupResponse, error)
+	// List lists the multicast groups of the given application.
+	List(ctx context.Context, in *ListMulticastGroupRequest, opts ...grpc.CallOption) (*ListMulticastGroupResponse, error)
+	// AddDevice adds the given device to the multicast group.
+	AddDevice(ctx context.Context, in *AddDeviceToMulticastGroupRequest, opts ...grpc.CallOption) (*AddDeviceToMulticastGroupResponse, error)
+	// RemoveDevice removes the given device from the multicast group.
+	RemoveDevice(ctx context.Context, in *RemoveDeviceFromMulticastGroupRequest, opts ...grpc.CallOption) (*RemoveDeviceFromMulticastGroupResponse, error)
+	// ListDevices lists the devices of the given multicast group.
+	ListDevices(ctx context.Context, in *ListMulticastGroupDevicesRequest, opts ...grpc.CallOption) (*ListMulticastGroupDevicesResponse, error)
+	// Enqueue encrypts the given payload with the multicast group keys
+	// and sends it to the devices of the multicast group.
+	Enqueue(ctx context.Context, in *EnqueueMulticastQueueItemRequest, opts ...grpc.CallOption) (*EnqueueMulticastQueueItemResponse, error)
+}
+
+type multicastGroupServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMulticastGroupServiceClient(cc *grpc.ClientConn) MulticastGroupServiceClient {
+	return &multicastGroupServiceClient{cc}
+}
+
+func (c *multicastGroupServiceClient) Create(ctx context.Context, in *CreateMulticastGroupRequest, opts ...grpc.CallOption) (*CreateMulticastGroupResponse, error) {
+	out := new(CreateMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) Get(ctx context.Context, in *GetMulticastGroupRequest, opts ...grpc.CallOption) (*GetMulticastGroupResponse, error) {
+	out := new(GetMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) Update(ctx context.Context, in *UpdateMulticastGroupRequest, opts ...grpc.CallOption) (*UpdateMulticastGroupResponse, error) {
+	out := new(UpdateMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) Delete(ctx context.Context, in *DeleteMulticastGroupRequest, opts ...grpc.CallOption) (*DeleteMulticastGroupResponse, error) {
+	out := new(DeleteMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) List(ctx context.Context, in *ListMulticastGroupRequest, opts ...grpc.CallOption) (*ListMulticastGroupResponse, error) {
+	out := new(ListMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) AddDevice(ctx context.Context, in *AddDeviceToMulticastGroupRequest, opts ...grpc.CallOption) (*AddDeviceToMulticastGroupResponse, error) {
+	out := new(AddDeviceToMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/AddDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) RemoveDevice(ctx context.Context, in *RemoveDeviceFromMulticastGroupRequest, opts ...grpc.CallOption) (*RemoveDeviceFromMulticastGroupResponse, error) {
+	out := new(RemoveDeviceFromMulticastGroupResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/RemoveDevice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) ListDevices(ctx context.Context, in *ListMulticastGroupDevicesRequest, opts ...grpc.CallOption) (*ListMulticastGroupDevicesResponse, error) {
+	out := new(ListMulticastGroupDevicesResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/ListDevices", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *multicastGroupServiceClient) Enqueue(ctx context.Context, in *EnqueueMulticastQueueItemRequest, opts ...grpc.CallOption) (*EnqueueMulticastQueueItemResponse, error) {
+	out := new(EnqueueMulticastQueueItemResponse)
+	err := grpc.Invoke(ctx, "/api.MulticastGroupService/Enqueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for MulticastGroupService service
+
+type MulticastGroupServiceServer interface {
+	// Create creates the given multicast group.
+	Create(context.Context, *CreateMulticastGroupRequest) (*CreateMulticastGroupResponse, error)
+	// Get returns the multicast group matching the given id.
+	Get(context.Context, *GetMulticastGroupRequest) (*GetMulticastGroupResponse, error)
+	// Update updates the given multicast group.
+	Update(context.Context, *UpdateMulticastGroupRequest) (*UpdateMulticastGroupResponse, error)
+	// Delete deletes the multicast group matching the given id.
+	Delete(context.Context, *DeleteMulticastGroupRequest) (*DeleteMulticastGroupResponse, error)
+	// List lists the multicast groups of the given application.
+	List(context.Context, *ListMulticastGroupRequest) (*ListMulticastGroupResponse, error)
+	// AddDevice adds the given device to the multicast group.
+	AddDevice(context.Context, *AddDeviceToMulticastGroupRequest) (*AddDeviceToMulticastGroupResponse, error)
+	// RemoveDevice removes the given device from the multicast group.
+	RemoveDevice(context.Context, *RemoveDeviceFromMulticastGroupRequest) (*RemoveDeviceFromMulticastGroupResponse, error)
+	// ListDevices lists the devices of the given multicast group.
+	ListDevices(context.Context, *ListMulticastGroupDevicesRequest) (*ListMulticastGroupDevicesResponse, error)
+	// Enqueue encrypts the given payload with the multicast group keys
+	// and sends it to the devices of the multicast group.
+	Enqueue(context.Context, *EnqueueMulticastQueueItemRequest) (*EnqueueMulticastQueueItemResponse, error)
+}
+
+func RegisterMulticastGroupServiceServer(s *grpc.Server, srv MulticastGroupServiceServer) {
+	s.RegisterService(&_MulticastGroupService_serviceDesc, srv)
+}
+
+func _MulticastGroupService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Create(ctx, req.(*CreateMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Get(ctx, req.(*GetMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Update(ctx, req.(*UpdateMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Delete(ctx, req.(*DeleteMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).List(ctx, req.(*ListMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_AddDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDeviceToMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).AddDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/AddDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).AddDevice(ctx, req.(*AddDeviceToMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_RemoveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeviceFromMulticastGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).RemoveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/RemoveDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).RemoveDevice(ctx, req.(*RemoveDeviceFromMulticastGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMulticastGroupDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/ListDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).ListDevices(ctx, req.(*ListMulticastGroupDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MulticastGroupService_Enqueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnqueueMulticastQueueItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MulticastGroupServiceServer).Enqueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.MulticastGroupService/Enqueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MulticastGroupServiceServer).Enqueue(ctx, req.(*EnqueueMulticastQueueItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MulticastGroupService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.MulticastGroupService",
+	HandlerType: (*MulticastGroupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _MulticastGroupService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _MulticastGroupService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _MulticastGroupService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _MulticastGroupService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _MulticastGroupService_List_Handler,
+		},
+		{
+			MethodName: "AddDevice",
+			Handler:    _MulticastGroupService_AddDevice_Handler,
+		},
+		{
+			MethodName: "RemoveDevice",
+			Handler:    _MulticastGroupService_RemoveDevice_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _MulticastGroupService_ListDevices_Handler,
+		},
+		{
+			MethodName: "Enqueue",
+			Handler:    _MulticastGroupService_Enqueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "multicastGroup.proto",
+}
+
+func init() { proto.RegisterFile("multicastGroup.proto", fileDescriptor12) }
+
+var fileDescriptor12 = []byte{
+	// 908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xef, 0x72, 0xdb, 0x44,
+	0x10, 0x9f, 0xb3, 0x53, 0x17, 0x6f, 0x9a, 0x0c, 0x73, 0x04, 0x46, 0x51, 0x8d, 0xb1, 0x05, 0xf5,
+	0x18, 0x33, 0xb5, 0xc1, 0x2d, 0x7f, 0x9a, 0x7c, 0xf2, 0x24, 0x6d, 0x26, 0x43, 0x61, 0x40, 0xa5,
+	0x5f, 0xf8, 0x84, 0xb0, 0xce, 0x41, 0x53, 0x4b, 0xa7, 0x48, 0x27, 0x43, 0x27, 0xf8, 0x0b, 0x3c,
+	0x42, 0x3b, 0xf0, 0x81, 0x37, 0xe0, 0x75, 0x78, 0x05, 0x1e, 0x80, 0x47, 0x60, 0x6e, 0xef, 0x6a,
+	0x5b, 0x8a, 0x2c, 0x39, 0xed, 0xf4, 0x9b, 0x6f, 0x77, 0x75, 0xbf, 0xdf, 0xfe, 0xf6, 0x76, 0x77,
+	0x0c, 0x7b, 0x7e, 0x32, 0x15, 0xde, 0xd8, 0x89, 0xc5, 0x49, 0xc4, 0x93, 0xb0, 0x1f, 0x46, 0x5c,
+	0x70, 0x5a, 0x75, 0x42, 0xcf, 0x6c, 0x9c, 0x71, 0x7e, 0x36, 0x65, 0x03, 0x27, 0xf4, 0x06, 0x4e,
+	0x10, 0x70, 0xe1, 0x08, 0x8f, 0x07, 0xb1, 0x0a, 0xb1, 0xfe, 0x23, 0xb0, 0xfb, 0x55, 0xea, 0x5b,
+	0xba, 0x0b, 0x15, 0xcf, 0x35, 0x48, 0x8b, 0x74, 0xab, 0x76, 0xc5, 0x73, 0xe9, 0x07, 0xb0, 0xe3,
+	0x84, 0xe1, 0xd4, 0x1b, 0xe3, 0x87, 0xa7, 0xc7, 0x46, 0x05, 0x5d, 0x69, 0x23, 0xa5, 0xb0, 0x15,
+	0x38, 0x3e, 0x33, 0xaa, 0x2d, 0xd2, 0xad, 0xdb, 0xf8, 0x9b, 0xbe, 0x03, 0x35, 0x7f, 0x3c, 0x72,
+	0xdd, 0xc8, 0xd8, 0x42, 0xab, 0x3e, 0xd1, 0x06, 0xd4, 0xfd, 0xf1, 0x28, 0x0c, 0x1f, 0x7d, 0xc9,
+	0x9e, 0x1a, 0xd7, 0xd0, 0xb5, 0x34, 0x28, 0xef, 0xd7, 0x3f, 0x3f, 0x41, 0x6f, 0xed, 0x85, 0x57,
+	0x1b, 0x24, 0xce, 0xe4, 0x28, 0x10, 0xc6, 0xf5, 0x16, 0xe9, 0xee, 0xd8, 0xf8, 0x5b, 0x7e, 0x31,
+	0x89, 0xd8, 0x79, 0xc2, 0x82, 0xf1, 0x53, 0xe3, 0x0d, 0x74, 0x2c, 0x0d, 0x32, 0x1f, 0x37, 0x32,
+	0xea, 0x68, 0xae, 0xb8, 0x91, 0xf5, 0x3d, 0xdc, 0x3c, 0x8a, 0x98, 0x23, 0x58, 0x3a, 0x6f, 0x5b,
+	0xc6, 0xc7, 0x82, 0x1e, 0xc2, 0x6e, 0x5a, 0x4c, 0x94, 0x62, 0x7b, 0xf8, 0x56, 0xdf, 0x09, 0xbd,
+	0x7e, 0xe6, 0x9b, 0x4c, 0xa8, 0xd5, 0x87, 0x46, 0xfe, 0xdd, 0x71, 0xc8, 0x83, 0x98, 0x65, 0xb5,
+	0xb5, 0x7a, 0x60, 0x9c, 0x30, 0x91, 0x4f, 0x24, 0x1b, 0xfb, 0x9c, 0xc0, 0x7e, 0x4e, 0xb0, 0xbe,
+	0xf9, 0x55, 0x68, 0x4b, 0x01, 0xc7, 0x48, 0xdb, 0x1d, 0x09, 0x2c, 0x6f, 0xdd, 0x5e, 0x1a, 0xa4,
+	0x37, 0x09, 0x5d, 0xed, 0x55, 0xf5, 0x5d, 0x1a, 0xa4, 0x9c, 0x8f, 0xf1, 0xf0, 0x1a, 0xe4, 0x6c,
+	0x42, 0x23, 0xff, 0x6e, 0x95, 0xb4, 0x75, 0x1b, 0x6e, 0x1e, 0xb3, 0x29, 0x13, 0x6c, 0x33, 0x05,
+	0x9b, 0xd0, 0xc8, 0x0f, 0xd7, 0xd7, 0x71, 0xd8, 0x7f, 0xe8, 0xc5, 0x6b, 0xca, 0x71, 0xa9, 0x0d,
+	0x48, 0x5e, 0x1b, 0xec, 0xc1, 0xb5, 0xa9, 0xe7, 0x7b, 0x42, 0x37, 0x89, 0x3a, 0xc8, 0x46, 0xe0,
+	0x93, 0x49, 0xcc, 0x94, 0x7c, 0x55, 0x5b, 0x9f, 0x2c, 0x01, 0x66, 0x1e, 0xa0, 0x2e, 0x69, 0x13,
+	0x40, 0x70, 0xe1, 0x4c, 0x8f, 0x78, 0x12, 0x08, 0x0d, 0xb7, 0x62, 0xa1, 0x9f, 0x41, 0x2d, 0x62,
+	0x71, 0x32, 0x95, 0x60, 0xd5, 0xee, 0xf6, 0xb0, 0x89, 0x92, 0xae, 0x7d, 0x22, 0xb6, 0x8e, 0xb6,
+	0x26, 0xd0, 0x1a, 0xb9, 0xee, 0x31, 0x9b, 0x79, 0x63, 0xf6, 0x1d, 0xcf, 0xcf, 0xb6, 0x07, 0x6f,
+	0xa6, 0x6b, 0xb1, 0x48, 0xf8, 0x92, 0x5d, 0x66, 0xe7, 0xb2, 0xd9, 0xfd, 0xc7, 0xa7, 0xfa, 0xe9,
+	0xe8, 0x93, 0xf5, 0x3e, 0xb4, 0x0b, 0x70, 0xb4, 0xe6, 0x4f, 0xe0, 0x96, 0xcd, 0x7c, 0x3e, 0x63,
+	0x2a, 0xee, 0x41, 0xc4, 0xfd, 0xd7, 0xc7, 0xa8, 0x0b, 0x9d, 0x32, 0x30, 0x4d, 0xeb, 0x57, 0x68,
+	0x5d, 0xae, 0x8c, 0xfa, 0x2a, 0x7e, 0x19, 0x46, 0x57, 0x7b, 0x17, 0x3f, 0xc0, 0x5e, 0x1e, 0xf2,
+	0x4a, 0x5e, 0x64, 0x35, 0xaf, 0xc5, 0xf0, 0xad, 0xac, 0x0c, 0xdf, 0x54, 0x4f, 0x57, 0x33, 0x3d,
+	0x6d, 0xcd, 0xa0, 0x5d, 0x90, 0xdf, 0x86, 0x0f, 0xf0, 0x93, 0xcc, 0x03, 0xdc, 0xcf, 0xe9, 0x69,
+	0x75, 0xe7, 0xe2, 0xed, 0xfd, 0x02, 0xad, 0xfb, 0xc1, 0x79, 0xc2, 0x92, 0x65, 0x0f, 0x7e, 0x2b,
+	0x4f, 0xa7, 0x82, 0xf9, 0x2f, 0xa9, 0xeb, 0xe4, 0x1b, 0x1e, 0x29, 0x5d, 0x77, 0x6c, 0x75, 0x90,
+	0x7a, 0xb8, 0x8e, 0x70, 0x30, 0xed, 0x1b, 0x36, 0xfe, 0xb6, 0x3e, 0x87, 0x76, 0x01, 0xb2, 0xce,
+	0xf8, 0xc5, 0x76, 0x21, 0xcb, 0xed, 0x32, 0x7c, 0x0e, 0xf0, 0x76, 0x3a, 0xa7, 0x47, 0x2c, 0xc2,
+	0x72, 0xfc, 0x45, 0xa0, 0xa6, 0xc6, 0x3d, 0x6d, 0x61, 0xea, 0x05, 0x7b, 0xc5, 0x6c, 0x17, 0x44,
+	0xe8, 0x47, 0xf7, 0xf0, 0xb7, 0x7f, 0xfe, 0x7d, 0x56, 0x79, 0x60, 0x8d, 0xd4, 0xb2, 0x5e, 0x0e,
+	0x96, 0x78, 0x70, 0x91, 0x59, 0xf0, 0xa9, 0xa9, 0x33, 0x1f, 0x2c, 0xbc, 0xb7, 0xcf, 0xa4, 0x3b,
+	0x3e, 0x20, 0x3d, 0xfa, 0x13, 0x54, 0x4f, 0x98, 0xa0, 0xef, 0xae, 0x9b, 0x0a, 0x8a, 0x56, 0xc9,
+	0xd0, 0xb0, 0x2c, 0xe4, 0xd4, 0xa0, 0x26, 0x72, 0xca, 0xe2, 0x0c, 0x2e, 0x3c, 0x77, 0x4e, 0x7f,
+	0x27, 0x50, 0x53, 0x73, 0x5a, 0xeb, 0x50, 0xb0, 0x10, 0xcc, 0x76, 0x41, 0x84, 0xc6, 0xbc, 0x8b,
+	0x98, 0x7d, 0xf3, 0xc3, 0x35, 0x98, 0x19, 0x2d, 0x3c, 0x77, 0x2e, 0xf3, 0x3d, 0x87, 0x9a, 0x9a,
+	0xee, 0x9a, 0x44, 0xc1, 0x66, 0x30, 0xdb, 0x05, 0x11, 0xe9, 0xc4, 0x7b, 0x25, 0x89, 0x6f, 0xc9,
+	0x36, 0xa2, 0x4a, 0xc5, 0xb5, 0xcb, 0xc3, 0x7c, 0x6f, 0xad, 0x5f, 0xa3, 0x1d, 0x20, 0xda, 0x5d,
+	0x3a, 0xcc, 0x29, 0x7d, 0x49, 0xad, 0xe9, 0x9f, 0x04, 0xea, 0x8b, 0x41, 0x4b, 0x6f, 0x21, 0x54,
+	0xd9, 0x80, 0x37, 0x3b, 0x65, 0x61, 0x9a, 0xd8, 0x21, 0x12, 0xfb, 0xd4, 0xfa, 0x78, 0xa3, 0x5a,
+	0x48, 0x7e, 0x2e, 0x5e, 0x88, 0x4f, 0xf0, 0x6f, 0x02, 0x37, 0x56, 0x07, 0x2e, 0xed, 0x21, 0xea,
+	0x46, 0x03, 0xdf, 0xfc, 0x68, 0xa3, 0x58, 0x4d, 0x73, 0x84, 0x34, 0x0f, 0x7b, 0xf7, 0xae, 0x4a,
+	0x73, 0x70, 0xa1, 0x66, 0xe8, 0x9c, 0xfe, 0x41, 0x60, 0x5b, 0x56, 0x48, 0x61, 0xc5, 0x5a, 0xc8,
+	0xb2, 0x2d, 0x60, 0x76, 0xca, 0xc2, 0x34, 0xc3, 0x2f, 0x90, 0xe1, 0x90, 0x5e, 0x59, 0x48, 0xfa,
+	0x8c, 0xc0, 0x75, 0x3d, 0xba, 0x34, 0xa9, 0xb2, 0x11, 0x6a, 0x76, 0xca, 0xc2, 0x34, 0xa9, 0x7b,
+	0x48, 0xea, 0x8e, 0xd5, 0xdf, 0x98, 0x14, 0xde, 0x78, 0x40, 0x7a, 0x3f, 0xd6, 0xf0, 0x0f, 0xc4,
+	0x9d, 0xff, 0x07, 0x00, 0x97, 0xf2, 0x1a, 0x49, 0x7b, 0x0c, 0x00, 0x00,
+}
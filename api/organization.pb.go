@@ -85,6 +85,12 @@ type GetOrganizationResponse struct {
 	CreatedAt string `protobuf:"bytes,5,opt,name=createdAt" json:"createdAt,omitempty"`
 	// When the user was last updated (excludes changes in application access).
 	UpdatedAt string `protobuf:"bytes,6,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// Members must login using a second factor (TOTP).
+	RequireSecondFactor bool `protobuf:"varint,7,opt,name=requireSecondFactor" json:"requireSecondFactor,omitempty"`
+	// Resource quota of the organization.
+	Quota *OrganizationQuota `protobuf:"bytes,8,opt,name=quota" json:"quota,omitempty"`
+	// Current resource usage of the organization.
+	Usage *OrganizationUsage `protobuf:"bytes,9,opt,name=usage" json:"usage,omitempty"`
 }
 
 func (m *GetOrganizationResponse) Reset()                    { *m = GetOrganizationResponse{} }
@@ -134,6 +140,141 @@ func (m *GetOrganizationResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (m *GetOrganizationResponse) GetRequireSecondFactor() bool {
+	if m != nil {
+		return m.RequireSecondFactor
+	}
+	return false
+}
+
+func (m *GetOrganizationResponse) GetQuota() *OrganizationQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *GetOrganizationResponse) GetUsage() *OrganizationUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+// Max. number of resources of an organization, 0 means unlimited.
+type OrganizationQuota struct {
+	MaxApplications   int32 `protobuf:"varint,1,opt,name=maxApplications" json:"maxApplications,omitempty"`
+	MaxDevices        int32 `protobuf:"varint,2,opt,name=maxDevices" json:"maxDevices,omitempty"`
+	MaxGateways       int32 `protobuf:"varint,3,opt,name=maxGateways" json:"maxGateways,omitempty"`
+	MaxDeviceProfiles int32 `protobuf:"varint,4,opt,name=maxDeviceProfiles" json:"maxDeviceProfiles,omitempty"`
+	MaxIntegrations   int32 `protobuf:"varint,5,opt,name=maxIntegrations" json:"maxIntegrations,omitempty"`
+	MaxUplinksPerDay  int32 `protobuf:"varint,6,opt,name=maxUplinksPerDay" json:"maxUplinksPerDay,omitempty"`
+}
+
+func (m *OrganizationQuota) Reset()                    { *m = OrganizationQuota{} }
+func (m *OrganizationQuota) String() string            { return proto.CompactTextString(m) }
+func (*OrganizationQuota) ProtoMessage()               {}
+func (*OrganizationQuota) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
+
+func (m *OrganizationQuota) GetMaxApplications() int32 {
+	if m != nil {
+		return m.MaxApplications
+	}
+	return 0
+}
+
+func (m *OrganizationQuota) GetMaxDevices() int32 {
+	if m != nil {
+		return m.MaxDevices
+	}
+	return 0
+}
+
+func (m *OrganizationQuota) GetMaxGateways() int32 {
+	if m != nil {
+		return m.MaxGateways
+	}
+	return 0
+}
+
+func (m *OrganizationQuota) GetMaxDeviceProfiles() int32 {
+	if m != nil {
+		return m.MaxDeviceProfiles
+	}
+	return 0
+}
+
+func (m *OrganizationQuota) GetMaxIntegrations() int32 {
+	if m != nil {
+		return m.MaxIntegrations
+	}
+	return 0
+}
+
+func (m *OrganizationQuota) GetMaxUplinksPerDay() int32 {
+	if m != nil {
+		return m.MaxUplinksPerDay
+	}
+	return 0
+}
+
+type OrganizationUsage struct {
+	Applications   int32 `protobuf:"varint,1,opt,name=applications" json:"applications,omitempty"`
+	Devices        int32 `protobuf:"varint,2,opt,name=devices" json:"devices,omitempty"`
+	Gateways       int32 `protobuf:"varint,3,opt,name=gateways" json:"gateways,omitempty"`
+	DeviceProfiles int32 `protobuf:"varint,4,opt,name=deviceProfiles" json:"deviceProfiles,omitempty"`
+	Integrations   int32 `protobuf:"varint,5,opt,name=integrations" json:"integrations,omitempty"`
+	// Number of uplinks received today (UTC).
+	UplinksToday int32 `protobuf:"varint,6,opt,name=uplinksToday" json:"uplinksToday,omitempty"`
+}
+
+func (m *OrganizationUsage) Reset()                    { *m = OrganizationUsage{} }
+func (m *OrganizationUsage) String() string            { return proto.CompactTextString(m) }
+func (*OrganizationUsage) ProtoMessage()               {}
+func (*OrganizationUsage) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{4} }
+
+func (m *OrganizationUsage) GetApplications() int32 {
+	if m != nil {
+		return m.Applications
+	}
+	return 0
+}
+
+func (m *OrganizationUsage) GetDevices() int32 {
+	if m != nil {
+		return m.Devices
+	}
+	return 0
+}
+
+func (m *OrganizationUsage) GetGateways() int32 {
+	if m != nil {
+		return m.Gateways
+	}
+	return 0
+}
+
+func (m *OrganizationUsage) GetDeviceProfiles() int32 {
+	if m != nil {
+		return m.DeviceProfiles
+	}
+	return 0
+}
+
+func (m *OrganizationUsage) GetIntegrations() int32 {
+	if m != nil {
+		return m.Integrations
+	}
+	return 0
+}
+
+func (m *OrganizationUsage) GetUplinksToday() int32 {
+	if m != nil {
+		return m.UplinksToday
+	}
+	return 0
+}
+
 // Add a new organization.
 type CreateOrganizationRequest struct {
 	// Organization name.
@@ -142,12 +283,16 @@ type CreateOrganizationRequest struct {
 	DisplayName string `protobuf:"bytes,2,opt,name=displayName" json:"displayName,omitempty"`
 	// Can the organization create and "own" Gateways?
 	CanHaveGateways bool `protobuf:"varint,3,opt,name=canHaveGateways" json:"canHaveGateways,omitempty"`
+	// Members must login using a second factor (TOTP).
+	RequireSecondFactor bool `protobuf:"varint,4,opt,name=requireSecondFactor" json:"requireSecondFactor,omitempty"`
+	// Resource quota of the organization.
+	Quota *OrganizationQuota `protobuf:"bytes,5,opt,name=quota" json:"quota,omitempty"`
 }
 
 func (m *CreateOrganizationRequest) Reset()                    { *m = CreateOrganizationRequest{} }
 func (m *CreateOrganizationRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateOrganizationRequest) ProtoMessage()               {}
-func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{3} }
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{5} }
 
 func (m *CreateOrganizationRequest) GetName() string {
 	if m != nil {
@@ -170,6 +315,20 @@ func (m *CreateOrganizationRequest) GetCanHaveGateways() bool {
 	return false
 }
 
+func (m *CreateOrganizationRequest) GetRequireSecondFactor() bool {
+	if m != nil {
+		return m.RequireSecondFactor
+	}
+	return false
+}
+
+func (m *CreateOrganizationRequest) GetQuota() *OrganizationQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
 type CreateOrganizationResponse struct {
 	// ID of the organization.
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -178,7 +337,7 @@ type CreateOrganizationResponse struct {
 func (m *CreateOrganizationResponse) Reset()                    { *m = CreateOrganizationResponse{} }
 func (m *CreateOrganizationResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateOrganizationResponse) ProtoMessage()               {}
-func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{4} }
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{6} }
 
 func (m *CreateOrganizationResponse) GetId() int64 {
 	if m != nil {
@@ -197,12 +356,16 @@ type UpdateOrganizationRequest struct {
 	DisplayName string `protobuf:"bytes,3,opt,name=displayName" json:"displayName,omitempty"`
 	// Can the organization create and "own" Gateways?
 	CanHaveGateways bool `protobuf:"varint,4,opt,name=canHaveGateways" json:"canHaveGateways,omitempty"`
+	// Members must login using a second factor (TOTP).
+	RequireSecondFactor bool `protobuf:"varint,5,opt,name=requireSecondFactor" json:"requireSecondFactor,omitempty"`
+	// Resource quota of the organization (can only be set by global admin users).
+	Quota *OrganizationQuota `protobuf:"bytes,6,opt,name=quota" json:"quota,omitempty"`
 }
 
 func (m *UpdateOrganizationRequest) Reset()                    { *m = UpdateOrganizationRequest{} }
 func (m *UpdateOrganizationRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateOrganizationRequest) ProtoMessage()               {}
-func (*UpdateOrganizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{5} }
+func (*UpdateOrganizationRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{7} }
 
 func (m *UpdateOrganizationRequest) GetId() int64 {
 	if m != nil {
@@ -232,6 +395,20 @@ func (m *UpdateOrganizationRequest) GetCanHaveGateways() bool {
 	return false
 }
 
+func (m *UpdateOrganizationRequest) GetRequireSecondFactor() bool {
+	if m != nil {
+		return m.RequireSecondFactor
+	}
+	return false
+}
+
+func (m *UpdateOrganizationRequest) GetQuota() *OrganizationQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
 type ListOrganizationResponse struct {
 	TotalCount int32                      `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
 	Result     []*GetOrganizationResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
@@ -240,7 +417,7 @@ type ListOrganizationResponse struct {
 func (m *ListOrganizationResponse) Reset()                    { *m = ListOrganizationResponse{} }
 func (m *ListOrganizationResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListOrganizationResponse) ProtoMessage()               {}
-func (*ListOrganizationResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{6} }
+func (*ListOrganizationResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{8} }
 
 func (m *ListOrganizationResponse) GetTotalCount() int32 {
 	if m != nil {
@@ -262,7 +439,7 @@ type OrganizationEmptyResponse struct {
 func (m *OrganizationEmptyResponse) Reset()                    { *m = OrganizationEmptyResponse{} }
 func (m *OrganizationEmptyResponse) String() string            { return proto.CompactTextString(m) }
 func (*OrganizationEmptyResponse) ProtoMessage()               {}
-func (*OrganizationEmptyResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{7} }
+func (*OrganizationEmptyResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{9} }
 
 type OrganizationUserRequest struct {
 	// The organization id.
@@ -271,12 +448,20 @@ type OrganizationUserRequest struct {
 	UserID int64 `protobuf:"varint,2,opt,name=userID" json:"userID,omitempty"`
 	// The user's admin status for the organization
 	IsAdmin bool `protobuf:"varint,3,opt,name=isAdmin" json:"isAdmin,omitempty"`
+	// The user can manage the devices (and device keys) of the organization.
+	IsDeviceAdmin bool `protobuf:"varint,4,opt,name=isDeviceAdmin" json:"isDeviceAdmin,omitempty"`
+	// The user can manage the gateways of the organization.
+	IsGatewayAdmin bool `protobuf:"varint,5,opt,name=isGatewayAdmin" json:"isGatewayAdmin,omitempty"`
+	// The user can manage the integrations of the organization applications.
+	IsIntegrationAdmin bool `protobuf:"varint,6,opt,name=isIntegrationAdmin" json:"isIntegrationAdmin,omitempty"`
+	// The user has read-only access (without access to device keys).
+	IsViewer bool `protobuf:"varint,7,opt,name=isViewer" json:"isViewer,omitempty"`
 }
 
 func (m *OrganizationUserRequest) Reset()                    { *m = OrganizationUserRequest{} }
 func (m *OrganizationUserRequest) String() string            { return proto.CompactTextString(m) }
 func (*OrganizationUserRequest) ProtoMessage()               {}
-func (*OrganizationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{8} }
+func (*OrganizationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
 
 func (m *OrganizationUserRequest) GetId() int64 {
 	if m != nil {
@@ -299,6 +484,34 @@ func (m *OrganizationUserRequest) GetIsAdmin() bool {
 	return false
 }
 
+func (m *OrganizationUserRequest) GetIsDeviceAdmin() bool {
+	if m != nil {
+		return m.IsDeviceAdmin
+	}
+	return false
+}
+
+func (m *OrganizationUserRequest) GetIsGatewayAdmin() bool {
+	if m != nil {
+		return m.IsGatewayAdmin
+	}
+	return false
+}
+
+func (m *OrganizationUserRequest) GetIsIntegrationAdmin() bool {
+	if m != nil {
+		return m.IsIntegrationAdmin
+	}
+	return false
+}
+
+func (m *OrganizationUserRequest) GetIsViewer() bool {
+	if m != nil {
+		return m.IsViewer
+	}
+	return false
+}
+
 type DeleteOrganizationUserRequest struct {
 	// The organization id.
 	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
@@ -309,7 +522,7 @@ type DeleteOrganizationUserRequest struct {
 func (m *DeleteOrganizationUserRequest) Reset()                    { *m = DeleteOrganizationUserRequest{} }
 func (m *DeleteOrganizationUserRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteOrganizationUserRequest) ProtoMessage()               {}
-func (*DeleteOrganizationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{9} }
+func (*DeleteOrganizationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
 
 func (m *DeleteOrganizationUserRequest) GetId() int64 {
 	if m != nil {
@@ -338,7 +551,7 @@ type ListOrganizationUsersRequest struct {
 func (m *ListOrganizationUsersRequest) Reset()                    { *m = ListOrganizationUsersRequest{} }
 func (m *ListOrganizationUsersRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListOrganizationUsersRequest) ProtoMessage()               {}
-func (*ListOrganizationUsersRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{10} }
+func (*ListOrganizationUsersRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{12} }
 
 func (m *ListOrganizationUsersRequest) GetId() int64 {
 	if m != nil {
@@ -371,7 +584,7 @@ type GetOrganizationUserRequest struct {
 func (m *GetOrganizationUserRequest) Reset()                    { *m = GetOrganizationUserRequest{} }
 func (m *GetOrganizationUserRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetOrganizationUserRequest) ProtoMessage()               {}
-func (*GetOrganizationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{11} }
+func (*GetOrganizationUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{13} }
 
 func (m *GetOrganizationUserRequest) GetId() int64 {
 	if m != nil {
@@ -399,69 +612,538 @@ type GetOrganizationUserResponse struct {
 	CreatedAt string `protobuf:"bytes,4,opt,name=createdAt" json:"createdAt,omitempty"`
 	// When the user was last updated (excludes changes in application access).
 	UpdatedAt string `protobuf:"bytes,5,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// The user can manage the devices (and device keys) of the organization.
+	IsDeviceAdmin bool `protobuf:"varint,6,opt,name=isDeviceAdmin" json:"isDeviceAdmin,omitempty"`
+	// The user can manage the gateways of the organization.
+	IsGatewayAdmin bool `protobuf:"varint,7,opt,name=isGatewayAdmin" json:"isGatewayAdmin,omitempty"`
+	// The user can manage the integrations of the organization applications.
+	IsIntegrationAdmin bool `protobuf:"varint,8,opt,name=isIntegrationAdmin" json:"isIntegrationAdmin,omitempty"`
+	// The user has read-only access (without access to device keys).
+	IsViewer bool `protobuf:"varint,9,opt,name=isViewer" json:"isViewer,omitempty"`
 }
 
 func (m *GetOrganizationUserResponse) Reset()                    { *m = GetOrganizationUserResponse{} }
 func (m *GetOrganizationUserResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetOrganizationUserResponse) ProtoMessage()               {}
-func (*GetOrganizationUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{12} }
+func (*GetOrganizationUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{14} }
+
+func (m *GetOrganizationUserResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetOrganizationUserResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetOrganizationUserResponse) GetIsAdmin() bool {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return false
+}
+
+func (m *GetOrganizationUserResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetOrganizationUserResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *GetOrganizationUserResponse) GetIsDeviceAdmin() bool {
+	if m != nil {
+		return m.IsDeviceAdmin
+	}
+	return false
+}
+
+func (m *GetOrganizationUserResponse) GetIsGatewayAdmin() bool {
+	if m != nil {
+		return m.IsGatewayAdmin
+	}
+	return false
+}
+
+func (m *GetOrganizationUserResponse) GetIsIntegrationAdmin() bool {
+	if m != nil {
+		return m.IsIntegrationAdmin
+	}
+	return false
+}
+
+func (m *GetOrganizationUserResponse) GetIsViewer() bool {
+	if m != nil {
+		return m.IsViewer
+	}
+	return false
+}
+
+// Response for the users in an organization.
+type ListOrganizationUsersResponse struct {
+	// The total number of users in the organization.
+	TotalCount int32 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	// The users in the requested limit, offset range.
+	Result []*GetOrganizationUserResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListOrganizationUsersResponse) Reset()                    { *m = ListOrganizationUsersResponse{} }
+func (m *ListOrganizationUsersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListOrganizationUsersResponse) ProtoMessage()               {}
+func (*ListOrganizationUsersResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{15} }
+
+func (m *ListOrganizationUsersResponse) GetTotalCount() int32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListOrganizationUsersResponse) GetResult() []*GetOrganizationUserResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type CreateOrganizationInvitationRequest struct {
+	// The organization id.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The e-mail address to invite.
+	Email string `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+	// The user's admin status for the organization.
+	IsAdmin bool `protobuf:"varint,3,opt,name=isAdmin" json:"isAdmin,omitempty"`
+	// The user can manage the devices (and device keys) of the organization.
+	IsDeviceAdmin bool `protobuf:"varint,4,opt,name=isDeviceAdmin" json:"isDeviceAdmin,omitempty"`
+	// The user can manage the gateways of the organization.
+	IsGatewayAdmin bool `protobuf:"varint,5,opt,name=isGatewayAdmin" json:"isGatewayAdmin,omitempty"`
+	// The user can manage the integrations of the organization applications.
+	IsIntegrationAdmin bool `protobuf:"varint,6,opt,name=isIntegrationAdmin" json:"isIntegrationAdmin,omitempty"`
+	// The user has read-only access (without access to device keys).
+	IsViewer bool `protobuf:"varint,7,opt,name=isViewer" json:"isViewer,omitempty"`
+}
+
+func (m *CreateOrganizationInvitationRequest) Reset()         { *m = CreateOrganizationInvitationRequest{} }
+func (m *CreateOrganizationInvitationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationInvitationRequest) ProtoMessage()    {}
+func (*CreateOrganizationInvitationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{16}
+}
+
+func (m *CreateOrganizationInvitationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CreateOrganizationInvitationRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *CreateOrganizationInvitationRequest) GetIsAdmin() bool {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return false
+}
+
+func (m *CreateOrganizationInvitationRequest) GetIsDeviceAdmin() bool {
+	if m != nil {
+		return m.IsDeviceAdmin
+	}
+	return false
+}
+
+func (m *CreateOrganizationInvitationRequest) GetIsGatewayAdmin() bool {
+	if m != nil {
+		return m.IsGatewayAdmin
+	}
+	return false
+}
+
+func (m *CreateOrganizationInvitationRequest) GetIsIntegrationAdmin() bool {
+	if m != nil {
+		return m.IsIntegrationAdmin
+	}
+	return false
+}
+
+func (m *CreateOrganizationInvitationRequest) GetIsViewer() bool {
+	if m != nil {
+		return m.IsViewer
+	}
+	return false
+}
+
+type CreateOrganizationInvitationResponse struct {
+	// ID of the created invitation.
+	InvitationID int64 `protobuf:"varint,1,opt,name=invitationID" json:"invitationID,omitempty"`
+}
+
+func (m *CreateOrganizationInvitationResponse) Reset()         { *m = CreateOrganizationInvitationResponse{} }
+func (m *CreateOrganizationInvitationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationInvitationResponse) ProtoMessage()    {}
+func (*CreateOrganizationInvitationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{17}
+}
+
+func (m *CreateOrganizationInvitationResponse) GetInvitationID() int64 {
+	if m != nil {
+		return m.InvitationID
+	}
+	return 0
+}
+
+type OrganizationInvitationRequest struct {
+	// The organization id.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// The invitation id.
+	InvitationID int64 `protobuf:"varint,2,opt,name=invitationID" json:"invitationID,omitempty"`
+}
+
+func (m *OrganizationInvitationRequest) Reset()                    { *m = OrganizationInvitationRequest{} }
+func (m *OrganizationInvitationRequest) String() string            { return proto.CompactTextString(m) }
+func (*OrganizationInvitationRequest) ProtoMessage()               {}
+func (*OrganizationInvitationRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{18} }
+
+func (m *OrganizationInvitationRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OrganizationInvitationRequest) GetInvitationID() int64 {
+	if m != nil {
+		return m.InvitationID
+	}
+	return 0
+}
+
+// Request the invitations of an organization.
+type ListOrganizationInvitationsRequest struct {
+	// The organization id.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Max number of invitations to return in the result-set.
+	Limit int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int32 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListOrganizationInvitationsRequest) Reset()         { *m = ListOrganizationInvitationsRequest{} }
+func (m *ListOrganizationInvitationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationInvitationsRequest) ProtoMessage()    {}
+func (*ListOrganizationInvitationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{19}
+}
+
+func (m *ListOrganizationInvitationsRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ListOrganizationInvitationsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListOrganizationInvitationsRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type OrganizationInvitation struct {
+	// ID of the invitation.
+	InvitationID int64 `protobuf:"varint,1,opt,name=invitationID" json:"invitationID,omitempty"`
+	// The invited e-mail address.
+	Email string `protobuf:"bytes,2,opt,name=email" json:"email,omitempty"`
+	// The user's admin status for the organization.
+	IsAdmin bool `protobuf:"varint,3,opt,name=isAdmin" json:"isAdmin,omitempty"`
+	// The user can manage the devices (and device keys) of the organization.
+	IsDeviceAdmin bool `protobuf:"varint,4,opt,name=isDeviceAdmin" json:"isDeviceAdmin,omitempty"`
+	// The user can manage the gateways of the organization.
+	IsGatewayAdmin bool `protobuf:"varint,5,opt,name=isGatewayAdmin" json:"isGatewayAdmin,omitempty"`
+	// The user can manage the integrations of the organization applications.
+	IsIntegrationAdmin bool `protobuf:"varint,6,opt,name=isIntegrationAdmin" json:"isIntegrationAdmin,omitempty"`
+	// The user has read-only access (without access to device keys).
+	IsViewer bool `protobuf:"varint,7,opt,name=isViewer" json:"isViewer,omitempty"`
+	// Username of the user who sent the invitation.
+	InvitedBy string `protobuf:"bytes,8,opt,name=invitedBy" json:"invitedBy,omitempty"`
+	// When the invitation was created.
+	CreatedAt string `protobuf:"bytes,9,opt,name=createdAt" json:"createdAt,omitempty"`
+	// When the invitation was last sent.
+	UpdatedAt string `protobuf:"bytes,10,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// When the invitation expires.
+	ExpiresAt string `protobuf:"bytes,11,opt,name=expiresAt" json:"expiresAt,omitempty"`
+}
+
+func (m *OrganizationInvitation) Reset()                    { *m = OrganizationInvitation{} }
+func (m *OrganizationInvitation) String() string            { return proto.CompactTextString(m) }
+func (*OrganizationInvitation) ProtoMessage()               {}
+func (*OrganizationInvitation) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{20} }
+
+func (m *OrganizationInvitation) GetInvitationID() int64 {
+	if m != nil {
+		return m.InvitationID
+	}
+	return 0
+}
+
+func (m *OrganizationInvitation) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+func (m *OrganizationInvitation) GetIsAdmin() bool {
+	if m != nil {
+		return m.IsAdmin
+	}
+	return false
+}
+
+func (m *OrganizationInvitation) GetIsDeviceAdmin() bool {
+	if m != nil {
+		return m.IsDeviceAdmin
+	}
+	return false
+}
+
+func (m *OrganizationInvitation) GetIsGatewayAdmin() bool {
+	if m != nil {
+		return m.IsGatewayAdmin
+	}
+	return false
+}
+
+func (m *OrganizationInvitation) GetIsIntegrationAdmin() bool {
+	if m != nil {
+		return m.IsIntegrationAdmin
+	}
+	return false
+}
+
+func (m *OrganizationInvitation) GetIsViewer() bool {
+	if m != nil {
+		return m.IsViewer
+	}
+	return false
+}
+
+func (m *OrganizationInvitation) GetInvitedBy() string {
+	if m != nil {
+		return m.InvitedBy
+	}
+	return ""
+}
+
+func (m *OrganizationInvitation) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *OrganizationInvitation) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *OrganizationInvitation) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+// Response for the invitations of an organization.
+type ListOrganizationInvitationsResponse struct {
+	// The total number of invitations of the organization.
+	TotalCount int32 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	// The invitations in the requested limit, offset range.
+	Result []*OrganizationInvitation `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListOrganizationInvitationsResponse) Reset()         { *m = ListOrganizationInvitationsResponse{} }
+func (m *ListOrganizationInvitationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationInvitationsResponse) ProtoMessage()    {}
+func (*ListOrganizationInvitationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor6, []int{21}
+}
+
+func (m *ListOrganizationInvitationsResponse) GetTotalCount() int32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListOrganizationInvitationsResponse) GetResult() []*OrganizationInvitation {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type GetOrganizationUsageRequest struct {
+	// The organization id.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// First date (YYYY-MM-DD, UTC) to return the usage for.
+	StartDate string `protobuf:"bytes,2,opt,name=startDate" json:"startDate,omitempty"`
+	// Date (YYYY-MM-DD, UTC) until which to return the usage (exclusive).
+	EndDate string `protobuf:"bytes,3,opt,name=endDate" json:"endDate,omitempty"`
+}
+
+func (m *GetOrganizationUsageRequest) Reset()                    { *m = GetOrganizationUsageRequest{} }
+func (m *GetOrganizationUsageRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetOrganizationUsageRequest) ProtoMessage()               {}
+func (*GetOrganizationUsageRequest) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{22} }
+
+func (m *GetOrganizationUsageRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *GetOrganizationUsageRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetOrganizationUsageRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+// Usage of an application for a day (UTC).
+type OrganizationUsageRecord struct {
+	// Date (YYYY-MM-DD).
+	Date string `protobuf:"bytes,1,opt,name=date" json:"date,omitempty"`
+	// ID of the application.
+	ApplicationID int64 `protobuf:"varint,2,opt,name=applicationID" json:"applicationID,omitempty"`
+	// Name of the application (empty when the application has been deleted).
+	ApplicationName string `protobuf:"bytes,3,opt,name=applicationName" json:"applicationName,omitempty"`
+	// Number of uplinks.
+	Uplinks int64 `protobuf:"varint,4,opt,name=uplinks" json:"uplinks,omitempty"`
+	// Number of uplink payload bytes.
+	UplinkBytes int64 `protobuf:"varint,5,opt,name=uplinkBytes" json:"uplinkBytes,omitempty"`
+	// Number of downlinks.
+	Downlinks int64 `protobuf:"varint,6,opt,name=downlinks" json:"downlinks,omitempty"`
+	// Number of downlink payload bytes.
+	DownlinkBytes int64 `protobuf:"varint,7,opt,name=downlinkBytes" json:"downlinkBytes,omitempty"`
+	// Number of joins.
+	Joins int64 `protobuf:"varint,8,opt,name=joins" json:"joins,omitempty"`
+	// Number of devices which sent an uplink or joined.
+	ActiveDevices int64 `protobuf:"varint,9,opt,name=activeDevices" json:"activeDevices,omitempty"`
+}
+
+func (m *OrganizationUsageRecord) Reset()                    { *m = OrganizationUsageRecord{} }
+func (m *OrganizationUsageRecord) String() string            { return proto.CompactTextString(m) }
+func (*OrganizationUsageRecord) ProtoMessage()               {}
+func (*OrganizationUsageRecord) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{23} }
+
+func (m *OrganizationUsageRecord) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
 
-func (m *GetOrganizationUserResponse) GetId() int64 {
+func (m *OrganizationUsageRecord) GetApplicationID() int64 {
 	if m != nil {
-		return m.Id
+		return m.ApplicationID
 	}
 	return 0
 }
 
-func (m *GetOrganizationUserResponse) GetUsername() string {
+func (m *OrganizationUsageRecord) GetApplicationName() string {
 	if m != nil {
-		return m.Username
+		return m.ApplicationName
 	}
 	return ""
 }
 
-func (m *GetOrganizationUserResponse) GetIsAdmin() bool {
+func (m *OrganizationUsageRecord) GetUplinks() int64 {
 	if m != nil {
-		return m.IsAdmin
+		return m.Uplinks
 	}
-	return false
+	return 0
 }
 
-func (m *GetOrganizationUserResponse) GetCreatedAt() string {
+func (m *OrganizationUsageRecord) GetUplinkBytes() int64 {
 	if m != nil {
-		return m.CreatedAt
+		return m.UplinkBytes
 	}
-	return ""
+	return 0
 }
 
-func (m *GetOrganizationUserResponse) GetUpdatedAt() string {
+func (m *OrganizationUsageRecord) GetDownlinks() int64 {
 	if m != nil {
-		return m.UpdatedAt
+		return m.Downlinks
 	}
-	return ""
+	return 0
 }
 
-// Response for the users in an organization.
-type ListOrganizationUsersResponse struct {
-	// The total number of users in the organization.
-	TotalCount int32 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
-	// The users in the requested limit, offset range.
-	Result []*GetOrganizationUserResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+func (m *OrganizationUsageRecord) GetDownlinkBytes() int64 {
+	if m != nil {
+		return m.DownlinkBytes
+	}
+	return 0
 }
 
-func (m *ListOrganizationUsersResponse) Reset()                    { *m = ListOrganizationUsersResponse{} }
-func (m *ListOrganizationUsersResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListOrganizationUsersResponse) ProtoMessage()               {}
-func (*ListOrganizationUsersResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{13} }
+func (m *OrganizationUsageRecord) GetJoins() int64 {
+	if m != nil {
+		return m.Joins
+	}
+	return 0
+}
 
-func (m *ListOrganizationUsersResponse) GetTotalCount() int32 {
+func (m *OrganizationUsageRecord) GetActiveDevices() int64 {
 	if m != nil {
-		return m.TotalCount
+		return m.ActiveDevices
 	}
 	return 0
 }
 
-func (m *ListOrganizationUsersResponse) GetResult() []*GetOrganizationUserResponse {
+type GetOrganizationUsageResponse struct {
+	Result []*OrganizationUsageRecord `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *GetOrganizationUsageResponse) Reset()                    { *m = GetOrganizationUsageResponse{} }
+func (m *GetOrganizationUsageResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetOrganizationUsageResponse) ProtoMessage()               {}
+func (*GetOrganizationUsageResponse) Descriptor() ([]byte, []int) { return fileDescriptor6, []int{24} }
+
+func (m *GetOrganizationUsageResponse) GetResult() []*OrganizationUsageRecord {
 	if m != nil {
 		return m.Result
 	}
@@ -472,6 +1154,8 @@ func init() {
 	proto.RegisterType((*ListOrganizationRequest)(nil), "api.ListOrganizationRequest")
 	proto.RegisterType((*OrganizationRequest)(nil), "api.OrganizationRequest")
 	proto.RegisterType((*GetOrganizationResponse)(nil), "api.GetOrganizationResponse")
+	proto.RegisterType((*OrganizationQuota)(nil), "api.OrganizationQuota")
+	proto.RegisterType((*OrganizationUsage)(nil), "api.OrganizationUsage")
 	proto.RegisterType((*CreateOrganizationRequest)(nil), "api.CreateOrganizationRequest")
 	proto.RegisterType((*CreateOrganizationResponse)(nil), "api.CreateOrganizationResponse")
 	proto.RegisterType((*UpdateOrganizationRequest)(nil), "api.UpdateOrganizationRequest")
@@ -483,6 +1167,15 @@ func init() {
 	proto.RegisterType((*GetOrganizationUserRequest)(nil), "api.GetOrganizationUserRequest")
 	proto.RegisterType((*GetOrganizationUserResponse)(nil), "api.GetOrganizationUserResponse")
 	proto.RegisterType((*ListOrganizationUsersResponse)(nil), "api.ListOrganizationUsersResponse")
+	proto.RegisterType((*CreateOrganizationInvitationRequest)(nil), "api.CreateOrganizationInvitationRequest")
+	proto.RegisterType((*CreateOrganizationInvitationResponse)(nil), "api.CreateOrganizationInvitationResponse")
+	proto.RegisterType((*OrganizationInvitationRequest)(nil), "api.OrganizationInvitationRequest")
+	proto.RegisterType((*ListOrganizationInvitationsRequest)(nil), "api.ListOrganizationInvitationsRequest")
+	proto.RegisterType((*OrganizationInvitation)(nil), "api.OrganizationInvitation")
+	proto.RegisterType((*ListOrganizationInvitationsResponse)(nil), "api.ListOrganizationInvitationsResponse")
+	proto.RegisterType((*GetOrganizationUsageRequest)(nil), "api.GetOrganizationUsageRequest")
+	proto.RegisterType((*OrganizationUsageRecord)(nil), "api.OrganizationUsageRecord")
+	proto.RegisterType((*GetOrganizationUsageResponse)(nil), "api.GetOrganizationUsageResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -516,6 +1209,28 @@ type OrganizationClient interface {
 	UpdateUser(ctx context.Context, in *OrganizationUserRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
 	// Delete a user from an organization.
 	DeleteUser(ctx context.Context, in *DeleteOrganizationUserRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
+	// Invite an e-mail address to an organization.
+	CreateInvitation(ctx context.Context, in *CreateOrganizationInvitationRequest, opts ...grpc.CallOption) (*CreateOrganizationInvitationResponse, error)
+	// Get organization's invitation list.
+	ListInvitations(ctx context.Context, in *ListOrganizationInvitationsRequest, opts ...grpc.CallOption) (*ListOrganizationInvitationsResponse, error)
+	// Resend an invitation (this renews the invitation token and expiration).
+	ResendInvitation(ctx context.Context, in *OrganizationInvitationRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
+	// Revoke an invitation.
+	DeleteInvitation(ctx context.Context, in *OrganizationInvitationRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
+	// Get the usage (uplinks, downlinks, joins and active devices) of the
+	// organization per application and day.
+	GetUsage(ctx context.Context, in *GetOrganizationUsageRequest, opts ...grpc.CallOption) (*GetOrganizationUsageResponse, error)
+	// CreateHTTPIntegration creates an HTTP organization-integration, used
+	// by all applications of the organization.
+	CreateHTTPIntegration(ctx context.Context, in *HTTPIntegration, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
+	// GetHTTPIntegration returns the HTTP organization-integration.
+	GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*HTTPIntegration, error)
+	// UpdateHTTPIntegration updates the HTTP organization-integration.
+	UpdateHTTPIntegration(ctx context.Context, in *HTTPIntegration, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
+	// DeleteHTTPIntegration deletes the HTTP organization-integration.
+	DeleteHTTPIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error)
+	// ListIntegrations lists all configured organization-integrations.
+	ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error)
 }
 
 type organizationClient struct {
@@ -616,6 +1331,96 @@ func (c *organizationClient) DeleteUser(ctx context.Context, in *DeleteOrganizat
 	return out, nil
 }
 
+func (c *organizationClient) CreateInvitation(ctx context.Context, in *CreateOrganizationInvitationRequest, opts ...grpc.CallOption) (*CreateOrganizationInvitationResponse, error) {
+	out := new(CreateOrganizationInvitationResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/CreateInvitation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) ListInvitations(ctx context.Context, in *ListOrganizationInvitationsRequest, opts ...grpc.CallOption) (*ListOrganizationInvitationsResponse, error) {
+	out := new(ListOrganizationInvitationsResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/ListInvitations", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) ResendInvitation(ctx context.Context, in *OrganizationInvitationRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error) {
+	out := new(OrganizationEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/ResendInvitation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) DeleteInvitation(ctx context.Context, in *OrganizationInvitationRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error) {
+	out := new(OrganizationEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/DeleteInvitation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) GetUsage(ctx context.Context, in *GetOrganizationUsageRequest, opts ...grpc.CallOption) (*GetOrganizationUsageResponse, error) {
+	out := new(GetOrganizationUsageResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/GetUsage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) CreateHTTPIntegration(ctx context.Context, in *HTTPIntegration, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error) {
+	out := new(OrganizationEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/CreateHTTPIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) GetHTTPIntegration(ctx context.Context, in *GetHTTPIntegrationRequest, opts ...grpc.CallOption) (*HTTPIntegration, error) {
+	out := new(HTTPIntegration)
+	err := grpc.Invoke(ctx, "/api.Organization/GetHTTPIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) UpdateHTTPIntegration(ctx context.Context, in *HTTPIntegration, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error) {
+	out := new(OrganizationEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/UpdateHTTPIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) DeleteHTTPIntegration(ctx context.Context, in *DeleteIntegrationRequest, opts ...grpc.CallOption) (*OrganizationEmptyResponse, error) {
+	out := new(OrganizationEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/DeleteHTTPIntegration", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationClient) ListIntegrations(ctx context.Context, in *ListIntegrationRequest, opts ...grpc.CallOption) (*ListIntegrationResponse, error) {
+	out := new(ListIntegrationResponse)
+	err := grpc.Invoke(ctx, "/api.Organization/ListIntegrations", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Organization service
 
 type OrganizationServer interface {
@@ -639,6 +1444,28 @@ type OrganizationServer interface {
 	UpdateUser(context.Context, *OrganizationUserRequest) (*OrganizationEmptyResponse, error)
 	// Delete a user from an organization.
 	DeleteUser(context.Context, *DeleteOrganizationUserRequest) (*OrganizationEmptyResponse, error)
+	// Invite an e-mail address to an organization.
+	CreateInvitation(context.Context, *CreateOrganizationInvitationRequest) (*CreateOrganizationInvitationResponse, error)
+	// Get organization's invitation list.
+	ListInvitations(context.Context, *ListOrganizationInvitationsRequest) (*ListOrganizationInvitationsResponse, error)
+	// Resend an invitation (this renews the invitation token and expiration).
+	ResendInvitation(context.Context, *OrganizationInvitationRequest) (*OrganizationEmptyResponse, error)
+	// Revoke an invitation.
+	DeleteInvitation(context.Context, *OrganizationInvitationRequest) (*OrganizationEmptyResponse, error)
+	// Get the usage (uplinks, downlinks, joins and active devices) of the
+	// organization per application and day.
+	GetUsage(context.Context, *GetOrganizationUsageRequest) (*GetOrganizationUsageResponse, error)
+	// CreateHTTPIntegration creates an HTTP organization-integration, used
+	// by all applications of the organization.
+	CreateHTTPIntegration(context.Context, *HTTPIntegration) (*OrganizationEmptyResponse, error)
+	// GetHTTPIntegration returns the HTTP organization-integration.
+	GetHTTPIntegration(context.Context, *GetHTTPIntegrationRequest) (*HTTPIntegration, error)
+	// UpdateHTTPIntegration updates the HTTP organization-integration.
+	UpdateHTTPIntegration(context.Context, *HTTPIntegration) (*OrganizationEmptyResponse, error)
+	// DeleteHTTPIntegration deletes the HTTP organization-integration.
+	DeleteHTTPIntegration(context.Context, *DeleteIntegrationRequest) (*OrganizationEmptyResponse, error)
+	// ListIntegrations lists all configured organization-integrations.
+	ListIntegrations(context.Context, *ListIntegrationRequest) (*ListIntegrationResponse, error)
 }
 
 func RegisterOrganizationServer(s *grpc.Server, srv OrganizationServer) {
@@ -825,6 +1652,186 @@ func _Organization_DeleteUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Organization_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/CreateInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).CreateInvitation(ctx, req.(*CreateOrganizationInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/ListInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).ListInvitations(ctx, req.(*ListOrganizationInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_ResendInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).ResendInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/ResendInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).ResendInvitation(ctx, req.(*OrganizationInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_DeleteInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).DeleteInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/DeleteInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).DeleteInvitation(ctx, req.(*OrganizationInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/GetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).GetUsage(ctx, req.(*GetOrganizationUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_CreateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HTTPIntegration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).CreateHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/CreateHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).CreateHTTPIntegration(ctx, req.(*HTTPIntegration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_GetHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHTTPIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).GetHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/GetHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).GetHTTPIntegration(ctx, req.(*GetHTTPIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_UpdateHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HTTPIntegration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).UpdateHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/UpdateHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).UpdateHTTPIntegration(ctx, req.(*HTTPIntegration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_DeleteHTTPIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).DeleteHTTPIntegration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/DeleteHTTPIntegration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).DeleteHTTPIntegration(ctx, req.(*DeleteIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Organization_ListIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntegrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServer).ListIntegrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Organization/ListIntegrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServer).ListIntegrations(ctx, req.(*ListIntegrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Organization_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Organization",
 	HandlerType: (*OrganizationServer)(nil),
@@ -869,6 +1876,46 @@ var _Organization_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _Organization_DeleteUser_Handler,
 		},
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _Organization_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _Organization_ListInvitations_Handler,
+		},
+		{
+			MethodName: "ResendInvitation",
+			Handler:    _Organization_ResendInvitation_Handler,
+		},
+		{
+			MethodName: "DeleteInvitation",
+			Handler:    _Organization_DeleteInvitation_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _Organization_GetUsage_Handler,
+		},
+		{
+			MethodName: "CreateHTTPIntegration",
+			Handler:    _Organization_CreateHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "GetHTTPIntegration",
+			Handler:    _Organization_GetHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "UpdateHTTPIntegration",
+			Handler:    _Organization_UpdateHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "DeleteHTTPIntegration",
+			Handler:    _Organization_DeleteHTTPIntegration_Handler,
+		},
+		{
+			MethodName: "ListIntegrations",
+			Handler:    _Organization_ListIntegrations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "organization.proto",
@@ -877,52 +1924,106 @@ var _Organization_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("organization.proto", fileDescriptor6) }
 
 var fileDescriptor6 = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xe3, 0xc4, 0x6d, 0xa6, 0x08, 0xa4, 0xa5, 0x6a, 0x1c, 0x37, 0x69, 0xc2, 0x4a, 0x45,
-	0x51, 0x41, 0x89, 0x28, 0x1c, 0x10, 0xb7, 0xaa, 0x45, 0x01, 0x09, 0x81, 0x64, 0xa9, 0x27, 0x10,
-	0xd5, 0x12, 0x6f, 0xdb, 0x95, 0x1c, 0xdb, 0xf5, 0x6e, 0x40, 0xa1, 0x54, 0x42, 0x5c, 0x39, 0xf2,
-	0x01, 0x7c, 0x0d, 0x5f, 0xc0, 0x2f, 0xf0, 0x15, 0x9c, 0x90, 0xd7, 0x9b, 0xe2, 0x3a, 0x5e, 0x27,
-	0xaa, 0xe0, 0xd6, 0xdd, 0x99, 0xcc, 0x7b, 0xf3, 0xf6, 0xcd, 0xb8, 0x80, 0xc2, 0xf8, 0x84, 0x04,
-	0xec, 0x23, 0x11, 0x2c, 0x0c, 0xfa, 0x51, 0x1c, 0x8a, 0x10, 0x99, 0x24, 0x62, 0x4e, 0xeb, 0x24,
-	0x0c, 0x4f, 0x7c, 0x3a, 0x20, 0x11, 0x1b, 0x90, 0x20, 0x08, 0x85, 0xcc, 0xe0, 0x69, 0x0a, 0x3e,
-	0x82, 0xc6, 0x0b, 0xc6, 0xc5, 0xab, 0xcc, 0x8f, 0x5d, 0x7a, 0x36, 0xa1, 0x5c, 0xa0, 0x75, 0xa8,
-	0xf9, 0x6c, 0xcc, 0x84, 0x6d, 0x74, 0x8d, 0x5e, 0xcd, 0x4d, 0x0f, 0x68, 0x03, 0xac, 0xf0, 0xf8,
-	0x98, 0x53, 0x61, 0x57, 0xe4, 0xb5, 0x3a, 0x25, 0xf7, 0x9c, 0x92, 0x78, 0x74, 0x6a, 0x9b, 0x5d,
-	0xa3, 0x57, 0x77, 0xd5, 0x09, 0x6f, 0xc3, 0xed, 0xa2, 0xe2, 0x37, 0xa1, 0xc2, 0x3c, 0x59, 0xd9,
-	0x74, 0x2b, 0xcc, 0xc3, 0x3f, 0x0c, 0x68, 0x0c, 0x69, 0x8e, 0x07, 0x8f, 0xc2, 0x80, 0xd3, 0x7c,
-	0x2e, 0x42, 0x50, 0x0d, 0xc8, 0x98, 0x4a, 0x02, 0x75, 0x57, 0xfe, 0x8d, 0xba, 0xb0, 0xe6, 0x31,
-	0x1e, 0xf9, 0x64, 0xfa, 0x32, 0x09, 0xa5, 0x1c, 0xb2, 0x57, 0xa8, 0x07, 0xb7, 0x46, 0x24, 0x78,
-	0x46, 0xde, 0xd3, 0x21, 0x11, 0xf4, 0x03, 0x99, 0x72, 0xbb, 0xda, 0x35, 0x7a, 0xab, 0x6e, 0xfe,
-	0x1a, 0xb5, 0xa0, 0x3e, 0x8a, 0x29, 0x11, 0xd4, 0xdb, 0x13, 0x76, 0x4d, 0x56, 0xfa, 0x7b, 0x91,
-	0x44, 0x27, 0x91, 0xa7, 0xa2, 0x56, 0x1a, 0xbd, 0xbc, 0xc0, 0xe7, 0xd0, 0xdc, 0x97, 0xa9, 0x45,
-	0x4d, 0xcf, 0x88, 0x1b, 0x7a, 0xe2, 0x95, 0xa5, 0x88, 0x9b, 0x85, 0xc4, 0xf1, 0x7d, 0x70, 0x8a,
-	0xc0, 0x8b, 0x65, 0xc4, 0x5f, 0x0d, 0x68, 0x1e, 0x4a, 0xe2, 0x4b, 0x3c, 0xd0, 0xff, 0x16, 0x1d,
-	0x47, 0x60, 0xcf, 0x1b, 0x51, 0x31, 0xdf, 0x02, 0x10, 0xa1, 0x20, 0xfe, 0x7e, 0x38, 0x09, 0x66,
-	0x76, 0xcc, 0xdc, 0xa0, 0x47, 0x60, 0xc5, 0x94, 0x4f, 0xfc, 0xc4, 0x93, 0x66, 0x6f, 0x6d, 0xb7,
-	0xd5, 0x27, 0x11, 0xeb, 0x6b, 0xec, 0xe4, 0xaa, 0x5c, 0xbc, 0x09, 0xcd, 0x6c, 0xfc, 0xe9, 0x38,
-	0x12, 0xd3, 0x59, 0x12, 0x7e, 0x0d, 0x8d, 0x6c, 0xf0, 0x90, 0xd3, 0x58, 0xa7, 0xcc, 0x06, 0x58,
-	0x13, 0x4e, 0xe3, 0xe7, 0x07, 0x52, 0x1b, 0xd3, 0x55, 0x27, 0x64, 0xc3, 0x0a, 0xe3, 0x7b, 0xde,
-	0x98, 0x05, 0xea, 0xbd, 0x66, 0x47, 0x3c, 0x84, 0xf6, 0x01, 0xf5, 0xe9, 0x55, 0xe1, 0xaf, 0x01,
-	0x81, 0xdf, 0x40, 0x2b, 0x2f, 0x5a, 0x52, 0x86, 0xeb, 0xea, 0x5c, 0x8e, 0x74, 0xa5, 0x78, 0xa4,
-	0xcd, 0xec, 0x48, 0xe3, 0x03, 0x70, 0x72, 0x1a, 0x5e, 0x87, 0xe3, 0x77, 0x03, 0x36, 0x0b, 0xcb,
-	0x68, 0xa6, 0xdb, 0x81, 0xd5, 0xe4, 0x97, 0x19, 0xb3, 0x5d, 0x9e, 0xf5, 0x92, 0x5e, 0x9d, 0xd9,
-	0x6a, 0xe9, 0xcc, 0xd6, 0xf2, 0x33, 0x3b, 0x85, 0xb6, 0x46, 0xc5, 0x25, 0xfd, 0xf7, 0x38, 0xe7,
-	0xbf, 0x6e, 0x91, 0xff, 0xb2, 0x4d, 0xcf, 0x3c, 0xb8, 0xfb, 0x7b, 0x15, 0x6e, 0x64, 0x93, 0xd0,
-	0x11, 0x54, 0x13, 0x2e, 0x28, 0xb5, 0xb0, 0x66, 0x35, 0x3b, 0x6d, 0x4d, 0x54, 0x99, 0xd7, 0xf9,
-	0xf2, 0xf3, 0xd7, 0xb7, 0xca, 0x3a, 0x42, 0x72, 0xe9, 0x67, 0x3f, 0x0c, 0x1c, 0xbd, 0x05, 0x73,
-	0x48, 0x05, 0xb2, 0x65, 0x85, 0xa2, 0xda, 0xa5, 0xc3, 0x83, 0x3b, 0xb2, 0x74, 0x13, 0x35, 0xe6,
-	0x4b, 0x0f, 0xce, 0x99, 0x77, 0x81, 0x4e, 0xc1, 0x4a, 0x77, 0x10, 0xda, 0x92, 0x85, 0xb4, 0xdb,
-	0xd0, 0xe9, 0x68, 0xe3, 0x0a, 0xab, 0x2d, 0xb1, 0x1a, 0xb8, 0xa0, 0x8d, 0x27, 0xc6, 0x0e, 0xf2,
-	0xc1, 0x4a, 0xd7, 0x97, 0x42, 0xd2, 0xee, 0x32, 0x67, 0x6b, 0xae, 0xd9, 0xab, 0xc3, 0x8e, 0x25,
-	0x50, 0xcb, 0xd1, 0x35, 0x95, 0xa0, 0x8d, 0xc0, 0x4a, 0x67, 0xb6, 0x44, 0xba, 0x45, 0x38, 0x4a,
-	0xbc, 0x1d, 0xad, 0x78, 0x53, 0xa8, 0x27, 0x8f, 0x2a, 0xdd, 0x87, 0xee, 0x14, 0x3e, 0x72, 0x76,
-	0xbe, 0x1d, 0x5c, 0x96, 0xa2, 0x40, 0xb7, 0x25, 0x68, 0x07, 0xb5, 0x35, 0xa0, 0x83, 0x89, 0x44,
-	0xfb, 0x04, 0x2b, 0x43, 0x2a, 0x91, 0x51, 0x47, 0x6f, 0xdf, 0x14, 0x76, 0xa1, 0xbf, 0x71, 0x5f,
-	0x82, 0xf6, 0xd0, 0xdd, 0x52, 0xd0, 0xc1, 0x79, 0xba, 0x23, 0x2e, 0xd0, 0x19, 0xac, 0xec, 0x79,
-	0x9e, 0x44, 0x6f, 0xcd, 0x89, 0x98, 0x85, 0x5e, 0x24, 0x71, 0x4f, 0x02, 0x63, 0x5c, 0xde, 0x6d,
-	0xf2, 0xa0, 0x17, 0x00, 0xa9, 0x63, 0xfe, 0x01, 0xea, 0x03, 0x89, 0x7a, 0xcf, 0x59, 0xb2, 0xdd,
-	0x04, 0xfe, 0xb3, 0x01, 0x90, 0x1a, 0x4a, 0xe2, 0xa7, 0x2f, 0x59, 0xfa, 0x55, 0x58, 0xc8, 0x42,
-	0x89, 0xbe, 0xb3, 0x24, 0x8b, 0x77, 0x96, 0xfc, 0x17, 0xf0, 0xe1, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x81, 0xc9, 0x5a, 0xec, 0x3b, 0x0a, 0x00, 0x00,
+	// 1606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x73, 0xdb, 0x44,
+	0x14, 0x1f, 0xd9, 0xb1, 0x13, 0xbf, 0x94, 0x36, 0xd9, 0xa6, 0x89, 0xa3, 0x38, 0x1f, 0x55, 0x3f,
+	0x70, 0xd3, 0x4c, 0x0c, 0x69, 0x0f, 0xd0, 0x03, 0x33, 0x69, 0x0d, 0x6e, 0x18, 0x06, 0x8a, 0x9a,
+	0x72, 0x62, 0xe8, 0x6c, 0xad, 0x6d, 0xba, 0x60, 0x4b, 0x8a, 0x76, 0x9d, 0xc6, 0x0d, 0x99, 0x61,
+	0xe8, 0x89, 0x23, 0x70, 0xe0, 0xc2, 0x3f, 0xc2, 0xdf, 0xd1, 0x23, 0xc3, 0x8d, 0x03, 0x33, 0x9c,
+	0xb9, 0x02, 0xb3, 0xbb, 0x92, 0xbd, 0xd6, 0x87, 0xa3, 0x0c, 0xe1, 0x00, 0x37, 0xef, 0x7b, 0x4f,
+	0xef, 0xe3, 0xf7, 0x3e, 0xf6, 0x49, 0x06, 0xe4, 0x05, 0x7b, 0xd8, 0xa5, 0x2f, 0x30, 0xa7, 0x9e,
+	0xbb, 0xe9, 0x07, 0x1e, 0xf7, 0x50, 0x11, 0xfb, 0xd4, 0xac, 0xed, 0x79, 0xde, 0x5e, 0x87, 0x34,
+	0xb0, 0x4f, 0x1b, 0xd8, 0x75, 0x3d, 0x2e, 0x25, 0x98, 0x12, 0x31, 0x67, 0xb1, 0xef, 0x77, 0x68,
+	0x5b, 0x7b, 0xca, 0x7a, 0x0c, 0x0b, 0x1f, 0x50, 0xc6, 0x3f, 0xd2, 0xf4, 0xd9, 0x64, 0xbf, 0x47,
+	0x18, 0x47, 0x73, 0x50, 0xea, 0xd0, 0x2e, 0xe5, 0x55, 0x63, 0xcd, 0xa8, 0x97, 0x6c, 0x75, 0x40,
+	0xf3, 0x50, 0xf6, 0x9e, 0x3e, 0x65, 0x84, 0x57, 0x0b, 0x92, 0x1c, 0x9e, 0x04, 0x9d, 0x11, 0x1c,
+	0xb4, 0x9f, 0x55, 0x8b, 0x6b, 0x46, 0xbd, 0x62, 0x87, 0x27, 0xeb, 0x1a, 0x5c, 0x4c, 0x53, 0x7e,
+	0x1e, 0x0a, 0xd4, 0x91, 0x9a, 0x8b, 0x76, 0x81, 0x3a, 0xd6, 0xab, 0x02, 0x2c, 0xb4, 0x48, 0xcc,
+	0x0f, 0xe6, 0x7b, 0x2e, 0x23, 0x71, 0x59, 0x84, 0x60, 0xc2, 0xc5, 0x5d, 0x22, 0x1d, 0xa8, 0xd8,
+	0xf2, 0x37, 0x5a, 0x83, 0x69, 0x87, 0x32, 0xbf, 0x83, 0xfb, 0x1f, 0x0a, 0x96, 0xf2, 0x41, 0x27,
+	0xa1, 0x3a, 0x5c, 0x68, 0x63, 0xf7, 0x3e, 0x3e, 0x20, 0x2d, 0xcc, 0xc9, 0x73, 0xdc, 0x67, 0xd5,
+	0x89, 0x35, 0xa3, 0x3e, 0x65, 0xc7, 0xc9, 0xa8, 0x06, 0x95, 0x76, 0x40, 0x30, 0x27, 0xce, 0x36,
+	0xaf, 0x96, 0xa4, 0xa6, 0x21, 0x41, 0x70, 0x7b, 0xbe, 0x13, 0x72, 0xcb, 0x8a, 0x3b, 0x20, 0xa0,
+	0x37, 0xe0, 0x62, 0x40, 0xf6, 0x7b, 0x34, 0x20, 0x0f, 0x49, 0xdb, 0x73, 0x9d, 0xf7, 0x70, 0x9b,
+	0x7b, 0x41, 0x75, 0x52, 0x5a, 0x4a, 0x63, 0xa1, 0x0d, 0x28, 0xed, 0xf7, 0x3c, 0x8e, 0xab, 0x53,
+	0x6b, 0x46, 0x7d, 0x7a, 0x6b, 0x7e, 0x13, 0xfb, 0x74, 0x53, 0xc7, 0xe1, 0x63, 0xc1, 0xb5, 0x95,
+	0x90, 0x90, 0xee, 0x31, 0xbc, 0x47, 0xaa, 0x95, 0x0c, 0xe9, 0x47, 0x82, 0x6b, 0x2b, 0x21, 0xeb,
+	0x65, 0x01, 0x66, 0x13, 0xaa, 0x04, 0x12, 0x5d, 0x7c, 0xb8, 0x3d, 0xac, 0x05, 0x16, 0xa6, 0x38,
+	0x4e, 0x46, 0x2b, 0x00, 0x5d, 0x7c, 0xd8, 0x24, 0x07, 0xb4, 0x4d, 0x58, 0x98, 0x70, 0x8d, 0x22,
+	0x50, 0xef, 0xe2, 0xc3, 0x01, 0x9e, 0x45, 0x29, 0xa0, 0x93, 0xd0, 0x06, 0xcc, 0x0e, 0xe4, 0x1f,
+	0x04, 0xde, 0x53, 0xda, 0x21, 0x0a, 0xf7, 0x92, 0x9d, 0x64, 0x84, 0x9e, 0xed, 0xb8, 0x9c, 0xec,
+	0x05, 0xa1, 0x67, 0xa5, 0x81, 0x67, 0x3a, 0x19, 0xad, 0xc3, 0x4c, 0x17, 0x1f, 0x3e, 0xf2, 0x3b,
+	0xd4, 0xfd, 0x82, 0x3d, 0x20, 0x41, 0x13, 0xf7, 0x65, 0x32, 0x4a, 0x76, 0x82, 0x6e, 0xfd, 0x62,
+	0xc0, 0x6c, 0x02, 0x22, 0x64, 0xc1, 0x39, 0x9c, 0x84, 0x60, 0x84, 0x86, 0xaa, 0x30, 0xe9, 0x8c,
+	0x04, 0x1f, 0x1d, 0x91, 0x09, 0x53, 0x7b, 0xa3, 0x61, 0x0f, 0xce, 0xe8, 0x3a, 0x9c, 0x77, 0xd2,
+	0x02, 0x8e, 0x51, 0x85, 0x07, 0x34, 0x19, 0xea, 0x08, 0x4d, 0xc8, 0xf4, 0x54, 0x30, 0xbb, 0x9e,
+	0x33, 0x88, 0x71, 0x84, 0x66, 0xfd, 0x6c, 0xc0, 0xe2, 0x3d, 0x59, 0x9f, 0x69, 0x9d, 0x16, 0x75,
+	0x8b, 0x91, 0xdd, 0x2d, 0x85, 0x5c, 0xdd, 0x52, 0x4c, 0xef, 0x96, 0x8c, 0x8a, 0x9f, 0xc8, 0x51,
+	0xf1, 0xa5, 0x1c, 0x15, 0x6f, 0x6d, 0x80, 0x99, 0x16, 0x5c, 0xfa, 0x6c, 0xb0, 0x7e, 0x37, 0x60,
+	0xf1, 0x91, 0xef, 0x24, 0xc4, 0x53, 0xa7, 0xce, 0xbf, 0x3e, 0x49, 0x32, 0xb0, 0x29, 0xe5, 0xc0,
+	0xa6, 0x9c, 0x07, 0x1b, 0x1f, 0xaa, 0xc9, 0xe9, 0x1d, 0x22, 0xb3, 0x02, 0xc0, 0x3d, 0x8e, 0x3b,
+	0xf7, 0xbc, 0x9e, 0x1b, 0xcd, 0x70, 0x8d, 0x82, 0x6e, 0x43, 0x39, 0x20, 0xac, 0xd7, 0x11, 0x83,
+	0xbc, 0x58, 0x9f, 0xde, 0xaa, 0x49, 0x53, 0x19, 0x33, 0xd8, 0x0e, 0x65, 0xad, 0x25, 0x58, 0xd4,
+	0xf9, 0xef, 0x76, 0x7d, 0xde, 0x8f, 0x84, 0xac, 0x3f, 0x0c, 0x58, 0x18, 0x6d, 0x34, 0x12, 0x64,
+	0x41, 0x3f, 0x0f, 0xe5, 0x1e, 0x23, 0xc1, 0x4e, 0x53, 0x82, 0x5f, 0xb4, 0xc3, 0x93, 0x68, 0x39,
+	0xca, 0xb6, 0x9d, 0x2e, 0x75, 0xc3, 0x82, 0x8b, 0x8e, 0xe8, 0x2a, 0xbc, 0x46, 0x99, 0x1a, 0x18,
+	0x8a, 0xaf, 0x40, 0x1f, 0x25, 0x8a, 0xe6, 0xa3, 0x2c, 0x4c, 0x80, 0x12, 0x53, 0x68, 0xc7, 0xa8,
+	0x68, 0x13, 0x10, 0x65, 0xda, 0x48, 0x51, 0xb2, 0x65, 0x29, 0x9b, 0xc2, 0x11, 0x0d, 0x4f, 0xd9,
+	0x27, 0x94, 0x3c, 0x27, 0xd1, 0x34, 0x1f, 0x9c, 0xad, 0x16, 0x2c, 0x37, 0x49, 0x87, 0x70, 0xf2,
+	0x0f, 0x83, 0xb7, 0x3e, 0x85, 0x5a, 0x3c, 0x9f, 0x42, 0x0d, 0xcb, 0xd2, 0x33, 0xb8, 0xa2, 0x0b,
+	0xe9, 0x57, 0x74, 0x51, 0xbf, 0xa2, 0xad, 0x26, 0x98, 0x2d, 0x92, 0x50, 0x7e, 0x5a, 0x1f, 0x7f,
+	0x2a, 0xc0, 0x52, 0xaa, 0x9a, 0x8c, 0xdb, 0xda, 0x84, 0x29, 0xf1, 0xa4, 0xd6, 0x67, 0x83, 0xf3,
+	0x98, 0x64, 0x8f, 0xdc, 0xc1, 0x13, 0x63, 0xef, 0xe0, 0x52, 0xfc, 0x0e, 0x4e, 0x14, 0x4a, 0x39,
+	0x5f, 0xa1, 0x4c, 0x9e, 0xa2, 0x50, 0xa6, 0x72, 0x15, 0x4a, 0x25, 0x56, 0x28, 0x7d, 0x58, 0xce,
+	0xc8, 0x6f, 0xce, 0xa6, 0x7d, 0x2b, 0xd6, 0xb4, 0x6b, 0x69, 0x4d, 0xab, 0xa7, 0x63, 0xd0, 0xb8,
+	0x7f, 0x19, 0x70, 0x25, 0x39, 0x47, 0x77, 0xdc, 0x03, 0xca, 0xc7, 0x8e, 0xc8, 0x39, 0x28, 0x91,
+	0x2e, 0xa6, 0x9d, 0x30, 0x77, 0xea, 0xf0, 0x9f, 0xec, 0xd2, 0xf7, 0xe1, 0xea, 0x78, 0x00, 0xc2,
+	0x1c, 0xc8, 0x6b, 0x39, 0xa2, 0xee, 0x34, 0x43, 0x2c, 0x46, 0x68, 0xd6, 0x43, 0x58, 0x3e, 0x1d,
+	0x8c, 0x71, 0xa5, 0x85, 0x14, 0xa5, 0x4f, 0xc0, 0x8a, 0x57, 0xc7, 0x50, 0xf1, 0x19, 0xcd, 0x80,
+	0x3f, 0x0b, 0x30, 0x9f, 0x6e, 0x20, 0x4f, 0xdc, 0xff, 0x9f, 0x6a, 0x10, 0x23, 0x44, 0x46, 0x46,
+	0x9c, 0xbb, 0x7d, 0xd9, 0xcd, 0x15, 0x7b, 0x48, 0x18, 0x1d, 0x3f, 0x95, 0xb1, 0xe3, 0x07, 0xe2,
+	0xe3, 0xa7, 0x06, 0x15, 0x72, 0xe8, 0xd3, 0x80, 0xb0, 0x6d, 0x5e, 0x9d, 0x56, 0xdc, 0x01, 0xc1,
+	0x7a, 0x01, 0x57, 0xc6, 0x26, 0x39, 0xe7, 0x20, 0xb8, 0x15, 0x1b, 0x04, 0x4b, 0x89, 0x45, 0x41,
+	0xab, 0xc9, 0x68, 0x06, 0x90, 0x94, 0xc9, 0x2d, 0xde, 0x16, 0x32, 0x2a, 0xab, 0x06, 0x15, 0xc6,
+	0x71, 0xc0, 0x9b, 0x98, 0x47, 0xa3, 0x7b, 0x48, 0x10, 0x49, 0x27, 0xae, 0x23, 0x79, 0x6a, 0x47,
+	0x8a, 0x8e, 0xe2, 0x86, 0x58, 0x48, 0x31, 0xd2, 0xf6, 0x02, 0xb9, 0x71, 0x09, 0x9c, 0xa2, 0x6d,
+	0x54, 0xfc, 0x16, 0x45, 0xa2, 0x6d, 0xdd, 0x83, 0xe6, 0x18, 0x25, 0x8a, 0xad, 0x4b, 0x23, 0x68,
+	0xbb, 0x59, 0x9c, 0x2c, 0x3c, 0x0b, 0xf7, 0x63, 0x59, 0x6e, 0x45, 0x3b, 0x3a, 0x8a, 0xdd, 0x4e,
+	0xfd, 0xbc, 0xdb, 0xe7, 0x44, 0x2d, 0xdc, 0x45, 0x5b, 0x27, 0x89, 0x98, 0x1d, 0xef, 0xb9, 0xab,
+	0x9e, 0x2e, 0x4b, 0xfe, 0x90, 0x20, 0x3c, 0x8d, 0x0e, 0x4a, 0xc3, 0xa4, 0xf2, 0x74, 0x84, 0x28,
+	0x9a, 0xe4, 0x73, 0x8f, 0xba, 0x4c, 0x96, 0x55, 0xd1, 0x56, 0x07, 0x19, 0x65, 0x9b, 0xd3, 0x03,
+	0x12, 0xbd, 0x4e, 0x55, 0xc2, 0x28, 0x75, 0xa2, 0xb5, 0x0b, 0xb5, 0xf4, 0x14, 0x85, 0x75, 0x31,
+	0xdc, 0xda, 0x0c, 0x6d, 0x6b, 0xcb, 0x40, 0x3b, 0x4a, 0xfc, 0xd6, 0x6f, 0x17, 0xe1, 0x9c, 0x2e,
+	0x83, 0x1e, 0xc3, 0x84, 0xa8, 0x42, 0xa4, 0x1e, 0xcf, 0xf8, 0x02, 0x60, 0x2e, 0x67, 0x70, 0xc3,
+	0x75, 0xcf, 0xfc, 0xfa, 0xd5, 0xaf, 0xdf, 0x17, 0xe6, 0x10, 0x92, 0x9f, 0x1b, 0xf4, 0x4f, 0x12,
+	0x0c, 0x7d, 0x06, 0xc5, 0x16, 0xe1, 0xa8, 0x9a, 0x70, 0x2f, 0xd2, 0x3d, 0x76, 0xdd, 0xb4, 0x56,
+	0xa5, 0xea, 0x45, 0xb4, 0x90, 0x54, 0xdd, 0x38, 0xa2, 0xce, 0x31, 0x7a, 0x06, 0x65, 0x35, 0xcc,
+	0xd1, 0x8a, 0x54, 0x94, 0xf9, 0xfe, 0x63, 0xae, 0x66, 0xf2, 0x43, 0x5b, 0xcb, 0xd2, 0xd6, 0x82,
+	0x95, 0x12, 0xc6, 0x1d, 0x63, 0x1d, 0x75, 0xa0, 0xac, 0x5e, 0x28, 0x42, 0x4b, 0x99, 0x6f, 0x17,
+	0xe6, 0x4a, 0x22, 0xd8, 0xd1, 0xf5, 0xd8, 0x92, 0x86, 0x6a, 0x66, 0x56, 0x50, 0xc2, 0x5a, 0x1b,
+	0xca, 0x6a, 0x95, 0x1c, 0x03, 0xdd, 0x49, 0x76, 0x42, 0xf0, 0xd6, 0x33, 0xc1, 0xeb, 0x43, 0x45,
+	0x24, 0x55, 0xae, 0x1e, 0xe8, 0x72, 0x6a, 0x92, 0xf5, 0xb5, 0xd3, 0xb4, 0xc6, 0x89, 0x84, 0x46,
+	0xaf, 0x49, 0xa3, 0xab, 0x68, 0x39, 0xc3, 0x68, 0xa3, 0x27, 0xad, 0x7d, 0x09, 0x93, 0x2d, 0x22,
+	0x2d, 0xa3, 0xd5, 0xec, 0xdd, 0x45, 0x99, 0x3d, 0x71, 0xb9, 0xb1, 0x36, 0xa5, 0xd1, 0x3a, 0xba,
+	0x3e, 0xd6, 0x68, 0xe3, 0x48, 0xad, 0xae, 0xc7, 0x68, 0x1f, 0x26, 0xb7, 0x1d, 0x47, 0x5a, 0x4f,
+	0x6b, 0x1c, 0x12, 0xe4, 0x85, 0xb8, 0x2e, 0x0d, 0x5b, 0xd6, 0xf8, 0x68, 0x45, 0x42, 0x8f, 0x01,
+	0x54, 0xc5, 0x9c, 0x81, 0xd5, 0x37, 0xa5, 0xd5, 0x9b, 0x66, 0xce, 0x70, 0x85, 0xf9, 0xaf, 0x0c,
+	0x00, 0x55, 0x50, 0xd2, 0xbe, 0xca, 0xe4, 0xd8, 0x97, 0x95, 0x13, 0xbd, 0x08, 0x41, 0x5f, 0xcf,
+	0x0b, 0xfa, 0x0f, 0x06, 0xcc, 0xa8, 0xf6, 0xd3, 0x96, 0x8d, 0x7a, 0x46, 0x57, 0x26, 0x36, 0x29,
+	0xf3, 0x46, 0x0e, 0xc9, 0x51, 0xcf, 0xac, 0x2b, 0x59, 0x9e, 0x0d, 0x77, 0x19, 0x99, 0x9b, 0x6f,
+	0x0d, 0xb8, 0x20, 0xaa, 0x7a, 0xa8, 0x8a, 0xa1, 0xd7, 0x53, 0x6b, 0x3d, 0xb9, 0x87, 0x99, 0xf5,
+	0x93, 0x05, 0x43, 0xb7, 0x6e, 0x4a, 0xb7, 0xae, 0xa1, 0x3c, 0x6e, 0xa1, 0x1f, 0x0d, 0x98, 0xb1,
+	0x09, 0x23, 0xae, 0xa3, 0xaf, 0x66, 0xe3, 0x6e, 0xf7, 0x9c, 0x69, 0x6b, 0x4a, 0x2f, 0xde, 0xb1,
+	0xde, 0xce, 0xe1, 0x45, 0xe3, 0x48, 0xdf, 0xfa, 0x8e, 0x1b, 0x81, 0x74, 0x48, 0x40, 0xf6, 0x9d,
+	0x01, 0x33, 0xaa, 0x7c, 0xce, 0xd8, 0xbd, 0x3b, 0xd2, 0xbd, 0xdb, 0xeb, 0x5b, 0xa7, 0x77, 0x0f,
+	0x1d, 0xc0, 0x94, 0x1c, 0x2a, 0xe2, 0xb3, 0x5e, 0xc6, 0xd0, 0x18, 0xae, 0x39, 0xe6, 0xe5, 0x31,
+	0x12, 0xf9, 0x87, 0x99, 0xb0, 0xf5, 0xd2, 0x80, 0x4b, 0xaa, 0x30, 0xef, 0xef, 0xee, 0x3e, 0xd0,
+	0xd6, 0x4f, 0x34, 0x27, 0x6d, 0xc4, 0xa8, 0x27, 0x62, 0x70, 0x5b, 0x9a, 0xdd, 0xb4, 0x6e, 0x64,
+	0x63, 0x30, 0x50, 0xc6, 0x1a, 0xcf, 0x38, 0xf7, 0xd5, 0x84, 0x41, 0x2d, 0xc2, 0xe3, 0x1e, 0xac,
+	0x44, 0x51, 0xc6, 0x18, 0x11, 0x0a, 0xa9, 0x1e, 0x46, 0x13, 0x06, 0xe5, 0xf7, 0x40, 0x82, 0xa0,
+	0x26, 0xdc, 0x99, 0x82, 0x60, 0x9e, 0x0e, 0x84, 0x6f, 0x0c, 0xb8, 0xa4, 0xea, 0x32, 0xee, 0xc5,
+	0xb2, 0x36, 0xf2, 0x52, 0x70, 0xc8, 0x39, 0x73, 0xd7, 0x4f, 0x81, 0xc8, 0x11, 0xcc, 0xa8, 0xa9,
+	0x32, 0x64, 0xa0, 0xa5, 0xc1, 0xb4, 0x48, 0xf1, 0xa1, 0x96, 0xce, 0x0c, 0x3d, 0xd8, 0x90, 0x1e,
+	0x5c, 0x47, 0x57, 0xf3, 0x78, 0xf0, 0xa4, 0x2c, 0xff, 0xd7, 0xb9, 0xf5, 0xf7, 0x00, 0x6f, 0xbd,
+	0x96, 0xa7, 0x23, 0x1a, 0x00, 0x00,
 }
@@ -165,6 +165,19 @@ func (m *LoginRequest) GetPassword() string {
 type LoginResponse struct {
 	// The JWT tag to be used to access lora-app-server interfaces.
 	Jwt string `protobuf:"bytes,1,opt,name=jwt" json:"jwt,omitempty"`
+	// A second factor is required to complete the login. In this case the
+	// jwt is not set and the login must be completed using
+	// LoginSecondFactor with the challengeToken.
+	SecondFactorRequired bool `protobuf:"varint,2,opt,name=secondFactorRequired" json:"secondFactorRequired,omitempty"`
+	// Challenge token (valid for 5 minutes).
+	ChallengeToken string `protobuf:"bytes,3,opt,name=challengeToken" json:"challengeToken,omitempty"`
+	// Set when the user must enroll a TOTP secret to complete the login
+	// (required by one of the organizations of the user). The login is
+	// completed using a code generated with this secret.
+	TotpEnrollment *TOTPEnrollment `protobuf:"bytes,4,opt,name=totpEnrollment" json:"totpEnrollment,omitempty"`
+	// The refresh token to request a new JWT using RefreshToken, once
+	// the JWT has expired.
+	RefreshToken string `protobuf:"bytes,5,opt,name=refreshToken" json:"refreshToken,omitempty"`
 }
 
 func (m *LoginResponse) Reset()                    { *m = LoginResponse{} }
@@ -179,6 +192,97 @@ func (m *LoginResponse) GetJwt() string {
 	return ""
 }
 
+func (m *LoginResponse) GetSecondFactorRequired() bool {
+	if m != nil {
+		return m.SecondFactorRequired
+	}
+	return false
+}
+
+func (m *LoginResponse) GetChallengeToken() string {
+	if m != nil {
+		return m.ChallengeToken
+	}
+	return ""
+}
+
+func (m *LoginResponse) GetTotpEnrollment() *TOTPEnrollment {
+	if m != nil {
+		return m.TotpEnrollment
+	}
+	return nil
+}
+
+func (m *LoginResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type LoginSecondFactorRequest struct {
+	// Challenge token returned by Login.
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challengeToken" json:"challengeToken,omitempty"`
+	// TOTP code or recovery code.
+	Code string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+}
+
+func (m *LoginSecondFactorRequest) Reset()                    { *m = LoginSecondFactorRequest{} }
+func (m *LoginSecondFactorRequest) String() string            { return proto.CompactTextString(m) }
+func (*LoginSecondFactorRequest) ProtoMessage()               {}
+func (*LoginSecondFactorRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{6} }
+
+func (m *LoginSecondFactorRequest) GetChallengeToken() string {
+	if m != nil {
+		return m.ChallengeToken
+	}
+	return ""
+}
+
+func (m *LoginSecondFactorRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type LoginSecondFactorResponse struct {
+	// The JWT tag to be used to access lora-app-server interfaces.
+	Jwt string `protobuf:"bytes,1,opt,name=jwt" json:"jwt,omitempty"`
+	// Recovery codes, only set when the TOTP secret was enrolled during
+	// the login.
+	RecoveryCodes []string `protobuf:"bytes,2,rep,name=recoveryCodes" json:"recoveryCodes,omitempty"`
+	// The refresh token to request a new JWT using RefreshToken, once
+	// the JWT has expired.
+	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken" json:"refreshToken,omitempty"`
+}
+
+func (m *LoginSecondFactorResponse) Reset()                    { *m = LoginSecondFactorResponse{} }
+func (m *LoginSecondFactorResponse) String() string            { return proto.CompactTextString(m) }
+func (*LoginSecondFactorResponse) ProtoMessage()               {}
+func (*LoginSecondFactorResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{7} }
+
+func (m *LoginSecondFactorResponse) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+func (m *LoginSecondFactorResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+func (m *LoginSecondFactorResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
 // Request the users defined in the system.
 type ListUserRequest struct {
 	// Max number of user to return in the result-set.
@@ -192,7 +296,7 @@ type ListUserRequest struct {
 func (m *ListUserRequest) Reset()                    { *m = ListUserRequest{} }
 func (m *ListUserRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListUserRequest) ProtoMessage()               {}
-func (*ListUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{6} }
+func (*ListUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{8} }
 
 func (m *ListUserRequest) GetLimit() int32 {
 	if m != nil {
@@ -223,7 +327,7 @@ type UserRequest struct {
 func (m *UserRequest) Reset()                    { *m = UserRequest{} }
 func (m *UserRequest) String() string            { return proto.CompactTextString(m) }
 func (*UserRequest) ProtoMessage()               {}
-func (*UserRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{7} }
+func (*UserRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{9} }
 
 func (m *UserRequest) GetId() int64 {
 	if m != nil {
@@ -239,7 +343,7 @@ type AddUserResponse struct {
 func (m *AddUserResponse) Reset()                    { *m = AddUserResponse{} }
 func (m *AddUserResponse) String() string            { return proto.CompactTextString(m) }
 func (*AddUserResponse) ProtoMessage()               {}
-func (*AddUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{8} }
+func (*AddUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{10} }
 
 func (m *AddUserResponse) GetId() int64 {
 	if m != nil {
@@ -269,7 +373,7 @@ type UserSettings struct {
 func (m *UserSettings) Reset()                    { *m = UserSettings{} }
 func (m *UserSettings) String() string            { return proto.CompactTextString(m) }
 func (*UserSettings) ProtoMessage()               {}
-func (*UserSettings) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{9} }
+func (*UserSettings) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{11} }
 
 func (m *UserSettings) GetId() int64 {
 	if m != nil {
@@ -339,12 +443,14 @@ type GetUserResponse struct {
 	Email string `protobuf:"bytes,8,opt,name=email" json:"email,omitempty"`
 	// Optional note to store with the user.
 	Note string `protobuf:"bytes,9,opt,name=note" json:"note,omitempty"`
+	// The e-mail address of the user has been verified.
+	EmailVerified bool `protobuf:"varint,10,opt,name=emailVerified" json:"emailVerified,omitempty"`
 }
 
 func (m *GetUserResponse) Reset()                    { *m = GetUserResponse{} }
 func (m *GetUserResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetUserResponse) ProtoMessage()               {}
-func (*GetUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{10} }
+func (*GetUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{12} }
 
 func (m *GetUserResponse) GetId() int64 {
 	if m != nil {
@@ -409,6 +515,13 @@ func (m *GetUserResponse) GetNote() string {
 	return ""
 }
 
+func (m *GetUserResponse) GetEmailVerified() bool {
+	if m != nil {
+		return m.EmailVerified
+	}
+	return false
+}
+
 // Add a new user. Not quite the UserSettings data as it includes a password
 // and excludes the ID and create/update dates.
 type AddUserRequest struct {
@@ -433,7 +546,7 @@ type AddUserRequest struct {
 func (m *AddUserRequest) Reset()                    { *m = AddUserRequest{} }
 func (m *AddUserRequest) String() string            { return proto.CompactTextString(m) }
 func (*AddUserRequest) ProtoMessage()               {}
-func (*AddUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{11} }
+func (*AddUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{13} }
 
 func (m *AddUserRequest) GetUsername() string {
 	if m != nil {
@@ -501,7 +614,7 @@ type AddUserOrganization struct {
 func (m *AddUserOrganization) Reset()                    { *m = AddUserOrganization{} }
 func (m *AddUserOrganization) String() string            { return proto.CompactTextString(m) }
 func (*AddUserOrganization) ProtoMessage()               {}
-func (*AddUserOrganization) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{12} }
+func (*AddUserOrganization) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{14} }
 
 func (m *AddUserOrganization) GetOrganizationID() int64 {
 	if m != nil {
@@ -538,7 +651,7 @@ type UpdateUserRequest struct {
 func (m *UpdateUserRequest) Reset()                    { *m = UpdateUserRequest{} }
 func (m *UpdateUserRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateUserRequest) ProtoMessage()               {}
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{13} }
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{15} }
 
 func (m *UpdateUserRequest) GetId() int64 {
 	if m != nil {
@@ -597,7 +710,7 @@ type ListUserResponse struct {
 func (m *ListUserResponse) Reset()                    { *m = ListUserResponse{} }
 func (m *ListUserResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListUserResponse) ProtoMessage()               {}
-func (*ListUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{14} }
+func (*ListUserResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{16} }
 
 func (m *ListUserResponse) GetTotalCount() int32 {
 	if m != nil {
@@ -619,7 +732,7 @@ type UserEmptyResponse struct {
 func (m *UserEmptyResponse) Reset()                    { *m = UserEmptyResponse{} }
 func (m *UserEmptyResponse) String() string            { return proto.CompactTextString(m) }
 func (*UserEmptyResponse) ProtoMessage()               {}
-func (*UserEmptyResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{15} }
+func (*UserEmptyResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{17} }
 
 type UpdateUserPasswordRequest struct {
 	// The ID of the user for which to update the password.
@@ -631,7 +744,7 @@ type UpdateUserPasswordRequest struct {
 func (m *UpdateUserPasswordRequest) Reset()                    { *m = UpdateUserPasswordRequest{} }
 func (m *UpdateUserPasswordRequest) String() string            { return proto.CompactTextString(m) }
 func (*UpdateUserPasswordRequest) ProtoMessage()               {}
-func (*UpdateUserPasswordRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{16} }
+func (*UpdateUserPasswordRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{18} }
 
 func (m *UpdateUserPasswordRequest) GetId() int64 {
 	if m != nil {
@@ -654,7 +767,7 @@ type BrandingRequest struct {
 func (m *BrandingRequest) Reset()                    { *m = BrandingRequest{} }
 func (m *BrandingRequest) String() string            { return proto.CompactTextString(m) }
 func (*BrandingRequest) ProtoMessage()               {}
-func (*BrandingRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{17} }
+func (*BrandingRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{19} }
 
 // The branding data.
 type BrandingResponse struct {
@@ -666,7 +779,7 @@ type BrandingResponse struct {
 func (m *BrandingResponse) Reset()                    { *m = BrandingResponse{} }
 func (m *BrandingResponse) String() string            { return proto.CompactTextString(m) }
 func (*BrandingResponse) ProtoMessage()               {}
-func (*BrandingResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{18} }
+func (*BrandingResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{20} }
 
 func (m *BrandingResponse) GetLogo() string {
 	if m != nil {
@@ -689,388 +802,1742 @@ func (m *BrandingResponse) GetFooter() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*OrganizationLink)(nil), "api.OrganizationLink")
-	proto.RegisterType((*ProfileRequest)(nil), "api.ProfileRequest")
-	proto.RegisterType((*ProfileResponse)(nil), "api.ProfileResponse")
-	proto.RegisterType((*ProfileSettings)(nil), "api.ProfileSettings")
-	proto.RegisterType((*LoginRequest)(nil), "api.LoginRequest")
-	proto.RegisterType((*LoginResponse)(nil), "api.LoginResponse")
-	proto.RegisterType((*ListUserRequest)(nil), "api.ListUserRequest")
-	proto.RegisterType((*UserRequest)(nil), "api.UserRequest")
-	proto.RegisterType((*AddUserResponse)(nil), "api.AddUserResponse")
-	proto.RegisterType((*UserSettings)(nil), "api.UserSettings")
-	proto.RegisterType((*GetUserResponse)(nil), "api.GetUserResponse")
-	proto.RegisterType((*AddUserRequest)(nil), "api.AddUserRequest")
-	proto.RegisterType((*AddUserOrganization)(nil), "api.AddUserOrganization")
-	proto.RegisterType((*UpdateUserRequest)(nil), "api.UpdateUserRequest")
-	proto.RegisterType((*ListUserResponse)(nil), "api.ListUserResponse")
-	proto.RegisterType((*UserEmptyResponse)(nil), "api.UserEmptyResponse")
-	proto.RegisterType((*UpdateUserPasswordRequest)(nil), "api.UpdateUserPasswordRequest")
-	proto.RegisterType((*BrandingRequest)(nil), "api.BrandingRequest")
-	proto.RegisterType((*BrandingResponse)(nil), "api.BrandingResponse")
+type OpenIDConnectSettingsRequest struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *OpenIDConnectSettingsRequest) Reset()                    { *m = OpenIDConnectSettingsRequest{} }
+func (m *OpenIDConnectSettingsRequest) String() string            { return proto.CompactTextString(m) }
+func (*OpenIDConnectSettingsRequest) ProtoMessage()               {}
+func (*OpenIDConnectSettingsRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{21} }
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// The OpenID Connect login settings.
+type OpenIDConnectSettingsResponse struct {
+	// OpenID Connect login is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	// URL to which the user must be redirected to login.
+	LoginURL string `protobuf:"bytes,2,opt,name=loginURL" json:"loginURL,omitempty"`
+	// Label of the login button.
+	LoginLabel string `protobuf:"bytes,3,opt,name=loginLabel" json:"loginLabel,omitempty"`
+}
 
-// Client API for User service
+func (m *OpenIDConnectSettingsResponse) Reset()                    { *m = OpenIDConnectSettingsResponse{} }
+func (m *OpenIDConnectSettingsResponse) String() string            { return proto.CompactTextString(m) }
+func (*OpenIDConnectSettingsResponse) ProtoMessage()               {}
+func (*OpenIDConnectSettingsResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{22} }
 
-type UserClient interface {
-	// Get user list.
-	List(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error)
-	// Get data for a particular user.
-	Get(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
-	// Create a new user.
-	Create(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error)
-	// Update an existing user.
-	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
-	// Delete a user.
-	Delete(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
-	// UpdatePassword updates a password.
-	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
+func (m *OpenIDConnectSettingsResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-type userClient struct {
-	cc *grpc.ClientConn
+func (m *OpenIDConnectSettingsResponse) GetLoginURL() string {
+	if m != nil {
+		return m.LoginURL
+	}
+	return ""
 }
 
-func NewUserClient(cc *grpc.ClientConn) UserClient {
-	return &userClient{cc}
+func (m *OpenIDConnectSettingsResponse) GetLoginLabel() string {
+	if m != nil {
+		return m.LoginLabel
+	}
+	return ""
 }
 
-func (c *userClient) List(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error) {
-	out := new(ListUserResponse)
-	err := grpc.Invoke(ctx, "/api.User/List", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type OpenIDConnectLoginRequest struct {
+	// Authorization code returned by the provider.
+	Code string `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+	// State returned by the provider.
+	State string `protobuf:"bytes,2,opt,name=state" json:"state,omitempty"`
 }
 
-func (c *userClient) Get(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
-	out := new(GetUserResponse)
-	err := grpc.Invoke(ctx, "/api.User/Get", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *OpenIDConnectLoginRequest) Reset()                    { *m = OpenIDConnectLoginRequest{} }
+func (m *OpenIDConnectLoginRequest) String() string            { return proto.CompactTextString(m) }
+func (*OpenIDConnectLoginRequest) ProtoMessage()               {}
+func (*OpenIDConnectLoginRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{23} }
+
+func (m *OpenIDConnectLoginRequest) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userClient) Create(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error) {
-	out := new(AddUserResponse)
-	err := grpc.Invoke(ctx, "/api.User/Create", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *OpenIDConnectLoginRequest) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userClient) Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
-	out := new(UserEmptyResponse)
-	err := grpc.Invoke(ctx, "/api.User/Update", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// TOTP secret to enroll in an authenticator app.
+type TOTPEnrollment struct {
+	// Base32 encoded secret.
+	Secret string `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
+	// otpauth:// provisioning URI (e.g. to render as QR code).
+	ProvisioningURI string `protobuf:"bytes,2,opt,name=provisioningURI" json:"provisioningURI,omitempty"`
 }
 
-func (c *userClient) Delete(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
-	out := new(UserEmptyResponse)
-	err := grpc.Invoke(ctx, "/api.User/Delete", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *TOTPEnrollment) Reset()                    { *m = TOTPEnrollment{} }
+func (m *TOTPEnrollment) String() string            { return proto.CompactTextString(m) }
+func (*TOTPEnrollment) ProtoMessage()               {}
+func (*TOTPEnrollment) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{24} }
+
+func (m *TOTPEnrollment) GetSecret() string {
+	if m != nil {
+		return m.Secret
 	}
-	return out, nil
+	return ""
 }
 
-func (c *userClient) UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
-	out := new(UserEmptyResponse)
-	err := grpc.Invoke(ctx, "/api.User/UpdatePassword", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *TOTPEnrollment) GetProvisioningURI() string {
+	if m != nil {
+		return m.ProvisioningURI
 	}
-	return out, nil
+	return ""
 }
 
-// Server API for User service
-
-type UserServer interface {
-	// Get user list.
-	List(context.Context, *ListUserRequest) (*ListUserResponse, error)
-	// Get data for a particular user.
-	Get(context.Context, *UserRequest) (*GetUserResponse, error)
-	// Create a new user.
-	Create(context.Context, *AddUserRequest) (*AddUserResponse, error)
-	// Update an existing user.
-	Update(context.Context, *UpdateUserRequest) (*UserEmptyResponse, error)
-	// Delete a user.
-	Delete(context.Context, *UserRequest) (*UserEmptyResponse, error)
-	// UpdatePassword updates a password.
-	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*UserEmptyResponse, error)
+type GetTOTPRequest struct {
 }
 
-func RegisterUserServer(s *grpc.Server, srv UserServer) {
-	s.RegisterService(&_User_serviceDesc, srv)
+func (m *GetTOTPRequest) Reset()                    { *m = GetTOTPRequest{} }
+func (m *GetTOTPRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTOTPRequest) ProtoMessage()               {}
+func (*GetTOTPRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{25} }
+
+type GetTOTPResponse struct {
+	// Two-factor authentication is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled" json:"enabled,omitempty"`
+	// Number of unused recovery codes.
+	RecoveryCodeCount int32 `protobuf:"varint,2,opt,name=recoveryCodeCount" json:"recoveryCodeCount,omitempty"`
 }
 
-func _User_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(UserServer).List(ctx, in)
+func (m *GetTOTPResponse) Reset()                    { *m = GetTOTPResponse{} }
+func (m *GetTOTPResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetTOTPResponse) ProtoMessage()               {}
+func (*GetTOTPResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{26} }
+
+func (m *GetTOTPResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.User/List",
+	return false
+}
+
+func (m *GetTOTPResponse) GetRecoveryCodeCount() int32 {
+	if m != nil {
+		return m.RecoveryCodeCount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).List(ctx, req.(*ListUserRequest))
+	return 0
+}
+
+type CreateTOTPRequest struct {
+}
+
+func (m *CreateTOTPRequest) Reset()                    { *m = CreateTOTPRequest{} }
+func (m *CreateTOTPRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateTOTPRequest) ProtoMessage()               {}
+func (*CreateTOTPRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{27} }
+
+type EnableTOTPRequest struct {
+	// TOTP code generated with the pending secret.
+	Code string `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+}
+
+func (m *EnableTOTPRequest) Reset()                    { *m = EnableTOTPRequest{} }
+func (m *EnableTOTPRequest) String() string            { return proto.CompactTextString(m) }
+func (*EnableTOTPRequest) ProtoMessage()               {}
+func (*EnableTOTPRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{28} }
+
+func (m *EnableTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _User_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type EnableTOTPResponse struct {
+	// Recovery codes, each code can be used once instead of a TOTP code.
+	// These are only returned once.
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes" json:"recoveryCodes,omitempty"`
+}
+
+func (m *EnableTOTPResponse) Reset()                    { *m = EnableTOTPResponse{} }
+func (m *EnableTOTPResponse) String() string            { return proto.CompactTextString(m) }
+func (*EnableTOTPResponse) ProtoMessage()               {}
+func (*EnableTOTPResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{29} }
+
+func (m *EnableTOTPResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
 	}
-	if interceptor == nil {
-		return srv.(UserServer).Get(ctx, in)
+	return nil
+}
+
+type DisableTOTPRequest struct {
+	// TOTP code or recovery code.
+	Code string `protobuf:"bytes,1,opt,name=code" json:"code,omitempty"`
+}
+
+func (m *DisableTOTPRequest) Reset()                    { *m = DisableTOTPRequest{} }
+func (m *DisableTOTPRequest) String() string            { return proto.CompactTextString(m) }
+func (*DisableTOTPRequest) ProtoMessage()               {}
+func (*DisableTOTPRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{30} }
+
+func (m *DisableTOTPRequest) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.User/Get",
+	return ""
+}
+
+type DisableTOTPResponse struct {
+}
+
+func (m *DisableTOTPResponse) Reset()                    { *m = DisableTOTPResponse{} }
+func (m *DisableTOTPResponse) String() string            { return proto.CompactTextString(m) }
+func (*DisableTOTPResponse) ProtoMessage()               {}
+func (*DisableTOTPResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{31} }
+
+type LoginLockout struct {
+	// Username which is locked out.
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	// IP address which is locked out.
+	Ip string `protobuf:"bytes,2,opt,name=ip" json:"ip,omitempty"`
+	// Timestamp until which the username or ip address is locked out.
+	LockedUntil string `protobuf:"bytes,3,opt,name=lockedUntil" json:"lockedUntil,omitempty"`
+}
+
+func (m *LoginLockout) Reset()                    { *m = LoginLockout{} }
+func (m *LoginLockout) String() string            { return proto.CompactTextString(m) }
+func (*LoginLockout) ProtoMessage()               {}
+func (*LoginLockout) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{32} }
+
+func (m *LoginLockout) GetUsername() string {
+	if m != nil {
+		return m.Username
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).Get(ctx, req.(*UserRequest))
+	return ""
+}
+
+func (m *LoginLockout) GetIp() string {
+	if m != nil {
+		return m.Ip
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _User_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddUserRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *LoginLockout) GetLockedUntil() string {
+	if m != nil {
+		return m.LockedUntil
 	}
-	if interceptor == nil {
-		return srv.(UserServer).Create(ctx, in)
+	return ""
+}
+
+type ListLoginLockoutsRequest struct {
+}
+
+func (m *ListLoginLockoutsRequest) Reset()                    { *m = ListLoginLockoutsRequest{} }
+func (m *ListLoginLockoutsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListLoginLockoutsRequest) ProtoMessage()               {}
+func (*ListLoginLockoutsRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{33} }
+
+type ListLoginLockoutsResponse struct {
+	Result []*LoginLockout `protobuf:"bytes,1,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListLoginLockoutsResponse) Reset()                    { *m = ListLoginLockoutsResponse{} }
+func (m *ListLoginLockoutsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListLoginLockoutsResponse) ProtoMessage()               {}
+func (*ListLoginLockoutsResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{34} }
+
+func (m *ListLoginLockoutsResponse) GetResult() []*LoginLockout {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteLoginLockoutRequest struct {
+	// Username to clear the lockout for.
+	Username string `protobuf:"bytes,1,opt,name=username" json:"username,omitempty"`
+	// IP address to clear the lockout for.
+	Ip string `protobuf:"bytes,2,opt,name=ip" json:"ip,omitempty"`
+}
+
+func (m *DeleteLoginLockoutRequest) Reset()                    { *m = DeleteLoginLockoutRequest{} }
+func (m *DeleteLoginLockoutRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteLoginLockoutRequest) ProtoMessage()               {}
+func (*DeleteLoginLockoutRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{35} }
+
+func (m *DeleteLoginLockoutRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *DeleteLoginLockoutRequest) GetIp() string {
+	if m != nil {
+		return m.Ip
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
+	// Refresh token returned by the login or previous refresh.
+	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken" json:"refreshToken,omitempty"`
+}
+
+func (m *RefreshTokenRequest) Reset()                    { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string            { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()               {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{36} }
+
+func (m *RefreshTokenRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	// The JWT tag to be used to access lora-app-server interfaces.
+	Jwt string `protobuf:"bytes,1,opt,name=jwt" json:"jwt,omitempty"`
+	// The new refresh token, the previous refresh token is no longer valid.
+	RefreshToken string `protobuf:"bytes,2,opt,name=refreshToken" json:"refreshToken,omitempty"`
+}
+
+func (m *RefreshTokenResponse) Reset()                    { *m = RefreshTokenResponse{} }
+func (m *RefreshTokenResponse) String() string            { return proto.CompactTextString(m) }
+func (*RefreshTokenResponse) ProtoMessage()               {}
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{37} }
+
+func (m *RefreshTokenResponse) GetJwt() string {
+	if m != nil {
+		return m.Jwt
+	}
+	return ""
+}
+
+func (m *RefreshTokenResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+}
+
+func (m *LogoutRequest) Reset()                    { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string            { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()               {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{38} }
+
+type LogoutResponse struct {
+}
+
+func (m *LogoutResponse) Reset()                    { *m = LogoutResponse{} }
+func (m *LogoutResponse) String() string            { return proto.CompactTextString(m) }
+func (*LogoutResponse) ProtoMessage()               {}
+func (*LogoutResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{39} }
+
+type UserSession struct {
+	// ID of the session.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// Timestamp when the session was created (login).
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Timestamp when the session was last refreshed.
+	UpdatedAt string `protobuf:"bytes,3,opt,name=updatedAt" json:"updatedAt,omitempty"`
+	// Timestamp when the session expires.
+	ExpiresAt string `protobuf:"bytes,4,opt,name=expiresAt" json:"expiresAt,omitempty"`
+	// IP address from which the session was created.
+	RemoteAddr string `protobuf:"bytes,5,opt,name=remoteAddr" json:"remoteAddr,omitempty"`
+	// The session is the session of the current request.
+	Current bool `protobuf:"varint,6,opt,name=current" json:"current,omitempty"`
+}
+
+func (m *UserSession) Reset()                    { *m = UserSession{} }
+func (m *UserSession) String() string            { return proto.CompactTextString(m) }
+func (*UserSession) ProtoMessage()               {}
+func (*UserSession) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{40} }
+
+func (m *UserSession) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UserSession) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *UserSession) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+func (m *UserSession) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+func (m *UserSession) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
+}
+
+func (m *UserSession) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type ListUserSessionsRequest struct {
+	// ID of the user.
+	UserID int64 `protobuf:"varint,1,opt,name=userID" json:"userID,omitempty"`
+	// Max number of sessions to return in the result-set.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListUserSessionsRequest) Reset()                    { *m = ListUserSessionsRequest{} }
+func (m *ListUserSessionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListUserSessionsRequest) ProtoMessage()               {}
+func (*ListUserSessionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{41} }
+
+func (m *ListUserSessionsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *ListUserSessionsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListUserSessionsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListUserSessionsResponse struct {
+	// Total number of sessions.
+	TotalCount int64          `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*UserSession `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListUserSessionsResponse) Reset()                    { *m = ListUserSessionsResponse{} }
+func (m *ListUserSessionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListUserSessionsResponse) ProtoMessage()               {}
+func (*ListUserSessionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{42} }
+
+func (m *ListUserSessionsResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListUserSessionsResponse) GetResult() []*UserSession {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type DeleteUserSessionRequest struct {
+	// ID of the user.
+	UserID int64 `protobuf:"varint,1,opt,name=userID" json:"userID,omitempty"`
+	// ID of the session.
+	Id int64 `protobuf:"varint,2,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteUserSessionRequest) Reset()                    { *m = DeleteUserSessionRequest{} }
+func (m *DeleteUserSessionRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteUserSessionRequest) ProtoMessage()               {}
+func (*DeleteUserSessionRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{43} }
+
+func (m *DeleteUserSessionRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *DeleteUserSessionRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteUserSessionsRequest struct {
+	// ID of the user.
+	UserID int64 `protobuf:"varint,1,opt,name=userID" json:"userID,omitempty"`
+}
+
+func (m *DeleteUserSessionsRequest) Reset()                    { *m = DeleteUserSessionsRequest{} }
+func (m *DeleteUserSessionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteUserSessionsRequest) ProtoMessage()               {}
+func (*DeleteUserSessionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{44} }
+
+func (m *DeleteUserSessionsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+type RequestPasswordResetRequest struct {
+	// E-mail address of the user.
+	Email string `protobuf:"bytes,1,opt,name=email" json:"email,omitempty"`
+}
+
+func (m *RequestPasswordResetRequest) Reset()                    { *m = RequestPasswordResetRequest{} }
+func (m *RequestPasswordResetRequest) String() string            { return proto.CompactTextString(m) }
+func (*RequestPasswordResetRequest) ProtoMessage()               {}
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{45} }
+
+func (m *RequestPasswordResetRequest) GetEmail() string {
+	if m != nil {
+		return m.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+}
+
+func (m *RequestPasswordResetResponse) Reset()                    { *m = RequestPasswordResetResponse{} }
+func (m *RequestPasswordResetResponse) String() string            { return proto.CompactTextString(m) }
+func (*RequestPasswordResetResponse) ProtoMessage()               {}
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{46} }
+
+type ResetPasswordRequest struct {
+	// Password reset token (sent by e-mail).
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	// The new password.
+	Password string `protobuf:"bytes,2,opt,name=password" json:"password,omitempty"`
+}
+
+func (m *ResetPasswordRequest) Reset()                    { *m = ResetPasswordRequest{} }
+func (m *ResetPasswordRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResetPasswordRequest) ProtoMessage()               {}
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{47} }
+
+func (m *ResetPasswordRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ResetPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+}
+
+func (m *ResetPasswordResponse) Reset()                    { *m = ResetPasswordResponse{} }
+func (m *ResetPasswordResponse) String() string            { return proto.CompactTextString(m) }
+func (*ResetPasswordResponse) ProtoMessage()               {}
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{48} }
+
+type VerifyEmailRequest struct {
+	// E-mail verification token (sent by e-mail).
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+}
+
+func (m *VerifyEmailRequest) Reset()                    { *m = VerifyEmailRequest{} }
+func (m *VerifyEmailRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerifyEmailRequest) ProtoMessage()               {}
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{49} }
+
+func (m *VerifyEmailRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type VerifyEmailResponse struct {
+}
+
+func (m *VerifyEmailResponse) Reset()                    { *m = VerifyEmailResponse{} }
+func (m *VerifyEmailResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifyEmailResponse) ProtoMessage()               {}
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) { return fileDescriptor4, []int{50} }
+
+type AcceptOrganizationInvitationRequest struct {
+	// Organization invitation token (sent by e-mail).
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	// Username of the new user (leave blank to accept the invitation
+	// with the authenticated user).
+	Username string `protobuf:"bytes,2,opt,name=username" json:"username,omitempty"`
+	// Password of the new user.
+	Password string `protobuf:"bytes,3,opt,name=password" json:"password,omitempty"`
+}
+
+func (m *AcceptOrganizationInvitationRequest) Reset()         { *m = AcceptOrganizationInvitationRequest{} }
+func (m *AcceptOrganizationInvitationRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptOrganizationInvitationRequest) ProtoMessage()    {}
+func (*AcceptOrganizationInvitationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor4, []int{51}
+}
+
+func (m *AcceptOrganizationInvitationRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AcceptOrganizationInvitationRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AcceptOrganizationInvitationRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type AcceptOrganizationInvitationResponse struct {
+	// ID of the organization.
+	OrganizationID int64 `protobuf:"varint,1,opt,name=organizationID" json:"organizationID,omitempty"`
+	// ID of the user added to the organization.
+	UserID int64 `protobuf:"varint,2,opt,name=userID" json:"userID,omitempty"`
+}
+
+func (m *AcceptOrganizationInvitationResponse) Reset()         { *m = AcceptOrganizationInvitationResponse{} }
+func (m *AcceptOrganizationInvitationResponse) String() string { return proto.CompactTextString(m) }
+func (*AcceptOrganizationInvitationResponse) ProtoMessage()    {}
+func (*AcceptOrganizationInvitationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor4, []int{52}
+}
+
+func (m *AcceptOrganizationInvitationResponse) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *AcceptOrganizationInvitationResponse) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OrganizationLink)(nil), "api.OrganizationLink")
+	proto.RegisterType((*ProfileRequest)(nil), "api.ProfileRequest")
+	proto.RegisterType((*ProfileResponse)(nil), "api.ProfileResponse")
+	proto.RegisterType((*ProfileSettings)(nil), "api.ProfileSettings")
+	proto.RegisterType((*LoginRequest)(nil), "api.LoginRequest")
+	proto.RegisterType((*LoginResponse)(nil), "api.LoginResponse")
+	proto.RegisterType((*LoginSecondFactorRequest)(nil), "api.LoginSecondFactorRequest")
+	proto.RegisterType((*LoginSecondFactorResponse)(nil), "api.LoginSecondFactorResponse")
+	proto.RegisterType((*ListUserRequest)(nil), "api.ListUserRequest")
+	proto.RegisterType((*UserRequest)(nil), "api.UserRequest")
+	proto.RegisterType((*AddUserResponse)(nil), "api.AddUserResponse")
+	proto.RegisterType((*UserSettings)(nil), "api.UserSettings")
+	proto.RegisterType((*GetUserResponse)(nil), "api.GetUserResponse")
+	proto.RegisterType((*AddUserRequest)(nil), "api.AddUserRequest")
+	proto.RegisterType((*AddUserOrganization)(nil), "api.AddUserOrganization")
+	proto.RegisterType((*UpdateUserRequest)(nil), "api.UpdateUserRequest")
+	proto.RegisterType((*ListUserResponse)(nil), "api.ListUserResponse")
+	proto.RegisterType((*UserEmptyResponse)(nil), "api.UserEmptyResponse")
+	proto.RegisterType((*UpdateUserPasswordRequest)(nil), "api.UpdateUserPasswordRequest")
+	proto.RegisterType((*BrandingRequest)(nil), "api.BrandingRequest")
+	proto.RegisterType((*BrandingResponse)(nil), "api.BrandingResponse")
+	proto.RegisterType((*OpenIDConnectSettingsRequest)(nil), "api.OpenIDConnectSettingsRequest")
+	proto.RegisterType((*OpenIDConnectSettingsResponse)(nil), "api.OpenIDConnectSettingsResponse")
+	proto.RegisterType((*OpenIDConnectLoginRequest)(nil), "api.OpenIDConnectLoginRequest")
+	proto.RegisterType((*TOTPEnrollment)(nil), "api.TOTPEnrollment")
+	proto.RegisterType((*GetTOTPRequest)(nil), "api.GetTOTPRequest")
+	proto.RegisterType((*GetTOTPResponse)(nil), "api.GetTOTPResponse")
+	proto.RegisterType((*CreateTOTPRequest)(nil), "api.CreateTOTPRequest")
+	proto.RegisterType((*EnableTOTPRequest)(nil), "api.EnableTOTPRequest")
+	proto.RegisterType((*EnableTOTPResponse)(nil), "api.EnableTOTPResponse")
+	proto.RegisterType((*DisableTOTPRequest)(nil), "api.DisableTOTPRequest")
+	proto.RegisterType((*DisableTOTPResponse)(nil), "api.DisableTOTPResponse")
+	proto.RegisterType((*LoginLockout)(nil), "api.LoginLockout")
+	proto.RegisterType((*ListLoginLockoutsRequest)(nil), "api.ListLoginLockoutsRequest")
+	proto.RegisterType((*ListLoginLockoutsResponse)(nil), "api.ListLoginLockoutsResponse")
+	proto.RegisterType((*DeleteLoginLockoutRequest)(nil), "api.DeleteLoginLockoutRequest")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "api.RefreshTokenRequest")
+	proto.RegisterType((*RefreshTokenResponse)(nil), "api.RefreshTokenResponse")
+	proto.RegisterType((*LogoutRequest)(nil), "api.LogoutRequest")
+	proto.RegisterType((*LogoutResponse)(nil), "api.LogoutResponse")
+	proto.RegisterType((*UserSession)(nil), "api.UserSession")
+	proto.RegisterType((*ListUserSessionsRequest)(nil), "api.ListUserSessionsRequest")
+	proto.RegisterType((*ListUserSessionsResponse)(nil), "api.ListUserSessionsResponse")
+	proto.RegisterType((*DeleteUserSessionRequest)(nil), "api.DeleteUserSessionRequest")
+	proto.RegisterType((*DeleteUserSessionsRequest)(nil), "api.DeleteUserSessionsRequest")
+	proto.RegisterType((*RequestPasswordResetRequest)(nil), "api.RequestPasswordResetRequest")
+	proto.RegisterType((*RequestPasswordResetResponse)(nil), "api.RequestPasswordResetResponse")
+	proto.RegisterType((*ResetPasswordRequest)(nil), "api.ResetPasswordRequest")
+	proto.RegisterType((*ResetPasswordResponse)(nil), "api.ResetPasswordResponse")
+	proto.RegisterType((*VerifyEmailRequest)(nil), "api.VerifyEmailRequest")
+	proto.RegisterType((*VerifyEmailResponse)(nil), "api.VerifyEmailResponse")
+	proto.RegisterType((*AcceptOrganizationInvitationRequest)(nil), "api.AcceptOrganizationInvitationRequest")
+	proto.RegisterType((*AcceptOrganizationInvitationResponse)(nil), "api.AcceptOrganizationInvitationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for User service
+
+type UserClient interface {
+	// Get user list.
+	List(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error)
+	// Get data for a particular user.
+	Get(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// Create a new user.
+	Create(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error)
+	// Update an existing user.
+	Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
+	// Delete a user.
+	Delete(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
+	// UpdatePassword updates a password.
+	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
+	// DeleteTOTP resets the two-factor authentication of a user.
+	DeleteTOTP(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
+	// ListLoginLockouts lists the usernames and ip addresses which are
+	// locked out after too many failed login attempts.
+	ListLoginLockouts(ctx context.Context, in *ListLoginLockoutsRequest, opts ...grpc.CallOption) (*ListLoginLockoutsResponse, error)
+	// DeleteLoginLockout clears the lockout and failed login attempts of
+	// the given username and / or ip address.
+	DeleteLoginLockout(ctx context.Context, in *DeleteLoginLockoutRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
+	// ListSessions lists the active sessions of the given user.
+	ListSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error)
+	// DeleteSession revokes the given session of the user.
+	DeleteSession(ctx context.Context, in *DeleteUserSessionRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
+	// DeleteSessions revokes all sessions of the given user.
+	DeleteSessions(ctx context.Context, in *DeleteUserSessionsRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
+	// SendEmailVerification (re)sends the e-mail verification e-mail to
+	// the given user.
+	SendEmailVerification(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error)
+}
+
+type userClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewUserClient(cc *grpc.ClientConn) UserClient {
+	return &userClient{cc}
+}
+
+func (c *userClient) List(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error) {
+	out := new(ListUserResponse)
+	err := grpc.Invoke(ctx, "/api.User/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Get(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	out := new(GetUserResponse)
+	err := grpc.Invoke(ctx, "/api.User/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Create(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error) {
+	out := new(AddUserResponse)
+	err := grpc.Invoke(ctx, "/api.User/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Update(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
+	out := new(UserEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.User/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) Delete(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
+	out := new(UserEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.User/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
+	out := new(UserEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.User/UpdatePassword", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteTOTP(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
+	out := new(UserEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.User/DeleteTOTP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListLoginLockouts(ctx context.Context, in *ListLoginLockoutsRequest, opts ...grpc.CallOption) (*ListLoginLockoutsResponse, error) {
+	out := new(ListLoginLockoutsResponse)
+	err := grpc.Invoke(ctx, "/api.User/ListLoginLockouts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteLoginLockout(ctx context.Context, in *DeleteLoginLockoutRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
+	out := new(UserEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.User/DeleteLoginLockout", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListSessions(ctx context.Context, in *ListUserSessionsRequest, opts ...grpc.CallOption) (*ListUserSessionsResponse, error) {
+	out := new(ListUserSessionsResponse)
+	err := grpc.Invoke(ctx, "/api.User/ListSessions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteSession(ctx context.Context, in *DeleteUserSessionRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
+	out := new(UserEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.User/DeleteSession", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) DeleteSessions(ctx context.Context, in *DeleteUserSessionsRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
+	out := new(UserEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.User/DeleteSessions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SendEmailVerification(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*UserEmptyResponse, error) {
+	out := new(UserEmptyResponse)
+	err := grpc.Invoke(ctx, "/api.User/SendEmailVerification", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for User service
+
+type UserServer interface {
+	// Get user list.
+	List(context.Context, *ListUserRequest) (*ListUserResponse, error)
+	// Get data for a particular user.
+	Get(context.Context, *UserRequest) (*GetUserResponse, error)
+	// Create a new user.
+	Create(context.Context, *AddUserRequest) (*AddUserResponse, error)
+	// Update an existing user.
+	Update(context.Context, *UpdateUserRequest) (*UserEmptyResponse, error)
+	// Delete a user.
+	Delete(context.Context, *UserRequest) (*UserEmptyResponse, error)
+	// UpdatePassword updates a password.
+	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*UserEmptyResponse, error)
+	// DeleteTOTP resets the two-factor authentication of a user.
+	DeleteTOTP(context.Context, *UserRequest) (*UserEmptyResponse, error)
+	// ListLoginLockouts lists the usernames and ip addresses which are
+	// locked out after too many failed login attempts.
+	ListLoginLockouts(context.Context, *ListLoginLockoutsRequest) (*ListLoginLockoutsResponse, error)
+	// DeleteLoginLockout clears the lockout and failed login attempts of
+	// the given username and / or ip address.
+	DeleteLoginLockout(context.Context, *DeleteLoginLockoutRequest) (*UserEmptyResponse, error)
+	// ListSessions lists the active sessions of the given user.
+	ListSessions(context.Context, *ListUserSessionsRequest) (*ListUserSessionsResponse, error)
+	// DeleteSession revokes the given session of the user.
+	DeleteSession(context.Context, *DeleteUserSessionRequest) (*UserEmptyResponse, error)
+	// DeleteSessions revokes all sessions of the given user.
+	DeleteSessions(context.Context, *DeleteUserSessionsRequest) (*UserEmptyResponse, error)
+	// SendEmailVerification (re)sends the e-mail verification e-mail to
+	// the given user.
+	SendEmailVerification(context.Context, *UserRequest) (*UserEmptyResponse, error)
+}
+
+func RegisterUserServer(s *grpc.Server, srv UserServer) {
+	s.RegisterService(&_User_serviceDesc, srv)
+}
+
+func _User_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).List(ctx, req.(*ListUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Get(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Create(ctx, req.(*AddUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Update(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Delete(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdatePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdatePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/UpdatePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdatePassword(ctx, req.(*UpdateUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/DeleteTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteTOTP(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListLoginLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginLockoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListLoginLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/ListLoginLockouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListLoginLockouts(ctx, req.(*ListLoginLockoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteLoginLockout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLoginLockoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteLoginLockout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/DeleteLoginLockout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteLoginLockout(ctx, req.(*DeleteLoginLockoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListSessions(ctx, req.(*ListUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/DeleteSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteSession(ctx, req.(*DeleteUserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_DeleteSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).DeleteSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/DeleteSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).DeleteSessions(ctx, req.(*DeleteUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SendEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SendEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.User/SendEmailVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SendEmailVerification(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _User_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.User",
+	HandlerType: (*UserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _User_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _User_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _User_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _User_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _User_Delete_Handler,
+		},
+		{
+			MethodName: "UpdatePassword",
+			Handler:    _User_UpdatePassword_Handler,
+		},
+		{
+			MethodName: "DeleteTOTP",
+			Handler:    _User_DeleteTOTP_Handler,
+		},
+		{
+			MethodName: "ListLoginLockouts",
+			Handler:    _User_ListLoginLockouts_Handler,
+		},
+		{
+			MethodName: "DeleteLoginLockout",
+			Handler:    _User_DeleteLoginLockout_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _User_ListSessions_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _User_DeleteSession_Handler,
+		},
+		{
+			MethodName: "DeleteSessions",
+			Handler:    _User_DeleteSessions_Handler,
+		},
+		{
+			MethodName: "SendEmailVerification",
+			Handler:    _User_SendEmailVerification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "user.proto",
+}
+
+// Client API for Internal service
+
+type InternalClient interface {
+	// Log in a user
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Complete the login of a user using the second factor
+	LoginSecondFactor(ctx context.Context, in *LoginSecondFactorRequest, opts ...grpc.CallOption) (*LoginSecondFactorResponse, error)
+	// Get the current user's profile
+	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
+	// Get the branding for the UI
+	Branding(ctx context.Context, in *BrandingRequest, opts ...grpc.CallOption) (*BrandingResponse, error)
+	// Get the OpenID Connect login settings for the UI
+	OpenIDConnectSettings(ctx context.Context, in *OpenIDConnectSettingsRequest, opts ...grpc.CallOption) (*OpenIDConnectSettingsResponse, error)
+	// Log in a user using the OpenID Connect authorization code and state
+	OpenIDConnectLogin(ctx context.Context, in *OpenIDConnectLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// Get the two-factor authentication status of the current user
+	GetTOTP(ctx context.Context, in *GetTOTPRequest, opts ...grpc.CallOption) (*GetTOTPResponse, error)
+	// Create a new (pending) TOTP secret for the current user
+	CreateTOTP(ctx context.Context, in *CreateTOTPRequest, opts ...grpc.CallOption) (*TOTPEnrollment, error)
+	// Enable the pending TOTP secret of the current user
+	EnableTOTP(ctx context.Context, in *EnableTOTPRequest, opts ...grpc.CallOption) (*EnableTOTPResponse, error)
+	// Disable the two-factor authentication of the current user
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error)
+	// Refresh the session using the refresh token, returning a new JWT
+	// and refresh token
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// Log out the current user, revoking its session
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	// Request a password reset, sending a password reset link to the
+	// users with the given e-mail address
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	// Reset the password using the password reset token
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	// Verify the e-mail address using the e-mail verification token
+	VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error)
+	// Accept an organization invitation using the invitation token. When a
+	// username is given, a new user is created for the invited e-mail
+	// address, else the invitation is accepted by the authenticated user.
+	AcceptOrganizationInvitation(ctx context.Context, in *AcceptOrganizationInvitationRequest, opts ...grpc.CallOption) (*AcceptOrganizationInvitationResponse, error)
+}
+
+type internalClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewInternalClient(cc *grpc.ClientConn) InternalClient {
+	return &internalClient{cc}
+}
+
+func (c *internalClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/Login", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) LoginSecondFactor(ctx context.Context, in *LoginSecondFactorRequest, opts ...grpc.CallOption) (*LoginSecondFactorResponse, error) {
+	out := new(LoginSecondFactorResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/LoginSecondFactor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
+	out := new(ProfileResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/Profile", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) Branding(ctx context.Context, in *BrandingRequest, opts ...grpc.CallOption) (*BrandingResponse, error) {
+	out := new(BrandingResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/Branding", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) OpenIDConnectSettings(ctx context.Context, in *OpenIDConnectSettingsRequest, opts ...grpc.CallOption) (*OpenIDConnectSettingsResponse, error) {
+	out := new(OpenIDConnectSettingsResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/OpenIDConnectSettings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) OpenIDConnectLogin(ctx context.Context, in *OpenIDConnectLoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/OpenIDConnectLogin", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) GetTOTP(ctx context.Context, in *GetTOTPRequest, opts ...grpc.CallOption) (*GetTOTPResponse, error) {
+	out := new(GetTOTPResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/GetTOTP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) CreateTOTP(ctx context.Context, in *CreateTOTPRequest, opts ...grpc.CallOption) (*TOTPEnrollment, error) {
+	out := new(TOTPEnrollment)
+	err := grpc.Invoke(ctx, "/api.Internal/CreateTOTP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) EnableTOTP(ctx context.Context, in *EnableTOTPRequest, opts ...grpc.CallOption) (*EnableTOTPResponse, error) {
+	out := new(EnableTOTPResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/EnableTOTP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error) {
+	out := new(DisableTOTPResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/DisableTOTP", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/RefreshToken", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/Logout", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/RequestPasswordReset", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/ResetPassword", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...grpc.CallOption) (*VerifyEmailResponse, error) {
+	out := new(VerifyEmailResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/VerifyEmail", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalClient) AcceptOrganizationInvitation(ctx context.Context, in *AcceptOrganizationInvitationRequest, opts ...grpc.CallOption) (*AcceptOrganizationInvitationResponse, error) {
+	out := new(AcceptOrganizationInvitationResponse)
+	err := grpc.Invoke(ctx, "/api.Internal/AcceptOrganizationInvitation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Internal service
+
+type InternalServer interface {
+	// Log in a user
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// Complete the login of a user using the second factor
+	LoginSecondFactor(context.Context, *LoginSecondFactorRequest) (*LoginSecondFactorResponse, error)
+	// Get the current user's profile
+	Profile(context.Context, *ProfileRequest) (*ProfileResponse, error)
+	// Get the branding for the UI
+	Branding(context.Context, *BrandingRequest) (*BrandingResponse, error)
+	// Get the OpenID Connect login settings for the UI
+	OpenIDConnectSettings(context.Context, *OpenIDConnectSettingsRequest) (*OpenIDConnectSettingsResponse, error)
+	// Log in a user using the OpenID Connect authorization code and state
+	OpenIDConnectLogin(context.Context, *OpenIDConnectLoginRequest) (*LoginResponse, error)
+	// Get the two-factor authentication status of the current user
+	GetTOTP(context.Context, *GetTOTPRequest) (*GetTOTPResponse, error)
+	// Create a new (pending) TOTP secret for the current user
+	CreateTOTP(context.Context, *CreateTOTPRequest) (*TOTPEnrollment, error)
+	// Enable the pending TOTP secret of the current user
+	EnableTOTP(context.Context, *EnableTOTPRequest) (*EnableTOTPResponse, error)
+	// Disable the two-factor authentication of the current user
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error)
+	// Refresh the session using the refresh token, returning a new JWT
+	// and refresh token
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// Log out the current user, revoking its session
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// Request a password reset, sending a password reset link to the
+	// users with the given e-mail address
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	// Reset the password using the password reset token
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	// Verify the e-mail address using the e-mail verification token
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	// Accept an organization invitation using the invitation token. When a
+	// username is given, a new user is created for the invited e-mail
+	// address, else the invitation is accepted by the authenticated user.
+	AcceptOrganizationInvitation(context.Context, *AcceptOrganizationInvitationRequest) (*AcceptOrganizationInvitationResponse, error)
+}
+
+func RegisterInternalServer(s *grpc.Server, srv InternalServer) {
+	s.RegisterService(&_Internal_serviceDesc, srv)
+}
+
+func _Internal_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServer).Login(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.User/Create",
+		FullMethod: "/api.Internal/Login",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).Create(ctx, req.(*AddUserRequest))
+		return srv.(InternalServer).Login(ctx, req.(*LoginRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _Internal_LoginSecondFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginSecondFactorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).Update(ctx, in)
+		return srv.(InternalServer).LoginSecondFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.User/Update",
+		FullMethod: "/api.Internal/LoginSecondFactor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).Update(ctx, req.(*UpdateUserRequest))
+		return srv.(InternalServer).LoginSecondFactor(ctx, req.(*LoginSecondFactorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserRequest)
+func _Internal_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).Delete(ctx, in)
+		return srv.(InternalServer).Profile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.User/Delete",
+		FullMethod: "/api.Internal/Profile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).Delete(ctx, req.(*UserRequest))
+		return srv.(InternalServer).Profile(ctx, req.(*ProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UpdatePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserPasswordRequest)
+func _Internal_Branding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UpdatePassword(ctx, in)
+		return srv.(InternalServer).Branding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.User/UpdatePassword",
+		FullMethod: "/api.Internal/Branding",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UpdatePassword(ctx, req.(*UpdateUserPasswordRequest))
+		return srv.(InternalServer).Branding(ctx, req.(*BrandingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _User_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.User",
-	HandlerType: (*UserServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "List",
-			Handler:    _User_List_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _User_Get_Handler,
-		},
-		{
-			MethodName: "Create",
-			Handler:    _User_Create_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _User_Update_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _User_Delete_Handler,
-		},
-		{
-			MethodName: "UpdatePassword",
-			Handler:    _User_UpdatePassword_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "user.proto",
+func _Internal_OpenIDConnectSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenIDConnectSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServer).OpenIDConnectSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Internal/OpenIDConnectSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServer).OpenIDConnectSettings(ctx, req.(*OpenIDConnectSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Client API for Internal service
-
-type InternalClient interface {
-	// Log in a user
-	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	// Get the current user's profile
-	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error)
-	// Get the branding for the UI
-	Branding(ctx context.Context, in *BrandingRequest, opts ...grpc.CallOption) (*BrandingResponse, error)
+func _Internal_OpenIDConnectLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenIDConnectLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServer).OpenIDConnectLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Internal/OpenIDConnectLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServer).OpenIDConnectLogin(ctx, req.(*OpenIDConnectLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type internalClient struct {
-	cc *grpc.ClientConn
+func _Internal_GetTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServer).GetTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Internal/GetTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServer).GetTOTP(ctx, req.(*GetTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewInternalClient(cc *grpc.ClientConn) InternalClient {
-	return &internalClient{cc}
+func _Internal_CreateTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServer).CreateTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Internal/CreateTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServer).CreateTOTP(ctx, req.(*CreateTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *internalClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := grpc.Invoke(ctx, "/api.Internal/Login", in, out, c.cc, opts...)
-	if err != nil {
+func _Internal_EnableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTOTPRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(InternalServer).EnableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Internal/EnableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServer).EnableTOTP(ctx, req.(*EnableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *internalClient) Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*ProfileResponse, error) {
-	out := new(ProfileResponse)
-	err := grpc.Invoke(ctx, "/api.Internal/Profile", in, out, c.cc, opts...)
-	if err != nil {
+func _Internal_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(InternalServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Internal/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *internalClient) Branding(ctx context.Context, in *BrandingRequest, opts ...grpc.CallOption) (*BrandingResponse, error) {
-	out := new(BrandingResponse)
-	err := grpc.Invoke(ctx, "/api.Internal/Branding", in, out, c.cc, opts...)
-	if err != nil {
+func _Internal_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(InternalServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Internal/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Server API for Internal service
-
-type InternalServer interface {
-	// Log in a user
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	// Get the current user's profile
-	Profile(context.Context, *ProfileRequest) (*ProfileResponse, error)
-	// Get the branding for the UI
-	Branding(context.Context, *BrandingRequest) (*BrandingResponse, error)
+func _Internal_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Internal/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterInternalServer(s *grpc.Server, srv InternalServer) {
-	s.RegisterService(&_Internal_serviceDesc, srv)
+func _Internal_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Internal/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Internal_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Internal_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InternalServer).Login(ctx, in)
+		return srv.(InternalServer).ResetPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Internal/Login",
+		FullMethod: "/api.Internal/ResetPassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InternalServer).Login(ctx, req.(*LoginRequest))
+		return srv.(InternalServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Internal_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProfileRequest)
+func _Internal_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InternalServer).Profile(ctx, in)
+		return srv.(InternalServer).VerifyEmail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Internal/Profile",
+		FullMethod: "/api.Internal/VerifyEmail",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InternalServer).Profile(ctx, req.(*ProfileRequest))
+		return srv.(InternalServer).VerifyEmail(ctx, req.(*VerifyEmailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Internal_Branding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BrandingRequest)
+func _Internal_AcceptOrganizationInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptOrganizationInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InternalServer).Branding(ctx, in)
+		return srv.(InternalServer).AcceptOrganizationInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/api.Internal/Branding",
+		FullMethod: "/api.Internal/AcceptOrganizationInvitation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InternalServer).Branding(ctx, req.(*BrandingRequest))
+		return srv.(InternalServer).AcceptOrganizationInvitation(ctx, req.(*AcceptOrganizationInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1083,6 +2550,10 @@ var _Internal_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _Internal_Login_Handler,
 		},
+		{
+			MethodName: "LoginSecondFactor",
+			Handler:    _Internal_LoginSecondFactor_Handler,
+		},
 		{
 			MethodName: "Profile",
 			Handler:    _Internal_Profile_Handler,
@@ -1091,6 +2562,54 @@ var _Internal_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Branding",
 			Handler:    _Internal_Branding_Handler,
 		},
+		{
+			MethodName: "OpenIDConnectSettings",
+			Handler:    _Internal_OpenIDConnectSettings_Handler,
+		},
+		{
+			MethodName: "OpenIDConnectLogin",
+			Handler:    _Internal_OpenIDConnectLogin_Handler,
+		},
+		{
+			MethodName: "GetTOTP",
+			Handler:    _Internal_GetTOTP_Handler,
+		},
+		{
+			MethodName: "CreateTOTP",
+			Handler:    _Internal_CreateTOTP_Handler,
+		},
+		{
+			MethodName: "EnableTOTP",
+			Handler:    _Internal_EnableTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Internal_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Internal_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _Internal_Logout_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _Internal_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _Internal_ResetPassword_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _Internal_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "AcceptOrganizationInvitation",
+			Handler:    _Internal_AcceptOrganizationInvitation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -1099,66 +2618,143 @@ var _Internal_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("user.proto", fileDescriptor4) }
 
 var fileDescriptor4 = []byte{
-	// 966 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcb, 0x6e, 0xe4, 0x44,
-	0x14, 0x95, 0xdb, 0xdd, 0x6e, 0xe7, 0x26, 0xd3, 0x8f, 0xca, 0x63, 0x8c, 0x35, 0x89, 0x82, 0x91,
-	0x50, 0x2b, 0x42, 0x69, 0x14, 0x76, 0x83, 0x34, 0x52, 0x33, 0x8f, 0x68, 0x20, 0x1a, 0x82, 0x27,
-	0xa3, 0x6c, 0x71, 0xe2, 0x8a, 0x29, 0x70, 0x57, 0x19, 0x57, 0x35, 0xc3, 0x43, 0x6c, 0x58, 0x65,
-	0xcf, 0x5f, 0xf0, 0x0d, 0x48, 0xfc, 0x00, 0x3b, 0x7e, 0x81, 0x5f, 0x60, 0xc1, 0x0e, 0x55, 0xb9,
-	0xec, 0x2e, 0x57, 0x3a, 0x11, 0x12, 0x42, 0x88, 0x9d, 0xeb, 0xd4, 0xf5, 0xa9, 0x7b, 0xcf, 0x3d,
-	0x75, 0x6d, 0x80, 0x05, 0xc7, 0xe5, 0x61, 0x51, 0x32, 0xc1, 0x90, 0x9b, 0x14, 0x24, 0x7c, 0x90,
-	0x31, 0x96, 0xe5, 0x78, 0x9a, 0x14, 0x64, 0x9a, 0x50, 0xca, 0x44, 0x22, 0x08, 0xa3, 0xbc, 0x0a,
-	0x89, 0x7e, 0x76, 0x60, 0xf4, 0x71, 0x99, 0x25, 0x94, 0x7c, 0xab, 0xf0, 0x13, 0x42, 0xbf, 0x40,
-	0x6f, 0xc3, 0x80, 0x19, 0xd8, 0xf3, 0x27, 0x81, 0xb3, 0xef, 0x4c, 0xdc, 0xd8, 0x42, 0xd1, 0x01,
-	0x8c, 0x4c, 0xe4, 0x45, 0x32, 0xc7, 0x41, 0x67, 0xdf, 0x99, 0xac, 0xc5, 0x37, 0x70, 0x14, 0x40,
-	0x9f, 0xf0, 0x59, 0x3a, 0x27, 0x34, 0x70, 0xf7, 0x9d, 0x89, 0x1f, 0xd7, 0x4b, 0xf4, 0x00, 0xd6,
-	0x2e, 0x4b, 0x9c, 0x08, 0x9c, 0xce, 0x44, 0xd0, 0x55, 0xaf, 0x2f, 0x01, 0xb9, 0xbb, 0x28, 0x52,
-	0xbd, 0xdb, 0xab, 0x76, 0x1b, 0x20, 0x1a, 0xc1, 0xe0, 0xb4, 0x64, 0x57, 0x24, 0xc7, 0x31, 0xfe,
-	0x72, 0x81, 0xb9, 0x88, 0x7e, 0x72, 0x60, 0xd8, 0x40, 0xbc, 0x60, 0x94, 0x63, 0x34, 0x81, 0xae,
-	0x54, 0x45, 0x55, 0xb1, 0x7e, 0xb4, 0x75, 0x98, 0x14, 0xe4, 0xf0, 0x18, 0x8b, 0x57, 0x1c, 0x97,
-	0x75, 0x4c, 0xac, 0x22, 0xd0, 0xfb, 0x70, 0xcf, 0xcc, 0x9c, 0x07, 0xee, 0xbe, 0x3b, 0x59, 0x3f,
-	0xda, 0x56, 0xaf, 0xd8, 0x3a, 0xc5, 0xed, 0x58, 0xf4, 0x2e, 0xf8, 0x1c, 0x0b, 0x41, 0x68, 0xc6,
-	0x55, 0x1d, 0xf5, 0x51, 0x3a, 0x9d, 0x97, 0x7a, 0x2f, 0x6e, 0xa2, 0xa2, 0x4f, 0x9a, 0x5c, 0xeb,
-	0x4d, 0xf4, 0x08, 0xc2, 0x94, 0xf0, 0xe4, 0x22, 0xc7, 0x33, 0xce, 0x49, 0x46, 0x9f, 0x7e, 0x4d,
-	0xb8, 0xdc, 0x91, 0xc9, 0x72, 0x55, 0x81, 0x1f, 0xdf, 0x11, 0x11, 0x3d, 0x83, 0x8d, 0x13, 0x96,
-	0x11, 0xaa, 0xf5, 0x40, 0x21, 0xf8, 0xb2, 0x32, 0x2a, 0x7b, 0xe3, 0x28, 0xf9, 0x9a, 0xb5, 0xdc,
-	0x2b, 0x12, 0xce, 0x5f, 0xb3, 0x32, 0xd5, 0x7d, 0x6b, 0xd6, 0xd1, 0x9b, 0x70, 0x4f, 0xf3, 0x68,
-	0x11, 0x47, 0xe0, 0x7e, 0xfe, 0x5a, 0x68, 0x0e, 0xf9, 0x18, 0x9d, 0xc3, 0xf0, 0x84, 0x70, 0x2d,
-	0x63, 0x75, 0xda, 0x16, 0xf4, 0x72, 0x32, 0x27, 0x55, 0x58, 0x2f, 0xae, 0x16, 0x68, 0x07, 0x3c,
-	0x76, 0x75, 0xc5, 0xb1, 0x50, 0xa7, 0xf4, 0x62, 0xbd, 0x92, 0x38, 0xc7, 0x49, 0x79, 0xf9, 0x99,
-	0xb2, 0xc4, 0x5a, 0xac, 0x57, 0xd1, 0x2e, 0xac, 0x9b, 0xa4, 0x03, 0xe8, 0x90, 0x54, 0x5b, 0xb0,
-	0x43, 0x64, 0x6a, 0xc3, 0x59, 0x9a, 0x9a, 0xdd, 0xbb, 0x11, 0xf2, 0xab, 0x03, 0x1b, 0x32, 0xa0,
-	0x91, 0xd5, 0x0a, 0x68, 0xc9, 0xd2, 0xb1, 0x64, 0xd9, 0x03, 0xe0, 0x98, 0x73, 0xc2, 0xe8, 0xd9,
-	0xd9, 0x89, 0x4a, 0xad, 0x17, 0x1b, 0x88, 0x69, 0xe5, 0x6e, 0xdb, 0xca, 0x21, 0xf8, 0x84, 0xcf,
-	0x2e, 0x05, 0xf9, 0x0a, 0x2b, 0xaf, 0xfa, 0x71, 0xb3, 0x6e, 0xdb, 0xdc, 0xbb, 0xd3, 0xe6, 0x7d,
-	0xdb, 0xe6, 0x7f, 0x3a, 0x30, 0xb4, 0x0c, 0xfb, 0xff, 0xae, 0x48, 0x1a, 0x05, 0xcf, 0x13, 0x92,
-	0x07, 0xbe, 0xda, 0xa9, 0x16, 0x08, 0x41, 0x97, 0x32, 0x81, 0x83, 0x35, 0x05, 0xaa, 0xe7, 0xe8,
-	0xba, 0x03, 0x83, 0xa6, 0xdd, 0xff, 0xc8, 0xd3, 0xff, 0x92, 0x0c, 0x8f, 0xec, 0x99, 0xe1, 0xa9,
-	0x99, 0x11, 0xa8, 0xbb, 0xaf, 0x33, 0x37, 0x47, 0x87, 0x3d, 0x36, 0x1a, 0x29, 0xfa, 0xab, 0xa4,
-	0xf0, 0x0d, 0x29, 0xce, 0x61, 0x73, 0x05, 0xdf, 0xdf, 0x1e, 0xd7, 0x46, 0x79, 0x9d, 0x56, 0x79,
-	0xd1, 0x2f, 0x0e, 0x8c, 0x5f, 0xa9, 0xde, 0xdc, 0x71, 0xef, 0xfe, 0x03, 0x87, 0x35, 0xd2, 0x78,
-	0xab, 0xa4, 0xe9, 0x1b, 0xd2, 0x7c, 0x0a, 0xa3, 0xe5, 0x2c, 0xd2, 0x37, 0x64, 0x0f, 0x40, 0x30,
-	0x91, 0xe4, 0x8f, 0xd9, 0x82, 0xd6, 0x13, 0xc9, 0x40, 0xd0, 0x3b, 0xe0, 0x95, 0x98, 0x2f, 0x72,
-	0x39, 0x96, 0xdc, 0x5b, 0x3f, 0x0c, 0x3a, 0x26, 0xda, 0x84, 0xb1, 0xc4, 0x9f, 0xce, 0x0b, 0xf1,
-	0x4d, 0xbd, 0x19, 0x1d, 0xc3, 0x1b, 0x4b, 0xdd, 0x4e, 0xb5, 0xcf, 0xee, 0xd0, 0xef, 0xd6, 0x71,
-	0x3b, 0x86, 0xe1, 0x07, 0x65, 0x42, 0x53, 0x42, 0xb3, 0xfa, 0x4b, 0x76, 0x01, 0xa3, 0x25, 0xa4,
-	0x4b, 0x42, 0xd0, 0xcd, 0x59, 0xc6, 0xb4, 0xeb, 0xd5, 0x33, 0x8a, 0x60, 0xa3, 0xc4, 0x19, 0xe1,
-	0xa2, 0x54, 0x8d, 0xd6, 0xd4, 0x2d, 0x4c, 0x4e, 0xda, 0x2b, 0xc6, 0x04, 0x2e, 0xeb, 0x49, 0x5b,
-	0xad, 0x8e, 0xfe, 0x70, 0xa1, 0x2b, 0x53, 0x47, 0xc7, 0xd0, 0x95, 0xfa, 0xa1, 0x4a, 0x03, 0x6b,
-	0xac, 0x87, 0xdb, 0x16, 0xaa, 0xab, 0x47, 0x3f, 0xfc, 0xf6, 0xfb, 0x8f, 0x9d, 0x0d, 0x04, 0xea,
-	0xe7, 0x42, 0x7a, 0x81, 0xa3, 0x67, 0xe0, 0x1e, 0x63, 0x81, 0x46, 0xea, 0x0d, 0x93, 0x63, 0xa5,
-	0xba, 0xd1, 0x7d, 0x45, 0x31, 0x46, 0xc3, 0x25, 0xc5, 0xf4, 0x3b, 0x92, 0x7e, 0x8f, 0x3e, 0x04,
-	0xef, 0xb1, 0x9a, 0x25, 0x68, 0xd3, 0xbc, 0x48, 0x6d, 0x36, 0xeb, 0x33, 0x10, 0x6d, 0x2b, 0xb6,
-	0x61, 0x64, 0x24, 0xf4, 0xd0, 0x39, 0x40, 0x67, 0xe0, 0x55, 0x5d, 0x42, 0x3b, 0x55, 0x5a, 0xb6,
-	0xd5, 0xc3, 0x9d, 0x26, 0xdd, 0x76, 0x7f, 0x43, 0x45, 0xb8, 0x15, 0xda, 0xe9, 0x49, 0xd6, 0x8f,
-	0xc0, 0x7b, 0x82, 0x73, 0x2c, 0xf0, 0x8a, 0x62, 0x6f, 0xe3, 0xd3, 0xe5, 0x1e, 0xdc, 0x28, 0x77,
-	0x0e, 0x83, 0x2a, 0xab, 0xd3, 0x66, 0x58, 0x59, 0xa9, 0x5a, 0xee, 0xba, 0xf5, 0x88, 0xb7, 0xd4,
-	0x11, 0xbb, 0x61, 0x60, 0x1d, 0x31, 0xad, 0xbd, 0xf6, 0xd0, 0x39, 0x38, 0xba, 0xee, 0x80, 0xff,
-	0x9c, 0x0a, 0x79, 0x79, 0x73, 0xf4, 0x02, 0x7a, 0xea, 0x53, 0x8f, 0xc6, 0x55, 0x9b, 0x8d, 0xdf,
-	0x87, 0x10, 0x99, 0x90, 0x3e, 0x61, 0x4f, 0x9d, 0x10, 0x44, 0x9b, 0xea, 0x04, 0xa2, 0x69, 0xa6,
-	0xb9, 0x0c, 0x92, 0xc2, 0xbc, 0x84, 0xbe, 0xfe, 0xab, 0xd1, 0xbd, 0x6b, 0xff, 0xa2, 0x85, 0x5b,
-	0x6d, 0x50, 0xb3, 0xee, 0x2a, 0xd6, 0xfb, 0x68, 0xbb, 0xcd, 0x5a, 0x68, 0xa6, 0x73, 0xf0, 0xeb,
-	0xdb, 0xa0, 0x4d, 0x6a, 0xdd, 0x17, 0x6d, 0x52, 0xfb, 0xca, 0xd4, 0xd9, 0xa2, 0x9d, 0x36, 0xef,
-	0x85, 0x8e, 0xbb, 0xf0, 0xd4, 0x8f, 0xf0, 0x7b, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x46,
-	0x10, 0x37, 0x39, 0x0b, 0x00, 0x00,
+	// 2200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4b, 0x6f, 0x1c, 0x4b,
+	0x15, 0x56, 0xcf, 0xd8, 0xe3, 0xf1, 0xf1, 0x63, 0xec, 0xf2, 0x8c, 0xdd, 0xee, 0xd8, 0xbe, 0x76,
+	0x25, 0x24, 0x93, 0x51, 0x9c, 0x41, 0xce, 0x06, 0x72, 0xa5, 0x2b, 0xf9, 0x26, 0x8e, 0x31, 0x58,
+	0x37, 0xa6, 0xed, 0xdc, 0x80, 0x84, 0x04, 0xe3, 0xe9, 0xf2, 0xa4, 0xf0, 0xb8, 0x6b, 0xd2, 0x55,
+	0x76, 0x6e, 0x40, 0x57, 0x42, 0xb0, 0x61, 0xc1, 0x8e, 0x1f, 0xc0, 0x1e, 0x89, 0x05, 0x7b, 0x24,
+	0xfe, 0x00, 0x3b, 0x58, 0xb3, 0x62, 0xcf, 0x5f, 0x40, 0xf5, 0xe8, 0x9e, 0xea, 0xd7, 0xd8, 0x08,
+	0x21, 0xc4, 0x6e, 0xea, 0x54, 0xf5, 0xf9, 0xce, 0x39, 0xfd, 0xd5, 0x79, 0xf4, 0x00, 0x5c, 0x73,
+	0x12, 0x3d, 0x1d, 0x45, 0x4c, 0x30, 0x54, 0xed, 0x8d, 0xa8, 0xb7, 0x31, 0x60, 0x6c, 0x30, 0x24,
+	0xdd, 0xde, 0x88, 0x76, 0x7b, 0x61, 0xc8, 0x44, 0x4f, 0x50, 0x16, 0x72, 0x7d, 0x04, 0xff, 0xc9,
+	0x81, 0xa5, 0xd7, 0xd1, 0xa0, 0x17, 0xd2, 0x9f, 0x29, 0xf9, 0x31, 0x0d, 0x2f, 0xd1, 0x43, 0x58,
+	0x64, 0x96, 0xec, 0xe8, 0xa5, 0xeb, 0x6c, 0x3b, 0xed, 0xaa, 0x9f, 0x91, 0xa2, 0x0e, 0x2c, 0xd9,
+	0x92, 0x2f, 0x7a, 0x57, 0xc4, 0xad, 0x6c, 0x3b, 0xed, 0x59, 0x3f, 0x27, 0x47, 0x2e, 0xcc, 0x50,
+	0xbe, 0x1f, 0x5c, 0xd1, 0xd0, 0xad, 0x6e, 0x3b, 0xed, 0xba, 0x1f, 0x2f, 0xd1, 0x06, 0xcc, 0xf6,
+	0x23, 0xd2, 0x13, 0x24, 0xd8, 0x17, 0xee, 0x94, 0x7a, 0x7c, 0x2c, 0x90, 0xbb, 0xd7, 0xa3, 0xc0,
+	0xec, 0x4e, 0xeb, 0xdd, 0x44, 0x80, 0x97, 0x60, 0xf1, 0x24, 0x62, 0x17, 0x74, 0x48, 0x7c, 0xf2,
+	0xfe, 0x9a, 0x70, 0x81, 0x7f, 0xef, 0x40, 0x23, 0x11, 0xf1, 0x11, 0x0b, 0x39, 0x41, 0x6d, 0x98,
+	0x92, 0x51, 0x51, 0x5e, 0xcc, 0xed, 0x35, 0x9f, 0xf6, 0x46, 0xf4, 0xe9, 0x21, 0x11, 0x6f, 0x38,
+	0x89, 0xe2, 0x33, 0xbe, 0x3a, 0x81, 0x3e, 0x85, 0x05, 0xdb, 0x72, 0xee, 0x56, 0xb7, 0xab, 0xed,
+	0xb9, 0xbd, 0x96, 0x7a, 0x24, 0x1b, 0x27, 0x3f, 0x7d, 0x16, 0x7d, 0x13, 0xea, 0x9c, 0x08, 0x41,
+	0xc3, 0x01, 0x77, 0xa7, 0x2c, 0x28, 0x63, 0xce, 0xa9, 0xd9, 0xf3, 0x93, 0x53, 0xf8, 0xfb, 0xd0,
+	0xc8, 0x6c, 0xa2, 0xcf, 0xc0, 0x0b, 0x28, 0xef, 0x9d, 0x0f, 0xc9, 0x3e, 0xe7, 0x74, 0x10, 0x1e,
+	0x7c, 0x45, 0xb9, 0xdc, 0x91, 0xc6, 0x72, 0xe5, 0x41, 0xdd, 0x9f, 0x70, 0x02, 0xbf, 0x82, 0xf9,
+	0x63, 0x36, 0xa0, 0xa1, 0x89, 0x07, 0xf2, 0xa0, 0x2e, 0x3d, 0x0b, 0xe5, 0xbb, 0x71, 0x54, 0xf8,
+	0x92, 0xb5, 0xdc, 0x1b, 0xf5, 0x38, 0xff, 0xc0, 0xa2, 0xc0, 0xbc, 0xb7, 0x64, 0x8d, 0xff, 0xee,
+	0xc0, 0x82, 0x51, 0x64, 0xa2, 0xb8, 0x04, 0xd5, 0x9f, 0x7e, 0x10, 0x46, 0x89, 0xfc, 0x89, 0xf6,
+	0xa0, 0xc9, 0x49, 0x9f, 0x85, 0xc1, 0xab, 0x5e, 0x5f, 0xb0, 0x48, 0x42, 0xd2, 0x88, 0x68, 0x5d,
+	0x75, 0xbf, 0x70, 0x4f, 0x72, 0xab, 0xff, 0xae, 0x37, 0x1c, 0x92, 0x70, 0x40, 0xce, 0xd8, 0x25,
+	0xd1, 0x74, 0x98, 0xf5, 0x33, 0x52, 0xf4, 0x29, 0x2c, 0x0a, 0x26, 0x46, 0x07, 0x61, 0xc4, 0x86,
+	0xc3, 0x2b, 0x12, 0x0a, 0x13, 0xd2, 0x15, 0x15, 0xd2, 0xb3, 0xd7, 0x67, 0x27, 0xe3, 0x2d, 0x3f,
+	0x73, 0x14, 0x61, 0x98, 0x8f, 0xc8, 0x45, 0x44, 0xf8, 0x3b, 0x0d, 0xa1, 0x79, 0x93, 0x92, 0xe1,
+	0x2f, 0xc1, 0x55, 0xfe, 0x9d, 0x66, 0xac, 0x94, 0x41, 0xcb, 0x1b, 0xe9, 0x14, 0x1a, 0x89, 0x60,
+	0xaa, 0xcf, 0x82, 0x98, 0xf4, 0xea, 0x37, 0xfe, 0x00, 0xeb, 0x05, 0x7a, 0x4b, 0x63, 0xf8, 0x00,
+	0x16, 0x22, 0xd2, 0x67, 0x37, 0x24, 0xfa, 0xf8, 0x82, 0x05, 0x84, 0xbb, 0x95, 0xed, 0x6a, 0x7b,
+	0xd6, 0x4f, 0x0b, 0x73, 0x0e, 0x55, 0x0b, 0x1c, 0x7a, 0x0b, 0x8d, 0x63, 0xca, 0x0d, 0xab, 0xb5,
+	0x1f, 0x4d, 0x98, 0x1e, 0xd2, 0x2b, 0xaa, 0x01, 0xa7, 0x7d, 0xbd, 0x40, 0xab, 0x50, 0x63, 0x17,
+	0x17, 0x9c, 0x08, 0x65, 0xf7, 0xb4, 0x6f, 0x56, 0x52, 0xce, 0x49, 0x2f, 0xea, 0xbf, 0x33, 0xea,
+	0xcd, 0x0a, 0x6f, 0xc2, 0x9c, 0xad, 0x74, 0x11, 0x2a, 0x34, 0x30, 0x19, 0xa1, 0x42, 0x03, 0xbc,
+	0x03, 0x8d, 0xfd, 0x20, 0xb0, 0x2f, 0x53, 0xee, 0xc8, 0x5f, 0x1c, 0x98, 0x97, 0x07, 0x12, 0x96,
+	0x67, 0x0e, 0xa4, 0x58, 0x5a, 0xc9, 0xb0, 0x74, 0x0b, 0x80, 0x13, 0xce, 0x29, 0x0b, 0xcf, 0xce,
+	0x8e, 0x95, 0x69, 0xd3, 0xbe, 0x25, 0xb1, 0x33, 0xcb, 0x54, 0x3a, 0xb3, 0x78, 0x50, 0xa7, 0x7c,
+	0xbf, 0x2f, 0xe8, 0x0d, 0x51, 0x14, 0xa8, 0xfb, 0xc9, 0x3a, 0x9d, 0x75, 0x6a, 0x13, 0xb3, 0xce,
+	0x4c, 0x36, 0xeb, 0xfc, 0xae, 0x02, 0x8d, 0x4c, 0xfe, 0xf8, 0xff, 0xf6, 0x48, 0x12, 0x85, 0x5c,
+	0xf5, 0xe8, 0xd0, 0xad, 0xab, 0x1d, 0xbd, 0x90, 0xf4, 0x0e, 0x99, 0x20, 0xee, 0xac, 0xa6, 0xb7,
+	0xfc, 0x2d, 0xf9, 0xaa, 0x36, 0xbf, 0x24, 0x11, 0xbd, 0xa0, 0x24, 0x70, 0x41, 0x99, 0x91, 0x16,
+	0xe2, 0x5f, 0x57, 0x60, 0x31, 0x21, 0xc5, 0x7f, 0x94, 0x88, 0xfe, 0x4b, 0xc1, 0xfa, 0x2c, 0x9b,
+	0xe8, 0x6b, 0x2a, 0xd1, 0xbb, 0x2a, 0xbb, 0x18, 0xcb, 0xed, 0x7c, 0x9f, 0xcd, 0xf5, 0x49, 0xc0,
+	0x66, 0x8a, 0x02, 0x56, 0x1f, 0x07, 0x0c, 0xbf, 0x85, 0x95, 0x02, 0x7d, 0x77, 0xae, 0xb1, 0x96,
+	0x7b, 0x95, 0x94, 0x7b, 0xf8, 0xcf, 0x0e, 0x2c, 0xbf, 0x51, 0x6f, 0x70, 0xc2, 0xed, 0xfc, 0x1f,
+	0xf0, 0x30, 0x09, 0x4d, 0xad, 0x28, 0x34, 0x33, 0x56, 0x68, 0x7e, 0x02, 0x4b, 0xe3, 0x8c, 0x65,
+	0xee, 0xd1, 0x16, 0x80, 0x60, 0xa2, 0x37, 0x7c, 0xc1, 0xae, 0xc3, 0x38, 0x6f, 0x59, 0x12, 0xf4,
+	0x04, 0x6a, 0x11, 0xe1, 0xd7, 0x43, 0xa1, 0x12, 0x65, 0x59, 0x35, 0x37, 0x67, 0xf0, 0x0a, 0x2c,
+	0x4b, 0xf9, 0xc1, 0xd5, 0x48, 0x7c, 0x8c, 0x37, 0xf1, 0x21, 0xac, 0x8f, 0xe3, 0x76, 0x62, 0x78,
+	0x36, 0x21, 0x7e, 0xa5, 0x35, 0x72, 0x19, 0x1a, 0x9f, 0x47, 0xbd, 0x30, 0xa0, 0xe1, 0xc0, 0x3c,
+	0x8e, 0xcf, 0x61, 0x69, 0x2c, 0x32, 0x2e, 0x21, 0x98, 0x1a, 0xb2, 0x01, 0x33, 0xac, 0x57, 0xbf,
+	0x75, 0x42, 0x1f, 0x50, 0x2e, 0x22, 0xf5, 0xa2, 0x8d, 0xea, 0x94, 0x4c, 0xe6, 0xe3, 0x0b, 0xc6,
+	0x04, 0x89, 0xe2, 0x7c, 0xac, 0x57, 0x78, 0x0b, 0x36, 0x5e, 0x8f, 0x48, 0x78, 0xf4, 0xf2, 0x05,
+	0x0b, 0x43, 0xd2, 0x17, 0x49, 0x63, 0x61, 0x6c, 0xb8, 0x86, 0xcd, 0x92, 0x7d, 0x63, 0x90, 0x0b,
+	0x33, 0x24, 0x94, 0x0d, 0x44, 0x60, 0x1a, 0x8a, 0x78, 0x29, 0xbd, 0x1d, 0xca, 0xe2, 0xf5, 0xc6,
+	0x3f, 0x8e, 0xbd, 0x8d, 0xd7, 0xf2, 0xcd, 0xa8, 0xdf, 0xc7, 0xbd, 0x73, 0x32, 0x34, 0x26, 0x59,
+	0x12, 0x7c, 0x00, 0xeb, 0x29, 0xd8, 0x54, 0x1b, 0x12, 0x57, 0x4a, 0x67, 0x5c, 0x29, 0x25, 0x51,
+	0xb8, 0xe8, 0x89, 0x98, 0x97, 0x7a, 0x81, 0x7d, 0x58, 0x4c, 0x57, 0x77, 0x5d, 0x97, 0xfa, 0x11,
+	0x89, 0xeb, 0xa6, 0x59, 0xa1, 0x36, 0x34, 0x46, 0x11, 0xbb, 0xa1, 0x92, 0xae, 0xb2, 0xff, 0xf1,
+	0x8f, 0x8c, 0xa6, 0xac, 0x58, 0xb6, 0x89, 0x87, 0x44, 0x48, 0xb5, 0x71, 0x8c, 0x7e, 0x08, 0x8d,
+	0x44, 0x72, 0x6b, 0x54, 0x9e, 0xc0, 0xb2, 0x5d, 0x8e, 0x35, 0x35, 0x75, 0xed, 0xcc, 0x6f, 0x48,
+	0xce, 0xbd, 0x50, 0x69, 0xd7, 0xc6, 0x7b, 0x04, 0xcb, 0x07, 0x4a, 0x9b, 0x25, 0x2c, 0x0a, 0x0a,
+	0x7e, 0x0e, 0xc8, 0x3e, 0x68, 0x6c, 0xcb, 0x75, 0x09, 0x4e, 0x41, 0x97, 0x80, 0xdb, 0x80, 0x5e,
+	0x52, 0x3e, 0x7e, 0xb8, 0x1c, 0xa5, 0x05, 0x2b, 0xa9, 0x93, 0xe6, 0x66, 0xfc, 0xc8, 0x34, 0x8f,
+	0xc7, 0xac, 0x7f, 0xc9, 0xae, 0x27, 0xe7, 0x6c, 0x79, 0x51, 0x46, 0x26, 0xe0, 0x15, 0x3a, 0x42,
+	0xdb, 0x30, 0x37, 0x64, 0xfd, 0x4b, 0x12, 0xbc, 0x09, 0x05, 0x8d, 0xf9, 0x61, 0x8b, 0xb0, 0x07,
+	0xae, 0xbc, 0xee, 0x36, 0x42, 0xc2, 0xd9, 0x57, 0xb0, 0x5e, 0xb0, 0x67, 0xbc, 0x7f, 0x9c, 0xdc,
+	0x79, 0x47, 0xdd, 0xf9, 0x65, 0x75, 0xe7, 0xed, 0xb3, 0xc9, 0x85, 0x3f, 0x84, 0xf5, 0x97, 0x64,
+	0x48, 0x04, 0x49, 0xed, 0xde, 0xa1, 0x04, 0x65, 0xdc, 0xc1, 0xdf, 0x86, 0x15, 0xdf, 0xea, 0xae,
+	0x62, 0x15, 0xd9, 0x46, 0xcc, 0x29, 0x68, 0xc4, 0x8e, 0xa1, 0x99, 0x7e, 0xb4, 0xb4, 0xf9, 0xcb,
+	0x6a, 0xab, 0x14, 0x68, 0x6b, 0xa8, 0x3e, 0x7c, 0xec, 0x85, 0x24, 0x73, 0x2c, 0x30, 0xaf, 0xed,
+	0x8f, 0x8e, 0xee, 0xd0, 0x4e, 0x75, 0xea, 0xce, 0xe5, 0xb0, 0x54, 0x67, 0x50, 0x99, 0xd8, 0x19,
+	0x54, 0xb3, 0x9d, 0xc1, 0x06, 0xcc, 0x92, 0xaf, 0x46, 0x34, 0x22, 0x7c, 0x3c, 0x9d, 0x25, 0x02,
+	0x99, 0x13, 0x22, 0x72, 0xc5, 0x04, 0xd9, 0x0f, 0x82, 0xc8, 0xb4, 0xd9, 0x96, 0x44, 0xde, 0xa9,
+	0xfe, 0x75, 0x14, 0x91, 0x50, 0x77, 0x24, 0x75, 0x3f, 0x5e, 0xe2, 0x1f, 0xc3, 0x5a, 0x9c, 0xfb,
+	0x8d, 0xd9, 0x31, 0x17, 0xe4, 0x7d, 0x97, 0xaf, 0x25, 0x29, 0x89, 0x66, 0x35, 0xee, 0x66, 0x2b,
+	0x4a, 0x9c, 0xeb, 0x66, 0xab, 0xfa, 0xb4, 0x5e, 0xe1, 0x00, 0xdc, 0x3c, 0x40, 0x69, 0x91, 0xa9,
+	0xa6, 0x8a, 0x4c, 0x3b, 0x53, 0x64, 0x96, 0x14, 0xe1, 0x2c, 0x55, 0x09, 0xdf, 0x3e, 0x07, 0x57,
+	0xf3, 0xcd, 0xde, 0xbc, 0xc5, 0x0f, 0xfd, 0x7a, 0x2a, 0x49, 0x77, 0xfc, 0x0c, 0xd6, 0x73, 0x3a,
+	0x6e, 0x0b, 0x06, 0x7e, 0x06, 0xf7, 0xcc, 0x91, 0x71, 0x05, 0xe3, 0x44, 0x58, 0x9d, 0xbf, 0x2e,
+	0xc2, 0x8e, 0x55, 0x84, 0x65, 0xe5, 0x28, 0x7e, 0xc8, 0x10, 0xe9, 0x3b, 0x92, 0xb9, 0x9c, 0x88,
+	0x6c, 0x51, 0x6c, 0xc2, 0xb4, 0xb0, 0xe8, 0xae, 0x17, 0x13, 0x4b, 0xe3, 0x1a, 0xb4, 0x32, 0x9a,
+	0x0c, 0x44, 0x07, 0x90, 0xea, 0x12, 0x3f, 0x1e, 0x48, 0x8b, 0x26, 0x02, 0xc8, 0x2c, 0x95, 0x3a,
+	0x6b, 0x54, 0x70, 0xb8, 0xbf, 0xdf, 0xef, 0x93, 0x91, 0xb0, 0x1b, 0xaa, 0xa3, 0xf0, 0x86, 0xea,
+	0x4f, 0x1b, 0xb7, 0x1a, 0x5d, 0xda, 0x0f, 0xd9, 0x0e, 0x55, 0x33, 0x0e, 0x5d, 0xc0, 0x83, 0xc9,
+	0xa0, 0x86, 0x5a, 0x77, 0xed, 0xeb, 0xc6, 0xef, 0xb5, 0x62, 0xbf, 0xd7, 0xbd, 0x7f, 0xce, 0xc2,
+	0x94, 0xe4, 0x01, 0x3a, 0x84, 0x29, 0xc9, 0x5f, 0xa4, 0x1b, 0x9c, 0xcc, 0x64, 0xe7, 0xb5, 0x32,
+	0x52, 0x13, 0x1a, 0xf4, 0xcb, 0xbf, 0xfe, 0xe3, 0xb7, 0x95, 0x79, 0x04, 0xea, 0x73, 0x8f, 0x54,
+	0xc9, 0xd1, 0x2b, 0xa8, 0x1e, 0x12, 0x81, 0xc6, 0x1c, 0x8e, 0x75, 0x14, 0xb6, 0x4e, 0x78, 0x4d,
+	0xa9, 0x58, 0x46, 0x8d, 0xb1, 0x8a, 0xee, 0xcf, 0x69, 0xf0, 0x35, 0xfa, 0x2e, 0xd4, 0x74, 0x5d,
+	0x43, 0x2b, 0x76, 0x97, 0x9c, 0xd6, 0x96, 0x99, 0x04, 0x71, 0x4b, 0x69, 0x6b, 0x60, 0xcb, 0xa0,
+	0xe7, 0x4e, 0x07, 0x9d, 0x41, 0x4d, 0xb7, 0x60, 0x68, 0x55, 0x9b, 0x95, 0xed, 0x63, 0xbd, 0xd5,
+	0xc4, 0xdc, 0x74, 0xf3, 0xe6, 0x29, 0x85, 0x4d, 0x2f, 0x6b, 0x9e, 0xd4, 0xfa, 0x3d, 0xa8, 0xe9,
+	0x8b, 0x54, 0xe0, 0x6c, 0x99, 0x3e, 0xe3, 0x6e, 0x27, 0xe7, 0xee, 0x15, 0x2c, 0x6a, 0xab, 0x4e,
+	0x92, 0x49, 0x24, 0x63, 0x6a, 0xe6, 0x96, 0x94, 0x42, 0xdc, 0x57, 0x10, 0x9b, 0x9e, 0x9b, 0x81,
+	0xe8, 0xc6, 0xe4, 0xd2, 0x11, 0x01, 0x6d, 0xbb, 0x2c, 0xc8, 0xff, 0x86, 0xfd, 0x1b, 0x4a, 0xf9,
+	0x6a, 0xa7, 0x99, 0x55, 0x2e, 0x98, 0x18, 0x21, 0x06, 0xcb, 0xb9, 0xb2, 0x8a, 0x36, 0x13, 0xee,
+	0x14, 0x95, 0x62, 0x6f, 0xab, 0x6c, 0xdb, 0x20, 0xde, 0x53, 0x88, 0x2d, 0xb4, 0xa2, 0x10, 0x55,
+	0x03, 0xb8, 0x3b, 0x8c, 0x75, 0x53, 0x40, 0xf9, 0xfa, 0x6b, 0x22, 0x57, 0x5a, 0x98, 0x4b, 0x9d,
+	0x33, 0x50, 0x9d, 0x42, 0xa8, 0xf7, 0x30, 0x2f, 0x8d, 0x8c, 0x13, 0x26, 0xda, 0x48, 0x5d, 0x89,
+	0x4c, 0x1e, 0xf5, 0x36, 0x4b, 0x76, 0x0d, 0xd2, 0x03, 0x85, 0xb4, 0x85, 0x36, 0xec, 0x30, 0xea,
+	0x2b, 0xf9, 0x75, 0x97, 0xc7, 0x10, 0xef, 0x61, 0x41, 0x3b, 0x11, 0x57, 0xda, 0x4d, 0xcb, 0xb1,
+	0x7c, 0x05, 0x28, 0xf5, 0xeb, 0xb1, 0x42, 0xbb, 0xdf, 0xd9, 0x99, 0x84, 0xa6, 0x69, 0x18, 0xc2,
+	0x62, 0x0a, 0x92, 0xa7, 0x82, 0x59, 0xe4, 0x69, 0x19, 0xa8, 0x71, 0xb1, 0x33, 0xd9, 0xc5, 0x11,
+	0xb4, 0x4e, 0x49, 0x18, 0x1c, 0x8c, 0xc7, 0xf9, 0xbe, 0x9e, 0x46, 0xee, 0x4e, 0xc9, 0x5d, 0x05,
+	0xf4, 0x08, 0xe3, 0x2c, 0x25, 0x55, 0x11, 0xda, 0xbd, 0xb1, 0xb4, 0x3e, 0x77, 0x3a, 0x7b, 0x7f,
+	0x5b, 0x80, 0xfa, 0x51, 0x28, 0x64, 0x0e, 0x1e, 0xa2, 0x2f, 0x60, 0x5a, 0x11, 0x04, 0x59, 0x3d,
+	0x5e, 0x8c, 0x87, 0x6c, 0x91, 0xc1, 0xda, 0x52, 0x58, 0x2e, 0xd6, 0x0c, 0xa1, 0x46, 0x8d, 0xa6,
+	0x8a, 0xbc, 0x56, 0xbf, 0x70, 0x60, 0x39, 0xf7, 0x39, 0x2e, 0xbe, 0x01, 0x25, 0x9f, 0xff, 0xbc,
+	0xad, 0xb2, 0x6d, 0x03, 0xfa, 0x44, 0x81, 0x3e, 0xc4, 0x3b, 0x05, 0xa0, 0x5d, 0xfd, 0xd5, 0x73,
+	0xf7, 0x42, 0x3d, 0x22, 0x4d, 0x38, 0x85, 0x19, 0xf3, 0x91, 0xd7, 0x24, 0xce, 0xf4, 0x17, 0x6b,
+	0xaf, 0x99, 0x16, 0x1a, 0x8c, 0x4d, 0x85, 0xb1, 0x86, 0x5a, 0x69, 0x8c, 0x91, 0xd1, 0xf4, 0x16,
+	0xea, 0xf1, 0x9c, 0x69, 0x2a, 0x44, 0x66, 0x12, 0xf5, 0x5a, 0x19, 0x69, 0x3a, 0x60, 0x68, 0x35,
+	0xad, 0xf7, 0x3c, 0x56, 0xf6, 0x2b, 0x07, 0x5a, 0x85, 0xd3, 0x23, 0xda, 0xd1, 0x1f, 0xc1, 0x27,
+	0x4c, 0x9e, 0x1e, 0x9e, 0x74, 0x24, 0x9d, 0x0d, 0xd1, 0xbd, 0xb4, 0x01, 0x8c, 0x06, 0xfd, 0x6e,
+	0xfc, 0x61, 0x1c, 0x5d, 0x01, 0xca, 0xcf, 0x92, 0x86, 0xf9, 0xa5, 0x43, 0x66, 0x21, 0x41, 0x0c,
+	0x1c, 0x76, 0x0b, 0xe0, 0x12, 0x96, 0x9c, 0xc0, 0x8c, 0x99, 0x06, 0xcd, 0x2b, 0x4a, 0x4f, 0x8b,
+	0x5e, 0x33, 0x2d, 0x4c, 0x97, 0x22, 0x84, 0xd2, 0xaa, 0x55, 0xe2, 0xfd, 0x01, 0xc0, 0x78, 0x08,
+	0x34, 0x45, 0x2e, 0x37, 0x15, 0x7a, 0x45, 0x1f, 0xb3, 0xe3, 0x37, 0x8f, 0x0b, 0xd4, 0x4a, 0x5b,
+	0xfb, 0x00, 0xe3, 0x01, 0xd1, 0x68, 0xce, 0x8d, 0x96, 0xde, 0x5a, 0x4e, 0x9e, 0xce, 0x02, 0x78,
+	0x3d, 0xaf, 0xbd, 0xab, 0xe7, 0x5d, 0x09, 0x32, 0x80, 0x39, 0x6b, 0x3e, 0x44, 0x5a, 0x5b, 0x7e,
+	0xb6, 0xf4, 0xdc, 0xfc, 0x86, 0xc1, 0xf9, 0x86, 0xc2, 0xf9, 0x04, 0x7b, 0x05, 0x38, 0xe6, 0xef,
+	0x0b, 0x09, 0x74, 0x09, 0xf3, 0xf6, 0xac, 0x84, 0xb4, 0xc2, 0x82, 0xc9, 0xcb, 0x5b, 0x2f, 0xd8,
+	0x31, 0x58, 0x0f, 0x15, 0xd6, 0x36, 0xce, 0x50, 0xca, 0x8c, 0x51, 0xbb, 0xaa, 0xf1, 0x93, 0x60,
+	0x3e, 0xd4, 0xf4, 0xe4, 0x84, 0x12, 0xa6, 0x58, 0x45, 0x68, 0x25, 0x25, 0x33, 0xaa, 0x3f, 0x51,
+	0xaa, 0xd7, 0x71, 0x33, 0x77, 0xd5, 0xd9, 0xb5, 0x90, 0x3a, 0x7f, 0xe3, 0x40, 0xd3, 0x68, 0x48,
+	0xf5, 0xd4, 0x68, 0xdb, 0xd8, 0x5b, 0xda, 0xa3, 0x7b, 0x3b, 0x13, 0x4e, 0x18, 0xf8, 0xae, 0x82,
+	0x7f, 0x8c, 0x1f, 0x64, 0xb2, 0x80, 0x39, 0xbc, 0x1b, 0xc9, 0xd3, 0xdd, 0x48, 0xab, 0x90, 0xe6,
+	0x30, 0x58, 0x48, 0xf5, 0xdd, 0x28, 0x0e, 0x5b, 0xbe, 0xab, 0xf7, 0xbc, 0xa2, 0x2d, 0x03, 0xfc,
+	0x48, 0x01, 0xef, 0xe0, 0x8d, 0x49, 0xc0, 0x86, 0x29, 0x56, 0x8f, 0x6e, 0x98, 0x92, 0xef, 0xf0,
+	0x3d, 0x37, 0xbf, 0x31, 0x99, 0x29, 0xaa, 0x4c, 0x7c, 0xdc, 0x55, 0x35, 0x43, 0x02, 0xfd, 0xc1,
+	0x81, 0x8d, 0x49, 0x1d, 0x38, 0x6a, 0xeb, 0x06, 0xf4, 0xf6, 0xc9, 0xc0, 0x7b, 0x7c, 0x87, 0x93,
+	0xc6, 0xb8, 0x6f, 0x29, 0xe3, 0xf6, 0xf0, 0x6e, 0x26, 0x7d, 0x58, 0x4f, 0xed, 0xd2, 0xe4, 0x31,
+	0xde, 0xed, 0x29, 0xa5, 0xcf, 0x9d, 0xce, 0x79, 0x4d, 0xfd, 0xc1, 0xfa, 0xec, 0x5f, 0x03, 0x00,
+	0x19, 0xc0, 0x28, 0xfa, 0x91, 0x1d, 0x00, 0x00,
 }
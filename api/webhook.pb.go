@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: webhook.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+type Webhook struct {
+	// ID of the webhook (set by the server).
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	// ID of the organization.
+	OrganizationID int64 `protobuf:"varint,2,opt,name=organizationID" json:"organizationID,omitempty"`
+	// Name of the webhook.
+	Name string `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	// URL to which the events are POSTed.
+	Url string `protobuf:"bytes,4,opt,name=url" json:"url,omitempty"`
+	// Secret used to sign the events (HMAC-SHA256). The secret is never
+	// returned. When left blank on update, the secret is not changed.
+	Secret string `protobuf:"bytes,5,opt,name=secret" json:"secret,omitempty"`
+}
+
+func (m *Webhook) Reset()                    { *m = Webhook{} }
+func (m *Webhook) String() string            { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()               {}
+func (*Webhook) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{0} }
+
+func (m *Webhook) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Webhook) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *Webhook) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type CreateWebhookRequest struct {
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook" json:"webhook,omitempty"`
+}
+
+func (m *CreateWebhookRequest) Reset()                    { *m = CreateWebhookRequest{} }
+func (m *CreateWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateWebhookRequest) ProtoMessage()               {}
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{1} }
+
+func (m *CreateWebhookRequest) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	// ID of the webhook.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *CreateWebhookResponse) Reset()                    { *m = CreateWebhookResponse{} }
+func (m *CreateWebhookResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateWebhookResponse) ProtoMessage()               {}
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{2} }
+
+func (m *CreateWebhookResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetWebhookRequest struct {
+	// ID of the webhook.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *GetWebhookRequest) Reset()                    { *m = GetWebhookRequest{} }
+func (m *GetWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetWebhookRequest) ProtoMessage()               {}
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{3} }
+
+func (m *GetWebhookRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GetWebhookResponse struct {
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook" json:"webhook,omitempty"`
+	// Timestamp when the record was created.
+	CreatedAt string `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Timestamp when the record was last updated.
+	UpdatedAt string `protobuf:"bytes,3,opt,name=updatedAt" json:"updatedAt,omitempty"`
+}
+
+func (m *GetWebhookResponse) Reset()                    { *m = GetWebhookResponse{} }
+func (m *GetWebhookResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetWebhookResponse) ProtoMessage()               {}
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{4} }
+
+func (m *GetWebhookResponse) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+func (m *GetWebhookResponse) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *GetWebhookResponse) GetUpdatedAt() string {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return ""
+}
+
+type UpdateWebhookRequest struct {
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook" json:"webhook,omitempty"`
+}
+
+func (m *UpdateWebhookRequest) Reset()                    { *m = UpdateWebhookRequest{} }
+func (m *UpdateWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateWebhookRequest) ProtoMessage()               {}
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{5} }
+
+func (m *UpdateWebhookRequest) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type UpdateWebhookResponse struct {
+}
+
+func (m *UpdateWebhookResponse) Reset()                    { *m = UpdateWebhookResponse{} }
+func (m *UpdateWebhookResponse) String() string            { return proto.CompactTextString(m) }
+func (*UpdateWebhookResponse) ProtoMessage()               {}
+func (*UpdateWebhookResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{6} }
+
+type DeleteWebhookRequest struct {
+	// ID of the webhook.
+	Id int64 `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *DeleteWebhookRequest) Reset()                    { *m = DeleteWebhookRequest{} }
+func (m *DeleteWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteWebhookRequest) ProtoMessage()               {}
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{7} }
+
+func (m *DeleteWebhookRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+}
+
+func (m *DeleteWebhookResponse) Reset()                    { *m = DeleteWebhookResponse{} }
+func (m *DeleteWebhookResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteWebhookResponse) ProtoMessage()               {}
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{8} }
+
+type ListWebhookRequest struct {
+	// ID of the organization.
+	OrganizationID int64 `protobuf:"varint,1,opt,name=organizationID" json:"organizationID,omitempty"`
+	// Max number of items to return.
+	Limit int64 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+	// Offset in the result-set (for pagination).
+	Offset int64 `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+}
+
+func (m *ListWebhookRequest) Reset()                    { *m = ListWebhookRequest{} }
+func (m *ListWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListWebhookRequest) ProtoMessage()               {}
+func (*ListWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{9} }
+
+func (m *ListWebhookRequest) GetOrganizationID() int64 {
+	if m != nil {
+		return m.OrganizationID
+	}
+	return 0
+}
+
+func (m *ListWebhookRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListWebhookRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type ListWebhookResponse struct {
+	// Total number of webhooks.
+	TotalCount int64                 `protobuf:"varint,1,opt,name=totalCount" json:"totalCount,omitempty"`
+	Result     []*GetWebhookResponse `protobuf:"bytes,2,rep,name=result" json:"result,omitempty"`
+}
+
+func (m *ListWebhookResponse) Reset()                    { *m = ListWebhookResponse{} }
+func (m *ListWebhookResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListWebhookResponse) ProtoMessage()               {}
+func (*ListWebhookResponse) Descriptor() ([]byte, []int) { return fileDescriptor16, []int{10} }
+
+func (m *ListWebhookResponse) GetTotalCount() int64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *ListWebhookResponse) GetResult() []*GetWebhookResponse {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Webhook)(nil), "api.Webhook")
+	proto.RegisterType((*CreateWebhookRequest)(nil), "api.CreateWebhookRequest")
+	proto.RegisterType((*CreateWebhookResponse)(nil), "api.CreateWebhookResponse")
+	proto.RegisterType((*GetWebhookRequest)(nil), "api.GetWebhookRequest")
+	proto.RegisterType((*GetWebhookResponse)(nil), "api.GetWebhookResponse")
+	proto.RegisterType((*UpdateWebhookRequest)(nil), "api.UpdateWebhookRequest")
+	proto.RegisterType((*UpdateWebhookResponse)(nil), "api.UpdateWebhookResponse")
+	proto.RegisterType((*DeleteWebhookRequest)(nil), "api.DeleteWebhookRequest")
+	proto.RegisterType((*DeleteWebhookResponse)(nil), "api.DeleteWebhookResponse")
+	proto.RegisterType((*ListWebhookRequest)(nil), "api.ListWebhookRequest")
+	proto.RegisterType((*ListWebhookResponse)(nil), "api.ListWebhookResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for WebhookService service
+
+type WebhookServiceClient interface {
+	// Create creates the given webhook.
+	Create(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	// Get returns the webhook matching the given id.
+	Get(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error)
+	// Update updates the given webhook.
+	Update(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error)
+	// Delete deletes the webhook matching the given id.
+	Delete(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// List lists the webhooks of the given organization.
+	List(ctx context.Context, in *ListWebhookRequest, opts ...grpc.CallOption) (*ListWebhookResponse, error)
+}
+
+type webhookServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWebhookServiceClient(cc *grpc.ClientConn) WebhookServiceClient {
+	return &webhookServiceClient{cc}
+}
+
+func (c *webhookServiceClient) Create(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := grpc.Invoke(ctx, "/api.WebhookService/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) Get(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error) {
+	out := new(GetWebhookResponse)
+	err := grpc.Invoke(ctx, "/api.WebhookService/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) Update(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error) {
+	out := new(UpdateWebhookResponse)
+	err := grpc.Invoke(ctx, "/api.WebhookService/Update", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) Delete(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := grpc.Invoke(ctx, "/api.WebhookService/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) List(ctx context.Context, in *ListWebhookRequest, opts ...grpc.CallOption) (*ListWebhookResponse, error) {
+	out := new(ListWebhookResponse)
+	err := grpc.Invoke(ctx, "/api.WebhookService/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for WebhookService service
+
+type WebhookServiceServer interface {
+	// Create creates the given webhook.
+	Create(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// Get returns the webhook matching the given id.
+	Get(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error)
+	// Update updates the given webhook.
+	Update(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+	// Delete deletes the webhook matching the given id.
+	Delete(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// List lists the webhooks of the given organization.
+	List(context.Context, *ListWebhookRequest) (*ListWebhookResponse, error)
+}
+
+func RegisterWebhookServiceServer(s *grpc.Server, srv WebhookServiceServer) {
+	s.RegisterService(&_WebhookService_serviceDesc, srv)
+}
+
+func _WebhookService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WebhookService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).Create(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WebhookService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).Get(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WebhookService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).Update(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WebhookService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).Delete(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WebhookService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).List(ctx, req.(*ListWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WebhookService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.WebhookService",
+	HandlerType: (*WebhookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _WebhookService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _WebhookService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _WebhookService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _WebhookService_Delete_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _WebhookService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "webhook.proto",
+}
+
+func init() { proto.RegisterFile("webhook.proto", fileDescriptor16) }
+
+var fileDescriptor16 = []byte{
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x5f, 0x6b, 0xd3, 0x50,
+	0x14, 0x27, 0x4d, 0x97, 0xd1, 0x33, 0x57, 0xf5, 0xd8, 0xae, 0xf1, 0x32, 0x74, 0x44, 0xac, 0x65,
+	0x0f, 0x2d, 0xd4, 0x37, 0x1f, 0x04, 0xd9, 0x60, 0x08, 0x3e, 0x48, 0x44, 0x44, 0x7c, 0xca, 0x9a,
+	0xd3, 0x7a, 0x35, 0xcb, 0x8d, 0xc9, 0x8d, 0x8a, 0x32, 0x04, 0xbf, 0x82, 0x1f, 0x4d, 0xf0, 0x13,
+	0xf8, 0x41, 0x24, 0xf7, 0x9e, 0xb1, 0x36, 0x89, 0x28, 0x7b, 0xcb, 0x3d, 0x7f, 0x7e, 0xe7, 0x77,
+	0x7e, 0xe7, 0xd7, 0xc2, 0xee, 0x27, 0x3a, 0x7d, 0xab, 0xd4, 0xfb, 0x69, 0x96, 0x2b, 0xad, 0xd0,
+	0x8d, 0x32, 0x29, 0xf6, 0x57, 0x4a, 0xad, 0x12, 0x9a, 0x45, 0x99, 0x9c, 0x45, 0x69, 0xaa, 0x74,
+	0xa4, 0xa5, 0x4a, 0x0b, 0x5b, 0x12, 0x7c, 0x83, 0xed, 0x57, 0xb6, 0x07, 0xfb, 0xd0, 0x91, 0xb1,
+	0xef, 0x1c, 0x38, 0x13, 0x37, 0xec, 0xc8, 0x18, 0xc7, 0xd0, 0x57, 0xf9, 0x2a, 0x4a, 0xe5, 0x17,
+	0xd3, 0xf1, 0xf4, 0xd8, 0xef, 0x98, 0x5c, 0x2d, 0x8a, 0x08, 0xdd, 0x34, 0x3a, 0x23, 0xdf, 0x3d,
+	0x70, 0x26, 0xbd, 0xd0, 0x7c, 0xe3, 0x0d, 0x70, 0xcb, 0x3c, 0xf1, 0xbb, 0x26, 0x54, 0x7d, 0xe2,
+	0x1e, 0x78, 0x05, 0x2d, 0x72, 0xd2, 0xfe, 0x96, 0x09, 0xf2, 0x2b, 0x78, 0x0c, 0x83, 0xa3, 0x9c,
+	0x22, 0x4d, 0x4c, 0x23, 0xa4, 0x0f, 0x25, 0x15, 0x1a, 0xc7, 0xb0, 0xcd, 0xcb, 0x18, 0x4a, 0x3b,
+	0xf3, 0x6b, 0xd3, 0x28, 0x93, 0xd3, 0x8b, 0xaa, 0x8b, 0x64, 0xf0, 0x00, 0x86, 0xb5, 0xfe, 0x22,
+	0x53, 0x69, 0x41, 0xf5, 0x75, 0x82, 0x7b, 0x70, 0xf3, 0x84, 0x74, 0x6d, 0x4a, 0xbd, 0xe8, 0x33,
+	0xe0, 0x7a, 0x11, 0x43, 0xfd, 0x27, 0x17, 0xdc, 0x87, 0xde, 0xc2, 0x70, 0x89, 0x9f, 0x68, 0x23,
+	0x56, 0x2f, 0xbc, 0x0c, 0x54, 0xd9, 0x32, 0x8b, 0x39, 0x6b, 0xc5, 0xba, 0x0c, 0x54, 0x3a, 0xbc,
+	0x34, 0x8f, 0x2b, 0xea, 0x30, 0x82, 0x61, 0xad, 0xdf, 0x92, 0x0f, 0xc6, 0x30, 0x38, 0xa6, 0x84,
+	0x34, 0xfd, 0x63, 0xf5, 0x11, 0x0c, 0x6b, 0x75, 0x0c, 0xf0, 0x0e, 0xf0, 0x99, 0x2c, 0x74, 0x83,
+	0x57, 0xdd, 0x1d, 0x4e, 0xab, 0x3b, 0x06, 0xb0, 0x95, 0xc8, 0x33, 0xa9, 0xd9, 0x3c, 0xf6, 0x51,
+	0xb9, 0x41, 0x2d, 0x97, 0x05, 0x59, 0x21, 0xdc, 0x90, 0x5f, 0xc1, 0x12, 0x6e, 0x6d, 0xcc, 0xe2,
+	0x03, 0xdc, 0x01, 0xd0, 0x4a, 0x47, 0xc9, 0x91, 0x2a, 0x53, 0xcd, 0x83, 0xd6, 0x22, 0x38, 0x03,
+	0x2f, 0xa7, 0xa2, 0x4c, 0xaa, 0x29, 0xee, 0x64, 0x67, 0x3e, 0x32, 0x1a, 0x35, 0x2f, 0x19, 0x72,
+	0xd9, 0xfc, 0x97, 0x0b, 0x7d, 0xce, 0xbd, 0xa0, 0xfc, 0xa3, 0x5c, 0x10, 0xbe, 0x06, 0xcf, 0x1a,
+	0x09, 0x6f, 0x9b, 0xee, 0x36, 0x57, 0x0a, 0xd1, 0x96, 0x62, 0x9d, 0xfc, 0xef, 0x3f, 0x7f, 0xff,
+	0xe8, 0x60, 0xb0, 0x6b, 0x7e, 0x6a, 0x7c, 0x97, 0xe2, 0x91, 0x73, 0x88, 0x21, 0xb8, 0x27, 0xa4,
+	0x71, 0xaf, 0xc1, 0xca, 0x82, 0xfe, 0x8d, 0x6d, 0x20, 0x0c, 0xe2, 0x00, 0x71, 0x03, 0x71, 0xf6,
+	0x55, 0xc6, 0xe7, 0xb8, 0x04, 0xcf, 0xde, 0x9b, 0xe9, 0xb6, 0x99, 0x47, 0x88, 0xb6, 0x14, 0x83,
+	0xdf, 0x37, 0xe0, 0x77, 0x85, 0xa8, 0x81, 0xf3, 0xd7, 0x54, 0xc6, 0xe7, 0x15, 0xf7, 0x37, 0xe0,
+	0x59, 0x5b, 0xf0, 0x9c, 0x36, 0x2f, 0x09, 0xd1, 0x96, 0xda, 0x5c, 0xe2, 0xb0, 0x6d, 0x89, 0xe7,
+	0xd0, 0xad, 0xce, 0x8d, 0x56, 0x81, 0xa6, 0xcb, 0x84, 0xdf, 0x4c, 0x30, 0xec, 0xd0, 0xc0, 0x5e,
+	0xc7, 0x4d, 0xb5, 0x4f, 0x3d, 0xf3, 0xb7, 0xf6, 0xf0, 0xcf, 0x00, 0xd0, 0xe3, 0x1f, 0xa4, 0x0a,
+	0x05, 0x00, 0x00,
+}
@@ -16,10 +16,44 @@ const configTemplate = `[general]
 # debug=5, info=4, warning=3, error=2, fatal=1, panic=0
 log_level={{ .General.LogLevel }}
 
-# The number of times passwords must be hashed. A higher number is safer as
-# an attack takes more time to perform.
+# Password hash algorithm (bcrypt or pbkdf2)
+#
+# New passwords are hashed using this algorithm. Passwords hashed using an
+# other algorithm (or other parameters) remain valid and are re-hashed when
+# the user logs in. Note that bcrypt only uses the first 72 bytes of a
+# password.
+password_hash_algorithm="{{ .General.PasswordHashAlgorithm }}"
+
+# The bcrypt cost. A higher number is safer as an attack takes more time to
+# perform (the time doubles with every increment).
+bcrypt_cost={{ .General.BcryptCost }}
+
+# The number of times passwords must be hashed (pbkdf2). A higher number is
+# safer as an attack takes more time to perform.
 password_hash_iterations={{ .General.PasswordHashIterations }}
 
+  # Password policy
+  #
+  # The password policy is applied when creating users or changing passwords,
+  # existing passwords are not affected.
+  [general.password_policy]
+  # minimum number of characters
+  min_length={{ .General.PasswordPolicy.MinLength }}
+
+  # minimum number of character classes (lower case, upper case, digits and
+  # symbols) the password must contain
+  min_character_classes={{ .General.PasswordPolicy.MinCharacterClasses }}
+
+  # file containing breached (or otherwise forbidden) passwords, which can not
+  # be used
+  #
+  # The file contains one password per line, or the hex encoded SHA-1 hash of
+  # the password optionally followed by ":" and a number (the format of the
+  # Pwned Passwords lists). The list is kept in memory (about 50MB per million
+  # passwords) and is limited to 1000000 passwords, use a subset of the full
+  # Pwned Passwords list (e.g. the most common passwords).
+  breached_passwords_file="{{ .General.PasswordPolicy.BreachedPasswordsFile }}"
+
 
 # PostgreSQL settings.
 #
@@ -156,9 +190,24 @@ id="{{ .ApplicationServer.ID }}"
   # You could generate this by executing 'openssl rand -base64 32' for example
   jwt_secret="{{ .ApplicationServer.ExternalAPI.JWTSecret }}"
 
+  # Lifetime of the JWT access tokens issued at login
+  #
+  # Once expired, a new access token is requested using the refresh token
+  # of the session. The session itself expires after the session TTL of the
+  # user (default 24 hours).
+  access_token_ttl="{{ .ApplicationServer.ExternalAPI.AccessTokenTTL }}"
+
   # when set, existing users can't be re-assigned (to avoid exposure of all users to an organization admin)"
   disable_assign_existing_users={{ .ApplicationServer.ExternalAPI.DisableAssignExistingUsers }}
 
+  # ip addresses or networks (e.g. 10.0.0.0/8) of the reverse proxies in front
+  # of the http server
+  #
+  # The client ip address (used by the login lockout, the audit log and the
+  # sessions) is only taken from the X-Forwarded-For header when the request
+  # was made by one of these proxies (or from localhost).
+  trusted_proxies=[{{ range $index, $element := .ApplicationServer.ExternalAPI.TrustedProxies }}{{ if $index }}, {{ end }}"{{ $element }}"{{ end }}]
+
 {{ if ne .ApplicationServer.Branding.Header  "" }}
   # Branding configuration.
   [application_server.branding]
@@ -191,6 +240,227 @@ id="{{ .ApplicationServer.ID }}"
   dr={{ .ApplicationServer.GatewayDiscovery.DR }}
 
 
+  # Bulk enqueue configuration.
+  #
+  # Bulk enqueue jobs (DeviceQueue.EnqueueBulk) are processed in the
+  # background, in batches. These settings apply per LoRa App Server
+  # instance.
+  [application_server.bulk_enqueue]
+  # the max. number of devices to process per batch
+  batch_size={{ .ApplicationServer.BulkEnqueue.BatchSize }}
+
+  # the max. number of devices to enqueue per second (0 = no limit)
+  rate={{ .ApplicationServer.BulkEnqueue.Rate }}
+
+  # Usage metering.
+  #
+  # The uplinks, downlinks, joins and active devices are counted per
+  # application and day in Redis. The counters are written to PostgreSQL
+  # at the given interval.
+  [application_server.usage]
+  flush_interval="{{ .ApplicationServer.Usage.FlushInterval }}"
+
+  # Management event webhooks.
+  #
+  # The events of the mutating API calls (e.g. a device has been created)
+  # are POSTed to the webhooks of the organization. Failed deliveries are
+  # retried with an exponential backoff, starting at retry_interval.
+  [application_server.webhook]
+  # timeout of a single delivery attempt
+  timeout="{{ .ApplicationServer.Webhook.Timeout }}"
+
+  # the max. number of delivery attempts, after which the event is dropped
+  max_attempts={{ .ApplicationServer.Webhook.MaxAttempts }}
+
+  # the interval before the first retry, doubled on each next retry
+  retry_interval="{{ .ApplicationServer.Webhook.RetryInterval }}"
+
+  # Multicast downlinks.
+  #
+  # The network-server API does not support multicast, therefore the
+  # encrypted multicast downlinks are POSTed as JSON to the given URL,
+  # which must take care of scheduling these on the gateways.
+  # When left blank, enqueueing multicast downlinks is disabled.
+  [application_server.multicast]
+  sender_url="{{ .ApplicationServer.Multicast.SenderURL }}"
+
+  # OpenID Connect login.
+  #
+  # When enabled, users can login using the authorization code flow of the
+  # configured OpenID Connect provider. Users are created on their first
+  # login. On each login the groups claim is used to set the global admin
+  # flag and the organization memberships configured by group_mapping.
+  [application_server.oidc]
+  enabled={{ .ApplicationServer.OIDC.Enabled }}
+
+  # issuer url of the provider, used for the discovery of the endpoints
+  provider_url="{{ .ApplicationServer.OIDC.ProviderURL }}"
+
+  # client id and secret registered at the provider
+  client_id="{{ .ApplicationServer.OIDC.ClientID }}"
+  client_secret="{{ .ApplicationServer.OIDC.ClientSecret }}"
+
+  # redirect url registered at the provider, this must point to the
+  # /auth/oidc/callback path of the external api (e.g.
+  # https://lora.example.com:8080/auth/oidc/callback)
+  redirect_url="{{ .ApplicationServer.OIDC.RedirectURL }}"
+
+  # label of the login button in the web-interface
+  login_label="{{ .ApplicationServer.OIDC.LoginLabel }}"
+
+  # claims containing the username, e-mail and groups of the user
+  # (non alpha-numeric characters are removed from the username)
+  username_claim="{{ .ApplicationServer.OIDC.UsernameClaim }}"
+  email_claim="{{ .ApplicationServer.OIDC.EmailClaim }}"
+  groups_claim="{{ .ApplicationServer.OIDC.GroupsClaim }}"
+
+  # members of this group are global admin (when left blank, the global
+  # admin flag is not managed)
+  admin_group="{{ .ApplicationServer.OIDC.AdminGroup }}"
+
+  # organization membership per group, repeat for each group
+  #
+  # example:
+  # [[application_server.oidc.group_mapping]]
+  # group="org-1-admins"
+  # organization_id=1
+  # is_admin=true
+  {{ range $index, $element := .ApplicationServer.OIDC.GroupMapping }}
+  [[application_server.oidc.group_mapping]]
+  group="{{ $element.Group }}"
+  organization_id={{ $element.OrganizationID }}
+  is_admin={{ $element.IsAdmin }}
+  {{ end }}
+
+  # LDAP login.
+  #
+  # When enabled, users without a local password are authenticated against
+  # the configured LDAP server (e.g. Active Directory). Users are created on
+  # their first login. On each login the group attribute is used to set the
+  # global admin flag and the organization memberships configured by
+  # group_mapping. Local users (e.g. the admin user) can still login.
+  [application_server.ldap]
+  enabled={{ .ApplicationServer.LDAP.Enabled }}
+
+  # hostname:port of the ldap server
+  server="{{ .ApplicationServer.LDAP.Server }}"
+
+  # use ldaps (tls), or upgrade the connection using starttls
+  tls={{ .ApplicationServer.LDAP.TLS }}
+  start_tls={{ .ApplicationServer.LDAP.StartTLS }}
+
+  # ca certificate used to verify the server certificate (optional)
+  ca_cert="{{ .ApplicationServer.LDAP.CACert }}"
+
+  # skip the verification of the server certificate (do not use in production)
+  insecure_skip_verify={{ .ApplicationServer.LDAP.InsecureSkipVerify }}
+
+  # dn and password used to search for the user (leave blank for an
+  # anonymous search)
+  bind_dn="{{ .ApplicationServer.LDAP.BindDN }}"
+  bind_password="{{ .ApplicationServer.LDAP.BindPassword }}"
+
+  # base dn and filter used to search for the user, %s is replaced by the
+  # (escaped) username
+  #
+  # for active directory use: (&(objectClass=user)(sAMAccountName=%s))
+  base_dn="{{ .ApplicationServer.LDAP.BaseDN }}"
+  user_filter="{{ .ApplicationServer.LDAP.UserFilter }}"
+
+  # attributes containing the e-mail and the group dns of the user
+  email_attribute="{{ .ApplicationServer.LDAP.EmailAttribute }}"
+  group_attribute="{{ .ApplicationServer.LDAP.GroupAttribute }}"
+
+  # members of this group (dn) are global admin (when left blank, the
+  # global admin flag is not managed)
+  admin_group="{{ .ApplicationServer.LDAP.AdminGroup }}"
+
+  # organization membership per group (dn), repeat for each group
+  #
+  # example:
+  # [[application_server.ldap.group_mapping]]
+  # group="cn=org-1-admins,ou=groups,dc=example,dc=com"
+  # organization_id=1
+  # is_admin=true
+  {{ range $index, $element := .ApplicationServer.LDAP.GroupMapping }}
+  [[application_server.ldap.group_mapping]]
+  group="{{ $element.Group }}"
+  organization_id={{ $element.OrganizationID }}
+  is_admin={{ $element.IsAdmin }}
+  {{ end }}
+
+  # Login brute-force protection.
+  #
+  # Failed login attempts are counted per username and per ip address.
+  # Each failed attempt is answered with a delay, doubling with every
+  # failed attempt. After too many failed attempts within the window, the
+  # username or ip address is locked out for the configured duration.
+  # Global admin users can clear lockouts using the User API.
+  [application_server.login_lockout]
+  # max. number of failed attempts per username (0 = no lockout)
+  max_attempts={{ .ApplicationServer.LoginLockout.MaxAttempts }}
+
+  # max. number of failed attempts per ip address (0 = no lockout)
+  ip_max_attempts={{ .ApplicationServer.LoginLockout.IPMaxAttempts }}
+
+  # window in which the failed attempts are counted
+  window="{{ .ApplicationServer.LoginLockout.Window }}"
+
+  # duration of the lockout
+  duration="{{ .ApplicationServer.LoginLockout.Duration }}"
+
+  # delay after the first failed attempt and the max. delay
+  delay="{{ .ApplicationServer.LoginLockout.Delay }}"
+  max_delay="{{ .ApplicationServer.LoginLockout.MaxDelay }}"
+
+  # max. number of password reset requests per e-mail address and per ip
+  # address within the window (0 = no limit)
+  password_reset_max_requests={{ .ApplicationServer.LoginLockout.PasswordResetMaxRequests }}
+  password_reset_ip_max_requests={{ .ApplicationServer.LoginLockout.PasswordResetIPMaxRequests }}
+
+  # E-mail settings.
+  #
+  # The mailer is used to send the password reset, e-mail verification and
+  # organization invitation e-mails. It is disabled when no server is
+  # configured.
+  [application_server.mailer]
+  # SMTP server (hostname:port), STARTTLS is used when supported by the server
+  server="{{ .ApplicationServer.Mailer.Server }}"
+
+  # SMTP username and password (leave blank to disable authentication)
+  username="{{ .ApplicationServer.Mailer.Username }}"
+  password="{{ .ApplicationServer.Mailer.Password }}"
+
+  # sender address of the e-mails
+  from="{{ .ApplicationServer.Mailer.From }}"
+
+  # public url of the web-interface, used for the links in the e-mails
+  # (e.g. https://lora-app-server.example.com)
+  base_url="{{ .ApplicationServer.Mailer.BaseURL }}"
+
+  # require users created through the API to verify their e-mail address
+  # before they are able to login
+  email_verification={{ .ApplicationServer.Mailer.EmailVerification }}
+
+  # lifetime of the password reset, e-mail verification and organization
+  # invitation links
+  password_reset_ttl="{{ .ApplicationServer.Mailer.PasswordResetTTL }}"
+  email_verification_ttl="{{ .ApplicationServer.Mailer.EmailVerificationTTL }}"
+  organization_invitation_ttl="{{ .ApplicationServer.Mailer.OrganizationInvitationTTL }}"
+
+  # template files for the password reset, e-mail verification and
+  # organization invitation e-mails (leave blank to use the built-in
+  # templates)
+  #
+  # The templates use the Go text/template syntax and contain the headers
+  # (e.g. Subject), an empty line and the body. The .Username, .URL and
+  # .ExpiresAt fields are available to the templates, the organization
+  # invitation template uses .OrganizationName and .InvitedBy instead of
+  # .Username.
+  password_reset_template="{{ .ApplicationServer.Mailer.PasswordResetTemplate }}"
+  email_verification_template="{{ .ApplicationServer.Mailer.EmailVerificationTemplate }}"
+  organization_invitation_template="{{ .ApplicationServer.Mailer.OrganizationInvitationTemplate }}"
+
 # Join-server configuration.
 #
 # LoRa App Server implements a (subset) of the join-api specified by the
@@ -210,6 +480,38 @@ tls_cert="{{ .JoinServer.TLSCert }}"
 tls_key="{{ .JoinServer.TLSKey }}"
 
 
+# Metrics collection settings.
+[metrics]
+  # Metrics stored in Prometheus.
+  #
+  # These metrics expose information about the state of the LoRa App Server
+  # instance, e.g. the number of API calls, uplinks handled and integration
+  # deliveries.
+  [metrics.prometheus]
+  # enable the Prometheus metrics endpoint (/metrics)
+  endpoint_enabled={{ .Metrics.Prometheus.EndpointEnabled }}
+
+  # ip:port to bind the Prometheus metrics endpoint to
+  bind="{{ .Metrics.Prometheus.Bind }}"
+
+  # enable the gRPC API request timing histograms
+  #
+  # These histograms are not enabled by default, as they add a considerable
+  # number of time-series per API method.
+  api_timing_histogram={{ .Metrics.Prometheus.APITimingHistogram }}
+
+
+# Health checks.
+#
+# The liveness (/health/live) and readiness (/health/ready) endpoints are
+# served by a separate http server, e.g. for Kubernetes probes. As the
+# readiness response contains the errors of the dependencies (e.g. the
+# network-server hostnames), this bind should not be publicly reachable.
+[health]
+# ip:port to bind the health endpoints to (leave empty to disable)
+bind="{{ .Health.Bind }}"
+
+
 # Network-server configuration.
 #
 # This configuration is only used to migrate from older LoRa App Server.
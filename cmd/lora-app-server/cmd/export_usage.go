@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/usage"
+)
+
+var exportUsageMonth string
+var exportUsageOutput string
+
+var exportUsageCmd = &cobra.Command{
+	Use:   "export-usage",
+	Short: "Export the usage per organization, application and day as CSV",
+	RunE:  exportUsage,
+}
+
+func init() {
+	now := time.Now().UTC()
+	lastMonth := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC)
+
+	exportUsageCmd.Flags().StringVar(&exportUsageMonth, "month", lastMonth.Format("2006-01"), "month to export (YYYY-MM)")
+	exportUsageCmd.Flags().StringVarP(&exportUsageOutput, "output", "o", "", "path to the output file (default stdout)")
+}
+
+func exportUsage(cmd *cobra.Command, args []string) error {
+	start, err := time.Parse("2006-01", exportUsageMonth)
+	if err != nil {
+		return errors.Wrap(err, "parse month error")
+	}
+	end := start.AddDate(0, 1, 0)
+
+	db, err := storage.OpenDatabase(config.C.PostgreSQL.DSN)
+	if err != nil {
+		return errors.Wrap(err, "database connection error")
+	}
+
+	// write the counters which have not yet been flushed by the
+	// application-server, the export is still made when this fails
+	if err := storage.FlushUsage(db, storage.NewRedisPool(config.C.Redis.URL)); err != nil {
+		log.WithError(err).Warning("flush usage error, the export might be incomplete")
+	}
+
+	records, err := storage.GetUsageRecords(db, 0, start, end)
+	if err != nil {
+		return errors.Wrap(err, "get usage records error")
+	}
+
+	var w io.Writer = os.Stdout
+	if exportUsageOutput != "" {
+		f, err := os.Create(exportUsageOutput)
+		if err != nil {
+			return errors.Wrap(err, "create file error")
+		}
+		defer f.Close()
+		w = f
+	}
+
+	return usage.WriteCSV(w, records)
+}
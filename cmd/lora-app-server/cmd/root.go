@@ -76,6 +76,38 @@ func init() {
 		rootCmd.PersistentFlags().MarkHidden(key)
 	}
 
+	viper.SetDefault("general.password_hash_algorithm", "bcrypt")
+	viper.SetDefault("general.bcrypt_cost", 12)
+	viper.SetDefault("general.password_policy.min_length", 6)
+	viper.SetDefault("application_server.bulk_enqueue.batch_size", 100)
+	viper.SetDefault("application_server.bulk_enqueue.rate", 10)
+	viper.SetDefault("application_server.usage.flush_interval", time.Minute)
+	viper.SetDefault("application_server.webhook.timeout", 10*time.Second)
+	viper.SetDefault("application_server.webhook.max_attempts", 10)
+	viper.SetDefault("application_server.webhook.retry_interval", 30*time.Second)
+	viper.SetDefault("application_server.oidc.login_label", "Login with SSO")
+	viper.SetDefault("application_server.oidc.username_claim", "preferred_username")
+	viper.SetDefault("application_server.oidc.email_claim", "email")
+	viper.SetDefault("application_server.oidc.groups_claim", "groups")
+	viper.SetDefault("application_server.ldap.user_filter", "(uid=%s)")
+	viper.SetDefault("application_server.ldap.email_attribute", "mail")
+	viper.SetDefault("application_server.ldap.group_attribute", "memberOf")
+	viper.SetDefault("application_server.login_lockout.max_attempts", 5)
+	viper.SetDefault("application_server.login_lockout.ip_max_attempts", 50)
+	viper.SetDefault("application_server.login_lockout.window", 15*time.Minute)
+	viper.SetDefault("application_server.login_lockout.duration", 15*time.Minute)
+	viper.SetDefault("application_server.login_lockout.delay", time.Second)
+	viper.SetDefault("application_server.login_lockout.max_delay", 8*time.Second)
+	viper.SetDefault("application_server.login_lockout.password_reset_max_requests", 3)
+	viper.SetDefault("application_server.login_lockout.password_reset_ip_max_requests", 20)
+	viper.SetDefault("metrics.prometheus.bind", "0.0.0.0:8004")
+	viper.SetDefault("health.bind", "0.0.0.0:8005")
+	viper.SetDefault("application_server.external_api.access_token_ttl", 15*time.Minute)
+	viper.SetDefault("application_server.mailer.from", "LoRa App Server <noreply@localhost>")
+	viper.SetDefault("application_server.mailer.password_reset_ttl", time.Hour)
+	viper.SetDefault("application_server.mailer.email_verification_ttl", 72*time.Hour)
+	viper.SetDefault("application_server.mailer.organization_invitation_ttl", 7*24*time.Hour)
+
 	viper.BindEnv("general.log_level", "LOG_LEVEL")
 
 	// for backwards compatibility
@@ -152,6 +184,7 @@ func init() {
 
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(exportUsageCmd)
 }
 
 // Execute executes the root command.
@@ -19,29 +19,40 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
 	"github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	migrate "github.com/rubenv/sql-migrate"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
 
 	pb "github.com/gusseleet/lora-app-server/api"
 	"github.com/gusseleet/lora-app-server/internal/api"
 	"github.com/gusseleet/lora-app-server/internal/api/auth"
 	"github.com/gusseleet/lora-app-server/internal/config"
 	"github.com/gusseleet/lora-app-server/internal/downlink"
+	"github.com/gusseleet/lora-app-server/internal/eventlog"
+	"github.com/gusseleet/lora-app-server/internal/fuota"
 	"github.com/gusseleet/lora-app-server/internal/gwping"
 	"github.com/gusseleet/lora-app-server/internal/handler/mqtthandler"
 	"github.com/gusseleet/lora-app-server/internal/handler/multihandler"
+	"github.com/gusseleet/lora-app-server/internal/health"
+	"github.com/gusseleet/lora-app-server/internal/mailer"
 	"github.com/gusseleet/lora-app-server/internal/migrations"
+	"github.com/gusseleet/lora-app-server/internal/multicast"
 	"github.com/gusseleet/lora-app-server/internal/nsclient"
+	"github.com/gusseleet/lora-app-server/internal/oidc"
 	"github.com/gusseleet/lora-app-server/internal/profilesmigrate"
 	"github.com/gusseleet/lora-app-server/internal/queuemigrate"
 	"github.com/gusseleet/lora-app-server/internal/static"
 	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/usage"
+	"github.com/gusseleet/lora-app-server/internal/webhook"
 	"github.com/brocaar/loraserver/api/as"
 )
 
@@ -55,13 +66,27 @@ func run(cmd *cobra.Command, args []string) error {
 		printStartMessage,
 		setPostgreSQLConnection,
 		setRedisPool,
+		startMetricsServer,
+		startHealthServer,
 		setHandler,
+		setMulticastSender,
 		setNetworkServerClient,
 		runDatabaseMigrations,
 		setJWTSecret,
-		setHashIterations,
+		setPasswordHash,
+		setPasswordPolicy,
+		setLoginLockout,
+		setAccessTokenTTL,
+		setMailer,
 		setDisableAssignExistingUsers,
+		setTrustedProxies,
 		handleDataDownPayloads,
+		startDownlinkScheduler,
+		startBulkEnqueue,
+		startDeviceQueueExpiry,
+		startFUOTADeploymentLoop,
+		startUsageFlush,
+		startWebhookDelivery,
 		startApplicationServerAPI,
 		startGatewayPing,
 		startJoinServerAPI,
@@ -122,6 +147,69 @@ func setRedisPool() error {
 	return nil
 }
 
+func startMetricsServer() error {
+	conf := config.C.Metrics.Prometheus
+	if !conf.EndpointEnabled {
+		return nil
+	}
+
+	if conf.APITimingHistogram {
+		grpc_prometheus.EnableHandlingTimeHistogram()
+	}
+
+	if err := storage.RegisterPoolMetrics(config.C.PostgreSQL.DB, config.C.Redis.Pool); err != nil {
+		return errors.Wrap(err, "register pool metrics error")
+	}
+
+	log.WithFields(log.Fields{
+		"bind": conf.Bind,
+		"path": "/metrics",
+	}).Info("starting prometheus metrics server")
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := http.Server{
+		Handler: mux,
+		Addr:    conf.Bind,
+	}
+
+	go func() {
+		err := server.ListenAndServe()
+		log.WithError(err).Error("prometheus metrics server error")
+	}()
+
+	return nil
+}
+
+func startHealthServer() error {
+	bind := config.C.Health.Bind
+	if bind == "" {
+		return nil
+	}
+
+	log.WithFields(log.Fields{
+		"bind": bind,
+		"path": "/health",
+	}).Info("starting health server")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health/live", health.LiveHandler)
+	mux.HandleFunc("/health/ready", health.ReadyHandler)
+
+	server := http.Server{
+		Handler: mux,
+		Addr:    bind,
+	}
+
+	go func() {
+		err := server.ListenAndServe()
+		log.WithError(err).Error("health server error")
+	}()
+
+	return nil
+}
+
 func setHandler() error {
 	h, err := mqtthandler.NewHandler(
 		config.C.ApplicationServer.Integration.MQTT.Server,
@@ -134,7 +222,15 @@ func setHandler() error {
 	if err != nil {
 		return errors.Wrap(err, "setup mqtt handler error")
 	}
-	config.C.ApplicationServer.Integration.Handler = multihandler.NewHandler(h)
+	config.C.ApplicationServer.Integration.Handler = multihandler.NewHandler(h, eventlog.NewHandler())
+	return nil
+}
+
+func setMulticastSender() error {
+	if config.C.ApplicationServer.Multicast.SenderURL == "" {
+		return nil
+	}
+	config.C.ApplicationServer.Multicast.Sender = multicast.NewHTTPSender(config.C.ApplicationServer.Multicast.SenderURL)
 	return nil
 }
 
@@ -184,8 +280,67 @@ func setJWTSecret() error {
 	return nil
 }
 
-func setHashIterations() error {
+func setPasswordHash() error {
+	switch config.C.General.PasswordHashAlgorithm {
+	case storage.PasswordHashBcrypt, storage.PasswordHashPBKDF2:
+	default:
+		return fmt.Errorf("invalid general.password_hash_algorithm: %s", config.C.General.PasswordHashAlgorithm)
+	}
+
+	storage.PasswordHashAlgorithm = config.C.General.PasswordHashAlgorithm
 	storage.HashIterations = config.C.General.PasswordHashIterations
+	storage.BcryptCost = config.C.General.BcryptCost
+	return nil
+}
+
+func setPasswordPolicy() error {
+	conf := config.C.General.PasswordPolicy
+	policy := storage.PasswordPolicy{
+		MinLength:           conf.MinLength,
+		MinCharacterClasses: conf.MinCharacterClasses,
+	}
+
+	if conf.BreachedPasswordsFile != "" {
+		if err := policy.LoadBreachedPasswords(conf.BreachedPasswordsFile); err != nil {
+			return errors.Wrap(err, "load breached passwords error")
+		}
+	}
+
+	storage.SetPasswordPolicy(policy)
+	return nil
+}
+
+func setLoginLockout() error {
+	storage.LoginMaxAttempts = config.C.ApplicationServer.LoginLockout.MaxAttempts
+	storage.LoginIPMaxAttempts = config.C.ApplicationServer.LoginLockout.IPMaxAttempts
+	storage.LoginAttemptsWindow = config.C.ApplicationServer.LoginLockout.Window
+	storage.LoginLockoutDuration = config.C.ApplicationServer.LoginLockout.Duration
+	storage.LoginDelay = config.C.ApplicationServer.LoginLockout.Delay
+	storage.LoginMaxDelay = config.C.ApplicationServer.LoginLockout.MaxDelay
+	storage.PasswordResetMaxRequests = config.C.ApplicationServer.LoginLockout.PasswordResetMaxRequests
+	storage.PasswordResetIPMaxRequests = config.C.ApplicationServer.LoginLockout.PasswordResetIPMaxRequests
+	return nil
+}
+
+func setAccessTokenTTL() error {
+	storage.AccessTokenTTL = config.C.ApplicationServer.ExternalAPI.AccessTokenTTL
+	return nil
+}
+
+func setMailer() error {
+	conf := config.C.ApplicationServer.Mailer
+	storage.PasswordResetTokenTTL = conf.PasswordResetTTL
+	storage.EmailVerificationTokenTTL = conf.EmailVerificationTTL
+	storage.OrganizationInvitationTTL = conf.OrganizationInvitationTTL
+
+	if conf.Server == "" {
+		return nil
+	}
+	if conf.BaseURL == "" {
+		return errors.New("application_server.mailer.base_url must be set when the mailer is enabled")
+	}
+
+	config.C.ApplicationServer.Mailer.Mailer = mailer.NewSMTPMailer(conf.Server, conf.Username, conf.Password, conf.From)
 	return nil
 }
 
@@ -194,11 +349,48 @@ func setDisableAssignExistingUsers() error {
 	return nil
 }
 
+func setTrustedProxies() error {
+	if err := api.SetTrustedProxies(config.C.ApplicationServer.ExternalAPI.TrustedProxies); err != nil {
+		return errors.Wrap(err, "set trusted proxies error")
+	}
+	return nil
+}
+
 func handleDataDownPayloads() error {
 	go downlink.HandleDataDownPayloads()
 	return nil
 }
 
+func startDownlinkScheduler() error {
+	go downlink.ScheduleLoop()
+	return nil
+}
+
+func startBulkEnqueue() error {
+	go downlink.BulkEnqueueLoop()
+	return nil
+}
+
+func startDeviceQueueExpiry() error {
+	go downlink.ExpireQueueItemsLoop()
+	return nil
+}
+
+func startFUOTADeploymentLoop() error {
+	go fuota.DeploymentLoop()
+	return nil
+}
+
+func startUsageFlush() error {
+	go usage.FlushLoop()
+	return nil
+}
+
+func startWebhookDelivery() error {
+	go webhook.DeliveryLoop()
+	return nil
+}
+
 func startApplicationServerAPI() error {
 	log.WithFields(log.Fields{
 		"bind":     config.C.ApplicationServer.API.Bind,
@@ -283,7 +475,7 @@ func startClientAPI(ctx context.Context) func() error {
 			log.Fatal("jwt secret must be set for external api")
 		}
 
-		clientAPIHandler := grpc.NewServer(gRPCLoggingServerOptions()...)
+		clientAPIHandler := grpc.NewServer(gRPCLoggingServerOptions(api.AuditLogUnaryServerInterceptor(validator))...)
 		pb.RegisterApplicationServer(clientAPIHandler, api.NewApplicationAPI(validator))
 		pb.RegisterDeviceQueueServer(clientAPIHandler, api.NewDeviceQueueAPI(validator))
 		pb.RegisterDeviceServer(clientAPIHandler, api.NewDeviceAPI(validator))
@@ -294,6 +486,14 @@ func startClientAPI(ctx context.Context) func() error {
 		pb.RegisterNetworkServerServer(clientAPIHandler, api.NewNetworkServerAPI(validator))
 		pb.RegisterServiceProfileServiceServer(clientAPIHandler, api.NewServiceProfileServiceAPI(validator))
 		pb.RegisterDeviceProfileServiceServer(clientAPIHandler, api.NewDeviceProfileServiceAPI(validator))
+		pb.RegisterDownlinkScheduleServer(clientAPIHandler, api.NewDownlinkScheduleAPI(validator))
+		pb.RegisterMulticastGroupServiceServer(clientAPIHandler, api.NewMulticastGroupServiceAPI(validator))
+		pb.RegisterFUOTADeploymentServiceServer(clientAPIHandler, api.NewFUOTADeploymentServiceAPI(validator))
+		pb.RegisterAPIKeyServiceServer(clientAPIHandler, api.NewAPIKeyAPI(validator))
+		pb.RegisterAuditServer(clientAPIHandler, api.NewAuditAPI(validator))
+		pb.RegisterWebhookServiceServer(clientAPIHandler, api.NewWebhookAPI(validator))
+		grpc_health_v1.RegisterHealthServer(clientAPIHandler, health.NewServer(clientAPIHandler))
+		grpc_prometheus.Register(clientAPIHandler)
 
 		// setup the client http interface variable
 		// we need to start the gRPC service first, as it is used by the
@@ -340,20 +540,27 @@ func startClientAPI(ctx context.Context) func() error {
 	}
 }
 
-func gRPCLoggingServerOptions() []grpc.ServerOption {
+// gRPCLoggingServerOptions returns the gRPC server options for logging and
+// Prometheus metrics. The given unary interceptors are chained after the
+// logging and metrics interceptors.
+func gRPCLoggingServerOptions(interceptors ...grpc.UnaryServerInterceptor) []grpc.ServerOption {
 	logrusEntry := log.NewEntry(log.StandardLogger())
 	logrusOpts := []grpc_logrus.Option{
 		grpc_logrus.WithLevels(grpc_logrus.DefaultCodeToLevel),
 	}
 
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
+		grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
+		grpc_prometheus.UnaryServerInterceptor,
+	}
+
 	return []grpc.ServerOption{
-		grpc_middleware.WithUnaryServerChain(
-			grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-			grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
-		),
+		grpc_middleware.WithUnaryServerChain(append(unaryInterceptors, interceptors...)...),
 		grpc_middleware.WithStreamServerChain(
 			grpc_ctxtags.StreamServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
 			grpc_logrus.StreamServerInterceptor(logrusEntry, logrusOpts...),
+			grpc_prometheus.StreamServerInterceptor,
 		),
 	}
 }
@@ -367,6 +574,8 @@ func mustGetAPIServer() *grpc.Server {
 	gs := grpc.NewServer(opts...)
 	asAPI := api.NewApplicationServerAPI()
 	as.RegisterApplicationServerServer(gs, asAPI)
+	grpc_health_v1.RegisterHealthServer(gs, health.NewServer(gs))
+	grpc_prometheus.Register(gs)
 	return gs
 }
 
@@ -391,6 +600,12 @@ func getHTTPHandler(ctx context.Context) (http.Handler, error) {
 	}).Methods("get")
 	r.PathPrefix("/api").Handler(jsonHandler)
 
+	if config.C.ApplicationServer.OIDC.Enabled {
+		log.WithField("path", "/auth/oidc").Info("registering openid connect login handlers")
+		r.HandleFunc("/auth/oidc/login", oidc.LoginHandler).Methods("get")
+		r.HandleFunc("/auth/oidc/callback", oidc.CallbackHandler).Methods("get")
+	}
+
 	// setup static file server
 
 
@@ -465,6 +680,24 @@ func getJSONGateway(ctx context.Context) (http.Handler, error) {
 	if err := pb.RegisterDeviceProfileServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
 		return nil, errors.Wrap(err, "register device-profile handler error")
 	}
+	if err := pb.RegisterDownlinkScheduleHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register downlink schedule handler error")
+	}
+	if err := pb.RegisterMulticastGroupServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register multicast group handler error")
+	}
+	if err := pb.RegisterFUOTADeploymentServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register fuota deployment handler error")
+	}
+	if err := pb.RegisterAPIKeyServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register api key handler error")
+	}
+	if err := pb.RegisterAuditHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register audit handler error")
+	}
+	if err := pb.RegisterWebhookServiceHandlerFromEndpoint(ctx, mux, apiEndpoint, grpcDialOpts); err != nil {
+		return nil, errors.Wrap(err, "register webhook handler error")
+	}
 
 	return mux, nil
 }
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/gusseleet/lora-app-server/api"
+	"github.com/gusseleet/lora-app-server/internal/api/auth"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+// APIKeyAPI exports the API key related functions.
+type APIKeyAPI struct {
+	validator auth.Validator
+}
+
+// NewAPIKeyAPI creates a new APIKeyAPI.
+func NewAPIKeyAPI(validator auth.Validator) *APIKeyAPI {
+	return &APIKeyAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given API key.
+func (a *APIKeyAPI) Create(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	if req.ApiKey == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "apiKey expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIKeysAccess(auth.Create, req.ApiKey.OrganizationID, req.ApiKey.ApplicationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	k := storage.APIKey{
+		Name: req.ApiKey.Name,
+	}
+	if req.ApiKey.OrganizationID != 0 {
+		k.OrganizationID = &req.ApiKey.OrganizationID
+	}
+	if req.ApiKey.ApplicationID != 0 {
+		k.ApplicationID = &req.ApiKey.ApplicationID
+	}
+	if req.ApiKey.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339Nano, req.ApiKey.ExpiresAt)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "expiresAt: %s", err)
+		}
+		k.ExpiresAt = &expiresAt
+	}
+
+	key, err := storage.CreateAPIKey(config.C.PostgreSQL.DB, &k)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateAPIKeyResponse{
+		Id:  k.ID,
+		Key: key,
+	}, nil
+}
+
+// List lists the API keys of the given organization or application.
+func (a *APIKeyAPI) List(ctx context.Context, req *pb.ListAPIKeyRequest) (*pb.ListAPIKeyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIKeysAccess(auth.List, req.OrganizationID, req.ApplicationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetAPIKeyCount(config.C.PostgreSQL.DB, req.OrganizationID, req.ApplicationID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	keys, err := storage.GetAPIKeys(config.C.PostgreSQL.DB, req.OrganizationID, req.ApplicationID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListAPIKeyResponse{
+		TotalCount: int64(count),
+	}
+	for _, k := range keys {
+		item := pb.APIKeyListItem{
+			ApiKey: &pb.APIKey{
+				Id:        k.ID,
+				Name:      k.Name,
+				ExpiresAt: formatOptionalTime(k.ExpiresAt),
+			},
+			CreatedAt: k.CreatedAt.Format(time.RFC3339Nano),
+		}
+		if k.OrganizationID != nil {
+			item.ApiKey.OrganizationID = *k.OrganizationID
+		}
+		if k.ApplicationID != nil {
+			item.ApiKey.ApplicationID = *k.ApplicationID
+		}
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// Delete revokes the given API key.
+func (a *APIKeyAPI) Delete(ctx context.Context, req *pb.DeleteAPIKeyRequest) (*pb.DeleteAPIKeyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAPIKeyAccess(auth.Delete, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteAPIKey(config.C.PostgreSQL.DB, req.Id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.DeleteAPIKeyResponse{}, nil
+}
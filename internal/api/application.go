@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"golang.org/x/net/context"
@@ -12,9 +13,11 @@ import (
 	"github.com/gusseleet/lora-app-server/internal/api/auth"
 	"github.com/gusseleet/lora-app-server/internal/codec"
 	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/eventlog"
 	"github.com/gusseleet/lora-app-server/internal/handler"
 	"github.com/gusseleet/lora-app-server/internal/handler/httphandler"
 	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
 )
 
 // ApplicationAPI exports the Application related functions.
@@ -45,9 +48,17 @@ func (a *ApplicationAPI) Create(ctx context.Context, req *pb.CreateApplicationRe
 		PayloadCodec:         codec.Type(req.PayloadCodec),
 		PayloadEncoderScript: req.PayloadEncoderScript,
 		PayloadDecoderScript: req.PayloadDecoderScript,
+
+		DisableOrganizationIntegrations: req.DisableOrganizationIntegrations,
 	}
 
-	if err := storage.CreateApplication(config.C.PostgreSQL.DB, &app); err != nil {
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.CheckOrganizationQuota(tx, req.OrganizationID, storage.QuotaApplications); err != nil {
+			return err
+		}
+		return storage.CreateApplication(tx, &app)
+	})
+	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
@@ -77,6 +88,8 @@ func (a *ApplicationAPI) Get(ctx context.Context, req *pb.GetApplicationRequest)
 		PayloadCodec:         string(app.PayloadCodec),
 		PayloadEncoderScript: app.PayloadEncoderScript,
 		PayloadDecoderScript: app.PayloadDecoderScript,
+
+		DisableOrganizationIntegrations: app.DisableOrganizationIntegrations,
 	}
 
 	return &resp, nil
@@ -102,6 +115,7 @@ func (a *ApplicationAPI) Update(ctx context.Context, req *pb.UpdateApplicationRe
 	app.PayloadCodec = codec.Type(req.PayloadCodec)
 	app.PayloadEncoderScript = req.PayloadEncoderScript
 	app.PayloadDecoderScript = req.PayloadDecoderScript
+	app.DisableOrganizationIntegrations = req.DisableOrganizationIntegrations
 
 	err = storage.UpdateApplication(config.C.PostgreSQL.DB, app)
 	if err != nil {
@@ -218,7 +232,7 @@ func (a *ApplicationAPI) List(ctx context.Context, req *pb.ListApplicationReques
 // CreateHTTPIntegration creates an HTTP application-integration.
 func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.HTTPIntegration) (*pb.EmptyResponse, error) {
 	if err := a.validator.Validate(ctx,
-		auth.ValidateApplicationAccess(in.Id, auth.Update),
+		auth.ValidateApplicationIntegrationAccess(in.Id, auth.Create),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
@@ -249,7 +263,17 @@ func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.HTTPI
 		Kind:          handler.HTTPHandlerKind,
 		Settings:      confJSON,
 	}
-	if err = storage.CreateIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		app, err := storage.GetApplication(tx, in.Id)
+		if err != nil {
+			return err
+		}
+		if err := storage.CheckOrganizationQuota(tx, app.OrganizationID, storage.QuotaIntegrations); err != nil {
+			return err
+		}
+		return storage.CreateIntegration(tx, &integration)
+	})
+	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
@@ -259,7 +283,7 @@ func (a *ApplicationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.HTTPI
 // GetHTTPIntegration returns the HTTP application-itegration.
 func (a *ApplicationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetHTTPIntegrationRequest) (*pb.HTTPIntegration, error) {
 	if err := a.validator.Validate(ctx,
-		auth.ValidateApplicationAccess(in.Id, auth.Update),
+		auth.ValidateApplicationIntegrationAccess(in.Id, auth.Read),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
@@ -296,7 +320,7 @@ func (a *ApplicationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetHTTPI
 // UpdateHTTPIntegration updates the HTTP application-integration.
 func (a *ApplicationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.HTTPIntegration) (*pb.EmptyResponse, error) {
 	if err := a.validator.Validate(ctx,
-		auth.ValidateApplicationAccess(in.Id, auth.Update),
+		auth.ValidateApplicationIntegrationAccess(in.Id, auth.Update),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
@@ -338,7 +362,7 @@ func (a *ApplicationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.HTTPI
 // DeleteHTTPIntegration deletes the application-integration of the given type.
 func (a *ApplicationAPI) DeleteHTTPIntegration(ctx context.Context, in *pb.DeleteIntegrationRequest) (*pb.EmptyResponse, error) {
 	if err := a.validator.Validate(ctx,
-		auth.ValidateApplicationAccess(in.Id, auth.Update),
+		auth.ValidateApplicationIntegrationAccess(in.Id, auth.Delete),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
@@ -358,7 +382,7 @@ func (a *ApplicationAPI) DeleteHTTPIntegration(ctx context.Context, in *pb.Delet
 // ListIntegrations lists all configured integrations.
 func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegrationRequest) (*pb.ListIntegrationResponse, error) {
 	if err := a.validator.Validate(ctx,
-		auth.ValidateApplicationAccess(in.Id, auth.Update),
+		auth.ValidateApplicationIntegrationAccess(in.Id, auth.List),
 	); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
@@ -380,3 +404,179 @@ func (a *ApplicationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegr
 
 	return &out, nil
 }
+
+// StreamEvents streams the events of the given application (or device).
+func (a *ApplicationAPI) StreamEvents(req *pb.StreamApplicationEventsRequest, srv pb.Application_StreamEventsServer) error {
+	if err := a.validator.Validate(srv.Context(),
+		auth.ValidateApplicationAccess(req.Id, auth.Read),
+	); err != nil {
+		return grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	var devEUI lorawan.EUI64
+	if req.DevEUI != "" {
+		if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+		}
+
+		d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI)
+		if err != nil {
+			return errToRPCError(err)
+		}
+		if d.ApplicationID != req.Id {
+			return grpc.Errorf(codes.NotFound, "device does not exist for the given application")
+		}
+	}
+
+	eventsChan := make(chan eventlog.Event)
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- eventlog.GetEvents(srv.Context(), req.Id, eventsChan)
+	}()
+
+	for {
+		select {
+		case e := <-eventsChan:
+			if req.DevEUI != "" && e.DevEUI != devEUI {
+				continue
+			}
+
+			err := srv.Send(&pb.StreamApplicationEventsResponse{
+				Type:        string(e.Type),
+				DevEUI:      e.DevEUI.String(),
+				PayloadJSON: string(e.Payload),
+			})
+			if err != nil {
+				return err
+			}
+		case err := <-errChan:
+			if err != nil {
+				return errToRPCError(err)
+			}
+			return nil
+		}
+	}
+}
+
+// ListUsers lists the users of the given application.
+func (a *ApplicationAPI) ListUsers(ctx context.Context, req *pb.ListApplicationUsersRequest) (*pb.ListApplicationUsersResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationUsersAccess(req.Id, auth.List),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	users, err := storage.GetApplicationUsers(config.C.PostgreSQL.DB, req.Id, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	count, err := storage.GetApplicationUserCount(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListApplicationUsersResponse{
+		TotalCount: int32(count),
+	}
+	for _, user := range users {
+		resp.Result = append(resp.Result, applicationUserToPB(user))
+	}
+
+	return &resp, nil
+}
+
+// GetUser returns the given application user.
+func (a *ApplicationAPI) GetUser(ctx context.Context, req *pb.GetApplicationUserRequest) (*pb.GetApplicationUserResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationUserAccess(req.Id, req.UserID, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	user, err := storage.GetApplicationUser(config.C.PostgreSQL.DB, req.Id, req.UserID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return applicationUserToPB(user), nil
+}
+
+// AddUser adds the given user to the application.
+func (a *ApplicationAPI) AddUser(ctx context.Context, req *pb.ApplicationUserRequest) (*pb.EmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationUsersAccess(req.Id, auth.Create),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.CreateApplicationUser(tx, req.Id, req.UserID, req.IsAdmin); err != nil {
+			return err
+		}
+		return storage.UpdateApplicationUserRoles(tx, req.Id, req.UserID, applicationUserRoles(req))
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
+// UpdateUser updates the given application user.
+func (a *ApplicationAPI) UpdateUser(ctx context.Context, req *pb.ApplicationUserRequest) (*pb.EmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationUserAccess(req.Id, req.UserID, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.UpdateApplicationUser(tx, req.Id, req.UserID, req.IsAdmin); err != nil {
+			return err
+		}
+		return storage.UpdateApplicationUserRoles(tx, req.Id, req.UserID, applicationUserRoles(req))
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
+// DeleteUser deletes the given user from the application.
+func (a *ApplicationAPI) DeleteUser(ctx context.Context, req *pb.DeleteApplicationUserRequest) (*pb.EmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateApplicationUserAccess(req.Id, req.UserID, auth.Delete),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteApplicationUser(config.C.PostgreSQL.DB, req.Id, req.UserID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EmptyResponse{}, nil
+}
+
+func applicationUserRoles(req *pb.ApplicationUserRequest) storage.Roles {
+	return storage.Roles{
+		IsDeviceAdmin:      req.IsDeviceAdmin,
+		IsIntegrationAdmin: req.IsIntegrationAdmin,
+		IsViewer:           req.IsViewer,
+	}
+}
+
+func applicationUserToPB(user storage.ApplicationUser) *pb.GetApplicationUserResponse {
+	return &pb.GetApplicationUserResponse{
+		Id:                 user.UserID,
+		Username:           user.Username,
+		IsAdmin:            user.IsAdmin,
+		IsDeviceAdmin:      user.IsDeviceAdmin,
+		IsIntegrationAdmin: user.IsIntegrationAdmin,
+		IsViewer:           user.IsViewer,
+		CreatedAt:          user.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:          user.UpdatedAt.Format(time.RFC3339Nano),
+	}
+}
@@ -6,13 +6,21 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
+	"github.com/gusseleet/lora-app-server/internal/applayer/clocksync"
+	"github.com/gusseleet/lora-app-server/internal/applayer/fragmentation"
+	"github.com/gusseleet/lora-app-server/internal/applayer/multicastsetup"
 	"github.com/gusseleet/lora-app-server/internal/codec"
 	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/downlink"
+	"github.com/gusseleet/lora-app-server/internal/fuota"
+	"github.com/gusseleet/lora-app-server/internal/gps"
 	"github.com/gusseleet/lora-app-server/internal/gwping"
 	"github.com/gusseleet/lora-app-server/internal/handler"
 	"github.com/gusseleet/lora-app-server/internal/storage"
@@ -31,6 +39,9 @@ func NewApplicationServerAPI() *ApplicationServerAPI {
 
 // HandleUplinkData handles incoming (uplink) data.
 func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.HandleUplinkDataRequest) (*as.HandleUplinkDataResponse, error) {
+	timer := prometheus.NewTimer(uplinkHandleDuration)
+	defer timer.ObserveDuration()
+
 	var appEUI, devEUI lorawan.EUI64
 	copy(appEUI[:], req.AppEUI)
 	copy(devEUI[:], req.DevEUI)
@@ -68,6 +79,7 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		marg := int(req.DeviceStatusMargin)
 		d.DeviceStatusMargin = &marg
 	}
+	d.LastUplinkDataRate = downlink.DataRateString(req.TxInfo.DataRate.Modulation, int(req.TxInfo.DataRate.SpreadFactor), int(req.TxInfo.DataRate.BandWidth), int(req.TxInfo.DataRate.Bitrate))
 	err = storage.UpdateDevice(config.C.PostgreSQL.DB, &d)
 	if err != nil {
 		errStr := fmt.Sprintf("update device error: %s", err)
@@ -75,6 +87,30 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, grpc.Errorf(codes.Internal, errStr)
 	}
 
+	org, err := storage.GetOrganization(config.C.PostgreSQL.DB, app.OrganizationID)
+	if err != nil {
+		errStr := fmt.Sprintf("get organization error: %s", err)
+		log.WithField("id", app.OrganizationID).Error(errStr)
+		return nil, grpc.Errorf(codes.Internal, errStr)
+	}
+
+	// uplinks exceeding the daily quota of the organization are dropped,
+	// the device-status above is still updated
+	if err := storage.CheckOrganizationUplinkQuota(config.C.Redis.Pool, org); err != nil {
+		if err == storage.ErrOrganizationMaxUplinksPerDay {
+			return &as.HandleUplinkDataResponse{}, nil
+		}
+		log.WithField("organization_id", org.ID).WithError(err).Error("check uplink quota error")
+	}
+
+	err = storage.IncrUsage(config.C.Redis.Pool, app.ID, d.DevEUI, storage.UsageCounters{
+		Uplinks:     1,
+		UplinkBytes: int64(len(req.Data)),
+	})
+	if err != nil {
+		log.WithField("dev_eui", d.DevEUI).WithError(err).Error("increment usage error")
+	}
+
 	b, err := lorawan.EncryptFRMPayload(da.AppSKey, true, da.DevAddr, req.FCnt, req.Data)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -84,9 +120,27 @@ func (a *ApplicationServerAPI) HandleUplinkData(ctx context.Context, req *as.Han
 		return nil, grpc.Errorf(codes.Internal, "decrypt payload error: %s", err)
 	}
 
+	// handle the FUOTA application layer packages, the payloads are still
+	// forwarded to the integrations
+	switch req.FPort {
+	case multicastsetup.DefaultFPort:
+		if err := fuota.HandleRemoteMulticastSetupCommand(config.C.PostgreSQL.DB, devEUI, b); err != nil {
+			log.WithField("dev_eui", devEUI).WithError(err).Error("handle remote multicast setup command error")
+		}
+	case fragmentation.DefaultFPort:
+		if err := fuota.HandleFragmentationSessionCommand(config.C.PostgreSQL.DB, devEUI, b); err != nil {
+			log.WithField("dev_eui", devEUI).WithError(err).Error("handle fragmentation session command error")
+		}
+	case clocksync.DefaultFPort:
+		if !handleClockSyncUplink(d, req.RxInfo, b) {
+			return &as.HandleUplinkDataResponse{}, nil
+		}
+	}
+
 	codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
 	if codecPL != nil {
 		if err := codecPL.UnmarshalBinary(b); err != nil {
+			codecDecodeErrorCounter.WithLabelValues(string(app.PayloadCodec)).Inc()
 			log.WithFields(log.Fields{
 				"codec":          app.PayloadCodec,
 				"application_id": app.ID,
@@ -279,6 +333,86 @@ func getAppSKey(appkey lorawan.AES128Key, netID lorawan.NetID, appNonce [3]byte,
 	return getSKey(0x02, appkey, netID, appNonce, devNonce)
 }
 
+// handleClockSyncUplink handles the Application Layer Clock Synchronization
+// uplink when enabled for the device-profile of the given device. It returns
+// false when the uplink must not be forwarded to the integrations.
+func handleClockSyncUplink(d storage.Device, rxInfo []*as.RXInfo, b []byte) bool {
+	dp, err := storage.GetDeviceProfile(config.C.PostgreSQL.DB, d.DeviceProfileID)
+	if err != nil {
+		log.WithField("dev_eui", d.DevEUI).WithError(err).Error("get device-profile error")
+		return true
+	}
+	if !dp.ClockSyncEnabled {
+		return true
+	}
+
+	if err := handleClockSyncCommands(d.DevEUI, rxInfo, b); err != nil {
+		log.WithField("dev_eui", d.DevEUI).WithError(err).Error("handle clock sync command error")
+	}
+
+	return dp.ClockSyncForwardUplinks
+}
+
+func handleClockSyncCommands(devEUI lorawan.EUI64, rxInfo []*as.RXInfo, b []byte) error {
+	cmds, err := clocksync.UnmarshalUplink(b)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal uplink error")
+	}
+
+	for _, cmd := range cmds {
+		req, ok := cmd.(clocksync.AppTimeReqPayload)
+		if !ok {
+			log.WithField("dev_eui", devEUI).Infof("unhandled clock sync command: %T", cmd)
+			continue
+		}
+
+		rxTime := getRXTime(rxInfo)
+		if rxTime == nil {
+			return errors.New("AppTimeReq received without gateway time")
+		}
+
+		ans, send := clocksync.GetAppTimeAns(req, int64(gps.TimeSinceGPSEpoch(*rxTime)/time.Second))
+		if !send {
+			continue
+		}
+
+		ansB, err := ans.MarshalBinary()
+		if err != nil {
+			return errors.Wrap(err, "marshal AppTimeAns error")
+		}
+
+		if err := downlink.EnqueueDownlinkPayload(config.C.PostgreSQL.DB, devEUI, "", false, clocksync.DefaultFPort, ansB); err != nil {
+			return errors.Wrap(err, "enqueue AppTimeAns error")
+		}
+
+		log.WithFields(log.Fields{
+			"dev_eui":         devEUI,
+			"time_correction": ans.TimeCorrection,
+		}).Info("AppTimeAns enqueued")
+	}
+
+	return nil
+}
+
+// getRXTime returns the earliest (GPS) time at which the uplink was received
+// by the gateways, or nil when none of the gateways provided a time.
+func getRXTime(rxInfo []*as.RXInfo) *time.Time {
+	var out *time.Time
+	for _, rx := range rxInfo {
+		if rx == nil || rx.Time == "" {
+			continue
+		}
+		ts, err := time.Parse(time.RFC3339Nano, rx.Time)
+		if err != nil || ts.IsZero() {
+			continue
+		}
+		if out == nil || ts.Before(*out) {
+			out = &ts
+		}
+	}
+	return out
+}
+
 func getSKey(typ byte, appkey lorawan.AES128Key, netID lorawan.NetID, appNonce [3]byte, devNonce [2]byte) (lorawan.AES128Key, error) {
 	var key lorawan.AES128Key
 	b := make([]byte, 0, 16)
@@ -2,14 +2,17 @@ package api
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/json"
 	"testing"
 	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
 
+	"github.com/gusseleet/lora-app-server/internal/applayer/clocksync"
 	"github.com/gusseleet/lora-app-server/internal/codec"
 	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/gps"
 	"github.com/gusseleet/lora-app-server/internal/handler"
 	"github.com/gusseleet/lora-app-server/internal/storage"
 	"github.com/gusseleet/lora-app-server/internal/test"
@@ -227,6 +230,7 @@ func TestApplicationServerAPI(t *testing.T) {
 					So(*d.DeviceStatusBattery, ShouldEqual, 10)
 					So(*d.DeviceStatusMargin, ShouldEqual, 11)
 					So(time.Now().Sub(*d.LastSeenAt), ShouldBeLessThan, time.Second)
+					So(d.LastUplinkDataRate, ShouldEqual, "SF5BW250")
 				})
 			})
 
@@ -247,6 +251,62 @@ func TestApplicationServerAPI(t *testing.T) {
 				})
 			})
 
+			Convey("Given clock synchronization is enabled for the device-profile", func() {
+				dp.ClockSyncEnabled = true
+				So(storage.UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+				// the device clock is 10 seconds behind
+				gpsSeconds := uint32(gps.TimeSinceGPSEpoch(now) / time.Second)
+				appTimeReq := []byte{0x01, 0, 0, 0, 0, 0x03}
+				binary.LittleEndian.PutUint32(appTimeReq[1:5], gpsSeconds-10)
+
+				clockSyncReq := req
+				clockSyncReq.FPort = clocksync.DefaultFPort
+				b, err := lorawan.EncryptFRMPayload(da.AppSKey, true, da.DevAddr, req.FCnt, appTimeReq)
+				So(err, ShouldBeNil)
+				clockSyncReq.Data = b
+
+				Convey("When calling HandleUplinkData with an AppTimeReq", func() {
+					_, err := api.HandleUplinkData(ctx, &clockSyncReq)
+					So(err, ShouldBeNil)
+
+					Convey("Then an AppTimeAns with the time correction has been enqueued", func() {
+						ans, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, 0, []byte{0x01, 10, 0, 0, 0, 0x03})
+						So(err, ShouldBeNil)
+
+						So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+						So(<-nsClient.CreateDeviceQueueItemChan, ShouldResemble, ns.CreateDeviceQueueItemRequest{
+							Item: &ns.DeviceQueueItem{
+								DevEUI:     d.DevEUI[:],
+								FrmPayload: ans,
+								FCnt:       0,
+								FPort:      clocksync.DefaultFPort,
+							},
+						})
+					})
+
+					Convey("Then the payload was not sent to the handler", func() {
+						So(h.SendDataUpChan, ShouldHaveLength, 0)
+					})
+				})
+
+				Convey("Given forwarding the clock synchronization uplinks is enabled", func() {
+					dp.ClockSyncForwardUplinks = true
+					So(storage.UpdateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+					Convey("When calling HandleUplinkData with an AppTimeReq", func() {
+						_, err := api.HandleUplinkData(ctx, &clockSyncReq)
+						So(err, ShouldBeNil)
+
+						Convey("Then the payload was sent to the handler", func() {
+							So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+							<-nsClient.CreateDeviceQueueItemChan
+							So(h.SendDataUpChan, ShouldHaveLength, 1)
+						})
+					})
+				})
+			})
+
 			Convey("When calling HandleUplinkData (Custom JS codec configured)", func() {
 				app.PayloadCodec = codec.CustomJSType
 				app.PayloadDecoderScript = `
@@ -87,6 +87,22 @@ func TestApplicationAPI(t *testing.T) {
 				})
 			})
 
+			Convey("When the organization has reached its max. number of applications", func() {
+				org.MaxApplications = 1
+				So(storage.UpdateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+				_, err := api.Create(ctx, &pb.CreateApplicationRequest{
+					OrganizationID:   org.ID,
+					Name:             "test-app-2",
+					ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+				})
+
+				Convey("Then a resource exhausted error is returned", func() {
+					So(err, ShouldNotBeNil)
+					So(grpc.Code(err), ShouldEqual, codes.ResourceExhausted)
+				})
+			})
+
 			Convey("Given an extra application belonging to a different organization", func() {
 				org2 := storage.Organization{
 					Name: "test-org-2",
@@ -195,6 +211,82 @@ func TestApplicationAPI(t *testing.T) {
 				})
 			})
 
+			Convey("Given a user", func() {
+				user := storage.User{
+					Username: "testuser",
+					Email:    "foo@bar.com",
+					IsActive: true,
+				}
+				_, err := storage.CreateUser(config.C.PostgreSQL.DB, &user, "password123")
+				So(err, ShouldBeNil)
+
+				Convey("When adding the user to the application", func() {
+					_, err := api.AddUser(ctx, &pb.ApplicationUserRequest{
+						Id:            createResp.Id,
+						UserID:        user.ID,
+						IsDeviceAdmin: true,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the user can be retrieved", func() {
+						u, err := api.GetUser(ctx, &pb.GetApplicationUserRequest{
+							Id:     createResp.Id,
+							UserID: user.ID,
+						})
+						So(err, ShouldBeNil)
+						So(u.Username, ShouldEqual, user.Username)
+						So(u.IsAdmin, ShouldBeFalse)
+						So(u.IsDeviceAdmin, ShouldBeTrue)
+						So(u.IsViewer, ShouldBeFalse)
+					})
+
+					Convey("Then the users can be listed", func() {
+						resp, err := api.ListUsers(ctx, &pb.ListApplicationUsersRequest{
+							Id:    createResp.Id,
+							Limit: 10,
+						})
+						So(err, ShouldBeNil)
+						So(resp.TotalCount, ShouldEqual, 1)
+						So(resp.Result, ShouldHaveLength, 1)
+						So(resp.Result[0].Id, ShouldEqual, user.ID)
+					})
+
+					Convey("Then the user can be updated", func() {
+						_, err := api.UpdateUser(ctx, &pb.ApplicationUserRequest{
+							Id:       createResp.Id,
+							UserID:   user.ID,
+							IsAdmin:  true,
+							IsViewer: true,
+						})
+						So(err, ShouldBeNil)
+
+						u, err := api.GetUser(ctx, &pb.GetApplicationUserRequest{
+							Id:     createResp.Id,
+							UserID: user.ID,
+						})
+						So(err, ShouldBeNil)
+						So(u.IsAdmin, ShouldBeTrue)
+						So(u.IsDeviceAdmin, ShouldBeFalse)
+						So(u.IsViewer, ShouldBeTrue)
+					})
+
+					Convey("Then the user can be deleted", func() {
+						_, err := api.DeleteUser(ctx, &pb.DeleteApplicationUserRequest{
+							Id:     createResp.Id,
+							UserID: user.ID,
+						})
+						So(err, ShouldBeNil)
+
+						_, err = api.GetUser(ctx, &pb.GetApplicationUserRequest{
+							Id:     createResp.Id,
+							UserID: user.ID,
+						})
+						So(grpc.Code(err), ShouldEqual, codes.NotFound)
+					})
+				})
+			})
+
 			Convey("When creating a HTTP integration", func() {
 				integration := pb.HTTPIntegration{
 					Id: createResp.Id,
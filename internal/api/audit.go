@@ -0,0 +1,484 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/gusseleet/lora-app-server/api"
+	"github.com/gusseleet/lora-app-server/internal/api/auth"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/webhook"
+)
+
+// auditLogMethodPrefixes contains the prefixes of the RPC method names
+// which mutate data and are recorded in the audit log.
+var auditLogMethodPrefixes = []string{
+	"Create",
+	"Update",
+	"Delete",
+	"Add",
+	"Remove",
+	"Enqueue",
+	"Flush",
+	"Activate",
+	"Enable",
+	"Disable",
+	"Generate",
+}
+
+// auditLogRedacted replaces the redacted values in the audit log request.
+const auditLogRedacted = "***"
+
+// AuditAPI exports the audit log related functions.
+type AuditAPI struct {
+	validator auth.Validator
+}
+
+// NewAuditAPI creates a new AuditAPI.
+func NewAuditAPI(validator auth.Validator) *AuditAPI {
+	return &AuditAPI{
+		validator: validator,
+	}
+}
+
+// List lists the audit log entries of the given organization, or of all
+// organizations when no organization is given.
+func (a *AuditAPI) List(ctx context.Context, req *pb.ListAuditLogRequest) (*pb.ListAuditLogResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateAuditLogsAccess(auth.List, req.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetAuditLogCount(config.C.PostgreSQL.DB, req.OrganizationID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	logs, err := storage.GetAuditLogs(config.C.PostgreSQL.DB, req.OrganizationID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListAuditLogResponse{
+		TotalCount: int64(count),
+	}
+	for _, l := range logs {
+		item := pb.AuditLogEntry{
+			Id:         l.ID,
+			CreatedAt:  l.CreatedAt.Format(time.RFC3339Nano),
+			Username:   l.Username,
+			Method:     l.Method,
+			Target:     l.Target,
+			RemoteAddr: l.RemoteAddr,
+			Request:    string(l.Request),
+		}
+		if string(l.Changes) != "{}" {
+			item.Changes = string(l.Changes)
+		}
+		if l.APIKeyID != nil {
+			item.ApiKeyID = *l.APIKeyID
+		}
+		if l.OrganizationID != nil {
+			item.OrganizationID = *l.OrganizationID
+		}
+		resp.Result = append(resp.Result, &item)
+	}
+
+	return &resp, nil
+}
+
+// AuditLogUnaryServerInterceptor returns an interceptor recording the
+// successful mutating API calls in the audit log. The calls targeting an
+// organization (or a user of an organization) are queued for delivery to
+// the webhooks of the organization. Failing to record an API call is logged, but does not fail
+// the API call itself.
+func AuditLogUnaryServerInterceptor(validator auth.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isAuditLogMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		request, target, err := getAuditLogRequest(req)
+		if err != nil {
+			log.WithError(err).WithField("method", info.FullMethod).Error("get audit log request error")
+			return handler(ctx, req)
+		}
+
+		// the organization is resolved before handling the request, as the
+		// targeted objects no longer exist after a delete
+		service := strings.SplitN(strings.TrimPrefix(info.FullMethod, "/"), "/", 2)[0]
+		organizationID, err := storage.GetAuditLogOrganizationID(config.C.PostgreSQL.DB, service, target)
+		if err != nil {
+			log.WithError(err).WithField("method", info.FullMethod).Error("get audit log organization id error")
+		}
+		userOrganizationIDs, err := getUserOrganizationIDs(service, target)
+		if err != nil {
+			log.WithError(err).WithField("method", info.FullMethod).Error("get user organization ids error")
+		}
+
+		// the state before an Update call is needed to record the changes
+		previous, err := getAuditLogPrevious(ctx, info.Server, info.FullMethod, target)
+		if err != nil {
+			log.WithError(err).WithField("method", info.FullMethod).Warning("get audit log previous state error")
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, err
+		}
+
+		var changes json.RawMessage
+		if previous != nil {
+			changes, err = getAuditLogChanges(previous, req)
+			if err != nil {
+				log.WithError(err).WithField("method", info.FullMethod).Error("get audit log changes error")
+			}
+		}
+
+		// add the IDs of the created objects
+		if _, respTarget, err := getAuditLogRequest(resp); err == nil {
+			for k, v := range respTarget {
+				if _, ok := target[k]; !ok {
+					target[k] = v
+				}
+			}
+		}
+		if organizationID == nil {
+			organizationID, err = storage.GetAuditLogOrganizationID(config.C.PostgreSQL.DB, service, target)
+			if err != nil {
+				log.WithError(err).WithField("method", info.FullMethod).Error("get audit log organization id error")
+			}
+		}
+		if ids, err := getUserOrganizationIDs(service, target); err == nil {
+			userOrganizationIDs = mergeOrganizationIDs(userOrganizationIDs, ids)
+		} else {
+			log.WithError(err).WithField("method", info.FullMethod).Error("get user organization ids error")
+		}
+
+		l, err := createAuditLog(ctx, validator, info.FullMethod, organizationID, target, request, changes)
+		if err != nil {
+			log.WithError(err).WithField("method", info.FullMethod).Error("create audit log error")
+			return resp, nil
+		}
+
+		// a user does not belong to a single organization, the events of a
+		// user are delivered to the webhooks of each organization of the
+		// user
+		webhookOrganizationIDs := userOrganizationIDs
+		if l.OrganizationID != nil {
+			webhookOrganizationIDs = []int64{*l.OrganizationID}
+		}
+		for _, id := range webhookOrganizationIDs {
+			if err := enqueueWebhookEvent(l, id); err != nil {
+				log.WithError(err).WithField("method", info.FullMethod).Error("enqueue webhook event error")
+			}
+		}
+
+		return resp, nil
+	}
+}
+
+func createAuditLog(ctx context.Context, validator auth.Validator, method string, organizationID *int64, target storage.AuditLogTarget, request, changes json.RawMessage) (storage.AuditLog, error) {
+	l := storage.AuditLog{
+		OrganizationID: organizationID,
+		Method:         method,
+		Target:         target,
+		RemoteAddr:     getRemoteAddr(ctx),
+		Request:        request,
+		Changes:        changes,
+	}
+
+	apiKeyID, err := validator.GetAPIKeyID(ctx)
+	if err != nil {
+		return l, errors.Wrap(err, "get api key id error")
+	}
+	if apiKeyID != 0 {
+		l.APIKeyID = &apiKeyID
+	} else {
+		l.Username, err = validator.GetUsername(ctx)
+		if err != nil {
+			return l, errors.Wrap(err, "get username error")
+		}
+	}
+
+	err = storage.CreateAuditLog(config.C.PostgreSQL.DB, &l)
+	return l, err
+}
+
+// enqueueWebhookEvent queues the mutating API call recorded by the given
+// audit log entry for delivery to the webhooks of the given organization.
+func enqueueWebhookEvent(l storage.AuditLog, organizationID int64) error {
+	e := webhook.Event{
+		Type:           webhook.EventType(l.Method),
+		CreatedAt:      l.CreatedAt,
+		OrganizationID: organizationID,
+		Target:         l.Target,
+		Username:       l.Username,
+		Request:        l.Request,
+		Changes:        l.Changes,
+	}
+	if l.APIKeyID != nil {
+		e.APIKeyID = *l.APIKeyID
+	}
+
+	return webhook.Enqueue(config.C.PostgreSQL.DB, e)
+}
+
+// getUserOrganizationIDs returns the IDs of the organizations of the user
+// targeted by a call to the User service. It returns nil for calls to the
+// other services.
+func getUserOrganizationIDs(service string, target storage.AuditLogTarget) ([]int64, error) {
+	if service != "api.User" {
+		return nil, nil
+	}
+
+	v, ok := target["id"]
+	if !ok {
+		v, ok = target["userID"]
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	userID, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse user id error")
+	}
+
+	return storage.GetOrganizationIDsForUser(config.C.PostgreSQL.DB, userID)
+}
+
+// mergeOrganizationIDs returns the IDs of a, with the IDs of b which are
+// not in a appended.
+func mergeOrganizationIDs(a, b []int64) []int64 {
+	for _, id := range b {
+		var found bool
+		for _, existing := range a {
+			if existing == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			a = append(a, id)
+		}
+	}
+	return a
+}
+
+// isAuditLogMethod returns true when the given full method name (e.g.
+// /api.Gateway/Delete) must be recorded in the audit log.
+func isAuditLogMethod(fullMethod string) bool {
+	i := strings.LastIndex(fullMethod, "/")
+	method := fullMethod[i+1:]
+
+	for _, prefix := range auditLogMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getAuditLogRequest returns the JSON encoded message with the secrets
+// redacted and the IDs of the objects it targets. Fields of nested
+// messages (e.g. the deviceProfile of a CreateDeviceProfileRequest) are
+// taken into account when not set on the message itself.
+func getAuditLogRequest(msg interface{}) (json.RawMessage, storage.AuditLogTarget, error) {
+	fields, err := getAuditLogFields(msg, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	target := make(storage.AuditLogTarget)
+	addAuditLogTarget(target, fields)
+	for _, v := range fields {
+		if nested, ok := v.(map[string]interface{}); ok {
+			addAuditLogTarget(target, nested)
+		}
+	}
+
+	redactAuditLogFields(fields)
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "marshal json error")
+	}
+
+	return json.RawMessage(b), target, nil
+}
+
+// getAuditLogFields returns the JSON fields of the given message. When
+// emitDefaults is set, the fields set to their default value are included.
+func getAuditLogFields(msg interface{}, emitDefaults bool) (map[string]interface{}, error) {
+	pm, ok := msg.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("expected proto.Message, got %T", msg)
+	}
+
+	m := jsonpb.Marshaler{EmitDefaults: emitDefaults}
+	s, err := m.MarshalToString(pm)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &fields); err != nil {
+		return nil, errors.Wrap(err, "unmarshal json error")
+	}
+
+	return fields, nil
+}
+
+// getAuditLogPrevious returns the fields of the object targeted by an
+// Update call, before it is updated. These are retrieved by calling the Get
+// method of the same service (e.g. GetKeys for UpdateKeys), using the
+// target as request. It returns nil for the other calls or when the service
+// does not implement the matching Get method.
+func getAuditLogPrevious(ctx context.Context, server interface{}, fullMethod string, target storage.AuditLogTarget) (map[string]interface{}, error) {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if server == nil || !strings.HasPrefix(method, "Update") {
+		return nil, nil
+	}
+
+	get := reflect.ValueOf(server).MethodByName("Get" + strings.TrimPrefix(method, "Update"))
+	if !get.IsValid() || get.Type().NumIn() != 2 || get.Type().NumOut() != 2 || get.Type().In(1).Kind() != reflect.Ptr {
+		return nil, nil
+	}
+
+	req, ok := reflect.New(get.Type().In(1).Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(target)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := u.Unmarshal(bytes.NewReader(b), req); err != nil {
+		return nil, errors.Wrap(err, "unmarshal get request error")
+	}
+
+	out := get.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(req)})
+	if err, ok := out[1].Interface().(error); ok && err != nil {
+		return nil, errors.Wrap(err, "get error")
+	}
+
+	return getAuditLogFields(out[0].Interface(), true)
+}
+
+// getAuditLogChanges returns the JSON encoded fields of the given Update
+// request which differ from the previous state, as field => old and new
+// value. The values of secrets are redacted.
+func getAuditLogChanges(previous map[string]interface{}, req interface{}) (json.RawMessage, error) {
+	fields, err := getAuditLogFields(req, true)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]interface{})
+	addAuditLogChanges(changes, "", previous, fields)
+
+	b, err := json.Marshal(changes)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal json error")
+	}
+	return json.RawMessage(b), nil
+}
+
+// addAuditLogChanges adds the fields of the request which differ from the
+// previous fields to changes. Nested messages are compared field by field,
+// fields which are not part of the previous state or which are not set
+// (nested messages, e.g. an omitted quota) are ignored.
+func addAuditLogChanges(changes map[string]interface{}, prefix string, previous, fields map[string]interface{}) {
+	for k, v := range fields {
+		prev, ok := previous[k]
+		if !ok || v == nil {
+			continue
+		}
+
+		nested, ok := v.(map[string]interface{})
+		prevNested, prevOK := prev.(map[string]interface{})
+		if ok && prevOK {
+			addAuditLogChanges(changes, prefix+k+".", prevNested, nested)
+			continue
+		}
+
+		if reflect.DeepEqual(prev, v) {
+			continue
+		}
+
+		if isAuditLogSecret(k) {
+			prev, v = auditLogRedacted, auditLogRedacted
+		}
+		changes[prefix+k] = map[string]interface{}{
+			"old": prev,
+			"new": v,
+		}
+	}
+}
+
+// addAuditLogTarget adds the ID fields (id, mac, *ID and *EUI) of the given
+// fields to the target, when not already set.
+func addAuditLogTarget(target storage.AuditLogTarget, fields map[string]interface{}) {
+	for k, v := range fields {
+		if k != "id" && k != "mac" && !strings.HasSuffix(k, "ID") && !strings.HasSuffix(k, "EUI") {
+			continue
+		}
+		if _, ok := target[k]; ok {
+			continue
+		}
+
+		switch v := v.(type) {
+		case string:
+			target[k] = v
+		case float64:
+			target[k] = fmt.Sprint(v)
+		}
+	}
+}
+
+// redactAuditLogFields replaces the values of the fields containing
+// secrets, e.g. passwords, (session) keys, tokens and 2FA codes.
+func redactAuditLogFields(fields map[string]interface{}) {
+	for k, v := range fields {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			redactAuditLogFields(v)
+			continue
+		case []interface{}:
+			for _, item := range v {
+				if nested, ok := item.(map[string]interface{}); ok {
+					redactAuditLogFields(nested)
+				}
+			}
+			continue
+		}
+
+		if isAuditLogSecret(k) {
+			fields[k] = auditLogRedacted
+		}
+	}
+}
+
+// isAuditLogSecret returns true when the given field contains a secret,
+// e.g. a password, (session) key, token or 2FA code.
+func isAuditLogSecret(field string) bool {
+	name := strings.ToLower(field)
+	return strings.HasSuffix(name, "key") || strings.Contains(name, "password") || strings.Contains(name, "secret") || strings.Contains(name, "token") || name == "code"
+}
@@ -0,0 +1,271 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	pb "github.com/gusseleet/lora-app-server/api"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+	"github.com/gusseleet/lora-app-server/internal/webhook"
+)
+
+func TestAuditAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with an organization and an api instance", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(config.C.Redis.Pool)
+
+		// request made by the grpc-gateway
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 12345},
+		})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", "192.168.1.1, 10.0.0.1"))
+		validator := &TestValidator{returnUsername: "admin"}
+		api := NewAuditAPI(validator)
+		interceptor := AuditLogUnaryServerInterceptor(validator)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		Convey("When calling a non-mutating method", func() {
+			_, err := interceptor(ctx, &pb.OrganizationRequest{Id: org.ID}, &grpc.UnaryServerInfo{FullMethod: "/api.Organization/Get"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &pb.GetOrganizationResponse{}, nil
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then no audit log entry has been created", func() {
+				count, err := storage.GetAuditLogCount(config.C.PostgreSQL.DB, 0)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When a mutating method fails", func() {
+			_, err := interceptor(ctx, &pb.OrganizationRequest{Id: org.ID}, &grpc.UnaryServerInfo{FullMethod: "/api.Organization/Delete"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, errors.New("test error")
+			})
+			So(err, ShouldNotBeNil)
+
+			Convey("Then no audit log entry has been created", func() {
+				count, err := storage.GetAuditLogCount(config.C.PostgreSQL.DB, 0)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+
+		Convey("When deleting the organization", func() {
+			_, err := interceptor(ctx, &pb.OrganizationRequest{Id: org.ID}, &grpc.UnaryServerInfo{FullMethod: "/api.Organization/Delete"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &pb.OrganizationEmptyResponse{}, storage.DeleteOrganization(config.C.PostgreSQL.DB, org.ID)
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the audit log entry has been created for the organization", func() {
+				resp, err := api.List(ctx, &pb.ListAuditLogRequest{
+					OrganizationID: org.ID,
+					Limit:          10,
+				})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].Username, ShouldEqual, "admin")
+				So(resp.Result[0].OrganizationID, ShouldEqual, org.ID)
+				So(resp.Result[0].Method, ShouldEqual, "/api.Organization/Delete")
+				So(resp.Result[0].Target, ShouldResemble, map[string]string{"id": fmt.Sprint(org.ID)})
+				So(resp.Result[0].RemoteAddr, ShouldEqual, "10.0.0.1")
+			})
+		})
+
+		Convey("When creating an organization", func() {
+			var orgID int64
+			_, err := interceptor(ctx, &pb.CreateOrganizationRequest{Name: "test-org-2"}, &grpc.UnaryServerInfo{FullMethod: "/api.Organization/Create"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				o := storage.Organization{Name: "test-org-2"}
+				err := storage.CreateOrganization(config.C.PostgreSQL.DB, &o)
+				orgID = o.ID
+				return &pb.CreateOrganizationResponse{Id: o.ID}, err
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the id of the created organization has been recorded", func() {
+				logs, err := storage.GetAuditLogs(config.C.PostgreSQL.DB, orgID, 10, 0)
+				So(err, ShouldBeNil)
+				So(logs, ShouldHaveLength, 1)
+				So(logs[0].Target, ShouldResemble, storage.AuditLogTarget{"id": fmt.Sprint(orgID)})
+			})
+		})
+
+		Convey("When updating the organization name", func() {
+			_, err := interceptor(ctx, &pb.UpdateOrganizationRequest{Id: org.ID, Name: "test-org-updated"}, &grpc.UnaryServerInfo{Server: NewOrganizationAPI(validator), FullMethod: "/api.Organization/Update"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				org.Name = "test-org-updated"
+				return &pb.OrganizationEmptyResponse{}, storage.UpdateOrganization(config.C.PostgreSQL.DB, &org)
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the changed name has been recorded", func() {
+				resp, err := api.List(ctx, &pb.ListAuditLogRequest{
+					OrganizationID: org.ID,
+					Limit:          10,
+				})
+				So(err, ShouldBeNil)
+				So(resp.Result, ShouldHaveLength, 1)
+
+				var changes map[string]interface{}
+				So(json.Unmarshal([]byte(resp.Result[0].Changes), &changes), ShouldBeNil)
+				So(changes, ShouldResemble, map[string]interface{}{
+					"name": map[string]interface{}{
+						"old": "test-org",
+						"new": "test-org-updated",
+					},
+				})
+			})
+		})
+
+		Convey("Given a webhook for the organization", func() {
+			w := storage.Webhook{
+				OrganizationID: org.ID,
+				Name:           "test-webhook",
+				URL:            "http://localhost/webhook",
+				Secret:         "secret",
+			}
+			So(storage.CreateWebhook(config.C.PostgreSQL.DB, &w), ShouldBeNil)
+
+			Convey("When updating the organization", func() {
+				_, err := interceptor(ctx, &pb.UpdateOrganizationRequest{Id: org.ID, Name: "test-org-updated"}, &grpc.UnaryServerInfo{FullMethod: "/api.Organization/Update"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+					return &pb.OrganizationEmptyResponse{}, nil
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then an event has been queued for the webhook", func() {
+					deliveries, err := storage.GetPendingWebhookDeliveries(config.C.PostgreSQL.DB, 10)
+					So(err, ShouldBeNil)
+					So(deliveries, ShouldHaveLength, 1)
+					So(deliveries[0].WebhookID, ShouldEqual, w.ID)
+
+					var e webhook.Event
+					So(json.Unmarshal(deliveries[0].Event, &e), ShouldBeNil)
+					So(e.Type, ShouldEqual, "Organization.Update")
+					So(e.OrganizationID, ShouldEqual, org.ID)
+					So(e.Username, ShouldEqual, "admin")
+					So(e.Target, ShouldResemble, storage.AuditLogTarget{"id": fmt.Sprint(org.ID)})
+				})
+			})
+
+			Convey("Given a user of the organization", func() {
+				userID, err := storage.CreateUser(config.C.PostgreSQL.DB, &storage.User{
+					Username: "testuser",
+					Email:    "foo@bar.com",
+					IsActive: true,
+				}, "password123")
+				So(err, ShouldBeNil)
+				So(storage.CreateOrganizationUser(config.C.PostgreSQL.DB, org.ID, userID, false), ShouldBeNil)
+
+				Convey("When deleting the user", func() {
+					_, err := interceptor(ctx, &pb.UserRequest{Id: userID}, &grpc.UnaryServerInfo{FullMethod: "/api.User/Delete"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+						So(storage.DeleteUser(config.C.PostgreSQL.DB, userID), ShouldBeNil)
+						return &pb.UserEmptyResponse{}, nil
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then an event has been queued for the webhook of the organization of the user", func() {
+						deliveries, err := storage.GetPendingWebhookDeliveries(config.C.PostgreSQL.DB, 10)
+						So(err, ShouldBeNil)
+						So(deliveries, ShouldHaveLength, 1)
+						So(deliveries[0].WebhookID, ShouldEqual, w.ID)
+
+						var e webhook.Event
+						So(json.Unmarshal(deliveries[0].Event, &e), ShouldBeNil)
+						So(e.Type, ShouldEqual, "User.Delete")
+						So(e.OrganizationID, ShouldEqual, org.ID)
+						So(e.Target, ShouldResemble, storage.AuditLogTarget{"id": fmt.Sprint(userID)})
+					})
+				})
+			})
+		})
+
+		Convey("When creating device keys using an API key", func() {
+			validator.returnAPIKeyID = 10
+			req := pb.CreateDeviceKeysRequest{
+				DevEUI: "0102030405060708",
+				DeviceKeys: &pb.DeviceKeys{
+					AppKey: "01020304050607080102030405060708",
+				},
+			}
+			_, err := interceptor(ctx, &req, &grpc.UnaryServerInfo{FullMethod: "/api.Device/CreateKeys"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &pb.CreateDeviceKeysResponse{}, nil
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the key has been redacted", func() {
+				logs, err := storage.GetAuditLogs(config.C.PostgreSQL.DB, 0, 10, 0)
+				So(err, ShouldBeNil)
+				So(logs, ShouldHaveLength, 1)
+				So(logs[0].Username, ShouldEqual, "")
+				So(*logs[0].APIKeyID, ShouldEqual, 10)
+				So(logs[0].Target, ShouldResemble, storage.AuditLogTarget{"devEUI": "0102030405060708"})
+
+				var r map[string]interface{}
+				So(json.Unmarshal(logs[0].Request, &r), ShouldBeNil)
+				So(r, ShouldResemble, map[string]interface{}{
+					"devEUI": "0102030405060708",
+					"deviceKeys": map[string]interface{}{
+						"appKey": "***",
+					},
+				})
+			})
+		})
+	})
+}
+
+func TestGetAuditLogChanges(t *testing.T) {
+	Convey("Given the previous keys of a device", t, func() {
+		previous, err := getAuditLogFields(&pb.GetDeviceKeysResponse{
+			DeviceKeys: &pb.DeviceKeys{
+				AppKey: "01020304050607080102030405060708",
+			},
+		}, true)
+		So(err, ShouldBeNil)
+
+		Convey("Then updating the keys records the change with the keys redacted", func() {
+			changes, err := getAuditLogChanges(previous, &pb.UpdateDeviceKeysRequest{
+				DevEUI: "0102030405060708",
+				DeviceKeys: &pb.DeviceKeys{
+					AppKey: "08070605040302010807060504030201",
+				},
+			})
+			So(err, ShouldBeNil)
+			So(string(changes), ShouldEqual, `{"deviceKeys.appKey":{"new":"***","old":"***"}}`)
+		})
+
+		Convey("Then an update setting the same keys records no changes", func() {
+			changes, err := getAuditLogChanges(previous, &pb.UpdateDeviceKeysRequest{
+				DevEUI: "0102030405060708",
+				DeviceKeys: &pb.DeviceKeys{
+					AppKey: "01020304050607080102030405060708",
+				},
+			})
+			So(err, ShouldBeNil)
+			So(string(changes), ShouldEqual, "{}")
+		})
+	})
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/gusseleet/lora-app-server/internal/storage"
 	jwt "github.com/dgrijalva/jwt-go"
@@ -21,6 +22,15 @@ type Claims struct {
 
 	// Username defines the identity of the user.
 	Username string `json:"username"`
+
+	// SessionID defines the ID of the session the token was issued for.
+	// Tokens issued before sessions were introduced do not contain a
+	// session ID.
+	SessionID int64 `json:"sid,omitempty"`
+
+	// APIKeyID defines the ID of the API key, when authenticated using an
+	// API key instead of a user token. It is never read from the JWT.
+	APIKeyID int64 `json:"-"`
 }
 
 // Validator defines the interface a validator needs to implement.
@@ -39,6 +49,14 @@ type Validator interface {
 
 	// GetIsAdmin returns if the authenticated user is a global admin.
 	GetIsAdmin(context.Context) (bool, error)
+
+	// GetAPIKeyID returns the ID of the API key used for authentication, or
+	// 0 when authenticated as user.
+	GetAPIKeyID(context.Context) (int64, error)
+
+	// GetSessionID returns the ID of the session of the authenticated user,
+	// or 0 when the token is not bound to a session.
+	GetSessionID(context.Context) (int64, error)
 }
 
 // ValidatorFunc defines the signature of a claim validator function.
@@ -100,6 +118,11 @@ func (v JWTValidator) GetIsAdmin(ctx context.Context) (bool, error) {
 		return false, err
 	}
 
+	// API keys are never global admin
+	if claims.APIKeyID != 0 {
+		return false, nil
+	}
+
 	user, err := storage.GetUserByUsername(v.db, claims.Username)
 	if err != nil {
 		return false, errors.Wrap(err, "get user by username error")
@@ -108,12 +131,36 @@ func (v JWTValidator) GetIsAdmin(ctx context.Context) (bool, error) {
 	return user.IsAdmin, nil
 }
 
+// GetAPIKeyID returns the ID of the API key used for authentication.
+func (v JWTValidator) GetAPIKeyID(ctx context.Context) (int64, error) {
+	claims, err := v.getClaims(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return claims.APIKeyID, nil
+}
+
+// GetSessionID returns the ID of the session of the authenticated user.
+func (v JWTValidator) GetSessionID(ctx context.Context) (int64, error) {
+	claims, err := v.getClaims(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return claims.SessionID, nil
+}
+
 func (v JWTValidator) getClaims(ctx context.Context) (*Claims, error) {
 	tokenStr, err := getTokenFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "get token from context error")
 	}
 
+	if strings.HasPrefix(tokenStr, storage.APIKeyPrefix) {
+		return v.getAPIKeyClaims(tokenStr)
+	}
+
 	token, err := jwt.ParseWithClaims(tokenStr, &Claims{}, func(token *jwt.Token) (interface{}, error) {
 		if token.Header["alg"] != v.algorithm {
 			return nil, ErrInvalidAlgorithm
@@ -134,9 +181,40 @@ func (v JWTValidator) getClaims(ctx context.Context) (*Claims, error) {
 		return nil, fmt.Errorf("api/auth: expected *Claims, got %T", token.Claims)
 	}
 
+	// the session is revoked on logout, password change or deactivation
+	// of the user
+	if claims.SessionID != 0 {
+		s, err := storage.GetUserSession(v.db, claims.SessionID)
+		if err != nil {
+			if errors.Cause(err) == storage.ErrDoesNotExist {
+				return nil, ErrSessionRevoked
+			}
+			return nil, errors.Wrap(err, "get user session error")
+		}
+		if s.IsExpired() {
+			return nil, ErrSessionRevoked
+		}
+	}
+
 	return claims, nil
 }
 
+func (v JWTValidator) getAPIKeyClaims(key string) (*Claims, error) {
+	k, err := storage.GetAPIKeyByKey(v.db, key)
+	if err != nil {
+		if errors.Cause(err) == storage.ErrDoesNotExist {
+			return nil, ErrInvalidToken
+		}
+		return nil, errors.Wrap(err, "get api key error")
+	}
+
+	if k.IsExpired() {
+		return nil, ErrAPIKeyExpired
+	}
+
+	return &Claims{APIKeyID: k.ID}, nil
+}
+
 func getTokenFromContext(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -8,6 +8,9 @@ import (
 
 	"golang.org/x/net/context"
 
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
 	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -92,3 +95,66 @@ func TestJWTValidator(t *testing.T) {
 		}
 	})
 }
+
+func TestJWTValidatorAPIKey(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(test.NewNetworkServerClient())
+
+	Convey("Given a JWT validator and an organization API key", t, func() {
+		test.MustResetDB(db)
+
+		v := NewJWTValidator(db, "HS256", "verysecret")
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(db, &org), ShouldBeNil)
+
+		apiKey := storage.APIKey{
+			Name:           "test-key",
+			OrganizationID: &org.ID,
+		}
+		key, err := storage.CreateAPIKey(db, &apiKey)
+		So(err, ShouldBeNil)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+			"authorization": []string{"Bearer " + key},
+		})
+
+		Convey("Then the API key is accepted", func() {
+			So(v.Validate(ctx, ValidateOrganizationAccess(Update, org.ID)), ShouldBeNil)
+
+			isAdmin, err := v.GetIsAdmin(ctx)
+			So(err, ShouldBeNil)
+			So(isAdmin, ShouldBeFalse)
+		})
+
+		Convey("Then an unknown API key is rejected", func() {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{
+				"authorization": []string{"Bearer " + key + "x"},
+			})
+			So(errors.Cause(v.Validate(ctx, ValidateOrganizationAccess(Read, org.ID))), ShouldEqual, ErrInvalidToken)
+		})
+
+		Convey("When the API key has expired", func() {
+			_, err := db.Exec("update api_key set expires_at = $1 where id = $2", time.Now().Add(-time.Second), apiKey.ID)
+			So(err, ShouldBeNil)
+
+			Convey("Then the API key is rejected", func() {
+				So(errors.Cause(v.Validate(ctx, ValidateOrganizationAccess(Read, org.ID))), ShouldEqual, ErrAPIKeyExpired)
+			})
+		})
+
+		Convey("When the API key has been deleted", func() {
+			So(storage.DeleteAPIKey(db, apiKey.ID), ShouldBeNil)
+
+			Convey("Then the API key is rejected", func() {
+				So(errors.Cause(v.Validate(ctx, ValidateOrganizationAccess(Read, org.ID))), ShouldEqual, ErrInvalidToken)
+			})
+		})
+	})
+}
@@ -8,5 +8,7 @@ var (
 	ErrNoAuthorizationInMetadata = errors.New("no authorization-data in metadata")
 	ErrInvalidAlgorithm          = errors.New("invalid algorithm")
 	ErrInvalidToken              = errors.New("invalid token")
+	ErrAPIKeyExpired             = errors.New("api key expired")
+	ErrSessionRevoked            = errors.New("session expired or revoked")
 	ErrNotAuthorized             = errors.New("not authorized")
 )
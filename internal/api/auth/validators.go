@@ -45,7 +45,54 @@ const userQuery = `
 	left join network_server ns
 		on ns.id = sp.network_server_id or ns.id = dp.network_server_id
 	left join device d
-		on a.id = d.application_id`
+		on a.id = d.application_id
+	left join application_user au
+		on u.id = au.user_id`
+
+// apiKeyQuery is the equivalent of userQuery for API keys. The users are
+// never joined so that user specific conditions never match. Organization
+// keys join all the resources of the organization, application keys only
+// the application and its devices.
+const apiKeyQuery = `
+	select count(*)
+	from api_key k
+	left join "user" u
+		on false
+	left join organization_user ou
+		on false
+	left join organization o
+		on o.id = k.organization_id
+	left join gateway g
+		on o.id = g.organization_id
+	left join application a
+		on a.organization_id = o.id or a.id = k.application_id
+	left join service_profile sp
+		on sp.organization_id = o.id
+	left join device_profile dp
+		on dp.organization_id = o.id
+	left join network_server ns
+		on ns.id = sp.network_server_id or ns.id = dp.network_server_id
+	left join device d
+		on a.id = d.application_id
+	left join application_user au
+		on false`
+
+// apiKeyConditions maps the user conditions to the API key conditions.
+// API keys are never global admin, organization keys have the permissions
+// of an organization admin and application keys the permissions of an
+// organization user (limited to the application). The application user
+// conditions never match, as application_user is never joined.
+var apiKeyConditions = map[string]string{
+	"u.username = $1":                "k.id = $1",
+	"u.is_active = true":             "true",
+	"u.is_admin = true":              "false",
+	"ou.is_admin = true":             "k.organization_id is not null",
+	"ou.is_admin=true":               "k.organization_id is not null",
+	"ou.is_device_admin = true":      "k.organization_id is not null",
+	"ou.is_gateway_admin = true":     "k.organization_id is not null",
+	"ou.is_integration_admin = true": "k.organization_id is not null",
+	"ou.is_viewer = false":           "true",
+}
 
 // ValidateActiveUser validates if the user in the JWT claim is active.
 func ValidateActiveUser() ValidatorFunc {
@@ -54,7 +101,11 @@ func ValidateActiveUser() ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username)
+		// an API key does not represent a user
+		if claims.APIKeyID != 0 {
+			return false, nil
+		}
+		return executeQuery(db, claims, where)
 	}
 }
 
@@ -80,9 +131,11 @@ func ValidateUsersAccess(flag Flag) ValidatorFunc {
 		} else {
 			// global admin
 			// organization admin
+			// application admin
 			where = [][]string{
 				{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 				{"u.username = $1", "u.is_active = true", "ou.is_admin = true"},
+				{"u.username = $1", "u.is_active = true", "au.is_admin = true"},
 			}
 		}
 	default:
@@ -90,7 +143,7 @@ func ValidateUsersAccess(flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username)
+		return executeQuery(db, claims, where)
 	}
 }
 
@@ -124,7 +177,27 @@ func ValidateUserAccess(userID int64, flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, userID)
+		return executeQuery(db, claims, where, userID)
+	}
+}
+
+// ValidateLoginLockoutsAccess validates if the client has access to the
+// login lockouts.
+func ValidateLoginLockoutsAccess(flag Flag) ValidatorFunc {
+	var where [][]string
+
+	switch flag {
+	case List, Delete:
+		// global admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where)
 	}
 }
 
@@ -133,13 +206,15 @@ func ValidateUserAccess(userID int64, flag Flag) ValidatorFunc {
 func ValidateIsApplicationAdmin(applicationID int64) ValidatorFunc {
 	// global admin
 	// organization admin
+	// application admin
 	where := [][]string{
 		{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 		{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+		{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+		return executeQuery(db, claims, where, applicationID)
 	}
 }
 
@@ -159,10 +234,12 @@ func ValidateApplicationsAccess(flag Flag, organizationID int64) ValidatorFunc {
 	case List:
 		// global admin
 		// organization user (when organization id is given)
+		// application user (when organization id is given)
 		// any active user (api will filter on user)
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "$2 > 0", "o.id = $2 or a.organization_id = $2"},
+			{"u.username = $1", "u.is_active = true", "$2 > 0", "au.application_id in (select id from application where organization_id = $2)"},
 			{"u.username = $1", "u.is_active = true", "$2 = 0"},
 		}
 	default:
@@ -170,7 +247,7 @@ func ValidateApplicationsAccess(flag Flag, organizationID int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -183,16 +260,20 @@ func ValidateApplicationAccess(applicationID int64, flag Flag) ValidatorFunc {
 	case Read:
 		// global admin
 		// organization user
+		// application user
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = $2"},
 		}
 	case Update:
 		// global admin
 		// organization admin
+		// application admin
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
 		}
 	case Delete:
 		// global admin
@@ -206,7 +287,35 @@ func ValidateApplicationAccess(applicationID int64, flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+		return executeQuery(db, claims, where, applicationID)
+	}
+}
+
+// ValidateApplicationIntegrationAccess validates if the client has access to
+// the integrations of the given application.
+func ValidateApplicationIntegrationAccess(applicationID int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, Read, Update, Delete, List:
+		// global admin
+		// organization admin
+		// organization integration admin
+		// application admin
+		// application integration admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "ou.is_integration_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_integration_admin = true", "au.application_id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, applicationID)
 	}
 }
 
@@ -225,24 +334,28 @@ func ValidateApplicationUsersAccess(applicationID int64, flag Flag) ValidatorFun
 		} else {
 			// global admin
 			// organization admin
+			// application admin
 			where = [][]string{
 				{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 				{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+				{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
 			}
 		}
 	case List:
 		// global admin
 		// organization user
+		// application user
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = $2"},
 		}
 	default:
 		panic("unsupported flag")
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+		return executeQuery(db, claims, where, applicationID)
 	}
 }
 
@@ -255,32 +368,39 @@ func ValidateApplicationUserAccess(applicationID, userID int64, flag Flag) Valid
 	case Read:
 		// global admin
 		// organization admin
+		// application admin
 		// user itself
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
 			{"u.username = $1", "u.is_active = true", "a.id = $2", "ou.user_id = $3"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = $2", "au.user_id = $3"},
 		}
 	case Update:
 		// global admin
 		// organization admin
+		// application admin
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true", "$3 = $3"},
 			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
 		}
 	case Delete:
 		// global admin
 		// organization admin
+		// application admin
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true", "$3 = $3"},
 			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
 		}
 	default:
 		panic("unsupported flag")
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID, userID)
+		return executeQuery(db, claims, where, applicationID, userID)
 	}
 }
 
@@ -293,23 +413,31 @@ func ValidateNodesAccess(applicationID int64, flag Flag) ValidatorFunc {
 	case Create:
 		// global admin
 		// organization admin
+		// organization device admin
+		// application admin
+		// application device admin
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "ou.is_device_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_device_admin = true", "au.application_id = $2"},
 		}
 	case List:
 		// global admin
 		// organization user
+		// application user
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = $2"},
 		}
 	default:
 		panic("unsupported flag")
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, applicationID)
+		return executeQuery(db, claims, where, applicationID)
 	}
 }
 
@@ -321,30 +449,55 @@ func ValidateNodeAccess(devEUI lorawan.EUI64, flag Flag) ValidatorFunc {
 	case Read:
 		// global admin
 		// organization user
+		// application user
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "d.dev_eui = $2"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = (select application_id from device where dev_eui = $2)"},
 		}
-	case Update:
+	case Update, Delete:
 		// global admin
 		// organization admin
+		// organization device admin
+		// application admin
+		// application device admin
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "d.dev_eui = $2"},
+			{"u.username = $1", "u.is_active = true", "ou.is_device_admin = true", "d.dev_eui = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = (select application_id from device where dev_eui = $2)"},
+			{"u.username = $1", "u.is_active = true", "au.is_device_admin = true", "au.application_id = (select application_id from device where dev_eui = $2)"},
 		}
-	case Delete:
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, devEUI[:])
+	}
+}
+
+// ValidateNodeActivationAccess validates if the client has access to the
+// activation (session keys) of the given node.
+func ValidateNodeActivationAccess(devEUI lorawan.EUI64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
 		// global admin
-		// organization admin
+		// organization user (except viewers)
+		// application user (except viewers)
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
-			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "d.dev_eui = $2"},
+			{"u.username = $1", "u.is_active = true", "ou.is_viewer = false", "d.dev_eui = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_viewer = false", "au.application_id = (select application_id from device where dev_eui = $2)"},
 		}
 	default:
 		panic("unsupported flag")
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, devEUI[:])
+		return executeQuery(db, claims, where, devEUI[:])
 	}
 }
 
@@ -354,19 +507,78 @@ func ValidateDeviceQueueAccess(devEUI lorawan.EUI64, flag Flag) ValidatorFunc {
 	var where = [][]string{}
 
 	switch flag {
-	case Create, List, Delete:
+	case List:
 		// global admin
 		// organization user
+		// application user
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "d.dev_eui = $2"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = (select application_id from device where dev_eui = $2)"},
+		}
+	case Create, Delete:
+		// global admin
+		// organization user (except viewers)
+		// application user (except viewers)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_viewer = false", "d.dev_eui = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_viewer = false", "au.application_id = (select application_id from device where dev_eui = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, devEUI[:])
+	}
+}
+
+// ValidateDeviceQueueBulkJobsAccess validates if the client has access to
+// the device-queue bulk jobs of the given application.
+func ValidateDeviceQueueBulkJobsAccess(applicationID int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization user (except viewers)
+		// application user (except viewers)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_viewer = false", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_viewer = false", "au.application_id = $2"},
 		}
 	default:
 		panic("unsupported flag")
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, devEUI[:])
+		return executeQuery(db, claims, where, applicationID)
+	}
+}
+
+// ValidateDeviceQueueBulkJobAccess validates if the client has access to the
+// given device-queue bulk job.
+func ValidateDeviceQueueBulkJobAccess(id int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization user
+		// application user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = (select application_id from device_queue_bulk_job where id = $2)"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = (select application_id from device_queue_bulk_job where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, id)
 	}
 }
 
@@ -378,9 +590,11 @@ func ValidateGatewaysAccess(flag Flag, organizationID int64) ValidatorFunc {
 	case Create:
 		// global admin
 		// organization admin
+		// organization gateway admin
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_admin = true", "o.can_have_gateways = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_gateway_admin = true", "o.can_have_gateways = true"},
 		}
 	case List:
 		// global admin
@@ -396,7 +610,7 @@ func ValidateGatewaysAccess(flag Flag, organizationID int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -416,15 +630,17 @@ func ValidateGatewayAccess(flag Flag, mac lorawan.EUI64) ValidatorFunc {
 		where = [][]string{
 			// global admin
 			// organization admin
+			// organization gateway admin
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "g.mac = $2", "ou.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "g.mac = $2", "ou.is_gateway_admin = true"},
 		}
 	default:
 		panic("unsupported flag")
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, mac[:])
+		return executeQuery(db, claims, where, mac[:])
 	}
 }
 
@@ -439,7 +655,7 @@ func ValidateIsOrganizationAdmin(organizationID int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -464,7 +680,7 @@ func ValidateOrganizationsAccess(flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username)
+		return executeQuery(db, claims, where)
 	}
 }
 
@@ -477,10 +693,12 @@ func ValidateOrganizationAccess(flag Flag, id int64) ValidatorFunc {
 	case Read:
 		// global admin
 		// organization user
+		// application user
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "o.id = $2"},
 			{"u.username = $1", "u.is_active = true", "a.organization_id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.application_id in (select id from application where organization_id = $2)"},
 		}
 	case Update:
 		// global admin
@@ -499,7 +717,7 @@ func ValidateOrganizationAccess(flag Flag, id int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, id)
+		return executeQuery(db, claims, where, id)
 	}
 }
 
@@ -535,7 +753,7 @@ func ValidateOrganizationUsersAccess(flag Flag, id int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, id)
+		return executeQuery(db, claims, where, id)
 	}
 }
 
@@ -573,7 +791,77 @@ func ValidateOrganizationUserAccess(flag Flag, organizationID, userID int64) Val
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID, userID)
+		return executeQuery(db, claims, where, organizationID, userID)
+	}
+}
+
+// ValidateOrganizationInvitationsAccess validates if the client has access
+// to the invitations of the given organization. Unlike adding existing
+// users, inviting is not affected by DisableAssignExistingUsers as the
+// invited user must accept the invitation.
+func ValidateOrganizationInvitationsAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, List, Update, Delete:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, organizationID)
+	}
+}
+
+// ValidateOrganizationUsageAccess validates if the client has access to
+// the usage (billing) of the given organization.
+func ValidateOrganizationUsageAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, organizationID)
+	}
+}
+
+// ValidateOrganizationIntegrationAccess validates if the client has access
+// to the integrations of the given organization.
+func ValidateOrganizationIntegrationAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, Read, Update, Delete, List:
+		// global admin
+		// organization admin
+		// organization integration admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "o.id = $2", "ou.is_integration_admin = true"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -596,7 +884,7 @@ func ValidateChannelConfigurationAccess(flag Flag) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username)
+		return executeQuery(db, claims, where)
 	}
 }
 
@@ -621,7 +909,7 @@ func ValidateNetworkServersAccess(flag Flag, organizationID int64) ValidatorFunc
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -639,7 +927,7 @@ func ValidateNetworkServerAccess(flag Flag, id int64) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username)
+		return executeQuery(db, claims, where)
 	}
 }
 
@@ -661,7 +949,7 @@ func ValidateOrganizationNetworkServerAccess(flag Flag, organizationID, networkS
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID, networkServerID)
+		return executeQuery(db, claims, where, organizationID, networkServerID)
 	}
 }
 
@@ -688,7 +976,7 @@ func ValidateServiceProfilesAccess(flag Flag, organizationID int64) ValidatorFun
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID)
+		return executeQuery(db, claims, where, organizationID)
 	}
 }
 
@@ -713,7 +1001,7 @@ func ValidateServiceProfileAccess(flag Flag, id string) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, id)
+		return executeQuery(db, claims, where, id)
 	}
 }
 
@@ -739,12 +1027,13 @@ func ValidateDeviceProfilesAccess(flag Flag, organizationID, applicationID int64
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "$3 = 0", "$2 > 0", "o.id = $2"},
 			{"u.username = $1", "u.is_active = true", "$2 = 0", "$3 > 0", "a.id = $3"},
+			{"u.username = $1", "u.is_active = true", "$2 = 0", "$3 > 0", "au.application_id = $3"},
 			{"u.username = $1", "u.is_active = true", "$2 = 0", "$3 = 0"},
 		}
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, organizationID, applicationID)
+		return executeQuery(db, claims, where, organizationID, applicationID)
 	}
 }
 
@@ -757,9 +1046,11 @@ func ValidateDeviceProfileAccess(flag Flag, id string) ValidatorFunc {
 	case Read:
 		// gloabal admin
 		// organization users
+		// application users (device-profiles of the organization)
 		where = [][]string{
 			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
 			{"u.username = $1", "u.is_active = true", "dp.device_profile_id = $2"},
+			{"u.username = $1", "u.is_active = true", "(select organization_id from application where id = au.application_id) = (select organization_id from device_profile where device_profile_id = $2)"},
 		}
 	case Update, Delete:
 		// global admin
@@ -771,11 +1062,364 @@ func ValidateDeviceProfileAccess(flag Flag, id string) ValidatorFunc {
 	}
 
 	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
-		return executeQuery(db, userQuery, where, claims.Username, id)
+		return executeQuery(db, claims, where, id)
 	}
 }
 
-func executeQuery(db sqlx.Queryer, query string, where [][]string, args ...interface{}) (bool, error) {
+// ValidateDownlinkSchedulesAccess validates if the client has access to the
+// downlink schedules of the given application.
+func ValidateDownlinkSchedulesAccess(applicationID int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin
+		// application admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
+		}
+	case List:
+		// global admin
+		// organization user
+		// application user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, applicationID)
+	}
+}
+
+// ValidateDownlinkScheduleAccess validates if the client has access to the
+// given downlink schedule.
+func ValidateDownlinkScheduleAccess(id int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization user
+		// application user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = (select application_id from downlink_schedule where id = $2)"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = (select application_id from downlink_schedule where id = $2)"},
+		}
+	case Update, Delete:
+		// global admin
+		// organization admin
+		// application admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = (select application_id from downlink_schedule where id = $2)"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = (select application_id from downlink_schedule where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, id)
+	}
+}
+
+// ValidateMulticastGroupsAccess validates if the client has access to the
+// multicast groups of the given application.
+func ValidateMulticastGroupsAccess(applicationID int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin
+		// application admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
+		}
+	case List:
+		// global admin
+		// organization user (except viewers, the groups contain the keys)
+		// application user (except viewers)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_viewer = false", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_viewer = false", "au.application_id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, applicationID)
+	}
+}
+
+// ValidateMulticastGroupAccess validates if the client has access to the
+// given multicast group.
+func ValidateMulticastGroupAccess(id int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization user (except viewers, the group contains the keys)
+		// application user (except viewers)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_viewer = false", "a.id = (select application_id from multicast_group where id = $2)"},
+			{"u.username = $1", "u.is_active = true", "au.is_viewer = false", "au.application_id = (select application_id from multicast_group where id = $2)"},
+		}
+	case Update, Delete:
+		// global admin
+		// organization admin
+		// application admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = (select application_id from multicast_group where id = $2)"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = (select application_id from multicast_group where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, id)
+	}
+}
+
+// ValidateMulticastGroupQueueAccess validates if the client has access to
+// the queue of the given multicast group.
+func ValidateMulticastGroupQueueAccess(id int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization user (except viewers)
+		// application user (except viewers)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_viewer = false", "a.id = (select application_id from multicast_group where id = $2)"},
+			{"u.username = $1", "u.is_active = true", "au.is_viewer = false", "au.application_id = (select application_id from multicast_group where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, id)
+	}
+}
+
+// ValidateFUOTADeploymentsAccess validates if the client has access to the
+// FUOTA deployments of the given application.
+func ValidateFUOTADeploymentsAccess(applicationID int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create:
+		// global admin
+		// organization admin
+		// application admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = $2"},
+		}
+	case List:
+		// global admin
+		// organization user
+		// application user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = $2"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, applicationID)
+	}
+}
+
+// ValidateFUOTADeploymentAccess validates if the client has access to the
+// given FUOTA deployment.
+func ValidateFUOTADeploymentAccess(id int64, flag Flag) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read:
+		// global admin
+		// organization user
+		// application user
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "a.id = (select application_id from fuota_deployment where id = $2)"},
+			{"u.username = $1", "u.is_active = true", "au.application_id = (select application_id from fuota_deployment where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, id)
+	}
+}
+
+// ValidateAPIKeysAccess validates if the client has access to the API keys
+// of the given organization or application.
+func ValidateAPIKeysAccess(flag Flag, organizationID, applicationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, List:
+		// global admin
+		// organization admin (organization keys)
+		// organization admin (application keys)
+		// application admin (application keys)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "$3 = 0", "o.id = $2"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "$2 = 0", "a.id = $3"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "$2 = 0", "au.application_id = $3"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		// API keys can not be used to manage API keys
+		if claims.APIKeyID != 0 {
+			return false, nil
+		}
+		return executeQuery(db, claims, where, organizationID, applicationID)
+	}
+}
+
+// ValidateAPIKeyAccess validates if the client has access to the given API
+// key.
+func ValidateAPIKeyAccess(flag Flag, id int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Delete:
+		// global admin
+		// organization admin
+		// application admin (application keys)
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "o.id = (select organization_id from api_key where id = $2)"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "a.id = (select application_id from api_key where id = $2)"},
+			{"u.username = $1", "u.is_active = true", "au.is_admin = true", "au.application_id = (select application_id from api_key where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		// API keys can not be used to manage API keys
+		if claims.APIKeyID != 0 {
+			return false, nil
+		}
+		return executeQuery(db, claims, where, id)
+	}
+}
+
+// ValidateAuditLogsAccess validates if the client has access to the audit
+// log of the given organization. An organizationID of 0 refers to the
+// audit log of all organizations.
+func ValidateAuditLogsAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case List:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "o.id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, organizationID)
+	}
+}
+
+// ValidateWebhooksAccess validates if the client has access to the
+// webhooks of the given organization.
+func ValidateWebhooksAccess(flag Flag, organizationID int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Create, List:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "o.id = $2"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, organizationID)
+	}
+}
+
+// ValidateWebhookAccess validates if the client has access to the given
+// webhook.
+func ValidateWebhookAccess(flag Flag, id int64) ValidatorFunc {
+	var where = [][]string{}
+
+	switch flag {
+	case Read, Update, Delete:
+		// global admin
+		// organization admin
+		where = [][]string{
+			{"u.username = $1", "u.is_active = true", "u.is_admin = true"},
+			{"u.username = $1", "u.is_active = true", "ou.is_admin = true", "o.id = (select organization_id from webhook where id = $2)"},
+		}
+	default:
+		panic("unsupported flag")
+	}
+
+	return func(db sqlx.Queryer, claims *Claims) (bool, error) {
+		return executeQuery(db, claims, where, id)
+	}
+}
+
+// executeQuery executes the validation query for the given claims, using
+// the user or API key query. The first argument ($1) is set to the username
+// or API key ID.
+func executeQuery(db sqlx.Queryer, claims *Claims, where [][]string, args ...interface{}) (bool, error) {
+	query := userQuery
+	var id interface{} = claims.Username
+
+	if claims.APIKeyID != 0 {
+		query = apiKeyQuery
+		id = claims.APIKeyID
+		where = apiKeyWhere(where)
+	}
+
+	args = append([]interface{}{id}, args...)
+
 	var ors []string
 	for _, ands := range where {
 		ors = append(ors, "(("+strings.Join(ands, ") and (")+"))")
@@ -789,3 +1433,19 @@ func executeQuery(db sqlx.Queryer, query string, where [][]string, args ...inter
 	}
 	return count > 0, nil
 }
+
+// apiKeyWhere translates the given where clauses to the API key query.
+func apiKeyWhere(where [][]string) [][]string {
+	out := make([][]string, len(where))
+	for i, ands := range where {
+		out[i] = make([]string, len(ands))
+		for j, cond := range ands {
+			if c, ok := apiKeyConditions[cond]; ok {
+				out[i][j] = c
+			} else {
+				out[i][j] = cond
+			}
+		}
+	}
+	return out
+}
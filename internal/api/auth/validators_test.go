@@ -40,6 +40,13 @@ func TestValidators(t *testing.T) {
 	   10: admin of organization 1
 	   11: member of organization 1 (but is_active=false)
 	   12: admin of organization 2
+	   13: device admin of organization 1
+	   14: gateway admin of organization 1
+	   15: integration admin of organization 1
+	   16: viewer of organization 1
+	   17: admin of application 1
+	   18: device admin of application 1
+	   19: viewer of application 1
 
 	   Organizations:
 	   1: organization 1 (can have gateways)
@@ -135,6 +142,13 @@ func TestValidators(t *testing.T) {
 		{ID: 20, Username: "user10", IsActive: true},
 		{ID: 21, Username: "user11", IsActive: false},
 		{ID: 22, Username: "user12", IsActive: true},
+		{ID: 23, Username: "user13", IsActive: true},
+		{ID: 24, Username: "user14", IsActive: true},
+		{ID: 25, Username: "user15", IsActive: true},
+		{ID: 26, Username: "user16", IsActive: true},
+		{ID: 27, Username: "user17", IsActive: true},
+		{ID: 28, Username: "user18", IsActive: true},
+		{ID: 29, Username: "user19", IsActive: true},
 	}
 	for _, user := range users {
 		_, err = db.Exec(`insert into "user" (id, created_at, updated_at, username, password_hash, session_ttl, is_active, is_admin) values ($1, now(), now(), $2, '', 0, $3, $4)`, user.ID, user.Username, user.IsActive, user.IsAdmin)
@@ -147,16 +161,43 @@ func TestValidators(t *testing.T) {
 		UserID         int64
 		OrganizationID int64
 		IsAdmin        bool
+		Roles          storage.Roles
 	}{
 		{UserID: users[8].ID, OrganizationID: organizations[0].ID, IsAdmin: false},
 		{UserID: users[9].ID, OrganizationID: organizations[0].ID, IsAdmin: true},
 		{UserID: users[10].ID, OrganizationID: organizations[0].ID, IsAdmin: false},
 		{UserID: users[11].ID, OrganizationID: organizations[1].ID, IsAdmin: true},
+		{UserID: users[12].ID, OrganizationID: organizations[0].ID, Roles: storage.Roles{IsDeviceAdmin: true}},
+		{UserID: users[13].ID, OrganizationID: organizations[0].ID, Roles: storage.Roles{IsGatewayAdmin: true}},
+		{UserID: users[14].ID, OrganizationID: organizations[0].ID, Roles: storage.Roles{IsIntegrationAdmin: true}},
+		{UserID: users[15].ID, OrganizationID: organizations[0].ID, Roles: storage.Roles{IsViewer: true}},
 	}
 	for _, orgUser := range orgUsers {
 		if err := storage.CreateOrganizationUser(db, orgUser.OrganizationID, orgUser.UserID, orgUser.IsAdmin); err != nil {
 			t.Fatal(err)
 		}
+		if err := storage.UpdateOrganizationUserRoles(db, orgUser.OrganizationID, orgUser.UserID, orgUser.Roles); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	appUsers := []struct {
+		UserID        int64
+		ApplicationID int64
+		IsAdmin       bool
+		Roles         storage.Roles
+	}{
+		{UserID: users[16].ID, ApplicationID: applications[0].ID, IsAdmin: true},
+		{UserID: users[17].ID, ApplicationID: applications[0].ID, Roles: storage.Roles{IsDeviceAdmin: true}},
+		{UserID: users[18].ID, ApplicationID: applications[0].ID, Roles: storage.Roles{IsViewer: true}},
+	}
+	for _, appUser := range appUsers {
+		if err := storage.CreateApplicationUser(db, appUser.ApplicationID, appUser.UserID, appUser.IsAdmin); err != nil {
+			t.Fatal(err)
+		}
+		if err := storage.UpdateApplicationUserRoles(db, appUser.ApplicationID, appUser.UserID, appUser.Roles); err != nil {
+			t.Fatal(err)
+		}
 	}
 
 	gateways := []storage.Gateway{
@@ -169,6 +210,61 @@ func TestValidators(t *testing.T) {
 		}
 	}
 
+	downlinkSchedules := []storage.DownlinkSchedule{
+		{ApplicationID: applications[0].ID, Name: "schedule-1", Cron: "@daily", FPort: 10},
+	}
+	for i := range downlinkSchedules {
+		if err := storage.CreateDownlinkSchedule(db, &downlinkSchedules[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	multicastGroups := []storage.MulticastGroup{
+		{ApplicationID: applications[0].ID, Name: "mg-1", Frequency: 868100000},
+	}
+	for i := range multicastGroups {
+		if err := storage.CreateMulticastGroup(db, &multicastGroups[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fuotaDeployments := []storage.FUOTADeployment{
+		{ApplicationID: applications[0].ID, MulticastGroupID: multicastGroups[0].ID, Name: "fuota-1", Payload: []byte{1, 2, 3}, FragSize: 10, UnicastTimeout: 60},
+	}
+	for i := range fuotaDeployments {
+		if err := storage.CreateFUOTADeployment(db, &fuotaDeployments[i], nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	apiKeys := []storage.APIKey{
+		{Name: "organization-1-key", OrganizationID: &organizations[0].ID},
+		{Name: "application-1-key", ApplicationID: &applications[0].ID},
+	}
+	for i := range apiKeys {
+		if _, err := storage.CreateAPIKey(db, &apiKeys[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bulkJobs := []storage.DeviceQueueBulkJob{
+		{ApplicationID: applications[0].ID, FPort: 10, Data: []byte{1, 2, 3}},
+	}
+	for i := range bulkJobs {
+		if err := storage.CreateDeviceQueueBulkJob(db, &bulkJobs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	webhooks := []storage.Webhook{
+		{OrganizationID: organizations[0].ID, Name: "webhook-1", URL: "http://localhost/webhook", Secret: "secret"},
+	}
+	for i := range webhooks {
+		if err := storage.CreateWebhook(db, &webhooks[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	Convey("Given a set of test users, applications and devices", t, func() {
 
 		Convey("When testing ValidateUsersAccess (DisableAssignExistingUsers=false)", func() {
@@ -450,6 +546,56 @@ func TestValidators(t *testing.T) {
 			runTests(tests, db)
 		})
 
+		Convey("When testing ValidateDeviceQueueBulkJobsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create",
+					Validators: []ValidatorFunc{ValidateDeviceQueueBulkJobsAccess(applications[0].ID, Create)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can create",
+					Validators: []ValidatorFunc{ValidateDeviceQueueBulkJobsAccess(applications[0].ID, Create)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "other users can not create",
+					Validators: []ValidatorFunc{ValidateDeviceQueueBulkJobsAccess(applications[0].ID, Create)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateDeviceQueueBulkJobAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read",
+					Validators: []ValidatorFunc{ValidateDeviceQueueBulkJobAccess(bulkJobs[0].ID, Read)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateDeviceQueueBulkJobAccess(bulkJobs[0].ID, Read)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "other users can not read",
+					Validators: []ValidatorFunc{ValidateDeviceQueueBulkJobAccess(bulkJobs[0].ID, Read)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
 		Convey("When testing ValidateGatewaysAccess", func() {
 			tests := []validatorTest{
 				{
@@ -754,6 +900,112 @@ func TestValidators(t *testing.T) {
 			runTests(tests, db)
 		})
 
+		Convey("When testing ValidateOrganizationInvitationsAccess", func() {
+			DisableAssignExistingUsers = true
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create, list, update and delete",
+					Validators: []ValidatorFunc{ValidateOrganizationInvitationsAccess(Create, organizations[0].ID), ValidateOrganizationInvitationsAccess(List, organizations[0].ID), ValidateOrganizationInvitationsAccess(Update, organizations[0].ID), ValidateOrganizationInvitationsAccess(Delete, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create, list, update and delete",
+					Validators: []ValidatorFunc{ValidateOrganizationInvitationsAccess(Create, organizations[0].ID), ValidateOrganizationInvitationsAccess(List, organizations[0].ID), ValidateOrganizationInvitationsAccess(Update, organizations[0].ID), ValidateOrganizationInvitationsAccess(Delete, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create, list, update or delete",
+					Validators: []ValidatorFunc{ValidateOrganizationInvitationsAccess(Create, organizations[0].ID), ValidateOrganizationInvitationsAccess(List, organizations[0].ID), ValidateOrganizationInvitationsAccess(Update, organizations[0].ID), ValidateOrganizationInvitationsAccess(Delete, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "normal users can not create, list, update or delete",
+					Validators: []ValidatorFunc{ValidateOrganizationInvitationsAccess(Create, organizations[0].ID), ValidateOrganizationInvitationsAccess(List, organizations[0].ID), ValidateOrganizationInvitationsAccess(Update, organizations[0].ID), ValidateOrganizationInvitationsAccess(Delete, organizations[0].ID)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateOrganizationUsageAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read",
+					Validators: []ValidatorFunc{ValidateOrganizationUsageAccess(Read, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read",
+					Validators: []ValidatorFunc{ValidateOrganizationUsageAccess(Read, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read",
+					Validators: []ValidatorFunc{ValidateOrganizationUsageAccess(Read, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "normal users can not read",
+					Validators: []ValidatorFunc{ValidateOrganizationUsageAccess(Read, organizations[0].ID)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateOrganizationIntegrationAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create, read, update, delete and list",
+					Validators: []ValidatorFunc{ValidateOrganizationIntegrationAccess(Create, organizations[0].ID), ValidateOrganizationIntegrationAccess(Read, organizations[0].ID), ValidateOrganizationIntegrationAccess(Update, organizations[0].ID), ValidateOrganizationIntegrationAccess(Delete, organizations[0].ID), ValidateOrganizationIntegrationAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create, read, update, delete and list",
+					Validators: []ValidatorFunc{ValidateOrganizationIntegrationAccess(Create, organizations[0].ID), ValidateOrganizationIntegrationAccess(Read, organizations[0].ID), ValidateOrganizationIntegrationAccess(Update, organizations[0].ID), ValidateOrganizationIntegrationAccess(Delete, organizations[0].ID), ValidateOrganizationIntegrationAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization integration admin users can create, read, update, delete and list",
+					Validators: []ValidatorFunc{ValidateOrganizationIntegrationAccess(Create, organizations[0].ID), ValidateOrganizationIntegrationAccess(Read, organizations[0].ID), ValidateOrganizationIntegrationAccess(Update, organizations[0].ID), ValidateOrganizationIntegrationAccess(Delete, organizations[0].ID), ValidateOrganizationIntegrationAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user15"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization integration admin users can not read the integrations of other organizations",
+					Validators: []ValidatorFunc{ValidateOrganizationIntegrationAccess(Read, organizations[1].ID)},
+					Claims:     Claims{Username: "user15"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users can not create, read and list",
+					Validators: []ValidatorFunc{ValidateOrganizationIntegrationAccess(Create, organizations[0].ID), ValidateOrganizationIntegrationAccess(Read, organizations[0].ID), ValidateOrganizationIntegrationAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "normal users can not read and list",
+					Validators: []ValidatorFunc{ValidateOrganizationIntegrationAccess(Read, organizations[0].ID), ValidateOrganizationIntegrationAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user4"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
 		Convey("WHen testing ValidateChannelConfigurationAccess", func() {
 			tests := []validatorTest{
 				{
@@ -1045,6 +1297,535 @@ func TestValidators(t *testing.T) {
 
 			runTests(tests, db)
 		})
+
+		Convey("When testing ValidateDownlinkSchedulesAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateDownlinkSchedulesAccess(applications[0].ID, Create), ValidateDownlinkSchedulesAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateDownlinkSchedulesAccess(applications[0].ID, Create), ValidateDownlinkSchedulesAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateDownlinkSchedulesAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateDownlinkSchedulesAccess(applications[0].ID, Create)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "other users can not create and list",
+					Validators: []ValidatorFunc{ValidateDownlinkSchedulesAccess(applications[0].ID, Create), ValidateDownlinkSchedulesAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateDownlinkScheduleAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Read), ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Update), ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Delete)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Read), ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Update), ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Delete)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Read)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not update and delete",
+					Validators: []ValidatorFunc{ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Update), ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Delete)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "other users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Read), ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Update), ValidateDownlinkScheduleAccess(downlinkSchedules[0].ID, Delete)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateMulticastGroupsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(applications[0].ID, Create), ValidateMulticastGroupsAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(applications[0].ID, Create), ValidateMulticastGroupsAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(applications[0].ID, Create)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "other users can not create and list",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(applications[0].ID, Create), ValidateMulticastGroupsAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateMulticastGroupAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(multicastGroups[0].ID, Read), ValidateMulticastGroupAccess(multicastGroups[0].ID, Update), ValidateMulticastGroupAccess(multicastGroups[0].ID, Delete)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(multicastGroups[0].ID, Read), ValidateMulticastGroupAccess(multicastGroups[0].ID, Update), ValidateMulticastGroupAccess(multicastGroups[0].ID, Delete)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(multicastGroups[0].ID, Read)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not update and delete",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(multicastGroups[0].ID, Update), ValidateMulticastGroupAccess(multicastGroups[0].ID, Delete)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "other users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateMulticastGroupAccess(multicastGroups[0].ID, Read), ValidateMulticastGroupAccess(multicastGroups[0].ID, Update), ValidateMulticastGroupAccess(multicastGroups[0].ID, Delete)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateMulticastGroupQueueAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create",
+					Validators: []ValidatorFunc{ValidateMulticastGroupQueueAccess(multicastGroups[0].ID, Create)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can create",
+					Validators: []ValidatorFunc{ValidateMulticastGroupQueueAccess(multicastGroups[0].ID, Create)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "other users can not create",
+					Validators: []ValidatorFunc{ValidateMulticastGroupQueueAccess(multicastGroups[0].ID, Create)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateFUOTADeploymentsAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(applications[0].ID, Create), ValidateFUOTADeploymentsAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(applications[0].ID, Create), ValidateFUOTADeploymentsAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can list",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(applications[0].ID, Create)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "other users can not create and list",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentsAccess(applications[0].ID, Create), ValidateFUOTADeploymentsAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateFUOTADeploymentAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentAccess(fuotaDeployments[0].ID, Read)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can read",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentAccess(fuotaDeployments[0].ID, Read)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "other users can not read",
+					Validators: []ValidatorFunc{ValidateFUOTADeploymentAccess(fuotaDeployments[0].ID, Read)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+		Convey("When testing ValidateAPIKeysAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateAPIKeysAccess(Create, organizations[0].ID, 0), ValidateAPIKeysAccess(List, 0, applications[1].ID), ValidateAPIKeysAccess(List, 0, 0)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list for the organization and its applications",
+					Validators: []ValidatorFunc{ValidateAPIKeysAccess(Create, organizations[0].ID, 0), ValidateAPIKeysAccess(List, 0, applications[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can not create or list for other organizations, applications or without filter",
+					Validators: []ValidatorFunc{ValidateAPIKeysAccess(Create, organizations[1].ID, 0), ValidateAPIKeysAccess(List, 0, applications[1].ID), ValidateAPIKeysAccess(List, 0, 0)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization users can not create or list",
+					Validators: []ValidatorFunc{ValidateAPIKeysAccess(Create, organizations[0].ID, 0), ValidateAPIKeysAccess(List, 0, applications[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization API keys can not create or list",
+					Validators: []ValidatorFunc{ValidateAPIKeysAccess(Create, organizations[0].ID, 0), ValidateAPIKeysAccess(List, organizations[0].ID, 0)},
+					Claims:     Claims{APIKeyID: apiKeys[0].ID},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateAPIKeyAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can delete",
+					Validators: []ValidatorFunc{ValidateAPIKeyAccess(Delete, apiKeys[0].ID), ValidateAPIKeyAccess(Delete, apiKeys[1].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can delete",
+					Validators: []ValidatorFunc{ValidateAPIKeyAccess(Delete, apiKeys[0].ID), ValidateAPIKeyAccess(Delete, apiKeys[1].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not delete",
+					Validators: []ValidatorFunc{ValidateAPIKeyAccess(Delete, apiKeys[0].ID), ValidateAPIKeyAccess(Delete, apiKeys[1].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "admin users of other organizations can not delete",
+					Validators: []ValidatorFunc{ValidateAPIKeyAccess(Delete, apiKeys[0].ID), ValidateAPIKeyAccess(Delete, apiKeys[1].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateWebhooksAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can create and list",
+					Validators: []ValidatorFunc{ValidateWebhooksAccess(Create, organizations[0].ID), ValidateWebhooksAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can create and list",
+					Validators: []ValidatorFunc{ValidateWebhooksAccess(Create, organizations[0].ID), ValidateWebhooksAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization api keys can create and list",
+					Validators: []ValidatorFunc{ValidateWebhooksAccess(Create, organizations[0].ID), ValidateWebhooksAccess(List, organizations[0].ID)},
+					Claims:     Claims{APIKeyID: apiKeys[0].ID},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not create and list",
+					Validators: []ValidatorFunc{ValidateWebhooksAccess(Create, organizations[0].ID), ValidateWebhooksAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "admin users of other organizations can not create and list",
+					Validators: []ValidatorFunc{ValidateWebhooksAccess(Create, organizations[0].ID), ValidateWebhooksAccess(List, organizations[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing ValidateWebhookAccess", func() {
+			tests := []validatorTest{
+				{
+					Name:       "global admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateWebhookAccess(Read, webhooks[0].ID), ValidateWebhookAccess(Update, webhooks[0].ID), ValidateWebhookAccess(Delete, webhooks[0].ID)},
+					Claims:     Claims{Username: "user1"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization admin users can read, update and delete",
+					Validators: []ValidatorFunc{ValidateWebhookAccess(Read, webhooks[0].ID), ValidateWebhookAccess(Update, webhooks[0].ID), ValidateWebhookAccess(Delete, webhooks[0].ID)},
+					Claims:     Claims{Username: "user10"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization users can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateWebhookAccess(Read, webhooks[0].ID), ValidateWebhookAccess(Update, webhooks[0].ID), ValidateWebhookAccess(Delete, webhooks[0].ID)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "admin users of other organizations can not read, update and delete",
+					Validators: []ValidatorFunc{ValidateWebhookAccess(Read, webhooks[0].ID), ValidateWebhookAccess(Update, webhooks[0].ID), ValidateWebhookAccess(Delete, webhooks[0].ID)},
+					Claims:     Claims{Username: "user12"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing the validators using organization roles", func() {
+			tests := []validatorTest{
+				{
+					Name:       "organization device admin can manage the devices of the organization",
+					Validators: []ValidatorFunc{ValidateNodesAccess(applications[0].ID, Create), ValidateNodeAccess(devices[0].DevEUI, Update), ValidateNodeAccess(devices[0].DevEUI, Delete), ValidateNodeActivationAccess(devices[0].DevEUI, Read), ValidateDeviceQueueAccess(devices[0].DevEUI, Create)},
+					Claims:     Claims{Username: "user13"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization device admin can not manage the application, gateways, integrations or devices of other organizations",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[0].ID, Update), ValidateGatewayAccess(Update, gateways[0].MAC), ValidateApplicationIntegrationAccess(applications[0].ID, Create), ValidateNodeAccess(devices[1].DevEUI, Update), ValidateNodesAccess(applications[1].ID, Create)},
+					Claims:     Claims{Username: "user13"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization gateway admin can manage the gateways of the organization",
+					Validators: []ValidatorFunc{ValidateGatewaysAccess(Create, organizations[0].ID), ValidateGatewayAccess(Update, gateways[0].MAC), ValidateGatewayAccess(Delete, gateways[0].MAC)},
+					Claims:     Claims{Username: "user14"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization gateway admin can not manage devices or gateways of other organizations",
+					Validators: []ValidatorFunc{ValidateNodesAccess(applications[0].ID, Create), ValidateNodeAccess(devices[0].DevEUI, Update), ValidateGatewayAccess(Update, gateways[1].MAC), ValidateGatewaysAccess(Create, organizations[1].ID)},
+					Claims:     Claims{Username: "user14"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization integration admin can manage the integrations of the organization applications",
+					Validators: []ValidatorFunc{ValidateApplicationIntegrationAccess(applications[0].ID, Create), ValidateApplicationIntegrationAccess(applications[0].ID, Read), ValidateApplicationIntegrationAccess(applications[0].ID, Update), ValidateApplicationIntegrationAccess(applications[0].ID, Delete), ValidateApplicationIntegrationAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user15"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization integration admin can not manage the application, devices or integrations of other organizations",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[0].ID, Update), ValidateNodeAccess(devices[0].DevEUI, Update), ValidateApplicationIntegrationAccess(applications[1].ID, Read)},
+					Claims:     Claims{Username: "user15"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization viewer can read the organization resources",
+					Validators: []ValidatorFunc{ValidateOrganizationAccess(Read, organizations[0].ID), ValidateApplicationAccess(applications[0].ID, Read), ValidateNodesAccess(applications[0].ID, List), ValidateNodeAccess(devices[0].DevEUI, Read), ValidateDeviceQueueAccess(devices[0].DevEUI, List), ValidateGatewayAccess(Read, gateways[0].MAC)},
+					Claims:     Claims{Username: "user16"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization viewer can not read device activations, multicast group keys, enqueue downlinks or manage integrations",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(applications[0].ID, List), ValidateMulticastGroupAccess(multicastGroups[0].ID, Read), ValidateNodeActivationAccess(devices[0].DevEUI, Read), ValidateDeviceQueueAccess(devices[0].DevEUI, Create), ValidateDeviceQueueAccess(devices[0].DevEUI, Delete), ValidateDeviceQueueBulkJobsAccess(applications[0].ID, Create), ValidateMulticastGroupQueueAccess(multicastGroups[0].ID, Create), ValidateApplicationIntegrationAccess(applications[0].ID, Read)},
+					Claims:     Claims{Username: "user16"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization user can read device activations and enqueue downlinks",
+					Validators: []ValidatorFunc{ValidateNodeActivationAccess(devices[0].DevEUI, Read), ValidateDeviceQueueAccess(devices[0].DevEUI, Create), ValidateDeviceQueueBulkJobsAccess(applications[0].ID, Create), ValidateMulticastGroupQueueAccess(multicastGroups[0].ID, Create)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization user can not manage devices or integrations",
+					Validators: []ValidatorFunc{ValidateNodesAccess(applications[0].ID, Create), ValidateNodeAccess(devices[0].DevEUI, Update), ValidateApplicationIntegrationAccess(applications[0].ID, Read)},
+					Claims:     Claims{Username: "user9"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing the validators using application roles", func() {
+			tests := []validatorTest{
+				{
+					Name:       "application admin can administrate the application",
+					Validators: []ValidatorFunc{ValidateIsApplicationAdmin(applications[0].ID), ValidateApplicationAccess(applications[0].ID, Update), ValidateApplicationIntegrationAccess(applications[0].ID, Update), ValidateApplicationUsersAccess(applications[0].ID, Create), ValidateApplicationUserAccess(applications[0].ID, users[17].ID, Update), ValidateNodesAccess(applications[0].ID, Create), ValidateNodeAccess(devices[0].DevEUI, Delete), ValidateMulticastGroupsAccess(applications[0].ID, Create), ValidateAPIKeysAccess(Create, 0, applications[0].ID)},
+					Claims:     Claims{Username: "user17"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "application admin can not delete the application or access other applications and the organization resources",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[0].ID, Delete), ValidateApplicationAccess(applications[1].ID, Read), ValidateNodeAccess(devices[1].DevEUI, Read), ValidateGatewayAccess(Read, gateways[0].MAC), ValidateOrganizationAccess(Update, organizations[0].ID), ValidateApplicationsAccess(Create, organizations[0].ID), ValidateAPIKeysAccess(Create, organizations[0].ID, 0)},
+					Claims:     Claims{Username: "user17"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "application users can read the application and its organization",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[0].ID, Read), ValidateApplicationsAccess(List, organizations[0].ID), ValidateOrganizationAccess(Read, organizations[0].ID), ValidateNodesAccess(applications[0].ID, List), ValidateNodeAccess(devices[0].DevEUI, Read), ValidateDeviceProfileAccess(Read, deviceProfiles[0].DeviceProfile.DeviceProfileID), ValidateApplicationUsersAccess(applications[0].ID, List)},
+					Claims:     Claims{Username: "user18"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "application device admin can manage the devices of the application",
+					Validators: []ValidatorFunc{ValidateNodesAccess(applications[0].ID, Create), ValidateNodeAccess(devices[0].DevEUI, Update), ValidateNodeAccess(devices[0].DevEUI, Delete), ValidateNodeActivationAccess(devices[0].DevEUI, Read), ValidateDeviceQueueAccess(devices[0].DevEUI, Create)},
+					Claims:     Claims{Username: "user18"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "application device admin can not administrate the application",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[0].ID, Update), ValidateApplicationIntegrationAccess(applications[0].ID, Read), ValidateApplicationUsersAccess(applications[0].ID, Create), ValidateNodesAccess(applications[1].ID, Create)},
+					Claims:     Claims{Username: "user18"},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "application viewer can read the application",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[0].ID, Read), ValidateNodeAccess(devices[0].DevEUI, Read), ValidateDeviceQueueAccess(devices[0].DevEUI, List)},
+					Claims:     Claims{Username: "user19"},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "application viewer can not read device activations, multicast group keys or enqueue downlinks",
+					Validators: []ValidatorFunc{ValidateMulticastGroupsAccess(applications[0].ID, List), ValidateMulticastGroupAccess(multicastGroups[0].ID, Read), ValidateNodeActivationAccess(devices[0].DevEUI, Read), ValidateDeviceQueueAccess(devices[0].DevEUI, Create), ValidateDeviceQueueBulkJobsAccess(applications[0].ID, Create), ValidateMulticastGroupQueueAccess(multicastGroups[0].ID, Create), ValidateNodeAccess(devices[0].DevEUI, Update)},
+					Claims:     Claims{Username: "user19"},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
+
+		Convey("When testing the validators using API keys", func() {
+			tests := []validatorTest{
+				{
+					Name:       "organization API keys have organization admin access to the organization",
+					Validators: []ValidatorFunc{ValidateOrganizationAccess(Update, organizations[0].ID), ValidateApplicationsAccess(Create, organizations[0].ID), ValidateGatewayAccess(Update, gateways[0].MAC), ValidateApplicationAccess(applications[0].ID, Update), ValidateNodeAccess(devices[0].DevEUI, Delete)},
+					Claims:     Claims{APIKeyID: apiKeys[0].ID},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "organization API keys have no access to other organizations",
+					Validators: []ValidatorFunc{ValidateOrganizationAccess(Read, organizations[1].ID), ValidateGatewayAccess(Read, gateways[1].MAC), ValidateApplicationAccess(applications[1].ID, Read), ValidateNodeAccess(devices[1].DevEUI, Read)},
+					Claims:     Claims{APIKeyID: apiKeys[0].ID},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "organization API keys are not global admin and do not represent a user",
+					Validators: []ValidatorFunc{ValidateOrganizationsAccess(Create), ValidateOrganizationAccess(Delete, organizations[0].ID), ValidateUserAccess(users[9].ID, Read), ValidateActiveUser()},
+					Claims:     Claims{APIKeyID: apiKeys[0].ID},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "application API keys have organization user access to the application",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[0].ID, Read), ValidateNodesAccess(applications[0].ID, List), ValidateNodeAccess(devices[0].DevEUI, Read), ValidateDeviceQueueAccess(devices[0].DevEUI, Create)},
+					Claims:     Claims{APIKeyID: apiKeys[1].ID},
+					ExpectedOK: true,
+				},
+				{
+					Name:       "application API keys have no admin access to the application",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[0].ID, Update), ValidateNodesAccess(applications[0].ID, Create), ValidateNodeAccess(devices[0].DevEUI, Delete)},
+					Claims:     Claims{APIKeyID: apiKeys[1].ID},
+					ExpectedOK: false,
+				},
+				{
+					Name:       "application API keys have no access to other applications or the organization resources",
+					Validators: []ValidatorFunc{ValidateApplicationAccess(applications[1].ID, Read), ValidateNodeAccess(devices[1].DevEUI, Read), ValidateDeviceQueueAccess(devices[1].DevEUI, Create), ValidateGatewayAccess(Read, gateways[0].MAC), ValidateGatewaysAccess(List, organizations[0].ID)},
+					Claims:     Claims{APIKeyID: apiKeys[1].ID},
+					ExpectedOK: false,
+				},
+			}
+
+			runTests(tests, db)
+		})
 	})
 }
 
@@ -60,6 +60,13 @@ func (a *DeviceAPI) Create(ctx context.Context, req *pb.CreateDeviceRequest) (*p
 	// as this also performs a remote call to create the node on the
 	// network-server, wrap it in a transaction
 	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		app, err := storage.GetApplication(tx, req.ApplicationID)
+		if err != nil {
+			return err
+		}
+		if err := storage.CheckOrganizationQuota(tx, app.OrganizationID, storage.QuotaDevices); err != nil {
+			return err
+		}
 		return storage.CreateDevice(tx, &d)
 	})
 	if err != nil {
@@ -406,7 +413,7 @@ func (a *DeviceAPI) GetActivation(ctx context.Context, req *pb.GetDeviceActivati
 	}
 
 	if err := a.validator.Validate(ctx,
-		auth.ValidateNodeAccess(devEUI, auth.Read)); err != nil {
+		auth.ValidateNodeActivationAccess(devEUI, auth.Read)); err != nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
@@ -40,9 +40,11 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 	}
 
 	dp := storage.DeviceProfile{
-		OrganizationID:  req.OrganizationID,
-		NetworkServerID: req.NetworkServerID,
-		Name:            req.Name,
+		OrganizationID:          req.OrganizationID,
+		NetworkServerID:         req.NetworkServerID,
+		Name:                    req.Name,
+		ClockSyncEnabled:        req.ClockSyncEnabled,
+		ClockSyncForwardUplinks: req.ClockSyncForwardUplinks,
 		DeviceProfile: backend.DeviceProfile{
 			SupportsClassB:    req.DeviceProfile.SupportsClassB,
 			ClassBTimeout:     int(req.DeviceProfile.ClassBTimeout),
@@ -72,6 +74,9 @@ func (a *DeviceProfileServiceAPI) Create(ctx context.Context, req *pb.CreateDevi
 	// as this also performs a remote call to create the device-profile
 	// on the network-server, wrap it in a transaction
 	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.CheckOrganizationQuota(tx, req.OrganizationID, storage.QuotaDeviceProfiles); err != nil {
+			return err
+		}
 		return storage.CreateDeviceProfile(tx, &dp)
 	})
 	if err != nil {
@@ -97,11 +102,13 @@ func (a *DeviceProfileServiceAPI) Get(ctx context.Context, req *pb.GetDeviceProf
 	}
 
 	resp := pb.GetDeviceProfileResponse{
-		Name:            dp.Name,
-		OrganizationID:  dp.OrganizationID,
-		NetworkServerID: dp.NetworkServerID,
-		CreatedAt:       dp.CreatedAt.Format(time.RFC3339Nano),
-		UpdatedAt:       dp.UpdatedAt.Format(time.RFC3339Nano),
+		Name:                    dp.Name,
+		OrganizationID:          dp.OrganizationID,
+		NetworkServerID:         dp.NetworkServerID,
+		CreatedAt:               dp.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:               dp.UpdatedAt.Format(time.RFC3339Nano),
+		ClockSyncEnabled:        dp.ClockSyncEnabled,
+		ClockSyncForwardUplinks: dp.ClockSyncForwardUplinks,
 		DeviceProfile: &pb.DeviceProfile{
 			DeviceProfileID:   dp.DeviceProfile.DeviceProfileID,
 			SupportsClassB:    dp.DeviceProfile.SupportsClassB,
@@ -150,6 +157,8 @@ func (a *DeviceProfileServiceAPI) Update(ctx context.Context, req *pb.UpdateDevi
 	}
 
 	dp.Name = req.Name
+	dp.ClockSyncEnabled = req.ClockSyncEnabled
+	dp.ClockSyncForwardUplinks = req.ClockSyncForwardUplinks
 	dp.DeviceProfile = backend.DeviceProfile{
 		DeviceProfileID:   req.DeviceProfile.DeviceProfileID,
 		SupportsClassB:    req.DeviceProfile.SupportsClassB,
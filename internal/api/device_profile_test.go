@@ -48,9 +48,10 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 
 		Convey("Then Create creates a device-profile", func() {
 			createReq := pb.CreateDeviceProfileRequest{
-				Name:            "test-dp",
-				OrganizationID:  org.ID,
-				NetworkServerID: n.ID,
+				Name:             "test-dp",
+				OrganizationID:   org.ID,
+				NetworkServerID:  n.ID,
+				ClockSyncEnabled: true,
 				DeviceProfile: &pb.DeviceProfile{
 					SupportsClassB:     true,
 					ClassBTimeout:      10,
@@ -93,6 +94,7 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 				So(getResp.Name, ShouldEqual, createReq.Name)
 				So(getResp.OrganizationID, ShouldEqual, createReq.OrganizationID)
 				So(getResp.NetworkServerID, ShouldEqual, createReq.NetworkServerID)
+				So(getResp.ClockSyncEnabled, ShouldBeTrue)
 				So(getResp.DeviceProfile, ShouldResemble, &pb.DeviceProfile{
 					DeviceProfileID:    createResp.DeviceProfileID,
 					SupportsClassB:     true,
@@ -119,7 +121,8 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 
 			Convey("Then Update updates the device-profile", func() {
 				_, err := api.Update(ctx, &pb.UpdateDeviceProfileRequest{
-					Name: "updated-dp",
+					Name:                    "updated-dp",
+					ClockSyncForwardUplinks: true,
 					DeviceProfile: &pb.DeviceProfile{
 						DeviceProfileID:    createResp.DeviceProfileID,
 						SupportsClassB:     true,
@@ -156,6 +159,8 @@ func TestDeviceProfileServiceAPI(t *testing.T) {
 				})
 				So(err, ShouldBeNil)
 				So(getResp.Name, ShouldEqual, "updated-dp")
+				So(getResp.ClockSyncEnabled, ShouldBeFalse)
+				So(getResp.ClockSyncForwardUplinks, ShouldBeTrue)
 				So(getResp.OrganizationID, ShouldEqual, createReq.OrganizationID)
 				So(getResp.NetworkServerID, ShouldEqual, createReq.NetworkServerID)
 				So(getResp.DeviceProfile, ShouldResemble, &pb.DeviceProfile{
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
@@ -43,13 +44,17 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
+	if req.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 255")
+	}
+
+	dev, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
 	// if JSON object is set, try to encode it to bytes
 	if req.JsonObject != "" {
-		dev, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI)
-		if err != nil {
-			return nil, errToRPCError(err)
-		}
-
 		app, err := storage.GetApplication(config.C.PostgreSQL.DB, dev.ApplicationID)
 		if err != nil {
 			return nil, errToRPCError(err)
@@ -72,16 +77,30 @@ func (d *DeviceQueueAPI) Enqueue(ctx context.Context, req *pb.EnqueueDeviceQueue
 		}
 	}
 
-	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
-		if err := downlink.EnqueueDownlinkPayload(tx, devEUI, req.Reference, req.Confirmed, uint8(req.FPort), req.Data); err != nil {
-			return errors.Wrap(err, "enqueue downlink payload error")
+	if err := downlink.ValidateDevicePayload(config.C.PostgreSQL.DB, dev, uint8(req.FPort), req.Data); err != nil {
+		if downlink.IsValidationError(err) {
+			return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
 		}
-		return nil
-	})
-	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	opts := downlink.QueueItemOptions{
+		Priority: int(req.Priority),
+		DedupKey: req.DedupKey,
+	}
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339Nano, req.ExpiresAt)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "expiresAt: %s", err)
+		}
+		opts.ExpiresAt = &expiresAt
+	}
+
+	err = downlink.EnqueueDownlinkPayloadWithOptions(devEUI, req.Reference, req.Confirmed, uint8(req.FPort), req.Data, opts)
+	if err != nil {
+		return nil, errToRPCError(errors.Wrap(err, "enqueue downlink payload error"))
+	}
+
 	return &pb.EnqueueDeviceQueueItemResponse{}, nil
 }
 
@@ -112,6 +131,10 @@ func (d *DeviceQueueAPI) Flush(ctx context.Context, req *pb.FlushDeviceQueueRequ
 			return errToRPCError(err)
 		}
 
+		if err := storage.FlushDeviceQueueItemMetaForDevEUI(tx, devEUI); err != nil {
+			return errToRPCError(err)
+		}
+
 		_, err := nsClient.FlushDeviceQueueForDevEUI(ctx, &ns.FlushDeviceQueueForDevEUIRequest{
 			DevEUI: devEUI[:],
 		})
@@ -154,6 +177,18 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 	queueItemsResp, err := nsClient.GetDeviceQueueItemsForDevEUI(ctx, &ns.GetDeviceQueueItemsForDevEUIRequest{
 		DevEUI: devEUI[:],
 	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	metas, err := storage.GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	metaMap := make(map[uint32]storage.DeviceQueueItemMeta)
+	for _, m := range metas {
+		metaMap[m.FCnt] = m
+	}
 
 	var resp pb.ListDeviceQueueItemsResponse
 	for _, qi := range queueItemsResp.Items {
@@ -162,12 +197,133 @@ func (d *DeviceQueueAPI) List(ctx context.Context, req *pb.ListDeviceQueueItemsR
 			return nil, errToRPCError(err)
 		}
 
-		resp.Items = append(resp.Items, &pb.DeviceQueueItem{
+		item := pb.DeviceQueueItem{
 			DevEUI:    devEUI.String(),
 			Confirmed: qi.Confirmed,
 			FPort:     qi.FPort,
 			Data:      b,
 			FCnt:      qi.FCnt,
+		}
+
+		if m, ok := metaMap[qi.FCnt]; ok {
+			item.Reference = m.Reference
+			item.Priority = uint32(m.Priority)
+			item.DedupKey = m.DedupKey
+			item.ExpiresAt = formatOptionalTime(m.ExpiresAt)
+		}
+
+		resp.Items = append(resp.Items, &item)
+	}
+
+	return &resp, nil
+}
+
+// EnqueueBulk creates a job which adds the given item to the device-queue
+// of all the devices matching the given selector.
+func (d *DeviceQueueAPI) EnqueueBulk(ctx context.Context, req *pb.EnqueueBulkDeviceQueueItemRequest) (*pb.EnqueueBulkDeviceQueueItemResponse, error) {
+	if err := d.validator.Validate(ctx,
+		auth.ValidateDeviceQueueBulkJobsAccess(req.ApplicationID, auth.Create)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.FPort == 0 || req.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 255")
+	}
+
+	// as all devices belong to the same application, the JSON object
+	// only needs to be encoded once
+	if req.JsonObject != "" {
+		app, err := storage.GetApplication(config.C.PostgreSQL.DB, req.ApplicationID)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		codecPL := codec.NewPayload(app.PayloadCodec, uint8(req.FPort), app.PayloadEncoderScript, app.PayloadDecoderScript)
+		if codecPL == nil {
+			return nil, grpc.Errorf(codes.FailedPrecondition, "no or invalid codec configured for application")
+		}
+
+		err = json.Unmarshal([]byte(req.JsonObject), &codecPL)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		req.Data, err = codecPL.MarshalBinary()
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	// the region and data-rate differ per device, the payload size is
+	// validated per device when the job is processed
+	if err := downlink.ValidatePayload("", "", uint8(req.FPort), req.Data); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	job := storage.DeviceQueueBulkJob{
+		ApplicationID: req.ApplicationID,
+		Reference:     req.Reference,
+		Confirmed:     req.Confirmed,
+		FPort:         uint8(req.FPort),
+		Data:          req.Data,
+	}
+	if req.DeviceProfileID != "" {
+		job.DeviceProfileID = &req.DeviceProfileID
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		return storage.CreateDeviceQueueBulkJob(tx, &job)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EnqueueBulkDeviceQueueItemResponse{
+		Id: job.ID,
+	}, nil
+}
+
+// GetBulkJob returns the progress of the given bulk job.
+func (d *DeviceQueueAPI) GetBulkJob(ctx context.Context, req *pb.GetDeviceQueueBulkJobRequest) (*pb.GetDeviceQueueBulkJobResponse, error) {
+	if err := d.validator.Validate(ctx,
+		auth.ValidateDeviceQueueBulkJobAccess(req.Id, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	job, err := storage.GetDeviceQueueBulkJob(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	count, err := storage.GetDeviceQueueBulkJobCount(config.C.PostgreSQL.DB, job.ID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	devices, err := storage.GetDeviceQueueBulkJobDevices(config.C.PostgreSQL.DB, job.ID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetDeviceQueueBulkJobResponse{
+		Id:            job.ID,
+		ApplicationID: job.ApplicationID,
+		CreatedAt:     job.CreatedAt.Format(time.RFC3339Nano),
+		TotalCount:    int64(count.Pending + count.Queued + count.Failed),
+		PendingCount:  int64(count.Pending),
+		QueuedCount:   int64(count.Queued),
+		FailedCount:   int64(count.Failed),
+	}
+	if job.DeviceProfileID != nil {
+		resp.DeviceProfileID = *job.DeviceProfileID
+	}
+
+	for _, dev := range devices {
+		resp.Devices = append(resp.Devices, &pb.DeviceQueueBulkJobDevice{
+			DevEUI:    dev.DevEUI.String(),
+			Status:    pb.DeviceQueueBulkJobDeviceStatus(pb.DeviceQueueBulkJobDeviceStatus_value[string(dev.Status)]),
+			Error:     dev.Error,
+			UpdatedAt: dev.UpdatedAt.Format(time.RFC3339Nano),
 		})
 	}
 
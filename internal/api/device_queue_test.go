@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"testing"
+	"time"
 
 	pb "github.com/gusseleet/lora-app-server/api"
 	"github.com/gusseleet/lora-app-server/internal/codec"
@@ -13,6 +14,8 @@ import (
 	"github.com/brocaar/lorawan"
 	"github.com/brocaar/lorawan/backend"
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 )
 
 func TestDownlinkQueueAPI(t *testing.T) {
@@ -23,6 +26,7 @@ func TestDownlinkQueueAPI(t *testing.T) {
 	}
 
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
 
 	Convey("Given a clean database, an organization, application + node and api instance", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
@@ -151,6 +155,153 @@ func TestDownlinkQueueAPI(t *testing.T) {
 			})
 		})
 
+		Convey("When enqueueing a downlink queue item on a reserved fPort", func() {
+			_, err := api.Enqueue(ctx, &pb.EnqueueDeviceQueueItemRequest{
+				DevEUI: d.DevEUI.String(),
+				FPort:  224,
+				Data:   []byte{1, 2, 3, 4},
+			})
+
+			Convey("Then an InvalidArgument error is returned", func() {
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("When enqueueing a downlink queue item with an invalid expiresAt", func() {
+			_, err := api.Enqueue(ctx, &pb.EnqueueDeviceQueueItemRequest{
+				DevEUI:    d.DevEUI.String(),
+				FPort:     10,
+				Data:      []byte{1, 2, 3, 4},
+				ExpiresAt: "tomorrow",
+			})
+
+			Convey("Then an InvalidArgument error is returned", func() {
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+		})
+
+		Convey("When enqueueing a downlink queue item with options", func() {
+			expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+			_, err := api.Enqueue(ctx, &pb.EnqueueDeviceQueueItemRequest{
+				DevEUI:    d.DevEUI.String(),
+				Reference: "test-123",
+				FPort:     10,
+				Data:      []byte{1, 2, 3, 4},
+				ExpiresAt: expiresAt.Format(time.RFC3339Nano),
+				Priority:  2,
+				DedupKey:  "set-setpoint",
+			})
+			So(err, ShouldBeNil)
+			So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+
+			Convey("Then the options are stored", func() {
+				items, err := storage.GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].FCnt, ShouldEqual, 12)
+				So(items[0].Reference, ShouldEqual, "test-123")
+				So(items[0].Priority, ShouldEqual, 2)
+				So(items[0].DedupKey, ShouldEqual, "set-setpoint")
+				So(items[0].ExpiresAt.Equal(expiresAt), ShouldBeTrue)
+			})
+		})
+
+		Convey("Given the device sent its last uplink at SF12BW125 in EU868", func() {
+			d.LastUplinkDataRate = "SF12BW125"
+			So(storage.UpdateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+			nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+				DeviceProfile: &ns.DeviceProfile{
+					RfRegion: "EU868",
+				},
+			}
+
+			Convey("When enqueueing a payload exceeding the maximum payload size", func() {
+				_, err := api.Enqueue(ctx, &pb.EnqueueDeviceQueueItemRequest{
+					DevEUI: d.DevEUI.String(),
+					FPort:  10,
+					Data:   make([]byte, 52),
+				})
+
+				Convey("Then an InvalidArgument error is returned", func() {
+					So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When enqueueing a payload of the maximum payload size", func() {
+				_, err := api.Enqueue(ctx, &pb.EnqueueDeviceQueueItemRequest{
+					DevEUI: d.DevEUI.String(),
+					FPort:  10,
+					Data:   make([]byte, 51),
+				})
+				So(err, ShouldBeNil)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When creating a bulk enqueue job", func() {
+			resp, err := api.EnqueueBulk(ctx, &pb.EnqueueBulkDeviceQueueItemRequest{
+				ApplicationID: app.ID,
+				FPort:         10,
+				Data:          []byte{1, 2, 3, 4},
+			})
+			So(err, ShouldBeNil)
+			So(resp.Id, ShouldBeGreaterThan, 0)
+			So(validator.ctx, ShouldResemble, ctx)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+			Convey("Then GetBulkJob returns the job with a pending device", func() {
+				job, err := api.GetBulkJob(ctx, &pb.GetDeviceQueueBulkJobRequest{
+					Id:    resp.Id,
+					Limit: 10,
+				})
+				So(err, ShouldBeNil)
+				So(job.ApplicationID, ShouldEqual, app.ID)
+				So(job.TotalCount, ShouldEqual, 1)
+				So(job.PendingCount, ShouldEqual, 1)
+				So(job.Devices, ShouldHaveLength, 1)
+				So(job.Devices[0].DevEUI, ShouldEqual, d.DevEUI.String())
+				So(job.Devices[0].Status, ShouldEqual, pb.DeviceQueueBulkJobDeviceStatus_PENDING)
+			})
+		})
+
+		Convey("Then creating a bulk enqueue job with fPort 0 fails", func() {
+			_, err := api.EnqueueBulk(ctx, &pb.EnqueueBulkDeviceQueueItemRequest{
+				ApplicationID: app.ID,
+				Data:          []byte{1, 2, 3, 4},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Then creating a bulk enqueue job with an fPort > 255 fails", func() {
+			_, err := api.EnqueueBulk(ctx, &pb.EnqueueBulkDeviceQueueItemRequest{
+				ApplicationID: app.ID,
+				FPort:         257,
+				Data:          []byte{1, 2, 3, 4},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Then creating a bulk enqueue job with a reserved fPort fails", func() {
+			_, err := api.EnqueueBulk(ctx, &pb.EnqueueBulkDeviceQueueItemRequest{
+				ApplicationID: app.ID,
+				FPort:         224,
+				Data:          []byte{1, 2, 3, 4},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+
+		Convey("Then enqueueing with an fPort > 255 fails", func() {
+			_, err := api.Enqueue(ctx, &pb.EnqueueDeviceQueueItemRequest{
+				DevEUI: d.DevEUI.String(),
+				FPort:  257,
+				Data:   []byte{1, 2, 3, 4},
+			})
+			So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+		})
+
 		Convey("Given a mocked device-queue item", func() {
 			nsClient.GetDeviceQueueItemsForDevEUIResponse = ns.GetDeviceQueueItemsForDevEUIResponse{
 				Items: []*ns.DeviceQueueItem{
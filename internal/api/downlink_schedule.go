@@ -0,0 +1,239 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/gusseleet/lora-app-server/api"
+	"github.com/gusseleet/lora-app-server/internal/api/auth"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/downlink"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// DownlinkScheduleAPI exports the downlink schedule related functions.
+type DownlinkScheduleAPI struct {
+	validator auth.Validator
+}
+
+// NewDownlinkScheduleAPI creates a new DownlinkScheduleAPI.
+func NewDownlinkScheduleAPI(validator auth.Validator) *DownlinkScheduleAPI {
+	return &DownlinkScheduleAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given downlink schedule.
+func (a *DownlinkScheduleAPI) Create(ctx context.Context, req *pb.CreateDownlinkScheduleRequest) (*pb.CreateDownlinkScheduleResponse, error) {
+	if req.DownlinkSchedule == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "downlinkSchedule expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDownlinkSchedulesAccess(req.DownlinkSchedule.ApplicationID, auth.Create),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	s := storage.DownlinkSchedule{
+		ApplicationID: req.DownlinkSchedule.ApplicationID,
+	}
+	if err := setDownlinkSchedule(&s, req.DownlinkSchedule); err != nil {
+		return nil, err
+	}
+
+	if err := storage.CreateDownlinkSchedule(config.C.PostgreSQL.DB, &s); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateDownlinkScheduleResponse{
+		Id: s.ID,
+	}, nil
+}
+
+// Get returns the downlink schedule matching the given id.
+func (a *DownlinkScheduleAPI) Get(ctx context.Context, req *pb.GetDownlinkScheduleRequest) (*pb.GetDownlinkScheduleResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDownlinkScheduleAccess(req.Id, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	s, err := storage.GetDownlinkSchedule(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return downlinkScheduleToResponse(s), nil
+}
+
+// Update updates the given downlink schedule.
+func (a *DownlinkScheduleAPI) Update(ctx context.Context, req *pb.UpdateDownlinkScheduleRequest) (*pb.UpdateDownlinkScheduleResponse, error) {
+	if req.DownlinkSchedule == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "downlinkSchedule expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDownlinkScheduleAccess(req.DownlinkSchedule.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	s, err := storage.GetDownlinkSchedule(config.C.PostgreSQL.DB, req.DownlinkSchedule.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err := setDownlinkSchedule(&s, req.DownlinkSchedule); err != nil {
+		return nil, err
+	}
+
+	if err := storage.UpdateDownlinkSchedule(config.C.PostgreSQL.DB, &s); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.UpdateDownlinkScheduleResponse{}, nil
+}
+
+// Delete deletes the downlink schedule matching the given id.
+func (a *DownlinkScheduleAPI) Delete(ctx context.Context, req *pb.DeleteDownlinkScheduleRequest) (*pb.DeleteDownlinkScheduleResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDownlinkScheduleAccess(req.Id, auth.Delete),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteDownlinkSchedule(config.C.PostgreSQL.DB, req.Id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.DeleteDownlinkScheduleResponse{}, nil
+}
+
+// List lists the downlink schedules of the given application.
+func (a *DownlinkScheduleAPI) List(ctx context.Context, req *pb.ListDownlinkScheduleRequest) (*pb.ListDownlinkScheduleResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateDownlinkSchedulesAccess(req.ApplicationID, auth.List),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetDownlinkScheduleCountForApplicationID(config.C.PostgreSQL.DB, req.ApplicationID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	schedules, err := storage.GetDownlinkSchedulesForApplicationID(config.C.PostgreSQL.DB, req.ApplicationID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListDownlinkScheduleResponse{
+		TotalCount: int64(count),
+	}
+	for _, s := range schedules {
+		resp.Result = append(resp.Result, downlinkScheduleToResponse(s))
+	}
+
+	return &resp, nil
+}
+
+// setDownlinkSchedule sets the fields of the given storage schedule from
+// the API item. It validates the fPort and payload and that the targeted
+// device or device-profile belongs to the application (or its
+// organization) of the schedule.
+func setDownlinkSchedule(s *storage.DownlinkSchedule, item *pb.DownlinkScheduleItem) error {
+	if item.FPort == 0 || item.FPort > 255 {
+		return grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 255")
+	}
+	if len(item.Data) == 0 && item.JsonObject == "" {
+		return grpc.Errorf(codes.InvalidArgument, "data or jsonObject must be set")
+	}
+	if err := downlink.ValidatePayload("", "", uint8(item.FPort), item.Data); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	s.Name = item.Name
+	s.Cron = item.Cron
+	s.FPort = uint8(item.FPort)
+	s.Confirmed = item.Confirmed
+	s.Data = item.Data
+	s.JSONObject = nil
+	s.DevEUI = nil
+	s.DeviceProfileID = nil
+
+	if item.JsonObject != "" {
+		s.JSONObject = json.RawMessage(item.JsonObject)
+	}
+
+	if item.DevEUI != "" {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(item.DevEUI)); err != nil {
+			return grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+		}
+
+		d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI)
+		if err != nil {
+			return errToRPCError(err)
+		}
+		if d.ApplicationID != s.ApplicationID {
+			return grpc.Errorf(codes.InvalidArgument, "device does not belong to the application")
+		}
+
+		s.DevEUI = &devEUI
+	}
+
+	if item.DeviceProfileID != "" {
+		app, err := storage.GetApplication(config.C.PostgreSQL.DB, s.ApplicationID)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
+		dp, err := storage.GetDeviceProfile(config.C.PostgreSQL.DB, item.DeviceProfileID)
+		if err != nil {
+			return errToRPCError(err)
+		}
+		if dp.OrganizationID != app.OrganizationID {
+			return grpc.Errorf(codes.InvalidArgument, "device-profile does not belong to the organization of the application")
+		}
+
+		s.DeviceProfileID = &item.DeviceProfileID
+	}
+
+	return nil
+}
+
+func downlinkScheduleToResponse(s storage.DownlinkSchedule) *pb.GetDownlinkScheduleResponse {
+	resp := pb.GetDownlinkScheduleResponse{
+		DownlinkSchedule: &pb.DownlinkScheduleItem{
+			Id:            s.ID,
+			ApplicationID: s.ApplicationID,
+			Name:          s.Name,
+			Cron:          s.Cron,
+			Confirmed:     s.Confirmed,
+			FPort:         uint32(s.FPort),
+			Data:          s.Data,
+			JsonObject:    string(s.JSONObject),
+		},
+		CreatedAt: s.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: s.UpdatedAt.Format(time.RFC3339Nano),
+		NextRunAt: s.NextRunAt.Format(time.RFC3339Nano),
+	}
+
+	if s.DevEUI != nil {
+		resp.DownlinkSchedule.DevEUI = s.DevEUI.String()
+	}
+	if s.DeviceProfileID != nil {
+		resp.DownlinkSchedule.DeviceProfileID = *s.DeviceProfileID
+	}
+	if s.LastRunAt != nil {
+		resp.LastRunAt = s.LastRunAt.Format(time.RFC3339Nano)
+	}
+
+	return &resp
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/gusseleet/lora-app-server/api"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+func TestSetDownlinkSchedule(t *testing.T) {
+	Convey("Given a set of invalid downlink schedule items", t, func() {
+		tests := []struct {
+			Name string
+			Item pb.DownlinkScheduleItem
+		}{
+			{
+				Name: "fPort 0",
+				Item: pb.DownlinkScheduleItem{Name: "test", Cron: "* * * * *", Data: []byte{1, 2, 3}},
+			},
+			{
+				Name: "fPort > 255",
+				Item: pb.DownlinkScheduleItem{Name: "test", Cron: "* * * * *", FPort: 257, Data: []byte{1, 2, 3}},
+			},
+			{
+				Name: "reserved fPort",
+				Item: pb.DownlinkScheduleItem{Name: "test", Cron: "* * * * *", FPort: 224, Data: []byte{1, 2, 3}},
+			},
+			{
+				Name: "no data or object",
+				Item: pb.DownlinkScheduleItem{Name: "test", Cron: "* * * * *", FPort: 10},
+			},
+		}
+
+		for _, test := range tests {
+			Convey("Then setting the schedule returns InvalidArgument for: "+test.Name, func() {
+				var s storage.DownlinkSchedule
+				So(grpc.Code(setDownlinkSchedule(&s, &test.Item)), ShouldEqual, codes.InvalidArgument)
+			})
+		}
+	})
+}
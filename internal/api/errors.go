@@ -2,6 +2,9 @@ package api
 
 import (
 	"github.com/gusseleet/lora-app-server/internal/handler/httphandler"
+	"github.com/gusseleet/lora-app-server/internal/ldap"
+	"github.com/gusseleet/lora-app-server/internal/mailer"
+	"github.com/gusseleet/lora-app-server/internal/oidc"
 	"github.com/gusseleet/lora-app-server/internal/storage"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
@@ -9,18 +12,65 @@ import (
 )
 
 var errToCode = map[error]codes.Code{
-	storage.ErrAlreadyExists:             codes.AlreadyExists,
-	storage.ErrDoesNotExist:              codes.NotFound,
-	storage.ErrUsedByOtherObjects:        codes.FailedPrecondition,
-	storage.ErrApplicationInvalidName:    codes.InvalidArgument,
-	storage.ErrNodeInvalidName:           codes.InvalidArgument,
-	storage.ErrNodeMaxRXDelay:            codes.InvalidArgument,
-	storage.ErrCFListTooManyChannels:     codes.InvalidArgument,
-	storage.ErrUserInvalidUsername:       codes.InvalidArgument,
-	storage.ErrUserPasswordLength:        codes.InvalidArgument,
-	storage.ErrInvalidUsernameOrPassword: codes.Unauthenticated,
-	storage.ErrInvalidEmail:              codes.InvalidArgument,
-	httphandler.ErrInvalidHeaderName:     codes.InvalidArgument,
+	storage.ErrAlreadyExists:                          codes.AlreadyExists,
+	storage.ErrDoesNotExist:                           codes.NotFound,
+	storage.ErrUsedByOtherObjects:                     codes.FailedPrecondition,
+	storage.ErrApplicationInvalidName:                 codes.InvalidArgument,
+	storage.ErrNodeInvalidName:                        codes.InvalidArgument,
+	storage.ErrNodeMaxRXDelay:                         codes.InvalidArgument,
+	storage.ErrCFListTooManyChannels:                  codes.InvalidArgument,
+	storage.ErrUserInvalidUsername:                    codes.InvalidArgument,
+	storage.ErrUserPasswordLength:                     codes.InvalidArgument,
+	storage.ErrUserPasswordCharacterClasses:           codes.InvalidArgument,
+	storage.ErrUserPasswordBreached:                   codes.InvalidArgument,
+	storage.ErrUserPasswordTooLong:                    codes.InvalidArgument,
+	storage.ErrInvalidUsernameOrPassword:              codes.Unauthenticated,
+	storage.ErrInvalidEmail:                           codes.InvalidArgument,
+	storage.ErrUsernameConflict:                       codes.AlreadyExists,
+	storage.ErrOrganizationInvalidQuota:               codes.InvalidArgument,
+	storage.ErrOrganizationMaxApplications:            codes.ResourceExhausted,
+	storage.ErrOrganizationMaxDevices:                 codes.ResourceExhausted,
+	storage.ErrOrganizationMaxGateways:                codes.ResourceExhausted,
+	storage.ErrOrganizationMaxDeviceProfiles:          codes.ResourceExhausted,
+	storage.ErrOrganizationMaxIntegrations:            codes.ResourceExhausted,
+	storage.ErrOrganizationMaxUplinksPerDay:           codes.ResourceExhausted,
+	storage.ErrApplicationUserGatewayAdmin:            codes.InvalidArgument,
+	storage.ErrDownlinkScheduleInvalidCron:            codes.InvalidArgument,
+	storage.ErrDownlinkScheduleInvalidFPort:           codes.InvalidArgument,
+	storage.ErrDownlinkScheduleInvalidJSONObject:      codes.InvalidArgument,
+	storage.ErrMulticastGroupInvalidFrequency:         codes.InvalidArgument,
+	storage.ErrMulticastGroupInvalidDR:                codes.InvalidArgument,
+	storage.ErrFUOTADeploymentInvalidPayload:          codes.InvalidArgument,
+	storage.ErrFUOTADeploymentInvalidFragSize:         codes.InvalidArgument,
+	storage.ErrFUOTADeploymentTooManyFragments:        codes.InvalidArgument,
+	storage.ErrFUOTADeploymentInvalidMulticastTimeout: codes.InvalidArgument,
+	storage.ErrFUOTADeploymentInvalidUnicastTimeout:   codes.InvalidArgument,
+	storage.ErrDeviceQueueItemInvalidPriority:         codes.InvalidArgument,
+	storage.ErrDeviceQueueItemInvalidExpiresAt:        codes.InvalidArgument,
+	storage.ErrAPIKeyInvalidName:                      codes.InvalidArgument,
+	storage.ErrAPIKeyInvalidScope:                     codes.InvalidArgument,
+	storage.ErrAPIKeyInvalidExpiresAt:                 codes.InvalidArgument,
+	storage.ErrWebhookInvalidName:                     codes.InvalidArgument,
+	storage.ErrWebhookInvalidURL:                      codes.InvalidArgument,
+	storage.ErrWebhookInvalidSecret:                   codes.InvalidArgument,
+	storage.ErrUserTOTPAlreadyEnabled:                 codes.FailedPrecondition,
+	storage.ErrUserTOTPNotEnabled:                     codes.FailedPrecondition,
+	storage.ErrUserTOTPInvalidCode:                    codes.InvalidArgument,
+	storage.ErrInvalidSecondFactorChallenge:           codes.Unauthenticated,
+	storage.ErrLoginLockedOut:                         codes.ResourceExhausted,
+	storage.ErrPasswordResetLimited:                   codes.ResourceExhausted,
+	storage.ErrInvalidRefreshToken:                    codes.Unauthenticated,
+	storage.ErrInvalidPasswordResetToken:              codes.InvalidArgument,
+	storage.ErrInvalidEmailVerificationToken:          codes.InvalidArgument,
+	storage.ErrEmailNotVerified:                       codes.FailedPrecondition,
+	storage.ErrInvalidOrganizationInvitationToken:     codes.InvalidArgument,
+	httphandler.ErrInvalidHeaderName:                  codes.InvalidArgument,
+	oidc.ErrDisabled:                                  codes.FailedPrecondition,
+	oidc.ErrInvalidState:                              codes.Unauthenticated,
+	oidc.ErrInvalidUsername:                           codes.FailedPrecondition,
+	ldap.ErrDisabled:                                  codes.FailedPrecondition,
+	ldap.ErrInvalidUsername:                           codes.InvalidArgument,
+	mailer.ErrDisabled:                                codes.FailedPrecondition,
 }
 
 func errToRPCError(err error) error {
@@ -0,0 +1,212 @@
+package api
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/gusseleet/lora-app-server/api"
+	"github.com/gusseleet/lora-app-server/internal/api/auth"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/fuota"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// FUOTADeploymentServiceAPI exports the FUOTA deployment related functions.
+type FUOTADeploymentServiceAPI struct {
+	validator auth.Validator
+}
+
+// NewFUOTADeploymentServiceAPI creates a new FUOTADeploymentServiceAPI.
+func NewFUOTADeploymentServiceAPI(validator auth.Validator) *FUOTADeploymentServiceAPI {
+	return &FUOTADeploymentServiceAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given FUOTA deployment.
+func (a *FUOTADeploymentServiceAPI) Create(ctx context.Context, req *pb.CreateFUOTADeploymentRequest) (*pb.CreateFUOTADeploymentResponse, error) {
+	if req.FuotaDeployment == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fuotaDeployment expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTADeploymentsAccess(req.FuotaDeployment.ApplicationID, auth.Create),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if config.C.ApplicationServer.Multicast.Sender == nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "multicast is not supported by the network-server and no multicast sender is configured")
+	}
+
+	if len(req.DevEUIs) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "at least one devEUI expected")
+	}
+
+	var devEUIs []lorawan.EUI64
+	for _, s := range req.DevEUIs {
+		var devEUI lorawan.EUI64
+		if err := devEUI.UnmarshalText([]byte(s)); err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+		}
+
+		d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		if d.ApplicationID != req.FuotaDeployment.ApplicationID {
+			return nil, grpc.Errorf(codes.InvalidArgument, "device %s does not belong to the given application", devEUI)
+		}
+
+		devEUIs = append(devEUIs, devEUI)
+	}
+
+	d := storage.FUOTADeployment{
+		ApplicationID:    req.FuotaDeployment.ApplicationID,
+		Name:             req.FuotaDeployment.Name,
+		Payload:          req.FuotaDeployment.Payload,
+		FragSize:         int(req.FuotaDeployment.FragSize),
+		Redundancy:       int(req.FuotaDeployment.Redundancy),
+		MulticastTimeout: int(req.FuotaDeployment.MulticastTimeout),
+		UnicastTimeout:   int(req.FuotaDeployment.UnicastTimeout),
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		return fuota.CreateDeployment(tx, &d, int(req.FuotaDeployment.Frequency), int(req.FuotaDeployment.Dr), devEUIs)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateFUOTADeploymentResponse{
+		Id: d.ID,
+	}, nil
+}
+
+// Get returns the FUOTA deployment matching the given id.
+func (a *FUOTADeploymentServiceAPI) Get(ctx context.Context, req *pb.GetFUOTADeploymentRequest) (*pb.GetFUOTADeploymentResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTADeploymentAccess(req.Id, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	d, err := storage.GetFUOTADeployment(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return fuotaDeploymentToResponse(config.C.PostgreSQL.DB, d)
+}
+
+// List lists the FUOTA deployments of the given application.
+func (a *FUOTADeploymentServiceAPI) List(ctx context.Context, req *pb.ListFUOTADeploymentRequest) (*pb.ListFUOTADeploymentResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTADeploymentsAccess(req.ApplicationID, auth.List),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetFUOTADeploymentCountForApplicationID(config.C.PostgreSQL.DB, req.ApplicationID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	deployments, err := storage.GetFUOTADeploymentsForApplicationID(config.C.PostgreSQL.DB, req.ApplicationID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListFUOTADeploymentResponse{
+		TotalCount: int64(count),
+	}
+	for _, d := range deployments {
+		item, err := fuotaDeploymentToResponse(config.C.PostgreSQL.DB, d)
+		if err != nil {
+			return nil, err
+		}
+		resp.Result = append(resp.Result, item)
+	}
+
+	return &resp, nil
+}
+
+// ListDevices lists the devices (and their progress) of the given FUOTA
+// deployment.
+func (a *FUOTADeploymentServiceAPI) ListDevices(ctx context.Context, req *pb.ListFUOTADeploymentDevicesRequest) (*pb.ListFUOTADeploymentDevicesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateFUOTADeploymentAccess(req.FuotaDeploymentID, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetFUOTADeploymentDeviceCount(config.C.PostgreSQL.DB, req.FuotaDeploymentID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	devices, err := storage.GetFUOTADeploymentDevices(config.C.PostgreSQL.DB, req.FuotaDeploymentID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListFUOTADeploymentDevicesResponse{
+		TotalCount: int64(count),
+	}
+	for _, d := range devices {
+		resp.Result = append(resp.Result, &pb.FUOTADeploymentDevice{
+			DevEUI:                      d.DevEUI.String(),
+			DeviceName:                  d.DeviceName,
+			State:                       pb.FUOTADeploymentDeviceState(pb.FUOTADeploymentDeviceState_value["DEVICE_"+string(d.State)]),
+			ErrorMessage:                d.ErrorMessage,
+			McGroupSetupCompletedAt:     formatOptionalTime(d.McGroupSetupCompletedAt),
+			FragSessionSetupCompletedAt: formatOptionalTime(d.FragSessionSetupCompletedAt),
+			McSessionCompletedAt:        formatOptionalTime(d.McSessionCompletedAt),
+			FragStatusCompletedAt:       formatOptionalTime(d.FragStatusCompletedAt),
+			NbFragReceived:              uint32(d.NbFragReceived),
+			MissingFrag:                 uint32(d.MissingFrag),
+			UpdatedAt:                   d.UpdatedAt.Format(time.RFC3339Nano),
+		})
+	}
+
+	return &resp, nil
+}
+
+func fuotaDeploymentToResponse(db sqlx.Queryer, d storage.FUOTADeployment) (*pb.GetFUOTADeploymentResponse, error) {
+	g, err := storage.GetMulticastGroup(db, d.MulticastGroupID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.GetFUOTADeploymentResponse{
+		FuotaDeployment: &pb.FUOTADeployment{
+			Id:               d.ID,
+			ApplicationID:    d.ApplicationID,
+			Name:             d.Name,
+			Payload:          d.Payload,
+			FragSize:         uint32(d.FragSize),
+			Redundancy:       uint32(d.Redundancy),
+			MulticastTimeout: uint32(d.MulticastTimeout),
+			UnicastTimeout:   uint32(d.UnicastTimeout),
+			Frequency:        uint32(g.Frequency),
+			Dr:               uint32(g.DR),
+			MulticastGroupID: d.MulticastGroupID,
+		},
+		CreatedAt:     d.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:     d.UpdatedAt.Format(time.RFC3339Nano),
+		State:         pb.FUOTADeploymentState(pb.FUOTADeploymentState_value[string(d.State)]),
+		NextStepAfter: d.NextStepAfter.Format(time.RFC3339Nano),
+	}, nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339Nano)
+}
@@ -58,6 +58,11 @@ func (a *GatewayAPI) Create(ctx context.Context, req *pb.CreateGatewayRequest) (
 	}
 
 	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		err = storage.CheckOrganizationQuota(tx, req.OrganizationID, storage.QuotaGateways)
+		if err != nil {
+			return errToRPCError(err)
+		}
+
 		err = storage.CreateGateway(tx, &storage.Gateway{
 			MAC:             mac,
 			Name:            req.Name,
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/gusseleet/lora-app-server/internal/join"
@@ -16,9 +17,12 @@ import (
 // backend interfaces specification.
 type JoinServerAPI struct{}
 
-// NewJoinServerAPI create a new JoinServerAPI.
+// NewJoinServerAPI create a new JoinServerAPI. The returned handler is
+// instrumented with the join-server API request metrics.
 func NewJoinServerAPI() http.Handler {
-	return &JoinServerAPI{}
+	return promhttp.InstrumentHandlerCounter(joinServerAPIRequestCounter,
+		promhttp.InstrumentHandlerDuration(joinServerAPIRequestDuration, &JoinServerAPI{}),
+	)
 }
 
 // ServeHTTP implements the http.Handler interface.
@@ -25,6 +25,7 @@ func TestJoinServerAPI(t *testing.T) {
 		t.Fatal(err)
 	}
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
 
 	Convey("Given a clean database with a device", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
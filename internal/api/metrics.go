@@ -0,0 +1,28 @@
+package api
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	uplinkHandleDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "lora_app_server_uplink_handle_duration_seconds",
+		Help: "The duration of handling an uplink received from the network-server (the count is the number of uplinks handled).",
+	})
+
+	codecDecodeErrorCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "lora_app_server_codec_decode_errors_total",
+		Help: "The number of uplink payloads which could not be decoded by the payload codec of the application.",
+	}, []string{"codec"})
+
+	joinServerAPIRequestCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "lora_app_server_join_server_api_requests_total",
+		Help: "The number of join-server API requests, per HTTP status code.",
+	}, []string{"code"})
+
+	joinServerAPIRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "lora_app_server_join_server_api_request_duration_seconds",
+		Help: "The duration of handling a join-server API request, per HTTP status code.",
+	}, []string{"code"})
+)
@@ -0,0 +1,303 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/gusseleet/lora-app-server/api"
+	"github.com/gusseleet/lora-app-server/internal/api/auth"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/downlink"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// MulticastGroupServiceAPI exports the multicast group related functions.
+type MulticastGroupServiceAPI struct {
+	validator auth.Validator
+}
+
+// NewMulticastGroupServiceAPI creates a new MulticastGroupServiceAPI.
+func NewMulticastGroupServiceAPI(validator auth.Validator) *MulticastGroupServiceAPI {
+	return &MulticastGroupServiceAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given multicast group.
+func (a *MulticastGroupServiceAPI) Create(ctx context.Context, req *pb.CreateMulticastGroupRequest) (*pb.CreateMulticastGroupResponse, error) {
+	if req.MulticastGroup == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicastGroup expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupsAccess(req.MulticastGroup.ApplicationID, auth.Create),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	g := storage.MulticastGroup{
+		ApplicationID: req.MulticastGroup.ApplicationID,
+	}
+	if err := setMulticastGroup(&g, req.MulticastGroup); err != nil {
+		return nil, err
+	}
+
+	if err := storage.CreateMulticastGroup(config.C.PostgreSQL.DB, &g); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateMulticastGroupResponse{
+		Id: g.ID,
+	}, nil
+}
+
+// Get returns the multicast group matching the given id.
+func (a *MulticastGroupServiceAPI) Get(ctx context.Context, req *pb.GetMulticastGroupRequest) (*pb.GetMulticastGroupResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(req.Id, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	g, err := storage.GetMulticastGroup(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return multicastGroupToResponse(g), nil
+}
+
+// Update updates the given multicast group.
+func (a *MulticastGroupServiceAPI) Update(ctx context.Context, req *pb.UpdateMulticastGroupRequest) (*pb.UpdateMulticastGroupResponse, error) {
+	if req.MulticastGroup == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "multicastGroup expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(req.MulticastGroup.Id, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	g, err := storage.GetMulticastGroup(config.C.PostgreSQL.DB, req.MulticastGroup.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err := setMulticastGroup(&g, req.MulticastGroup); err != nil {
+		return nil, err
+	}
+
+	if err := storage.UpdateMulticastGroup(config.C.PostgreSQL.DB, &g); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.UpdateMulticastGroupResponse{}, nil
+}
+
+// Delete deletes the multicast group matching the given id.
+func (a *MulticastGroupServiceAPI) Delete(ctx context.Context, req *pb.DeleteMulticastGroupRequest) (*pb.DeleteMulticastGroupResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(req.Id, auth.Delete),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteMulticastGroup(config.C.PostgreSQL.DB, req.Id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.DeleteMulticastGroupResponse{}, nil
+}
+
+// List lists the multicast groups of the given application.
+func (a *MulticastGroupServiceAPI) List(ctx context.Context, req *pb.ListMulticastGroupRequest) (*pb.ListMulticastGroupResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupsAccess(req.ApplicationID, auth.List),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetMulticastGroupCountForApplicationID(config.C.PostgreSQL.DB, req.ApplicationID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	groups, err := storage.GetMulticastGroupsForApplicationID(config.C.PostgreSQL.DB, req.ApplicationID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListMulticastGroupResponse{
+		TotalCount: int64(count),
+	}
+	for _, g := range groups {
+		resp.Result = append(resp.Result, multicastGroupToResponse(g))
+	}
+
+	return &resp, nil
+}
+
+// AddDevice adds the given device to the multicast group.
+func (a *MulticastGroupServiceAPI) AddDevice(ctx context.Context, req *pb.AddDeviceToMulticastGroupRequest) (*pb.AddDeviceToMulticastGroupResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(req.MulticastGroupID, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	g, err := storage.GetMulticastGroup(config.C.PostgreSQL.DB, req.MulticastGroupID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	if d.ApplicationID != g.ApplicationID {
+		return nil, grpc.Errorf(codes.InvalidArgument, "device does not belong to the application of the multicast group")
+	}
+
+	if err := storage.AddDeviceToMulticastGroup(config.C.PostgreSQL.DB, g.ID, devEUI); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.AddDeviceToMulticastGroupResponse{}, nil
+}
+
+// RemoveDevice removes the given device from the multicast group.
+func (a *MulticastGroupServiceAPI) RemoveDevice(ctx context.Context, req *pb.RemoveDeviceFromMulticastGroupRequest) (*pb.RemoveDeviceFromMulticastGroupResponse, error) {
+	var devEUI lorawan.EUI64
+	if err := devEUI.UnmarshalText([]byte(req.DevEUI)); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "devEUI: %s", err)
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(req.MulticastGroupID, auth.Update),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.RemoveDeviceFromMulticastGroup(config.C.PostgreSQL.DB, req.MulticastGroupID, devEUI); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.RemoveDeviceFromMulticastGroupResponse{}, nil
+}
+
+// ListDevices lists the devices of the given multicast group.
+func (a *MulticastGroupServiceAPI) ListDevices(ctx context.Context, req *pb.ListMulticastGroupDevicesRequest) (*pb.ListMulticastGroupDevicesResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupAccess(req.MulticastGroupID, auth.Read),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetMulticastGroupDeviceCount(config.C.PostgreSQL.DB, req.MulticastGroupID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	devices, err := storage.GetMulticastGroupDevices(config.C.PostgreSQL.DB, req.MulticastGroupID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListMulticastGroupDevicesResponse{
+		TotalCount: int64(count),
+	}
+	for _, d := range devices {
+		resp.Result = append(resp.Result, &pb.MulticastGroupDevice{
+			DevEUI:    d.DevEUI.String(),
+			Name:      d.DeviceName,
+			CreatedAt: d.CreatedAt.Format(time.RFC3339Nano),
+		})
+	}
+
+	return &resp, nil
+}
+
+// Enqueue encrypts the given payload with the multicast group keys and
+// sends it to the devices of the multicast group.
+func (a *MulticastGroupServiceAPI) Enqueue(ctx context.Context, req *pb.EnqueueMulticastQueueItemRequest) (*pb.EnqueueMulticastQueueItemResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateMulticastGroupQueueAccess(req.MulticastGroupID, auth.Create),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.FPort > 255 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "fPort must be between 1 and 223")
+	}
+
+	// the data-rate of the multicast group is not known, only the fPort is
+	// validated
+	if err := downlink.ValidatePayload("", "", uint8(req.FPort), req.Data); err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	if config.C.ApplicationServer.Multicast.Sender == nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "multicast is not supported by the network-server and no multicast sender is configured")
+	}
+
+	// The frame-counter is incremented by a single statement, this is not
+	// wrapped in a transaction so that the multicast group row is not
+	// locked while the payload is sent to the multicast sender.
+	fCnt, err := downlink.EnqueueMulticastPayload(config.C.PostgreSQL.DB, req.MulticastGroupID, uint8(req.FPort), req.Data)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EnqueueMulticastQueueItemResponse{
+		FCnt: fCnt,
+	}, nil
+}
+
+// setMulticastGroup sets the fields of the given storage multicast group
+// from the API item.
+func setMulticastGroup(g *storage.MulticastGroup, item *pb.MulticastGroup) error {
+	if err := g.McAddr.UnmarshalText([]byte(item.McAddr)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "mcAddr: %s", err)
+	}
+	if err := g.McAppSKey.UnmarshalText([]byte(item.McAppSKey)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "mcAppSKey: %s", err)
+	}
+	if err := g.McNwkSKey.UnmarshalText([]byte(item.McNwkSKey)); err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "mcNwkSKey: %s", err)
+	}
+
+	g.Name = item.Name
+	g.FCnt = item.FCnt
+	g.Frequency = int(item.Frequency)
+	g.DR = int(item.Dr)
+
+	return nil
+}
+
+func multicastGroupToResponse(g storage.MulticastGroup) *pb.GetMulticastGroupResponse {
+	return &pb.GetMulticastGroupResponse{
+		MulticastGroup: &pb.MulticastGroup{
+			Id:            g.ID,
+			ApplicationID: g.ApplicationID,
+			Name:          g.Name,
+			McAddr:        g.McAddr.String(),
+			McAppSKey:     g.McAppSKey.String(),
+			McNwkSKey:     g.McNwkSKey.String(),
+			FCnt:          g.FCnt,
+			Frequency:     uint32(g.Frequency),
+			Dr:            uint32(g.DR),
+		},
+		CreatedAt: g.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: g.UpdatedAt.Format(time.RFC3339Nano),
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -11,6 +12,9 @@ import (
 	pb "github.com/gusseleet/lora-app-server/api"
 	"github.com/gusseleet/lora-app-server/internal/api/auth"
 	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/handler"
+	"github.com/gusseleet/lora-app-server/internal/handler/httphandler"
+	"github.com/gusseleet/lora-app-server/internal/mailer"
 	"github.com/gusseleet/lora-app-server/internal/storage"
 )
 
@@ -34,9 +38,13 @@ func (a *OrganizationAPI) Create(ctx context.Context, req *pb.CreateOrganization
 	}
 
 	org := storage.Organization{
-		Name:            req.Name,
-		DisplayName:     req.DisplayName,
-		CanHaveGateways: req.CanHaveGateways,
+		Name:                req.Name,
+		DisplayName:         req.DisplayName,
+		CanHaveGateways:     req.CanHaveGateways,
+		RequireSecondFactor: req.RequireSecondFactor,
+	}
+	if req.Quota != nil {
+		org.OrganizationQuota = organizationQuotaFromPB(req.Quota)
 	}
 
 	err := storage.CreateOrganization(config.C.PostgreSQL.DB, &org)
@@ -61,13 +69,40 @@ func (a *OrganizationAPI) Get(ctx context.Context, req *pb.OrganizationRequest)
 		return nil, errToRPCError(err)
 	}
 
+	usage, err := storage.GetOrganizationUsage(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	usage.UplinksToday, err = storage.GetOrganizationUplinkCount(config.C.Redis.Pool, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
 	return &pb.GetOrganizationResponse{
-		Id:              org.ID,
-		Name:            org.Name,
-		DisplayName:     org.DisplayName,
-		CanHaveGateways: org.CanHaveGateways,
-		CreatedAt:       org.CreatedAt.Format(time.RFC3339Nano),
-		UpdatedAt:       org.UpdatedAt.Format(time.RFC3339Nano),
+		Id:                  org.ID,
+		Name:                org.Name,
+		DisplayName:         org.DisplayName,
+		CanHaveGateways:     org.CanHaveGateways,
+		CreatedAt:           org.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:           org.UpdatedAt.Format(time.RFC3339Nano),
+		RequireSecondFactor: org.RequireSecondFactor,
+		Quota: &pb.OrganizationQuota{
+			MaxApplications:   int32(org.MaxApplications),
+			MaxDevices:        int32(org.MaxDevices),
+			MaxGateways:       int32(org.MaxGateways),
+			MaxDeviceProfiles: int32(org.MaxDeviceProfiles),
+			MaxIntegrations:   int32(org.MaxIntegrations),
+			MaxUplinksPerDay:  int32(org.MaxUplinksPerDay),
+		},
+		Usage: &pb.OrganizationUsage{
+			Applications:   int32(usage.Applications),
+			Devices:        int32(usage.Devices),
+			Gateways:       int32(usage.Gateways),
+			DeviceProfiles: int32(usage.DeviceProfiles),
+			Integrations:   int32(usage.Integrations),
+			UplinksToday:   int32(usage.UplinksToday),
+		},
 	}, nil
 }
 
@@ -114,12 +149,13 @@ func (a *OrganizationAPI) List(ctx context.Context, req *pb.ListOrganizationRequ
 	result := make([]*pb.GetOrganizationResponse, len(orgs))
 	for i, org := range orgs {
 		result[i] = &pb.GetOrganizationResponse{
-			Id:              org.ID,
-			Name:            org.Name,
-			DisplayName:     org.DisplayName,
-			CanHaveGateways: org.CanHaveGateways,
-			CreatedAt:       org.CreatedAt.Format(time.RFC3339Nano),
-			UpdatedAt:       org.UpdatedAt.Format(time.RFC3339Nano),
+			Id:                  org.ID,
+			Name:                org.Name,
+			DisplayName:         org.DisplayName,
+			CanHaveGateways:     org.CanHaveGateways,
+			CreatedAt:           org.CreatedAt.Format(time.RFC3339Nano),
+			UpdatedAt:           org.UpdatedAt.Format(time.RFC3339Nano),
+			RequireSecondFactor: org.RequireSecondFactor,
 		}
 	}
 
@@ -148,8 +184,12 @@ func (a *OrganizationAPI) Update(ctx context.Context, req *pb.UpdateOrganization
 
 	org.Name = req.Name
 	org.DisplayName = req.DisplayName
+	org.RequireSecondFactor = req.RequireSecondFactor
 	if isAdmin {
 		org.CanHaveGateways = req.CanHaveGateways
+		if req.Quota != nil {
+			org.OrganizationQuota = organizationQuotaFromPB(req.Quota)
+		}
 	}
 
 	err = storage.UpdateOrganization(config.C.PostgreSQL.DB, &org)
@@ -204,11 +244,15 @@ func (a *OrganizationAPI) ListUsers(ctx context.Context, req *pb.ListOrganizatio
 	result := make([]*pb.GetOrganizationUserResponse, len(users))
 	for i, user := range users {
 		result[i] = &pb.GetOrganizationUserResponse{
-			Id:        user.UserID,
-			Username:  user.Username,
-			IsAdmin:   user.IsAdmin,
-			CreatedAt: user.CreatedAt.Format(time.RFC3339Nano),
-			UpdatedAt: user.UpdatedAt.Format(time.RFC3339Nano),
+			Id:                 user.UserID,
+			Username:           user.Username,
+			IsAdmin:            user.IsAdmin,
+			IsDeviceAdmin:      user.IsDeviceAdmin,
+			IsGatewayAdmin:     user.IsGatewayAdmin,
+			IsIntegrationAdmin: user.IsIntegrationAdmin,
+			IsViewer:           user.IsViewer,
+			CreatedAt:          user.CreatedAt.Format(time.RFC3339Nano),
+			UpdatedAt:          user.UpdatedAt.Format(time.RFC3339Nano),
 		}
 	}
 
@@ -225,7 +269,12 @@ func (a *OrganizationAPI) AddUser(ctx context.Context, req *pb.OrganizationUserR
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	err := storage.CreateOrganizationUser(config.C.PostgreSQL.DB, req.Id, req.UserID, req.IsAdmin)
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.CreateOrganizationUser(tx, req.Id, req.UserID, req.IsAdmin); err != nil {
+			return err
+		}
+		return storage.UpdateOrganizationUserRoles(tx, req.Id, req.UserID, organizationUserRoles(req))
+	})
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
@@ -240,7 +289,12 @@ func (a *OrganizationAPI) UpdateUser(ctx context.Context, req *pb.OrganizationUs
 		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
 	}
 
-	err := storage.UpdateOrganizationUser(config.C.PostgreSQL.DB, req.Id, req.UserID, req.IsAdmin)
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.UpdateOrganizationUser(tx, req.Id, req.UserID, req.IsAdmin); err != nil {
+			return err
+		}
+		return storage.UpdateOrganizationUserRoles(tx, req.Id, req.UserID, organizationUserRoles(req))
+	})
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
@@ -276,10 +330,429 @@ func (a *OrganizationAPI) GetUser(ctx context.Context, req *pb.GetOrganizationUs
 	}
 
 	return &pb.GetOrganizationUserResponse{
-		Id:        user.UserID,
-		Username:  user.Username,
-		IsAdmin:   user.IsAdmin,
-		CreatedAt: user.CreatedAt.Format(time.RFC3339Nano),
-		UpdatedAt: user.UpdatedAt.Format(time.RFC3339Nano),
+		Id:                 user.UserID,
+		Username:           user.Username,
+		IsAdmin:            user.IsAdmin,
+		IsDeviceAdmin:      user.IsDeviceAdmin,
+		IsGatewayAdmin:     user.IsGatewayAdmin,
+		IsIntegrationAdmin: user.IsIntegrationAdmin,
+		IsViewer:           user.IsViewer,
+		CreatedAt:          user.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt:          user.UpdatedAt.Format(time.RFC3339Nano),
+	}, nil
+}
+
+// CreateInvitation invites the given e-mail address to the organization.
+func (a *OrganizationAPI) CreateInvitation(ctx context.Context, req *pb.CreateOrganizationInvitationRequest) (*pb.CreateOrganizationInvitationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationInvitationsAccess(auth.Create, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if config.C.ApplicationServer.Mailer.Mailer == nil {
+		return nil, errToRPCError(mailer.ErrDisabled)
+	}
+
+	invitedBy, err := a.getInvitedBy(ctx)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	inv := storage.OrganizationInvitation{
+		Roles: storage.Roles{
+			IsDeviceAdmin:      req.IsDeviceAdmin,
+			IsGatewayAdmin:     req.IsGatewayAdmin,
+			IsIntegrationAdmin: req.IsIntegrationAdmin,
+			IsViewer:           req.IsViewer,
+		},
+		OrganizationID: req.Id,
+		Email:          req.Email,
+		IsAdmin:        req.IsAdmin,
+		InvitedBy:      invitedBy,
+	}
+
+	// the invitation is not stored when it could not be sent
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		token, err := storage.CreateOrganizationInvitation(tx, &inv)
+		if err != nil {
+			return err
+		}
+		return sendOrganizationInvitation(tx, inv, token)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateOrganizationInvitationResponse{
+		InvitationID: inv.ID,
+	}, nil
+}
+
+// ListInvitations lists the invitations of the organization.
+func (a *OrganizationAPI) ListInvitations(ctx context.Context, req *pb.ListOrganizationInvitationsRequest) (*pb.ListOrganizationInvitationsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationInvitationsAccess(auth.List, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	invitations, err := storage.GetOrganizationInvitations(config.C.PostgreSQL.DB, req.Id, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	count, err := storage.GetOrganizationInvitationCount(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	result := make([]*pb.OrganizationInvitation, len(invitations))
+	for i, inv := range invitations {
+		result[i] = &pb.OrganizationInvitation{
+			InvitationID:       inv.ID,
+			Email:              inv.Email,
+			IsAdmin:            inv.IsAdmin,
+			IsDeviceAdmin:      inv.IsDeviceAdmin,
+			IsGatewayAdmin:     inv.IsGatewayAdmin,
+			IsIntegrationAdmin: inv.IsIntegrationAdmin,
+			IsViewer:           inv.IsViewer,
+			InvitedBy:          inv.InvitedBy,
+			CreatedAt:          inv.CreatedAt.Format(time.RFC3339Nano),
+			UpdatedAt:          inv.UpdatedAt.Format(time.RFC3339Nano),
+			ExpiresAt:          inv.ExpiresAt.Format(time.RFC3339Nano),
+		}
+	}
+
+	return &pb.ListOrganizationInvitationsResponse{
+		TotalCount: int32(count),
+		Result:     result,
 	}, nil
 }
+
+// ResendInvitation resends the given invitation, with a new token and
+// expiration.
+func (a *OrganizationAPI) ResendInvitation(ctx context.Context, req *pb.OrganizationInvitationRequest) (*pb.OrganizationEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationInvitationsAccess(auth.Update, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if config.C.ApplicationServer.Mailer.Mailer == nil {
+		return nil, errToRPCError(mailer.ErrDisabled)
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := checkOrganizationInvitation(tx, req.Id, req.InvitationID); err != nil {
+			return err
+		}
+
+		inv, token, err := storage.RenewOrganizationInvitation(tx, req.InvitationID)
+		if err != nil {
+			return err
+		}
+		return sendOrganizationInvitation(tx, inv, token)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.OrganizationEmptyResponse{}, nil
+}
+
+// DeleteInvitation revokes the given invitation.
+func (a *OrganizationAPI) DeleteInvitation(ctx context.Context, req *pb.OrganizationInvitationRequest) (*pb.OrganizationEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationInvitationsAccess(auth.Delete, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := checkOrganizationInvitation(tx, req.Id, req.InvitationID); err != nil {
+			return err
+		}
+		return storage.DeleteOrganizationInvitation(tx, req.InvitationID)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.OrganizationEmptyResponse{}, nil
+}
+
+// GetUsage returns the usage of the organization per application and day.
+// When no start date is given, the usage of the current month is returned.
+func (a *OrganizationAPI) GetUsage(ctx context.Context, req *pb.GetOrganizationUsageRequest) (*pb.GetOrganizationUsageResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationUsageAccess(auth.Read, req.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	now := time.Now().UTC()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if req.StartDate != "" {
+		var err error
+		start, err = time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "startDate: %s", err)
+		}
+	}
+
+	end := start.AddDate(0, 1, 0)
+	if req.EndDate != "" {
+		var err error
+		end, err = time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "endDate: %s", err)
+		}
+	}
+
+	// include the counters which have not been written to the database yet
+	if err := storage.FlushUsage(config.C.PostgreSQL.DB, config.C.Redis.Pool); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	records, err := storage.GetUsageRecords(config.C.PostgreSQL.DB, req.Id, start, end)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.GetOrganizationUsageResponse{
+		Result: []*pb.OrganizationUsageRecord{},
+	}
+	for _, r := range records {
+		resp.Result = append(resp.Result, &pb.OrganizationUsageRecord{
+			Date:            r.Date.Format("2006-01-02"),
+			ApplicationID:   r.ApplicationID,
+			ApplicationName: r.ApplicationName,
+			Uplinks:         r.Uplinks,
+			UplinkBytes:     r.UplinkBytes,
+			Downlinks:       r.Downlinks,
+			DownlinkBytes:   r.DownlinkBytes,
+			Joins:           r.Joins,
+			ActiveDevices:   r.ActiveDevices,
+		})
+	}
+
+	return &resp, nil
+}
+
+// CreateHTTPIntegration creates an HTTP organization-integration.
+func (a *OrganizationAPI) CreateHTTPIntegration(ctx context.Context, in *pb.HTTPIntegration) (*pb.OrganizationEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationIntegrationAccess(auth.Create, in.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	confJSON, err := httpIntegrationSettings(in)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration := storage.OrganizationIntegration{
+		OrganizationID: in.Id,
+		Kind:           handler.HTTPHandlerKind,
+		Settings:       confJSON,
+	}
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.CheckOrganizationQuota(tx, in.Id, storage.QuotaIntegrations); err != nil {
+			return err
+		}
+		return storage.CreateOrganizationIntegration(tx, &integration)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.OrganizationEmptyResponse{}, nil
+}
+
+// GetHTTPIntegration returns the HTTP organization-integration.
+func (a *OrganizationAPI) GetHTTPIntegration(ctx context.Context, in *pb.GetHTTPIntegrationRequest) (*pb.HTTPIntegration, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationIntegrationAccess(auth.Read, in.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(config.C.PostgreSQL.DB, in.Id, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var conf httphandler.HandlerConfig
+	if err = json.Unmarshal(integration.Settings, &conf); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var headers []*pb.HTTPIntegrationHeader
+	for k, v := range conf.Headers {
+		headers = append(headers, &pb.HTTPIntegrationHeader{
+			Key:   k,
+			Value: v,
+		})
+	}
+
+	return &pb.HTTPIntegration{
+		Id:                   integration.OrganizationID,
+		Headers:              headers,
+		DataUpURL:            conf.DataUpURL,
+		JoinNotificationURL:  conf.JoinNotificationURL,
+		AckNotificationURL:   conf.ACKNotificationURL,
+		ErrorNotificationURL: conf.ErrorNotificationURL,
+	}, nil
+}
+
+// UpdateHTTPIntegration updates the HTTP organization-integration.
+func (a *OrganizationAPI) UpdateHTTPIntegration(ctx context.Context, in *pb.HTTPIntegration) (*pb.OrganizationEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationIntegrationAccess(auth.Update, in.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(config.C.PostgreSQL.DB, in.Id, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	integration.Settings, err = httpIntegrationSettings(in)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.UpdateOrganizationIntegration(config.C.PostgreSQL.DB, &integration); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.OrganizationEmptyResponse{}, nil
+}
+
+// DeleteHTTPIntegration deletes the HTTP organization-integration.
+func (a *OrganizationAPI) DeleteHTTPIntegration(ctx context.Context, in *pb.DeleteIntegrationRequest) (*pb.OrganizationEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationIntegrationAccess(auth.Delete, in.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integration, err := storage.GetOrganizationIntegrationByOrganizationID(config.C.PostgreSQL.DB, in.Id, handler.HTTPHandlerKind)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err = storage.DeleteOrganizationIntegration(config.C.PostgreSQL.DB, integration.ID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.OrganizationEmptyResponse{}, nil
+}
+
+// ListIntegrations lists all configured organization-integrations.
+func (a *OrganizationAPI) ListIntegrations(ctx context.Context, in *pb.ListIntegrationRequest) (*pb.ListIntegrationResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateOrganizationIntegrationAccess(auth.List, in.Id)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	integrations, err := storage.GetOrganizationIntegrationsForOrganizationID(config.C.PostgreSQL.DB, in.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var out pb.ListIntegrationResponse
+	for _, integration := range integrations {
+		switch integration.Kind {
+		case handler.HTTPHandlerKind:
+			out.Kinds = append(out.Kinds, pb.IntegrationKind_HTTP)
+		default:
+			return nil, grpc.Errorf(codes.Internal, "unknown integration kind: %s", integration.Kind)
+		}
+	}
+
+	return &out, nil
+}
+
+// getInvitedBy returns the name of the client sending an invitation, which
+// is the username or the name of the API key.
+func (a *OrganizationAPI) getInvitedBy(ctx context.Context) (string, error) {
+	apiKeyID, err := a.validator.GetAPIKeyID(ctx)
+	if err != nil {
+		return "", err
+	}
+	if apiKeyID != 0 {
+		key, err := storage.GetAPIKey(config.C.PostgreSQL.DB, apiKeyID)
+		if err != nil {
+			return "", err
+		}
+		return key.Name, nil
+	}
+
+	return a.validator.GetUsername(ctx)
+}
+
+// checkOrganizationInvitation returns ErrDoesNotExist when the given
+// invitation does not belong to the given organization.
+func checkOrganizationInvitation(db sqlx.Queryer, organizationID, invitationID int64) error {
+	inv, err := storage.GetOrganizationInvitation(db, invitationID)
+	if err != nil {
+		return err
+	}
+	if inv.OrganizationID != organizationID {
+		return storage.ErrDoesNotExist
+	}
+	return nil
+}
+
+// sendOrganizationInvitation sends the given invitation with the given token
+// to the invited e-mail address.
+func sendOrganizationInvitation(db sqlx.Queryer, inv storage.OrganizationInvitation, token string) error {
+	org, err := storage.GetOrganization(db, inv.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	conf := config.C.ApplicationServer.Mailer
+	return sendEmail(inv.Email, conf.OrganizationInvitationTemplate, mailer.DefaultOrganizationInvitationTemplate, "/organization-invitations/accept", token, mailer.TemplateData{
+		ExpiresAt:        inv.ExpiresAt,
+		OrganizationName: org.DisplayName,
+		InvitedBy:        inv.InvitedBy,
+	})
+}
+
+func organizationUserRoles(req *pb.OrganizationUserRequest) storage.Roles {
+	return storage.Roles{
+		IsDeviceAdmin:      req.IsDeviceAdmin,
+		IsGatewayAdmin:     req.IsGatewayAdmin,
+		IsIntegrationAdmin: req.IsIntegrationAdmin,
+		IsViewer:           req.IsViewer,
+	}
+}
+
+func organizationQuotaFromPB(q *pb.OrganizationQuota) storage.OrganizationQuota {
+	return storage.OrganizationQuota{
+		MaxApplications:   int(q.MaxApplications),
+		MaxDevices:        int(q.MaxDevices),
+		MaxGateways:       int(q.MaxGateways),
+		MaxDeviceProfiles: int(q.MaxDeviceProfiles),
+		MaxIntegrations:   int(q.MaxIntegrations),
+		MaxUplinksPerDay:  int(q.MaxUplinksPerDay),
+	}
+}
+
+// httpIntegrationSettings validates the given HTTP integration and returns
+// its handler configuration as JSON.
+func httpIntegrationSettings(in *pb.HTTPIntegration) ([]byte, error) {
+	headers := make(map[string]string)
+	for _, h := range in.Headers {
+		headers[h.Key] = h.Value
+	}
+
+	conf := httphandler.HandlerConfig{
+		Headers:              headers,
+		DataUpURL:            in.DataUpURL,
+		JoinNotificationURL:  in.JoinNotificationURL,
+		ACKNotificationURL:   in.AckNotificationURL,
+		ErrorNotificationURL: in.ErrorNotificationURL,
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(conf)
+}
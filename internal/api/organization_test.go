@@ -5,6 +5,8 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	pb "github.com/gusseleet/lora-app-server/api"
 	"github.com/gusseleet/lora-app-server/internal/config"
@@ -19,7 +21,9 @@ func TestOrganizationAPI(t *testing.T) {
 		db, err := storage.OpenDatabase(conf.PostgresDSN)
 		So(err, ShouldBeNil)
 		config.C.PostgreSQL.DB = db
+		config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
 		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(config.C.Redis.Pool)
 
 		ctx := context.Background()
 		validator := &TestValidator{}
@@ -105,6 +109,253 @@ func TestOrganizationAPI(t *testing.T) {
 
 				})
 
+				Convey("When getting the usage with an invalid date", func() {
+					_, err := api.GetUsage(ctx, &pb.GetOrganizationUsageRequest{
+						Id:        orgId,
+						StartDate: "2018-13-01",
+					})
+
+					Convey("Then an invalid argument error is returned", func() {
+						So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+					})
+				})
+
+				Convey("Then the usage of the organization can be retrieved", func() {
+					resp, err := api.GetUsage(ctx, &pb.GetOrganizationUsageRequest{
+						Id: orgId,
+					})
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+					So(resp.Result, ShouldHaveLength, 0)
+				})
+
+				Convey("When creating an HTTP integration", func() {
+					integration := pb.HTTPIntegration{
+						Id: orgId,
+						Headers: []*pb.HTTPIntegrationHeader{
+							{Key: "Foo", Value: "bar"},
+						},
+						DataUpURL:            "http://up",
+						JoinNotificationURL:  "http://join",
+						AckNotificationURL:   "http://ack",
+						ErrorNotificationURL: "http://error",
+					}
+					_, err := api.CreateHTTPIntegration(ctx, &integration)
+					So(err, ShouldBeNil)
+					So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+					Convey("Then the integration can be retrieved", func() {
+						i, err := api.GetHTTPIntegration(ctx, &pb.GetHTTPIntegrationRequest{Id: orgId})
+						So(err, ShouldBeNil)
+						So(*i, ShouldResemble, integration)
+					})
+
+					Convey("Then the integrations can be listed", func() {
+						resp, err := api.ListIntegrations(ctx, &pb.ListIntegrationRequest{Id: orgId})
+						So(err, ShouldBeNil)
+						So(resp.Kinds, ShouldResemble, []pb.IntegrationKind{pb.IntegrationKind_HTTP})
+					})
+
+					Convey("Then the integration can be updated", func() {
+						integration.DataUpURL = "http://up2"
+						_, err := api.UpdateHTTPIntegration(ctx, &integration)
+						So(err, ShouldBeNil)
+
+						i, err := api.GetHTTPIntegration(ctx, &pb.GetHTTPIntegrationRequest{Id: orgId})
+						So(err, ShouldBeNil)
+						So(*i, ShouldResemble, integration)
+					})
+
+					Convey("Then the integration can be deleted", func() {
+						_, err := api.DeleteHTTPIntegration(ctx, &pb.DeleteIntegrationRequest{Id: orgId})
+						So(err, ShouldBeNil)
+
+						_, err = api.GetHTTPIntegration(ctx, &pb.GetHTTPIntegrationRequest{Id: orgId})
+						So(grpc.Code(err), ShouldEqual, codes.NotFound)
+					})
+				})
+
+				Convey("When updating the quota of the organization", func() {
+					updateOrg := &pb.UpdateOrganizationRequest{
+						Id:          orgId,
+						Name:        createReq.Name,
+						DisplayName: createReq.DisplayName,
+						Quota: &pb.OrganizationQuota{
+							MaxApplications: 10,
+							MaxDevices:      100,
+						},
+					}
+
+					Convey("When the user is not a global admin", func() {
+						validator.returnIsAdmin = false
+						_, err := api.Update(ctx, updateOrg)
+						So(err, ShouldBeNil)
+
+						Convey("Then the quota has not been updated", func() {
+							org, err := api.Get(ctx, &pb.OrganizationRequest{Id: orgId})
+							So(err, ShouldBeNil)
+							So(org.Quota, ShouldResemble, &pb.OrganizationQuota{})
+						})
+					})
+
+					Convey("When the user is a global admin", func() {
+						_, err := api.Update(ctx, updateOrg)
+						So(err, ShouldBeNil)
+
+						Convey("Then the quota and usage are returned", func() {
+							org, err := api.Get(ctx, &pb.OrganizationRequest{Id: orgId})
+							So(err, ShouldBeNil)
+							So(org.Quota, ShouldResemble, updateOrg.Quota)
+							So(org.Usage, ShouldResemble, &pb.OrganizationUsage{})
+						})
+					})
+				})
+
+				Convey("When inviting an e-mail address without mailer", func() {
+					config.C.ApplicationServer.Mailer.Mailer = nil
+					_, err := api.CreateInvitation(ctx, &pb.CreateOrganizationInvitationRequest{
+						Id:    orgId,
+						Email: "invitee@example.com",
+					})
+
+					Convey("Then an error is returned", func() {
+						So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+					})
+				})
+
+				Convey("When inviting an e-mail address", func() {
+					mailer := test.NewMailer()
+					config.C.ApplicationServer.Mailer.Mailer = mailer
+					config.C.ApplicationServer.Mailer.BaseURL = "http://localhost:8080"
+					validator.returnUsername = "admin"
+					internalAPI := NewInternalUserAPI(validator)
+
+					createResp, err := api.CreateInvitation(ctx, &pb.CreateOrganizationInvitationRequest{
+						Id:            orgId,
+						Email:         "invitee@example.com",
+						IsDeviceAdmin: true,
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then the invitation has been sent", func() {
+						So(mailer.Messages, ShouldHaveLength, 1)
+						So(mailer.Messages[0].To, ShouldEqual, "invitee@example.com")
+						So(string(mailer.Messages[0].Msg), ShouldContainSubstring, "http://localhost:8080/#/organization-invitations/accept?token=")
+
+						invitations, err := api.ListInvitations(ctx, &pb.ListOrganizationInvitationsRequest{
+							Id:    orgId,
+							Limit: 10,
+						})
+						So(err, ShouldBeNil)
+						So(invitations.TotalCount, ShouldEqual, 1)
+						So(invitations.Result, ShouldHaveLength, 1)
+						So(invitations.Result[0].InvitationID, ShouldEqual, createResp.InvitationID)
+						So(invitations.Result[0].InvitedBy, ShouldEqual, "admin")
+						So(invitations.Result[0].IsDeviceAdmin, ShouldBeTrue)
+					})
+
+					Convey("Then inviting the same e-mail address again returns an error", func() {
+						_, err := api.CreateInvitation(ctx, &pb.CreateOrganizationInvitationRequest{
+							Id:    orgId,
+							Email: "INVITEE@example.com",
+						})
+						So(grpc.Code(err), ShouldEqual, codes.AlreadyExists)
+					})
+
+					Convey("When resending the invitation", func() {
+						_, err := api.ResendInvitation(ctx, &pb.OrganizationInvitationRequest{
+							Id:           orgId,
+							InvitationID: createResp.InvitationID,
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then only the new token is valid", func() {
+							So(mailer.Messages, ShouldHaveLength, 2)
+
+							_, err := internalAPI.AcceptOrganizationInvitation(ctx, &pb.AcceptOrganizationInvitationRequest{
+								Token:    getMailToken(mailer.Messages[0].Msg),
+								Username: "invitee",
+								Password: "password123",
+							})
+							So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+
+							_, err = internalAPI.AcceptOrganizationInvitation(ctx, &pb.AcceptOrganizationInvitationRequest{
+								Token:    getMailToken(mailer.Messages[1].Msg),
+								Username: "invitee",
+								Password: "password123",
+							})
+							So(err, ShouldBeNil)
+						})
+					})
+
+					Convey("When revoking the invitation", func() {
+						_, err := api.DeleteInvitation(ctx, &pb.OrganizationInvitationRequest{
+							Id:           orgId,
+							InvitationID: createResp.InvitationID,
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then the invitation can not be accepted", func() {
+							_, err := internalAPI.AcceptOrganizationInvitation(ctx, &pb.AcceptOrganizationInvitationRequest{
+								Token:    getMailToken(mailer.Messages[0].Msg),
+								Username: "invitee",
+								Password: "password123",
+							})
+							So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+						})
+					})
+
+					Convey("When accepting the invitation with a new account", func() {
+						resp, err := internalAPI.AcceptOrganizationInvitation(ctx, &pb.AcceptOrganizationInvitationRequest{
+							Token:    getMailToken(mailer.Messages[0].Msg),
+							Username: "invitee",
+							Password: "password123",
+						})
+						So(err, ShouldBeNil)
+						So(resp.OrganizationID, ShouldEqual, orgId)
+
+						Convey("Then the user has been created with the roles of the invitation", func() {
+							user, err := storage.GetUser(config.C.PostgreSQL.DB, resp.UserID)
+							So(err, ShouldBeNil)
+							So(user.Username, ShouldEqual, "invitee")
+							So(user.Email, ShouldEqual, "invitee@example.com")
+							So(user.EmailVerified, ShouldBeTrue)
+
+							orgUser, err := storage.GetOrganizationUser(config.C.PostgreSQL.DB, orgId, resp.UserID)
+							So(err, ShouldBeNil)
+							So(orgUser.IsAdmin, ShouldBeFalse)
+							So(orgUser.IsDeviceAdmin, ShouldBeTrue)
+
+							count, err := storage.GetOrganizationInvitationCount(config.C.PostgreSQL.DB, orgId)
+							So(err, ShouldBeNil)
+							So(count, ShouldEqual, 0)
+						})
+					})
+
+					Convey("When accepting the invitation with an existing account", func() {
+						user := storage.User{
+							Username: "existing",
+							IsActive: true,
+							Email:    "existing@example.com",
+						}
+						_, err := storage.CreateUser(config.C.PostgreSQL.DB, &user, "password123")
+						So(err, ShouldBeNil)
+
+						validator.returnUsername = user.Username
+						resp, err := internalAPI.AcceptOrganizationInvitation(ctx, &pb.AcceptOrganizationInvitationRequest{
+							Token: getMailToken(mailer.Messages[0].Msg),
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then the user has been added to the organization", func() {
+							So(resp.UserID, ShouldEqual, user.ID)
+
+							_, err := storage.GetOrganizationUser(config.C.PostgreSQL.DB, orgId, user.ID)
+							So(err, ShouldBeNil)
+						})
+					})
+				})
+
 				// Add a new user for adding to the organization.
 				Convey("When adding a user", func() {
 					userReq := &pb.AddUserRequest{
@@ -173,6 +424,9 @@ func TestOrganizationAPI(t *testing.T) {
 								Id:      addOrgUser.Id,
 								UserID:  addOrgUser.UserID,
 								IsAdmin: !addOrgUser.IsAdmin,
+
+								IsDeviceAdmin: true,
+								IsViewer:      true,
 							}
 							_, err := api.UpdateUser(ctx, updOrgUser)
 							So(err, ShouldBeNil)
@@ -191,6 +445,9 @@ func TestOrganizationAPI(t *testing.T) {
 										So(orgUsers.Result[0].Id, ShouldEqual, userResp.Id)
 										So(orgUsers.Result[0].Username, ShouldEqual, userReq.Username)
 										So(orgUsers.Result[0].IsAdmin, ShouldEqual, updOrgUser.IsAdmin)
+										So(orgUsers.Result[0].IsDeviceAdmin, ShouldBeTrue)
+										So(orgUsers.Result[0].IsGatewayAdmin, ShouldBeFalse)
+										So(orgUsers.Result[0].IsViewer, ShouldBeTrue)
 									}
 								}
 							})
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// trustedProxies contains the networks of the (reverse) proxies of which the
+// x-forwarded-for header is trusted. Requests made by the grpc-gateway
+// always come from the loopback address, which is always trusted.
+var trustedProxies []*net.IPNet
+
+// SetTrustedProxies sets the ip addresses or networks (CIDR notation) of the
+// proxies of which the x-forwarded-for header is trusted.
+func SetTrustedProxies(proxies []string) error {
+	var nets []*net.IPNet
+	for _, p := range proxies {
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return errors.Errorf("invalid trusted proxy: %s", p)
+			}
+			if ip.To4() != nil {
+				p = p + "/32"
+			} else {
+				p = p + "/128"
+			}
+		}
+
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return errors.Wrapf(err, "parse trusted proxy %s error", p)
+		}
+		nets = append(nets, n)
+	}
+
+	trustedProxies = nets
+	return nil
+}
+
+// getRemoteAddr returns the IP address of the client. This is the address
+// of the peer, unless the peer is the grpc-gateway or a trusted proxy. In
+// that case the x-forwarded-for metadata is used, skipping the trusted
+// proxies from right to left, as the values on the left can be set by the
+// client.
+func getRemoteAddr(ctx context.Context) string {
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		addr = host
+	}
+
+	if !isTrustedProxy(addr) {
+		return addr
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return addr
+	}
+
+	var fwd []string
+	for _, v := range md["x-forwarded-for"] {
+		fwd = append(fwd, strings.Split(v, ",")...)
+	}
+
+	for i := len(fwd) - 1; i >= 0; i-- {
+		a := strings.TrimSpace(fwd[i])
+		if net.ParseIP(a) == nil {
+			break
+		}
+		addr = a
+		if !isTrustedProxy(addr) {
+			break
+		}
+	}
+
+	return addr
+}
+
+// isTrustedProxy returns true when the given address is the loopback
+// address or within one of the trusted proxy networks.
+func isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+
+	if ip.IsLoopback() {
+		return true
+	}
+
+	for _, n := range trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
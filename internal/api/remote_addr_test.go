@@ -0,0 +1,85 @@
+package api
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestGetRemoteAddr(t *testing.T) {
+	Convey("Given a set of trusted proxies", t, func() {
+		So(SetTrustedProxies([]string{"10.0.0.1", "172.16.0.0/12"}), ShouldBeNil)
+		defer SetTrustedProxies(nil)
+
+		tests := []struct {
+			Name         string
+			PeerAddr     string
+			ForwardedFor []string
+			Expected     string
+		}{
+			{
+				Name:     "direct request",
+				PeerAddr: "192.168.1.1",
+				Expected: "192.168.1.1",
+			},
+			{
+				Name:         "direct request with a spoofed x-forwarded-for",
+				PeerAddr:     "192.168.1.1",
+				ForwardedFor: []string{"1.2.3.4"},
+				Expected:     "192.168.1.1",
+			},
+			{
+				Name:         "request through the grpc-gateway",
+				PeerAddr:     "127.0.0.1",
+				ForwardedFor: []string{"192.168.1.1"},
+				Expected:     "192.168.1.1",
+			},
+			{
+				Name:         "request through the grpc-gateway with a spoofed x-forwarded-for",
+				PeerAddr:     "127.0.0.1",
+				ForwardedFor: []string{"1.2.3.4, 192.168.1.1"},
+				Expected:     "192.168.1.1",
+			},
+			{
+				Name:         "request through trusted proxies",
+				PeerAddr:     "::1",
+				ForwardedFor: []string{"1.2.3.4, 192.168.1.1, 172.16.1.1, 10.0.0.1"},
+				Expected:     "192.168.1.1",
+			},
+			{
+				Name:         "request from a trusted proxy",
+				PeerAddr:     "10.0.0.1",
+				ForwardedFor: []string{"192.168.1.1"},
+				Expected:     "192.168.1.1",
+			},
+			{
+				Name:         "request with an invalid x-forwarded-for",
+				PeerAddr:     "127.0.0.1",
+				ForwardedFor: []string{"192.168.1.1, invalid"},
+				Expected:     "127.0.0.1",
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				ctx := peer.NewContext(context.Background(), &peer.Peer{
+					Addr: &net.TCPAddr{IP: net.ParseIP(test.PeerAddr), Port: 12345},
+				})
+				if len(test.ForwardedFor) != 0 {
+					ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-forwarded-for": test.ForwardedFor})
+				}
+
+				So(getRemoteAddr(ctx), ShouldEqual, test.Expected)
+			})
+		}
+
+		Convey("Then an invalid trusted proxy returns an error", func() {
+			So(SetTrustedProxies([]string{"invalid"}), ShouldNotBeNil)
+		})
+	})
+}
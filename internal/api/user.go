@@ -1,9 +1,13 @@
 package api
 
 import (
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -11,6 +15,9 @@ import (
 	pb "github.com/gusseleet/lora-app-server/api"
 	"github.com/gusseleet/lora-app-server/internal/api/auth"
 	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/ldap"
+	"github.com/gusseleet/lora-app-server/internal/mailer"
+	"github.com/gusseleet/lora-app-server/internal/oidc"
 	"github.com/gusseleet/lora-app-server/internal/storage"
 	/* Used for Create() validation.  Commented out for testing purposes - Working authentication */
 	//"os/user"
@@ -59,6 +66,10 @@ func (a *UserAPI) Create(ctx context.Context, req *pb.AddUserRequest) (*pb.AddUs
 		IsActive:   req.IsActive,
 		Email:      req.Email,
 		Note:       req.Note,
+
+		// users created through the API must verify their e-mail address,
+		// when enabled
+		EmailVerified: !config.C.ApplicationServer.Mailer.EmailVerification,
 	}
 
 	/* Commented out for testing purposes - Working authentication */
@@ -78,7 +89,8 @@ func (a *UserAPI) Create(ctx context.Context, req *pb.AddUserRequest) (*pb.AddUs
 	var userID int64
 
 	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
-		userID, err := storage.CreateUser(tx, &user, req.Password)
+		var err error
+		userID, err = storage.CreateUser(tx, &user, req.Password)
 		if err != nil {
 			return err
 		}
@@ -95,6 +107,14 @@ func (a *UserAPI) Create(ctx context.Context, req *pb.AddUserRequest) (*pb.AddUs
 		return nil, errToRPCError(err)
 	}
 
+	// the user has been created, the e-mail can be resent using
+	// SendEmailVerification
+	if !user.EmailVerified {
+		if err := sendEmailVerification(user); err != nil {
+			log.WithError(err).WithField("id", userID).Error("send e-mail verification error")
+		}
+	}
+
 	return &pb.AddUserResponse{Id: userID}, nil
 }
 
@@ -111,15 +131,16 @@ func (a *UserAPI) Get(ctx context.Context, req *pb.UserRequest) (*pb.GetUserResp
 	}
 
 	return &pb.GetUserResponse{
-		Id:         user.ID,
-		Username:   user.Username,
-		SessionTTL: user.SessionTTL,
-		IsAdmin:    user.IsAdmin,
-		IsActive:   user.IsActive,
-		CreatedAt:  user.CreatedAt.String(),
-		UpdatedAt:  user.UpdatedAt.String(),
-		Email:      user.Email,
-		Note:       user.Note,
+		Id:            user.ID,
+		Username:      user.Username,
+		SessionTTL:    user.SessionTTL,
+		IsAdmin:       user.IsAdmin,
+		IsActive:      user.IsActive,
+		CreatedAt:     user.CreatedAt.String(),
+		UpdatedAt:     user.UpdatedAt.String(),
+		Email:         user.Email,
+		Note:          user.Note,
+		EmailVerified: user.EmailVerified,
 	}, nil
 }
 
@@ -143,13 +164,14 @@ func (a *UserAPI) List(ctx context.Context, req *pb.ListUserRequest) (*pb.ListUs
 	result := make([]*pb.GetUserResponse, len(users))
 	for i, user := range users {
 		result[i] = &pb.GetUserResponse{
-			Id:         user.ID,
-			Username:   user.Username,
-			SessionTTL: user.SessionTTL,
-			IsAdmin:    user.IsAdmin,
-			IsActive:   user.IsActive,
-			CreatedAt:  user.CreatedAt.String(),
-			UpdatedAt:  user.UpdatedAt.String(),
+			Id:            user.ID,
+			Username:      user.Username,
+			SessionTTL:    user.SessionTTL,
+			IsAdmin:       user.IsAdmin,
+			IsActive:      user.IsActive,
+			CreatedAt:     user.CreatedAt.String(),
+			UpdatedAt:     user.UpdatedAt.String(),
+			EmailVerified: user.EmailVerified,
 		}
 	}
 
@@ -176,11 +198,27 @@ func (a *UserAPI) Update(ctx context.Context, req *pb.UpdateUserRequest) (*pb.Us
 		Note:       req.Note,
 	}
 
-	err := storage.UpdateUser(config.C.PostgreSQL.DB, userUpdate)
+	current, err := storage.GetUser(config.C.PostgreSQL.DB, req.Id)
 	if err != nil {
 		return nil, errToRPCError(err)
 	}
 
+	err = storage.UpdateUser(config.C.PostgreSQL.DB, userUpdate)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	// a changed e-mail address must be verified again
+	if config.C.ApplicationServer.Mailer.EmailVerification && current.Email != req.Email {
+		user, err := storage.GetUser(config.C.PostgreSQL.DB, req.Id)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		if err := sendEmailVerification(user); err != nil {
+			log.WithError(err).WithField("id", user.ID).Error("send e-mail verification error")
+		}
+	}
+
 	return &pb.UserEmptyResponse{}, nil
 }
 
@@ -212,6 +250,160 @@ func (a *UserAPI) UpdatePassword(ctx context.Context, req *pb.UpdateUserPassword
 	return &pb.UserEmptyResponse{}, nil
 }
 
+// DeleteTOTP resets the two-factor authentication of the user matching the
+// given ID.
+func (a *UserAPI) DeleteTOTP(ctx context.Context, req *pb.UserRequest) (*pb.UserEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.Id, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	err := storage.DeleteUserTOTP(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	return &pb.UserEmptyResponse{}, nil
+}
+
+// ListLoginLockouts lists the usernames and ip addresses which are locked
+// out after too many failed login attempts.
+func (a *UserAPI) ListLoginLockouts(ctx context.Context, req *pb.ListLoginLockoutsRequest) (*pb.ListLoginLockoutsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateLoginLockoutsAccess(auth.List)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	lockouts, err := storage.GetLoginLockouts(config.C.Redis.Pool)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var resp pb.ListLoginLockoutsResponse
+	for _, l := range lockouts {
+		resp.Result = append(resp.Result, &pb.LoginLockout{
+			Username:    l.Username,
+			Ip:          l.IP,
+			LockedUntil: l.LockedUntil.Format(time.RFC3339Nano),
+		})
+	}
+
+	return &resp, nil
+}
+
+// DeleteLoginLockout clears the lockout of the given username and / or ip
+// address.
+func (a *UserAPI) DeleteLoginLockout(ctx context.Context, req *pb.DeleteLoginLockoutRequest) (*pb.UserEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateLoginLockoutsAccess(auth.Delete)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if req.Username == "" && req.Ip == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "username or ip must be given")
+	}
+
+	if err := storage.DeleteLoginLockout(config.C.Redis.Pool, req.Username, req.Ip); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.UserEmptyResponse{}, nil
+}
+
+// ListSessions lists the active sessions of the given user.
+func (a *UserAPI) ListSessions(ctx context.Context, req *pb.ListUserSessionsRequest) (*pb.ListUserSessionsResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.UserID, auth.Read)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	sessionID, err := a.validator.GetSessionID(ctx)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	count, err := storage.GetUserSessionCount(config.C.PostgreSQL.DB, req.UserID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	sessions, err := storage.GetUserSessions(config.C.PostgreSQL.DB, req.UserID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListUserSessionsResponse{
+		TotalCount: int64(count),
+	}
+	for _, s := range sessions {
+		resp.Result = append(resp.Result, &pb.UserSession{
+			Id:         s.ID,
+			CreatedAt:  s.CreatedAt.Format(time.RFC3339Nano),
+			UpdatedAt:  s.UpdatedAt.Format(time.RFC3339Nano),
+			ExpiresAt:  s.ExpiresAt.Format(time.RFC3339Nano),
+			RemoteAddr: s.RemoteAddr,
+			Current:    s.ID == sessionID,
+		})
+	}
+
+	return &resp, nil
+}
+
+// DeleteSession revokes the given session of the user.
+func (a *UserAPI) DeleteSession(ctx context.Context, req *pb.DeleteUserSessionRequest) (*pb.UserEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.UserID, auth.UpdateProfile)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	s, err := storage.GetUserSession(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+	if s.UserID != req.UserID {
+		return nil, errToRPCError(storage.ErrDoesNotExist)
+	}
+
+	if err := storage.DeleteUserSession(config.C.PostgreSQL.DB, s.ID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.UserEmptyResponse{}, nil
+}
+
+// DeleteSessions revokes all sessions of the given user.
+func (a *UserAPI) DeleteSessions(ctx context.Context, req *pb.DeleteUserSessionsRequest) (*pb.UserEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.UserID, auth.UpdateProfile)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteUserSessions(config.C.PostgreSQL.DB, req.UserID); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.UserEmptyResponse{}, nil
+}
+
+// SendEmailVerification (re)sends the e-mail verification e-mail to the
+// given user.
+func (a *UserAPI) SendEmailVerification(ctx context.Context, req *pb.UserRequest) (*pb.UserEmptyResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateUserAccess(req.Id, auth.Update)); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	user, err := storage.GetUser(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err := sendEmailVerification(user); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.UserEmptyResponse{}, nil
+}
+
 // NewInternalUserAPI creates a new InternalUserAPI.
 func NewInternalUserAPI(validator auth.Validator) *InternalUserAPI {
 	return &InternalUserAPI{
@@ -219,14 +411,169 @@ func NewInternalUserAPI(validator auth.Validator) *InternalUserAPI {
 	}
 }
 
-// Login validates the login request and returns a JWT token.
+// Login validates the login request and returns a JWT token. When the user
+// must login using a second factor, a challenge token is returned instead.
 func (a *InternalUserAPI) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
-	jwt, err := storage.LoginUser(config.C.PostgreSQL.DB, req.Username, req.Password)
+	ip := getRemoteAddr(ctx)
+	if err := storage.CheckLoginLockout(config.C.Redis.Pool, req.Username, ip); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	user, err := storage.AuthenticateUser(config.C.PostgreSQL.DB, req.Username, req.Password)
+	// local users (e.g. the admin) take precedence over ldap users
+	if errors.Cause(err) == storage.ErrInvalidUsernameOrPassword && config.C.ApplicationServer.LDAP.Enabled {
+		user, err = ldap.Authenticate(config.C.PostgreSQL.DB, req.Username, req.Password)
+	}
+	if errors.Cause(err) == storage.ErrInvalidUsernameOrPassword {
+		delayFailedLogin(ctx, req.Username, ip)
+	}
 	if nil != err {
 		return nil, errToRPCError(err)
 	}
 
-	return &pb.LoginResponse{Jwt: jwt}, nil
+	if config.C.ApplicationServer.Mailer.EmailVerification && !user.EmailVerified {
+		return nil, errToRPCError(storage.ErrEmailNotVerified)
+	}
+
+	return createLoginResponse(user, ip)
+}
+
+// createLoginResponse returns the login response for the authenticated
+// user. When a second factor is enabled for the user, or required by one of
+// the organizations of the user, a challenge token (and when needed a TOTP
+// enrollment) is returned instead of the session tokens. It is used by all
+// login methods, so that none of them bypasses the second factor.
+func createLoginResponse(user storage.User, ip string) (*pb.LoginResponse, error) {
+	totp, err := storage.GetUserTOTP(config.C.PostgreSQL.DB, user.ID)
+	if err != nil && err != storage.ErrDoesNotExist {
+		return nil, errToRPCError(err)
+	}
+
+	required := totp.Enabled
+	if !required {
+		required, err = storage.GetSecondFactorRequiredForUser(config.C.PostgreSQL.DB, user.ID)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	// the failed attempts are only reset once the login has been completed,
+	// so that the failed second-factor attempts are not reset by a valid
+	// password
+	if !required {
+		if err := storage.ResetFailedLogins(config.C.Redis.Pool, user.Username); err != nil {
+			return nil, errToRPCError(err)
+		}
+
+		tokens, err := storage.CreateUserSession(config.C.PostgreSQL.DB, user, ip)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		return &pb.LoginResponse{
+			Jwt:          tokens.JWT,
+			RefreshToken: tokens.RefreshToken,
+		}, nil
+	}
+
+	token, err := storage.CreateSecondFactorChallenge(config.C.Redis.Pool, user.ID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.LoginResponse{
+		SecondFactorRequired: true,
+		ChallengeToken:       token,
+	}
+
+	// the second factor is required by an organization of the user, the
+	// login is completed by enrolling a new secret
+	if !totp.Enabled {
+		totp, err = storage.CreateUserTOTP(config.C.PostgreSQL.DB, user.ID)
+		if err != nil {
+			return nil, errToRPCError(err)
+		}
+		resp.TotpEnrollment = &pb.TOTPEnrollment{
+			Secret:          totp.Secret,
+			ProvisioningURI: totp.ProvisioningURI(user.Username),
+		}
+	}
+
+	return &resp, nil
+}
+
+// delayFailedLogin registers the failed login attempt and delays the
+// response, to slow down brute-force attacks.
+func delayFailedLogin(ctx context.Context, username, ip string) {
+	delay, err := storage.RegisterFailedLogin(config.C.Redis.Pool, username, ip)
+	if err != nil {
+		log.WithError(err).Error("register failed login error")
+		return
+	}
+
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+	}
+}
+
+// LoginSecondFactor completes the login using the challenge token and the
+// TOTP (or recovery) code and returns a JWT token. Failed attempts count
+// towards the login lockout of the user.
+func (a *InternalUserAPI) LoginSecondFactor(ctx context.Context, req *pb.LoginSecondFactorRequest) (*pb.LoginSecondFactorResponse, error) {
+	userID, err := storage.GetSecondFactorChallengeUserID(config.C.Redis.Pool, req.ChallengeToken)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	user, err := storage.GetUser(config.C.PostgreSQL.DB, userID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	ip := getRemoteAddr(ctx)
+	if err := storage.CheckLoginLockout(config.C.Redis.Pool, user.Username, ip); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	var recoveryCodes []string
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		totp, err := storage.GetUserTOTP(tx, userID)
+		if err != nil {
+			return err
+		}
+
+		if totp.Enabled {
+			return storage.ValidateUserTOTP(tx, userID, req.Code)
+		}
+
+		recoveryCodes, err = storage.EnableUserTOTP(tx, userID, req.Code)
+		return err
+	})
+	if errors.Cause(err) == storage.ErrUserTOTPInvalidCode {
+		delayFailedLogin(ctx, user.Username, ip)
+	}
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err := storage.DeleteSecondFactorChallenge(config.C.Redis.Pool, req.ChallengeToken); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	if err := storage.ResetFailedLogins(config.C.Redis.Pool, user.Username); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	tokens, err := storage.CreateUserSession(config.C.PostgreSQL.DB, user, ip)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.LoginSecondFactorResponse{
+		Jwt:           tokens.JWT,
+		RecoveryCodes: recoveryCodes,
+		RefreshToken:  tokens.RefreshToken,
+	}, nil
 }
 
 type claims struct {
@@ -295,3 +642,315 @@ func (a *InternalUserAPI) Branding(ctx context.Context, req *pb.BrandingRequest)
 
 	return &resp, nil
 }
+
+// OpenIDConnectSettings returns the OpenID Connect login settings.
+func (a *InternalUserAPI) OpenIDConnectSettings(ctx context.Context, req *pb.OpenIDConnectSettingsRequest) (*pb.OpenIDConnectSettingsResponse, error) {
+	if !config.C.ApplicationServer.OIDC.Enabled {
+		return &pb.OpenIDConnectSettingsResponse{}, nil
+	}
+
+	return &pb.OpenIDConnectSettingsResponse{
+		Enabled:    true,
+		LoginURL:   "/auth/oidc/login",
+		LoginLabel: config.C.ApplicationServer.OIDC.LoginLabel,
+	}, nil
+}
+
+// OpenIDConnectLogin completes the OpenID Connect login and returns a JWT
+// token, or a challenge token when a second factor is required.
+func (a *InternalUserAPI) OpenIDConnectLogin(ctx context.Context, req *pb.OpenIDConnectLoginRequest) (*pb.LoginResponse, error) {
+	user, err := oidc.Authenticate(config.C.PostgreSQL.DB, req.Code, req.State)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return createLoginResponse(user, getRemoteAddr(ctx))
+}
+
+// GetTOTP returns the two-factor authentication status of the current user.
+func (a *InternalUserAPI) GetTOTP(ctx context.Context, req *pb.GetTOTPRequest) (*pb.GetTOTPResponse, error) {
+	user, err := a.getActiveUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totp, err := storage.GetUserTOTP(config.C.PostgreSQL.DB, user.ID)
+	if err != nil {
+		if err == storage.ErrDoesNotExist {
+			return &pb.GetTOTPResponse{}, nil
+		}
+		return nil, errToRPCError(err)
+	}
+
+	count, err := storage.GetUserTOTPRecoveryCodeCount(config.C.PostgreSQL.DB, user.ID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.GetTOTPResponse{
+		Enabled:           totp.Enabled,
+		RecoveryCodeCount: int32(count),
+	}, nil
+}
+
+// CreateTOTP creates a new (pending) TOTP secret for the current user.
+func (a *InternalUserAPI) CreateTOTP(ctx context.Context, req *pb.CreateTOTPRequest) (*pb.TOTPEnrollment, error) {
+	user, err := a.getActiveUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var totp storage.UserTOTP
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		totp, err = storage.CreateUserTOTP(tx, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.TOTPEnrollment{
+		Secret:          totp.Secret,
+		ProvisioningURI: totp.ProvisioningURI(user.Username),
+	}, nil
+}
+
+// EnableTOTP enables the pending TOTP secret of the current user.
+func (a *InternalUserAPI) EnableTOTP(ctx context.Context, req *pb.EnableTOTPRequest) (*pb.EnableTOTPResponse, error) {
+	user, err := a.getActiveUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		recoveryCodes, err = storage.EnableUserTOTP(tx, user.ID, req.Code)
+		return err
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.EnableTOTPResponse{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DisableTOTP disables the two-factor authentication of the current user.
+func (a *InternalUserAPI) DisableTOTP(ctx context.Context, req *pb.DisableTOTPRequest) (*pb.DisableTOTPResponse, error) {
+	user, err := a.getActiveUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if err := storage.ValidateUserTOTP(tx, user.ID, req.Code); err != nil {
+			return err
+		}
+		return storage.DeleteUserTOTP(tx, user.ID)
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.DisableTOTPResponse{}, nil
+}
+
+// RefreshToken returns a new JWT and refresh token for the session matching
+// the given refresh token.
+func (a *InternalUserAPI) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	tokens, err := storage.RefreshUserSession(config.C.PostgreSQL.DB, req.RefreshToken)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.RefreshTokenResponse{
+		Jwt:          tokens.JWT,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+// Logout revokes the session of the current user. Tokens which are not
+// bound to a session can not be revoked and expire by themselves.
+func (a *InternalUserAPI) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	sessionID, err := a.validator.GetSessionID(ctx)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if sessionID != 0 {
+		if err := storage.DeleteUserSession(config.C.PostgreSQL.DB, sessionID); err != nil {
+			return nil, errToRPCError(err)
+		}
+	}
+
+	return &pb.LogoutResponse{}, nil
+}
+
+// RequestPasswordReset sends a password reset e-mail to the users with the
+// given e-mail address. To not disclose which e-mail addresses are known,
+// the response does not depend on the existence of these users.
+func (a *InternalUserAPI) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	conf := config.C.ApplicationServer.Mailer
+	if conf.Mailer == nil {
+		return nil, errToRPCError(mailer.ErrDisabled)
+	}
+
+	if err := storage.RegisterPasswordResetRequest(config.C.Redis.Pool, req.Email, getRemoteAddr(ctx)); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	users, err := storage.GetUsersByEmail(config.C.PostgreSQL.DB, req.Email)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	for _, user := range users {
+		token, expiresAt, err := storage.GetPasswordResetToken(config.C.PostgreSQL.DB, user.ID)
+		if err != nil {
+			// users without password can not reset their password
+			if err != storage.ErrDoesNotExist {
+				log.WithError(err).WithField("id", user.ID).Error("get password reset token error")
+			}
+			continue
+		}
+
+		if err := sendUserEmail(user, conf.PasswordResetTemplate, mailer.DefaultPasswordResetTemplate, "/password-reset", token, expiresAt); err != nil {
+			log.WithError(err).WithField("id", user.ID).Error("send password reset e-mail error")
+		}
+	}
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+// ResetPassword sets the password of the user using the password reset
+// token. All sessions of the user are revoked.
+func (a *InternalUserAPI) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		_, err := storage.ResetPassword(tx, req.Token, req.Password)
+		return err
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.ResetPasswordResponse{}, nil
+}
+
+// VerifyEmail marks the e-mail address of the user as verified using the
+// e-mail verification token.
+func (a *InternalUserAPI) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	if _, err := storage.VerifyUserEmail(config.C.PostgreSQL.DB, req.Token); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.VerifyEmailResponse{}, nil
+}
+
+// AcceptOrganizationInvitation adds a user to the organization using the
+// organization invitation token. When a username is given, a new user is
+// created for the invited e-mail address (the e-mail address is verified by
+// the token), else the authenticated user accepts the invitation.
+func (a *InternalUserAPI) AcceptOrganizationInvitation(ctx context.Context, req *pb.AcceptOrganizationInvitationRequest) (*pb.AcceptOrganizationInvitationResponse, error) {
+	var user storage.User
+	if req.Username == "" {
+		var err error
+		user, err = a.getActiveUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var inv storage.OrganizationInvitation
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		if req.Username != "" {
+			pending, err := storage.GetOrganizationInvitationForToken(tx, req.Token)
+			if err != nil {
+				return err
+			}
+
+			user = storage.User{
+				Username:      req.Username,
+				IsActive:      true,
+				Email:         pending.Email,
+				EmailVerified: true,
+			}
+			user.ID, err = storage.CreateUser(tx, &user, req.Password)
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+		inv, err = storage.AcceptOrganizationInvitation(tx, req.Token, user.ID)
+		return err
+	})
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.AcceptOrganizationInvitationResponse{
+		OrganizationID: inv.OrganizationID,
+		UserID:         user.ID,
+	}, nil
+}
+
+// getActiveUser returns the current user.
+func (a *InternalUserAPI) getActiveUser(ctx context.Context) (storage.User, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateActiveUser()); err != nil {
+		return storage.User{}, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	username, err := a.validator.GetUsername(ctx)
+	if err != nil {
+		return storage.User{}, errToRPCError(err)
+	}
+
+	user, err := storage.GetUserByUsername(config.C.PostgreSQL.DB, username)
+	if err != nil {
+		return storage.User{}, errToRPCError(err)
+	}
+
+	return user, nil
+}
+
+// sendEmailVerification sends the e-mail verification e-mail to the given
+// user.
+func sendEmailVerification(user storage.User) error {
+	token, expiresAt, err := storage.GetEmailVerificationToken(user)
+	if err != nil {
+		return err
+	}
+
+	conf := config.C.ApplicationServer.Mailer
+	return sendUserEmail(user, conf.EmailVerificationTemplate, mailer.DefaultEmailVerificationTemplate, "/verify-email", token, expiresAt)
+}
+
+// sendUserEmail renders the given template for the given user and sends it
+// to the e-mail address of the user. The URL links to the given path of the
+// web-interface, with the given token.
+func sendUserEmail(user storage.User, templateFile, defaultTemplate, path, token string, expiresAt time.Time) error {
+	return sendEmail(user.Email, templateFile, defaultTemplate, path, token, mailer.TemplateData{
+		Username:  user.Username,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// sendEmail renders the given template and sends it to the given e-mail
+// address. The URL of the template data is set to the given path of the
+// web-interface, with the given token.
+func sendEmail(to, templateFile, defaultTemplate, path, token string, data mailer.TemplateData) error {
+	conf := config.C.ApplicationServer.Mailer
+	if conf.Mailer == nil {
+		return mailer.ErrDisabled
+	}
+
+	data.URL = strings.TrimSuffix(conf.BaseURL, "/") + "/#" + path + "?token=" + url.QueryEscape(token)
+	msg, err := mailer.Render(templateFile, defaultTemplate, data)
+	if err != nil {
+		return errors.Wrap(err, "render e-mail error")
+	}
+
+	return conf.Mailer.SendMail(to, msg)
+}
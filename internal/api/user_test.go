@@ -1,12 +1,22 @@
 package api
 
 import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/brocaar/loraserver/api/ns"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	pb "github.com/gusseleet/lora-app-server/api"
 	"github.com/gusseleet/lora-app-server/internal/config"
@@ -22,9 +32,12 @@ func TestUserAPI(t *testing.T) {
 	}
 
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
+	storage.LoginDelay = 0
 
 	Convey("Given a clean database and api instance", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(config.C.Redis.Pool)
 
 		nsClient := test.NewNetworkServerClient()
 		nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
@@ -38,6 +51,11 @@ func TestUserAPI(t *testing.T) {
 		api := NewUserAPI(validator)
 		apiInternal := NewInternalUserAPI(validator)
 
+		mailer := test.NewMailer()
+		config.C.ApplicationServer.Mailer.Mailer = nil
+		config.C.ApplicationServer.Mailer.EmailVerification = false
+		config.C.ApplicationServer.Mailer.BaseURL = "http://localhost:8080/"
+
 		Convey("When creating an user assigned to an organization", func() {
 			org := storage.Organization{
 				Name: "test-org",
@@ -63,6 +81,268 @@ func TestUserAPI(t *testing.T) {
 			So(users[0].IsAdmin, ShouldBeTrue)
 		})
 
+		Convey("When logging in with an invalid password too many times", func() {
+			_, err := api.Create(ctx, &pb.AddUserRequest{
+				Username: "testuser",
+				Password: "testpasswd",
+				IsActive: true,
+				Email:    "foo@bar.com",
+			})
+			So(err, ShouldBeNil)
+
+			for i := 0; i < storage.LoginMaxAttempts; i++ {
+				_, err := apiInternal.Login(ctx, &pb.LoginRequest{
+					Username: "testuser",
+					Password: "invalid",
+				})
+				So(grpc.Code(err), ShouldEqual, codes.Unauthenticated)
+			}
+
+			Convey("Then the user is locked out, even with a valid password", func() {
+				_, err := apiInternal.Login(ctx, &pb.LoginRequest{
+					Username: "testuser",
+					Password: "testpasswd",
+				})
+				So(grpc.Code(err), ShouldEqual, codes.ResourceExhausted)
+			})
+
+			Convey("Then the lockout is listed", func() {
+				resp, err := api.ListLoginLockouts(ctx, &pb.ListLoginLockoutsRequest{})
+				So(err, ShouldBeNil)
+				So(validator.validatorFuncs, ShouldHaveLength, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].Username, ShouldEqual, "testuser")
+			})
+
+			Convey("When clearing the lockout", func() {
+				_, err := api.DeleteLoginLockout(ctx, &pb.DeleteLoginLockoutRequest{
+					Username: "testuser",
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the user can login", func() {
+					resp, err := apiInternal.Login(ctx, &pb.LoginRequest{
+						Username: "testuser",
+						Password: "testpasswd",
+					})
+					So(err, ShouldBeNil)
+					So(resp.Jwt, ShouldNotEqual, "")
+				})
+			})
+		})
+
+		Convey("When creating an user assigned to an organization requiring a second factor", func() {
+			org := storage.Organization{
+				Name:                "test-org",
+				RequireSecondFactor: true,
+			}
+			So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+			_, err := api.Create(ctx, &pb.AddUserRequest{
+				Username: "testuser",
+				Password: "testpasswd",
+				IsActive: true,
+				Organizations: []*pb.AddUserOrganization{
+					{OrganizationID: org.ID},
+				},
+				Email: "foo@bar.com",
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then the login response for an externally authenticated user requires the second factor", func() {
+				user, err := storage.GetUserByUsername(config.C.PostgreSQL.DB, "testuser")
+				So(err, ShouldBeNil)
+
+				resp, err := createLoginResponse(user, "")
+				So(err, ShouldBeNil)
+				So(resp.Jwt, ShouldEqual, "")
+				So(resp.SecondFactorRequired, ShouldBeTrue)
+				So(resp.ChallengeToken, ShouldNotEqual, "")
+				So(resp.TotpEnrollment, ShouldNotBeNil)
+			})
+
+			Convey("Then login returns a challenge and enrollment", func() {
+				resp, err := apiInternal.Login(ctx, &pb.LoginRequest{
+					Username: "testuser",
+					Password: "testpasswd",
+				})
+				So(err, ShouldBeNil)
+				So(resp.Jwt, ShouldEqual, "")
+				So(resp.SecondFactorRequired, ShouldBeTrue)
+				So(resp.ChallengeToken, ShouldNotEqual, "")
+				So(resp.TotpEnrollment, ShouldNotBeNil)
+
+				Convey("Then the login can not be completed with an invalid code", func() {
+					_, err := apiInternal.LoginSecondFactor(ctx, &pb.LoginSecondFactorRequest{
+						ChallengeToken: resp.ChallengeToken,
+						Code:           "000000x",
+					})
+					So(err, ShouldNotBeNil)
+				})
+
+				Convey("When failing the second factor too many times, using new challenges", func() {
+					challengeToken := resp.ChallengeToken
+					secret := resp.TotpEnrollment.Secret
+					for i := 0; i < storage.LoginMaxAttempts; i++ {
+						_, err := apiInternal.LoginSecondFactor(ctx, &pb.LoginSecondFactorRequest{
+							ChallengeToken: challengeToken,
+							Code:           "000000x",
+						})
+						So(err, ShouldNotBeNil)
+
+						resp, err := apiInternal.Login(ctx, &pb.LoginRequest{
+							Username: "testuser",
+							Password: "testpasswd",
+						})
+						if i < storage.LoginMaxAttempts-1 {
+							So(err, ShouldBeNil)
+							challengeToken = resp.ChallengeToken
+							secret = resp.TotpEnrollment.Secret
+						} else {
+							So(grpc.Code(err), ShouldEqual, codes.ResourceExhausted)
+						}
+					}
+
+					Convey("Then the user is locked out, even with a valid code", func() {
+						code := getTOTPCode(secret, time.Now())
+						_, err := apiInternal.LoginSecondFactor(ctx, &pb.LoginSecondFactorRequest{
+							ChallengeToken: challengeToken,
+							Code:           code,
+						})
+						So(grpc.Code(err), ShouldEqual, codes.ResourceExhausted)
+					})
+				})
+
+				Convey("When completing the login with a valid code", func() {
+					code := getTOTPCode(resp.TotpEnrollment.Secret, time.Now())
+					loginResp, err := apiInternal.LoginSecondFactor(ctx, &pb.LoginSecondFactorRequest{
+						ChallengeToken: resp.ChallengeToken,
+						Code:           code,
+					})
+					So(err, ShouldBeNil)
+
+					Convey("Then a JWT and the recovery codes are returned", func() {
+						So(loginResp.Jwt, ShouldNotEqual, "")
+						So(loginResp.RecoveryCodes, ShouldHaveLength, 10)
+					})
+
+					Convey("Then the challenge can not be used again", func() {
+						_, err := apiInternal.LoginSecondFactor(ctx, &pb.LoginSecondFactorRequest{
+							ChallengeToken: resp.ChallengeToken,
+							Code:           loginResp.RecoveryCodes[0],
+						})
+						So(err, ShouldNotBeNil)
+					})
+
+					Convey("Then the next login requires a code, without enrollment", func() {
+						resp, err := apiInternal.Login(ctx, &pb.LoginRequest{
+							Username: "testuser",
+							Password: "testpasswd",
+						})
+						So(err, ShouldBeNil)
+						So(resp.SecondFactorRequired, ShouldBeTrue)
+						So(resp.TotpEnrollment, ShouldBeNil)
+
+						loginResp, err := apiInternal.LoginSecondFactor(ctx, &pb.LoginSecondFactorRequest{
+							ChallengeToken: resp.ChallengeToken,
+							Code:           loginResp.RecoveryCodes[0],
+						})
+						So(err, ShouldBeNil)
+						So(loginResp.Jwt, ShouldNotEqual, "")
+						So(loginResp.RecoveryCodes, ShouldHaveLength, 0)
+					})
+				})
+			})
+		})
+
+		Convey("When creating an user with e-mail verification enabled", func() {
+			config.C.ApplicationServer.Mailer.Mailer = mailer
+			config.C.ApplicationServer.Mailer.EmailVerification = true
+
+			createReq := pb.AddUserRequest{
+				Username: "testuser",
+				Password: "testpasswd",
+				IsActive: true,
+				Email:    "foo@bar.com",
+			}
+			_, err := api.Create(ctx, &createReq)
+			So(err, ShouldBeNil)
+
+			Convey("Then an e-mail verification e-mail has been sent", func() {
+				So(mailer.Messages, ShouldHaveLength, 1)
+				So(mailer.Messages[0].To, ShouldEqual, "foo@bar.com")
+				So(string(mailer.Messages[0].Msg), ShouldContainSubstring, "http://localhost:8080/#/verify-email?token=")
+			})
+
+			Convey("Then the user can not login", func() {
+				_, err := apiInternal.Login(ctx, &pb.LoginRequest{
+					Username: createReq.Username,
+					Password: createReq.Password,
+				})
+				So(grpc.Code(err), ShouldEqual, codes.FailedPrecondition)
+			})
+
+			Convey("When verifying the e-mail address", func() {
+				_, err := apiInternal.VerifyEmail(ctx, &pb.VerifyEmailRequest{
+					Token: getMailToken(mailer.Messages[0].Msg),
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the user can login", func() {
+					_, err := apiInternal.Login(ctx, &pb.LoginRequest{
+						Username: createReq.Username,
+						Password: createReq.Password,
+					})
+					So(err, ShouldBeNil)
+				})
+
+				Convey("When requesting a password reset", func() {
+					_, err := apiInternal.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{
+						Email: "FOO@bar.com",
+					})
+					So(err, ShouldBeNil)
+					So(mailer.Messages, ShouldHaveLength, 2)
+					So(string(mailer.Messages[1].Msg), ShouldContainSubstring, "http://localhost:8080/#/password-reset?token=")
+					token := getMailToken(mailer.Messages[1].Msg)
+
+					Convey("When resetting the password", func() {
+						_, err := apiInternal.ResetPassword(ctx, &pb.ResetPasswordRequest{
+							Token:    token,
+							Password: "newpasswd",
+						})
+						So(err, ShouldBeNil)
+
+						Convey("Then the user can login with the new password", func() {
+							_, err := apiInternal.Login(ctx, &pb.LoginRequest{
+								Username: createReq.Username,
+								Password: "newpasswd",
+							})
+							So(err, ShouldBeNil)
+						})
+
+						Convey("Then the token can not be used again", func() {
+							_, err := apiInternal.ResetPassword(ctx, &pb.ResetPasswordRequest{
+								Token:    token,
+								Password: "otherpasswd",
+							})
+							So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+						})
+					})
+				})
+			})
+
+			Convey("When requesting a password reset for an unknown e-mail address", func() {
+				_, err := apiInternal.RequestPasswordReset(ctx, &pb.RequestPasswordResetRequest{
+					Email: "unknown@bar.com",
+				})
+
+				Convey("Then no error is returned and no e-mail is sent", func() {
+					So(err, ShouldBeNil)
+					So(mailer.Messages, ShouldHaveLength, 1)
+				})
+			})
+		})
+
 		Convey("When creating an user", func() {
 			validator.returnIsAdmin = true
 			createReq := &pb.AddUserRequest{
@@ -100,13 +380,75 @@ func TestUserAPI(t *testing.T) {
 					So(users.TotalCount, ShouldEqual, 2)
 				})
 
-				Convey("Then login in succeeds", func() {
-					jwt, err := apiInternal.Login(ctx, &pb.LoginRequest{
+				Convey("When logging in", func() {
+					loginResp, err := apiInternal.Login(ctx, &pb.LoginRequest{
 						Username: createReq.Username,
 						Password: createReq.Password,
 					})
 					So(err, ShouldBeNil)
-					So(jwt, ShouldNotBeNil)
+					So(loginResp.Jwt, ShouldNotEqual, "")
+					So(loginResp.RefreshToken, ShouldNotEqual, "")
+
+					sessions, err := storage.GetUserSessions(config.C.PostgreSQL.DB, createResp.Id, 10, 0)
+					So(err, ShouldBeNil)
+					So(sessions, ShouldHaveLength, 1)
+					validator.returnSessionID = sessions[0].ID
+
+					Convey("Then the session is listed as the current session", func() {
+						resp, err := api.ListSessions(ctx, &pb.ListUserSessionsRequest{
+							UserID: createResp.Id,
+							Limit:  10,
+						})
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+						So(resp.TotalCount, ShouldEqual, 1)
+						So(resp.Result, ShouldHaveLength, 1)
+						So(resp.Result[0].Id, ShouldEqual, sessions[0].ID)
+						So(resp.Result[0].Current, ShouldBeTrue)
+					})
+
+					Convey("Then the token can be refreshed once", func() {
+						resp, err := apiInternal.RefreshToken(ctx, &pb.RefreshTokenRequest{
+							RefreshToken: loginResp.RefreshToken,
+						})
+						So(err, ShouldBeNil)
+						So(resp.Jwt, ShouldNotEqual, "")
+						So(resp.RefreshToken, ShouldNotEqual, loginResp.RefreshToken)
+
+						_, err = apiInternal.RefreshToken(ctx, &pb.RefreshTokenRequest{
+							RefreshToken: loginResp.RefreshToken,
+						})
+						So(grpc.Code(err), ShouldEqual, codes.Unauthenticated)
+					})
+
+					Convey("When logging out", func() {
+						_, err := apiInternal.Logout(ctx, &pb.LogoutRequest{})
+						So(err, ShouldBeNil)
+
+						Convey("Then the session has been revoked", func() {
+							_, err := apiInternal.RefreshToken(ctx, &pb.RefreshTokenRequest{
+								RefreshToken: loginResp.RefreshToken,
+							})
+							So(grpc.Code(err), ShouldEqual, codes.Unauthenticated)
+						})
+					})
+
+					Convey("When revoking all sessions of the user", func() {
+						_, err := api.DeleteSessions(ctx, &pb.DeleteUserSessionsRequest{
+							UserID: createResp.Id,
+						})
+						So(err, ShouldBeNil)
+						So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+						Convey("Then no sessions are listed", func() {
+							resp, err := api.ListSessions(ctx, &pb.ListUserSessionsRequest{
+								UserID: createResp.Id,
+								Limit:  10,
+							})
+							So(err, ShouldBeNil)
+							So(resp.TotalCount, ShouldEqual, 0)
+						})
+					})
 				})
 
 				Convey("When updating the user", func() {
@@ -173,3 +515,31 @@ func TestUserAPI(t *testing.T) {
 		})
 	})
 }
+
+// getMailToken returns the token of the link in the given e-mail.
+func getMailToken(msg []byte) string {
+	match := regexp.MustCompile(`token=(\S+)`).FindSubmatch(msg)
+	if match == nil {
+		return ""
+	}
+	token, _ := url.QueryUnescape(string(match[1]))
+	return token
+}
+
+// getTOTPCode returns the TOTP code (RFC 6238) for the given base32 secret.
+func getTOTPCode(secret string, t time.Time) string {
+	key, err := base32.StdEncoding.DecodeString(secret)
+	if err != nil {
+		panic(err)
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(t.Unix()/30))
+	h := hmac.New(sha1.New, key)
+	h.Write(msg[:])
+	sum := h.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", v%1000000)
+}
@@ -6,11 +6,13 @@ import (
 )
 
 type TestValidator struct {
-	ctx            context.Context
-	validatorFuncs []auth.ValidatorFunc
-	returnError    error
-	returnUsername string
-	returnIsAdmin  bool
+	ctx             context.Context
+	validatorFuncs  []auth.ValidatorFunc
+	returnError     error
+	returnUsername  string
+	returnIsAdmin   bool
+	returnAPIKeyID  int64
+	returnSessionID int64
 }
 
 func (v *TestValidator) Validate(ctx context.Context, funcs ...auth.ValidatorFunc) error {
@@ -26,3 +28,11 @@ func (v *TestValidator) GetUsername(ctx context.Context) (string, error) {
 func (v *TestValidator) GetIsAdmin(ctx context.Context) (bool, error) {
 	return v.returnIsAdmin, v.returnError
 }
+
+func (v *TestValidator) GetAPIKeyID(ctx context.Context) (int64, error) {
+	return v.returnAPIKeyID, v.returnError
+}
+
+func (v *TestValidator) GetSessionID(ctx context.Context) (int64, error) {
+	return v.returnSessionID, v.returnError
+}
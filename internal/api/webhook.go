@@ -0,0 +1,158 @@
+package api
+
+import (
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/gusseleet/lora-app-server/api"
+	"github.com/gusseleet/lora-app-server/internal/api/auth"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+// WebhookAPI exports the webhook related functions.
+type WebhookAPI struct {
+	validator auth.Validator
+}
+
+// NewWebhookAPI creates a new WebhookAPI.
+func NewWebhookAPI(validator auth.Validator) *WebhookAPI {
+	return &WebhookAPI{
+		validator: validator,
+	}
+}
+
+// Create creates the given webhook.
+func (a *WebhookAPI) Create(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	if req.Webhook == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "webhook expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateWebhooksAccess(auth.Create, req.Webhook.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	w := storage.Webhook{
+		OrganizationID: req.Webhook.OrganizationID,
+		Name:           req.Webhook.Name,
+		URL:            req.Webhook.Url,
+		Secret:         req.Webhook.Secret,
+	}
+
+	if err := storage.CreateWebhook(config.C.PostgreSQL.DB, &w); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.CreateWebhookResponse{
+		Id: w.ID,
+	}, nil
+}
+
+// Get returns the webhook matching the given id.
+func (a *WebhookAPI) Get(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateWebhookAccess(auth.Read, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	w, err := storage.GetWebhook(config.C.PostgreSQL.DB, req.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return webhookToResponse(w), nil
+}
+
+// Update updates the given webhook. The secret is only updated when set.
+func (a *WebhookAPI) Update(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	if req.Webhook == nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "webhook expected")
+	}
+
+	if err := a.validator.Validate(ctx,
+		auth.ValidateWebhookAccess(auth.Update, req.Webhook.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	w, err := storage.GetWebhook(config.C.PostgreSQL.DB, req.Webhook.Id)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	w.Name = req.Webhook.Name
+	w.URL = req.Webhook.Url
+	if req.Webhook.Secret != "" {
+		w.Secret = req.Webhook.Secret
+	}
+
+	if err := storage.UpdateWebhook(config.C.PostgreSQL.DB, &w); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.UpdateWebhookResponse{}, nil
+}
+
+// Delete deletes the webhook matching the given id.
+func (a *WebhookAPI) Delete(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateWebhookAccess(auth.Delete, req.Id),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	if err := storage.DeleteWebhook(config.C.PostgreSQL.DB, req.Id); err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+// List lists the webhooks of the given organization.
+func (a *WebhookAPI) List(ctx context.Context, req *pb.ListWebhookRequest) (*pb.ListWebhookResponse, error) {
+	if err := a.validator.Validate(ctx,
+		auth.ValidateWebhooksAccess(auth.List, req.OrganizationID),
+	); err != nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "authentication failed: %s", err)
+	}
+
+	count, err := storage.GetWebhookCount(config.C.PostgreSQL.DB, req.OrganizationID)
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	webhooks, err := storage.GetWebhooks(config.C.PostgreSQL.DB, req.OrganizationID, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, errToRPCError(err)
+	}
+
+	resp := pb.ListWebhookResponse{
+		TotalCount: int64(count),
+	}
+	for _, w := range webhooks {
+		resp.Result = append(resp.Result, webhookToResponse(w))
+	}
+
+	return &resp, nil
+}
+
+// webhookToResponse returns the API representation of the given webhook,
+// without its secret.
+func webhookToResponse(w storage.Webhook) *pb.GetWebhookResponse {
+	return &pb.GetWebhookResponse{
+		Webhook: &pb.Webhook{
+			Id:             w.ID,
+			OrganizationID: w.OrganizationID,
+			Name:           w.Name,
+			Url:            w.URL,
+		},
+		CreatedAt: w.CreatedAt.Format(time.RFC3339Nano),
+		UpdatedAt: w.UpdatedAt.Format(time.RFC3339Nano),
+	}
+}
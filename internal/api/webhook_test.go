@@ -0,0 +1,134 @@
+package api
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	pb "github.com/gusseleet/lora-app-server/api"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestWebhookAPI(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database with an organization and an api instance", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		ctx := context.Background()
+		validator := &TestValidator{}
+		api := NewWebhookAPI(validator)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		Convey("When creating a webhook with an invalid url", func() {
+			_, err := api.Create(ctx, &pb.CreateWebhookRequest{
+				Webhook: &pb.Webhook{
+					OrganizationID: org.ID,
+					Name:           "test-webhook",
+					Url:            "localhost",
+					Secret:         "secret",
+				},
+			})
+
+			Convey("Then an invalid argument error is returned", func() {
+				So(grpc.Code(err), ShouldEqual, codes.InvalidArgument)
+			})
+		})
+
+		Convey("When creating a webhook", func() {
+			createResp, err := api.Create(ctx, &pb.CreateWebhookRequest{
+				Webhook: &pb.Webhook{
+					OrganizationID: org.ID,
+					Name:           "test-webhook",
+					Url:            "http://localhost/webhook",
+					Secret:         "secret",
+				},
+			})
+			So(err, ShouldBeNil)
+			So(validator.validatorFuncs, ShouldHaveLength, 1)
+
+			Convey("Then it can be retrieved without its secret", func() {
+				resp, err := api.Get(ctx, &pb.GetWebhookRequest{Id: createResp.Id})
+				So(err, ShouldBeNil)
+				So(resp.Webhook, ShouldResemble, &pb.Webhook{
+					Id:             createResp.Id,
+					OrganizationID: org.ID,
+					Name:           "test-webhook",
+					Url:            "http://localhost/webhook",
+				})
+			})
+
+			Convey("Then it can be listed", func() {
+				resp, err := api.List(ctx, &pb.ListWebhookRequest{
+					OrganizationID: org.ID,
+					Limit:          10,
+				})
+				So(err, ShouldBeNil)
+				So(resp.TotalCount, ShouldEqual, 1)
+				So(resp.Result, ShouldHaveLength, 1)
+				So(resp.Result[0].Webhook.Id, ShouldEqual, createResp.Id)
+			})
+
+			Convey("When updating the webhook without a secret", func() {
+				_, err := api.Update(ctx, &pb.UpdateWebhookRequest{
+					Webhook: &pb.Webhook{
+						Id:   createResp.Id,
+						Name: "test-webhook-updated",
+						Url:  "https://localhost/webhook",
+					},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the webhook has been updated and the secret has been kept", func() {
+					w, err := storage.GetWebhook(config.C.PostgreSQL.DB, createResp.Id)
+					So(err, ShouldBeNil)
+					So(w.Name, ShouldEqual, "test-webhook-updated")
+					So(w.URL, ShouldEqual, "https://localhost/webhook")
+					So(w.Secret, ShouldEqual, "secret")
+				})
+			})
+
+			Convey("When updating the webhook with a secret", func() {
+				_, err := api.Update(ctx, &pb.UpdateWebhookRequest{
+					Webhook: &pb.Webhook{
+						Id:     createResp.Id,
+						Name:   "test-webhook",
+						Url:    "http://localhost/webhook",
+						Secret: "new-secret",
+					},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then the secret has been updated", func() {
+					w, err := storage.GetWebhook(config.C.PostgreSQL.DB, createResp.Id)
+					So(err, ShouldBeNil)
+					So(w.Secret, ShouldEqual, "new-secret")
+				})
+			})
+
+			Convey("When deleting the webhook", func() {
+				_, err := api.Delete(ctx, &pb.DeleteWebhookRequest{Id: createResp.Id})
+				So(err, ShouldBeNil)
+
+				Convey("Then it has been deleted", func() {
+					_, err := api.Get(ctx, &pb.GetWebhookRequest{Id: createResp.Id})
+					So(grpc.Code(err), ShouldEqual, codes.NotFound)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,157 @@
+// Package clocksync implements the LoRaWAN Application Layer Clock
+// Synchronization package (fPort 202).
+package clocksync
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultFPort defines the default fPort used by the package.
+const DefaultFPort = 202
+
+// CID defines the command identifier.
+type CID byte
+
+// Available commands. Note that the request and answer of a command share
+// the same CID.
+const (
+	PackageVersionReq           CID = 0x00
+	AppTimeReq                  CID = 0x01
+	DeviceAppTimePeriodicityReq CID = 0x02
+	ForceDeviceResyncReq        CID = 0x03
+)
+
+// PackageVersionAnsPayload implements the PackageVersionAns payload.
+type PackageVersionAnsPayload struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// AppTimeReqPayload implements the AppTimeReq payload.
+type AppTimeReqPayload struct {
+	// DeviceTime contains the device time in seconds since the GPS epoch,
+	// modulo 2^32.
+	DeviceTime  uint32
+	AnsRequired bool
+	TokenReq    uint8
+}
+
+// AppTimeAnsPayload implements the AppTimeAns payload.
+type AppTimeAnsPayload struct {
+	// TimeCorrection contains the number of seconds the device must add to
+	// its clock.
+	TimeCorrection int32
+	TokenAns       uint8
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p AppTimeAnsPayload) MarshalBinary() ([]byte, error) {
+	if p.TokenAns > 15 {
+		return nil, errors.New("TokenAns must be between 0 and 15")
+	}
+
+	b := make([]byte, 6)
+	b[0] = byte(AppTimeReq)
+	binary.LittleEndian.PutUint32(b[1:5], uint32(p.TimeCorrection))
+	b[5] = p.TokenAns
+	return b, nil
+}
+
+// DeviceAppTimePeriodicityReqPayload implements the
+// DeviceAppTimePeriodicityReq payload.
+type DeviceAppTimePeriodicityReqPayload struct {
+	// Period defines the periodicity of the AppTimeReq as 128*2^Period
+	// seconds.
+	Period uint8
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p DeviceAppTimePeriodicityReqPayload) MarshalBinary() ([]byte, error) {
+	if p.Period > 15 {
+		return nil, errors.New("Period must be between 0 and 15")
+	}
+	return []byte{byte(DeviceAppTimePeriodicityReq), p.Period}, nil
+}
+
+// DeviceAppTimePeriodicityAnsPayload implements the
+// DeviceAppTimePeriodicityAns payload.
+type DeviceAppTimePeriodicityAnsPayload struct {
+	NotSupported bool
+	DeviceTime   uint32
+}
+
+// ForceDeviceResyncReqPayload implements the ForceDeviceResyncReq payload.
+type ForceDeviceResyncReqPayload struct {
+	NbTransmissions uint8
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p ForceDeviceResyncReqPayload) MarshalBinary() ([]byte, error) {
+	if p.NbTransmissions > 7 {
+		return nil, errors.New("NbTransmissions must be between 0 and 7")
+	}
+	return []byte{byte(ForceDeviceResyncReq), p.NbTransmissions}, nil
+}
+
+// UnmarshalUplink decodes the given uplink payload into a slice of
+// (request and answer) payloads.
+func UnmarshalUplink(b []byte) ([]interface{}, error) {
+	var out []interface{}
+
+	for len(b) > 0 {
+		cid := CID(b[0])
+		b = b[1:]
+
+		switch cid {
+		case PackageVersionReq:
+			if len(b) < 2 {
+				return nil, errors.New("PackageVersionAns: 2 bytes expected")
+			}
+			out = append(out, PackageVersionAnsPayload{
+				PackageIdentifier: b[0],
+				PackageVersion:    b[1],
+			})
+			b = b[2:]
+		case AppTimeReq:
+			if len(b) < 5 {
+				return nil, errors.New("AppTimeReq: 5 bytes expected")
+			}
+			out = append(out, AppTimeReqPayload{
+				DeviceTime:  binary.LittleEndian.Uint32(b[0:4]),
+				AnsRequired: b[4]&0x10 != 0,
+				TokenReq:    b[4] & 0x0f,
+			})
+			b = b[5:]
+		case DeviceAppTimePeriodicityReq:
+			if len(b) < 5 {
+				return nil, errors.New("DeviceAppTimePeriodicityAns: 5 bytes expected")
+			}
+			out = append(out, DeviceAppTimePeriodicityAnsPayload{
+				NotSupported: b[0]&0x01 != 0,
+				DeviceTime:   binary.LittleEndian.Uint32(b[1:5]),
+			})
+			b = b[5:]
+		default:
+			return nil, fmt.Errorf("unknown CID: %d", cid)
+		}
+	}
+
+	return out, nil
+}
+
+// GetAppTimeAns returns the AppTimeAns for the given AppTimeReq, given the
+// time since the GPS epoch (in seconds) at which the request was received.
+// The second return value is false when the device clock is correct and
+// the device did not request an answer.
+func GetAppTimeAns(req AppTimeReqPayload, gpsSeconds int64) (AppTimeAnsPayload, bool) {
+	// the DeviceTime is transmitted modulo 2^32
+	correction := int32(uint32(gpsSeconds) - req.DeviceTime)
+
+	return AppTimeAnsPayload{
+		TimeCorrection: correction,
+		TokenAns:       req.TokenReq,
+	}, correction != 0 || req.AnsRequired
+}
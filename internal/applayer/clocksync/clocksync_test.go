@@ -0,0 +1,127 @@
+package clocksync
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	Convey("Given an AppTimeAnsPayload with a negative time correction", t, func() {
+		pl := AppTimeAnsPayload{
+			TimeCorrection: -2,
+			TokenAns:       5,
+		}
+
+		Convey("Then MarshalBinary returns the expected bytes", func() {
+			b, err := pl.MarshalBinary()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{0x01, 0xfe, 0xff, 0xff, 0xff, 0x05})
+		})
+	})
+
+	Convey("Given a DeviceAppTimePeriodicityReqPayload", t, func() {
+		pl := DeviceAppTimePeriodicityReqPayload{
+			Period: 3,
+		}
+
+		Convey("Then MarshalBinary returns the expected bytes", func() {
+			b, err := pl.MarshalBinary()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{0x02, 0x03})
+		})
+	})
+
+	Convey("Given a ForceDeviceResyncReqPayload with an invalid NbTransmissions", t, func() {
+		pl := ForceDeviceResyncReqPayload{
+			NbTransmissions: 8,
+		}
+
+		Convey("Then MarshalBinary returns an error", func() {
+			_, err := pl.MarshalBinary()
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestUnmarshalUplink(t *testing.T) {
+	Convey("Given a payload containing an AppTimeReq and DeviceAppTimePeriodicityAns", t, func() {
+		b := []byte{
+			0x01, 0x04, 0x03, 0x02, 0x01, 0x13, // AppTimeReq
+			0x02, 0x01, 0x01, 0x00, 0x00, 0x00, // DeviceAppTimePeriodicityAns
+		}
+
+		Convey("Then UnmarshalUplink returns the expected payloads", func() {
+			cmds, err := UnmarshalUplink(b)
+			So(err, ShouldBeNil)
+			So(cmds, ShouldResemble, []interface{}{
+				AppTimeReqPayload{DeviceTime: 0x01020304, AnsRequired: true, TokenReq: 3},
+				DeviceAppTimePeriodicityAnsPayload{NotSupported: true, DeviceTime: 1},
+			})
+		})
+	})
+
+	Convey("Given a truncated AppTimeReq", t, func() {
+		Convey("Then UnmarshalUplink returns an error", func() {
+			_, err := UnmarshalUplink([]byte{0x01, 0x04, 0x03})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestGetAppTimeAns(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name        string
+			Req         AppTimeReqPayload
+			GPSSeconds  int64
+			ExpectedAns AppTimeAnsPayload
+			ExpectedOK  bool
+		}{
+			{
+				Name:        "device clock behind",
+				Req:         AppTimeReqPayload{DeviceTime: 1000, TokenReq: 2},
+				GPSSeconds:  1010,
+				ExpectedAns: AppTimeAnsPayload{TimeCorrection: 10, TokenAns: 2},
+				ExpectedOK:  true,
+			},
+			{
+				Name:        "device clock ahead",
+				Req:         AppTimeReqPayload{DeviceTime: 1010, TokenReq: 2},
+				GPSSeconds:  1000,
+				ExpectedAns: AppTimeAnsPayload{TimeCorrection: -10, TokenAns: 2},
+				ExpectedOK:  true,
+			},
+			{
+				Name:        "device clock correct",
+				Req:         AppTimeReqPayload{DeviceTime: 1000},
+				GPSSeconds:  1000,
+				ExpectedAns: AppTimeAnsPayload{},
+				ExpectedOK:  false,
+			},
+			{
+				Name:        "device clock correct, answer required",
+				Req:         AppTimeReqPayload{DeviceTime: 1000, AnsRequired: true, TokenReq: 1},
+				GPSSeconds:  1000,
+				ExpectedAns: AppTimeAnsPayload{TokenAns: 1},
+				ExpectedOK:  true,
+			},
+			{
+				Name:        "gps time wrapped around 2^32",
+				Req:         AppTimeReqPayload{DeviceTime: 0xfffffffe},
+				GPSSeconds:  1<<32 + 1,
+				ExpectedAns: AppTimeAnsPayload{TimeCorrection: 3},
+				ExpectedOK:  true,
+			},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Name, i), func() {
+				ans, ok := GetAppTimeAns(test.Req, test.GPSSeconds)
+				So(ans, ShouldResemble, test.ExpectedAns)
+				So(ok, ShouldEqual, test.ExpectedOK)
+			})
+		}
+	})
+}
@@ -0,0 +1,278 @@
+// Package fragmentation implements the LoRaWAN Fragmented Data Block
+// Transport application layer package (fPort 201).
+package fragmentation
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultFPort defines the default fPort used by the package.
+const DefaultFPort = 201
+
+// CID defines the command identifier.
+type CID byte
+
+// Available commands. Note that the request and answer of a command share
+// the same CID.
+const (
+	PackageVersionReq    CID = 0x00
+	FragSessionStatusReq CID = 0x01
+	FragSessionSetupReq  CID = 0x02
+	FragSessionDeleteReq CID = 0x03
+	DataFragment         CID = 0x08
+)
+
+// PackageVersionAnsPayload implements the PackageVersionAns payload.
+type PackageVersionAnsPayload struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// FragSessionSetupReqPayload implements the FragSessionSetupReq payload.
+type FragSessionSetupReqPayload struct {
+	FragIndex           uint8
+	McGroupBitMask      uint8
+	NbFrag              uint16
+	FragSize            uint8
+	FragmentationMatrix uint8
+	BlockAckDelay       uint8
+	Padding             uint8
+	Descriptor          [4]byte
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p FragSessionSetupReqPayload) MarshalBinary() ([]byte, error) {
+	if p.FragIndex > 3 {
+		return nil, errors.New("FragIndex must be between 0 and 3")
+	}
+	if p.McGroupBitMask > 15 {
+		return nil, errors.New("McGroupBitMask must be between 0 and 15")
+	}
+	if p.FragmentationMatrix > 7 || p.BlockAckDelay > 7 {
+		return nil, errors.New("FragmentationMatrix and BlockAckDelay must be between 0 and 7")
+	}
+
+	b := make([]byte, 11)
+	b[0] = byte(FragSessionSetupReq)
+	b[1] = p.FragIndex<<4 | p.McGroupBitMask
+	binary.LittleEndian.PutUint16(b[2:4], p.NbFrag)
+	b[4] = p.FragSize
+	b[5] = p.FragmentationMatrix<<3 | p.BlockAckDelay
+	b[6] = p.Padding
+	copy(b[7:11], p.Descriptor[:])
+
+	return b, nil
+}
+
+// FragSessionStatusReqPayload implements the FragSessionStatusReq payload.
+type FragSessionStatusReqPayload struct {
+	FragIndex uint8
+
+	// When set, all the devices must answer. When not set, only the
+	// devices which have not yet reconstructed the data block answer.
+	Participants bool
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p FragSessionStatusReqPayload) MarshalBinary() ([]byte, error) {
+	if p.FragIndex > 3 {
+		return nil, errors.New("FragIndex must be between 0 and 3")
+	}
+
+	b := []byte{byte(FragSessionStatusReq), p.FragIndex << 1}
+	if p.Participants {
+		b[1] |= 0x01
+	}
+	return b, nil
+}
+
+// DataFragmentPayload implements the DataFragment payload.
+type DataFragmentPayload struct {
+	FragIndex uint8
+
+	// N is the (1 based) fragment number.
+	N       uint16
+	Payload []byte
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p DataFragmentPayload) MarshalBinary() ([]byte, error) {
+	if p.FragIndex > 3 {
+		return nil, errors.New("FragIndex must be between 0 and 3")
+	}
+	if p.N >= 1<<14 {
+		return nil, errors.New("N must be less than 16384")
+	}
+
+	b := make([]byte, 3, 3+len(p.Payload))
+	b[0] = byte(DataFragment)
+	binary.LittleEndian.PutUint16(b[1:3], uint16(p.FragIndex)<<14|p.N)
+	return append(b, p.Payload...), nil
+}
+
+// FragSessionSetupAnsPayload implements the FragSessionSetupAns payload.
+type FragSessionSetupAnsPayload struct {
+	FragIndex                    uint8
+	WrongDescriptor              bool
+	FragSessionIndexNotSupported bool
+	NotEnoughMemory              bool
+	EncodingUnsupported          bool
+}
+
+// FragSessionStatusAnsPayload implements the FragSessionStatusAns payload.
+type FragSessionStatusAnsPayload struct {
+	FragIndex             uint8
+	NbFragReceived        uint16
+	MissingFrag           uint8
+	NotEnoughMatrixMemory bool
+}
+
+// FragSessionDeleteAnsPayload implements the FragSessionDeleteAns payload.
+type FragSessionDeleteAnsPayload struct {
+	FragIndex           uint8
+	SessionDoesNotExist bool
+}
+
+// UnmarshalAnswers decodes the given uplink payload into a slice of
+// answer payloads.
+func UnmarshalAnswers(b []byte) ([]interface{}, error) {
+	var out []interface{}
+
+	for len(b) > 0 {
+		cid := CID(b[0])
+		b = b[1:]
+
+		switch cid {
+		case PackageVersionReq:
+			if len(b) < 2 {
+				return nil, errors.New("PackageVersionAns: 2 bytes expected")
+			}
+			out = append(out, PackageVersionAnsPayload{
+				PackageIdentifier: b[0],
+				PackageVersion:    b[1],
+			})
+			b = b[2:]
+		case FragSessionStatusReq:
+			if len(b) < 4 {
+				return nil, errors.New("FragSessionStatusAns: 4 bytes expected")
+			}
+			ri := binary.LittleEndian.Uint16(b[0:2])
+			out = append(out, FragSessionStatusAnsPayload{
+				FragIndex:             uint8(ri >> 14),
+				NbFragReceived:        ri & 0x3fff,
+				MissingFrag:           b[2],
+				NotEnoughMatrixMemory: b[3]&0x01 != 0,
+			})
+			b = b[4:]
+		case FragSessionSetupReq:
+			if len(b) < 1 {
+				return nil, errors.New("FragSessionSetupAns: 1 byte expected")
+			}
+			out = append(out, FragSessionSetupAnsPayload{
+				FragIndex:                    b[0] >> 6,
+				WrongDescriptor:              b[0]&0x08 != 0,
+				FragSessionIndexNotSupported: b[0]&0x04 != 0,
+				NotEnoughMemory:              b[0]&0x02 != 0,
+				EncodingUnsupported:          b[0]&0x01 != 0,
+			})
+			b = b[1:]
+		case FragSessionDeleteReq:
+			if len(b) < 1 {
+				return nil, errors.New("FragSessionDeleteAns: 1 byte expected")
+			}
+			out = append(out, FragSessionDeleteAnsPayload{
+				FragIndex:           b[0] & 0x03,
+				SessionDoesNotExist: b[0]&0x04 != 0,
+			})
+			b = b[1:]
+		default:
+			return nil, fmt.Errorf("unknown CID: %d", cid)
+		}
+	}
+
+	return out, nil
+}
+
+// Encode splits the given data into fragments of the given size and
+// appends the given number of redundancy fragments for forward error
+// correction, using the parity check matrix defined by the specification.
+// The last uncoded fragment is padded with zeros, use Padding to get the
+// number of padding bytes.
+func Encode(data []byte, fragSize, redundancy int) ([][]byte, error) {
+	if fragSize <= 0 {
+		return nil, errors.New("fragSize must be greater than 0")
+	}
+	if len(data) == 0 {
+		return nil, errors.New("data must not be empty")
+	}
+
+	var uncoded [][]byte
+	for i := 0; i < len(data); i += fragSize {
+		frag := make([]byte, fragSize)
+		copy(frag, data[i:])
+		uncoded = append(uncoded, frag)
+	}
+
+	out := uncoded
+	for y := 1; y <= redundancy; y++ {
+		line := matrixLine(y, len(uncoded))
+		frag := make([]byte, fragSize)
+		for x := range uncoded {
+			if !line[x] {
+				continue
+			}
+			for i := range frag {
+				frag[i] ^= uncoded[x][i]
+			}
+		}
+		out = append(out, frag)
+	}
+
+	return out, nil
+}
+
+// Padding returns the number of padding bytes added to the last uncoded
+// fragment.
+func Padding(dataLen, fragSize int) int {
+	return (fragSize - dataLen%fragSize) % fragSize
+}
+
+// matrixLine returns line n of the parity check matrix for m uncoded
+// fragments.
+func matrixLine(n, m int) []bool {
+	line := make([]bool, m)
+
+	mm := 0
+	if m&(m-1) == 0 {
+		// m is a power of 2
+		mm = 1
+	}
+
+	x := 1 + 1001*n
+	for nbCoeff := 0; nbCoeff < m/2; nbCoeff++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = x % (m + mm)
+		}
+		line[r] = true
+	}
+
+	// with a single uncoded fragment, the redundancy fragment is a copy
+	if m == 1 {
+		line[0] = true
+	}
+
+	return line
+}
+
+// prbs23 implements the pseudo-random binary sequence generator used by
+// the parity check matrix.
+func prbs23(x int) int {
+	b0 := x & 1
+	b1 := (x & 32) >> 5
+	return (x >> 1) + ((b0 ^ b1) << 22)
+}
@@ -0,0 +1,118 @@
+package fragmentation
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	Convey("Given a FragSessionSetupReqPayload", t, func() {
+		pl := FragSessionSetupReqPayload{
+			FragIndex:      1,
+			McGroupBitMask: 1,
+			NbFrag:         10,
+			FragSize:       50,
+			BlockAckDelay:  2,
+			Padding:        3,
+			Descriptor:     [4]byte{1, 2, 3, 4},
+		}
+
+		Convey("Then MarshalBinary returns the expected bytes", func() {
+			b, err := pl.MarshalBinary()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{0x02, 0x11, 10, 0, 50, 0x02, 3, 1, 2, 3, 4})
+		})
+	})
+
+	Convey("Given a FragSessionStatusReqPayload", t, func() {
+		pl := FragSessionStatusReqPayload{
+			FragIndex:    1,
+			Participants: true,
+		}
+
+		Convey("Then MarshalBinary returns the expected bytes", func() {
+			b, err := pl.MarshalBinary()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{0x01, 0x03})
+		})
+	})
+
+	Convey("Given a DataFragmentPayload", t, func() {
+		pl := DataFragmentPayload{
+			FragIndex: 1,
+			N:         2,
+			Payload:   []byte{1, 2, 3},
+		}
+
+		Convey("Then MarshalBinary returns the expected bytes", func() {
+			b, err := pl.MarshalBinary()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{0x08, 0x02, 0x40, 1, 2, 3})
+		})
+	})
+}
+
+func TestUnmarshalAnswers(t *testing.T) {
+	Convey("Given a payload containing multiple answers", t, func() {
+		b := []byte{
+			0x02, 0x48, // FragSessionSetupAns
+			0x01, 0x05, 0x40, 0x02, 0x01, // FragSessionStatusAns
+		}
+
+		Convey("Then UnmarshalAnswers returns the expected answers", func() {
+			answers, err := UnmarshalAnswers(b)
+			So(err, ShouldBeNil)
+			So(answers, ShouldResemble, []interface{}{
+				FragSessionSetupAnsPayload{FragIndex: 1, WrongDescriptor: true},
+				FragSessionStatusAnsPayload{FragIndex: 1, NbFragReceived: 5, MissingFrag: 2, NotEnoughMatrixMemory: true},
+			})
+		})
+	})
+
+	Convey("Given an unknown CID", t, func() {
+		Convey("Then UnmarshalAnswers returns an error", func() {
+			_, err := UnmarshalAnswers([]byte{0x20})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestEncode(t *testing.T) {
+	Convey("Given 5 bytes of data, a fragment size of 2 and 2 redundancy fragments", t, func() {
+		data := []byte{1, 2, 3, 4, 5}
+
+		Convey("When calling Encode", func() {
+			frags, err := Encode(data, 2, 2)
+			So(err, ShouldBeNil)
+			So(frags, ShouldHaveLength, 5)
+
+			Convey("Then the uncoded fragments contain the padded data", func() {
+				So(frags[0:3], ShouldResemble, [][]byte{{1, 2}, {3, 4}, {5, 0}})
+				So(Padding(len(data), 2), ShouldEqual, 1)
+			})
+
+			Convey("Then each redundancy fragment is the XOR of the uncoded fragments of its matrix line", func() {
+				for y := 1; y <= 2; y++ {
+					exp := make([]byte, 2)
+					for x, set := range matrixLine(y, 3) {
+						if !set {
+							continue
+						}
+						for i := range exp {
+							exp[i] ^= frags[x][i]
+						}
+					}
+					So(frags[2+y], ShouldResemble, exp)
+				}
+			})
+		})
+	})
+
+	Convey("Given an invalid fragment size", t, func() {
+		Convey("Then Encode returns an error", func() {
+			_, err := Encode([]byte{1, 2, 3}, 0, 0)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
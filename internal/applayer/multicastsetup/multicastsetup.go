@@ -0,0 +1,295 @@
+// Package multicastsetup implements the LoRaWAN Remote Multicast Setup
+// application layer package (fPort 200).
+package multicastsetup
+
+import (
+	"crypto/aes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DefaultFPort defines the default fPort used by the package.
+const DefaultFPort = 200
+
+// CID defines the command identifier.
+type CID byte
+
+// Available commands. Note that the request and answer of a command share
+// the same CID.
+const (
+	PackageVersionReq  CID = 0x00
+	McGroupStatusReq   CID = 0x01
+	McGroupSetupReq    CID = 0x02
+	McGroupDeleteReq   CID = 0x03
+	McClassCSessionReq CID = 0x04
+)
+
+// PackageVersionAnsPayload implements the PackageVersionAns payload.
+type PackageVersionAnsPayload struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// McGroupStatusAnsPayload implements the McGroupStatusAns payload.
+type McGroupStatusAnsPayload struct {
+	NbTotalGroups uint8
+	AnsGroupMask  uint8
+	Items         []McGroupStatusAnsItem
+}
+
+// McGroupStatusAnsItem contains a defined multicast group.
+type McGroupStatusAnsItem struct {
+	McGroupID uint8
+	McAddr    lorawan.DevAddr
+}
+
+// McGroupSetupReqPayload implements the McGroupSetupReq payload.
+type McGroupSetupReqPayload struct {
+	McGroupID      uint8
+	McAddr         lorawan.DevAddr
+	McKeyEncrypted lorawan.AES128Key
+	MinMcFCnt      uint32
+	MaxMcFCnt      uint32
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p McGroupSetupReqPayload) MarshalBinary() ([]byte, error) {
+	if p.McGroupID > 3 {
+		return nil, errors.New("McGroupID must be between 0 and 3")
+	}
+
+	b := make([]byte, 30)
+	b[0] = byte(McGroupSetupReq)
+	b[1] = p.McGroupID
+
+	mcAddr, err := p.McAddr.MarshalBinary()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal mcaddr error")
+	}
+	copy(b[2:6], mcAddr)
+	copy(b[6:22], p.McKeyEncrypted[:])
+	binary.LittleEndian.PutUint32(b[22:26], p.MinMcFCnt)
+	binary.LittleEndian.PutUint32(b[26:30], p.MaxMcFCnt)
+
+	return b, nil
+}
+
+// McGroupSetupAnsPayload implements the McGroupSetupAns payload.
+type McGroupSetupAnsPayload struct {
+	McGroupID uint8
+	IDError   bool
+}
+
+// McGroupDeleteAnsPayload implements the McGroupDeleteAns payload.
+type McGroupDeleteAnsPayload struct {
+	McGroupID        uint8
+	McGroupUndefined bool
+}
+
+// McClassCSessionReqPayload implements the McClassCSessionReq payload.
+type McClassCSessionReqPayload struct {
+	McGroupID uint8
+
+	// SessionTime contains the start of the session in seconds since the
+	// GPS epoch, modulo 2^32.
+	SessionTime uint32
+
+	// SessionTimeOut defines the max. duration of the session as 2^n
+	// seconds.
+	SessionTimeOut uint8
+
+	// DLFrequency in Hz (must be a multiple of 100).
+	DLFrequency int
+	DR          uint8
+}
+
+// MarshalBinary encodes the command (including CID) to a slice of bytes.
+func (p McClassCSessionReqPayload) MarshalBinary() ([]byte, error) {
+	if p.McGroupID > 3 {
+		return nil, errors.New("McGroupID must be between 0 and 3")
+	}
+	if p.SessionTimeOut > 15 {
+		return nil, errors.New("SessionTimeOut must be between 0 and 15")
+	}
+	if p.DLFrequency%100 != 0 || p.DLFrequency/100 >= 1<<24 {
+		return nil, errors.New("DLFrequency must be a multiple of 100 and fit in 3 bytes")
+	}
+
+	b := make([]byte, 11)
+	b[0] = byte(McClassCSessionReq)
+	b[1] = p.McGroupID
+	binary.LittleEndian.PutUint32(b[2:6], p.SessionTime)
+	b[6] = p.SessionTimeOut
+
+	freq := make([]byte, 4)
+	binary.LittleEndian.PutUint32(freq, uint32(p.DLFrequency/100))
+	copy(b[7:10], freq[0:3])
+	b[10] = p.DR
+
+	return b, nil
+}
+
+// McClassCSessionAnsPayload implements the McClassCSessionAns payload.
+type McClassCSessionAnsPayload struct {
+	McGroupID        uint8
+	DRError          bool
+	FreqError        bool
+	McGroupUndefined bool
+
+	// TimeToStart is only set when there is no error.
+	TimeToStart *uint32
+}
+
+// UnmarshalAnswers decodes the given uplink payload into a slice of
+// answer payloads.
+func UnmarshalAnswers(b []byte) ([]interface{}, error) {
+	var out []interface{}
+
+	for len(b) > 0 {
+		cid := CID(b[0])
+		b = b[1:]
+
+		switch cid {
+		case PackageVersionReq:
+			if len(b) < 2 {
+				return nil, errors.New("PackageVersionAns: 2 bytes expected")
+			}
+			out = append(out, PackageVersionAnsPayload{
+				PackageIdentifier: b[0],
+				PackageVersion:    b[1],
+			})
+			b = b[2:]
+		case McGroupStatusReq:
+			if len(b) < 1 {
+				return nil, errors.New("McGroupStatusAns: at least 1 byte expected")
+			}
+			pl := McGroupStatusAnsPayload{
+				NbTotalGroups: (b[0] >> 4) & 0x07,
+				AnsGroupMask:  b[0] & 0x0f,
+			}
+			b = b[1:]
+			for i := uint8(0); i < 4; i++ {
+				if pl.AnsGroupMask&(1<<i) == 0 {
+					continue
+				}
+				if len(b) < 5 {
+					return nil, errors.New("McGroupStatusAns: 5 bytes per group expected")
+				}
+				item := McGroupStatusAnsItem{
+					McGroupID: b[0] & 0x03,
+				}
+				if err := item.McAddr.UnmarshalBinary(b[1:5]); err != nil {
+					return nil, errors.Wrap(err, "unmarshal mcaddr error")
+				}
+				pl.Items = append(pl.Items, item)
+				b = b[5:]
+			}
+			out = append(out, pl)
+		case McGroupSetupReq:
+			if len(b) < 1 {
+				return nil, errors.New("McGroupSetupAns: 1 byte expected")
+			}
+			out = append(out, McGroupSetupAnsPayload{
+				McGroupID: b[0] & 0x03,
+				IDError:   b[0]&0x04 != 0,
+			})
+			b = b[1:]
+		case McGroupDeleteReq:
+			if len(b) < 1 {
+				return nil, errors.New("McGroupDeleteAns: 1 byte expected")
+			}
+			out = append(out, McGroupDeleteAnsPayload{
+				McGroupID:        b[0] & 0x03,
+				McGroupUndefined: b[0]&0x04 != 0,
+			})
+			b = b[1:]
+		case McClassCSessionReq:
+			if len(b) < 1 {
+				return nil, errors.New("McClassCSessionAns: at least 1 byte expected")
+			}
+			pl := McClassCSessionAnsPayload{
+				McGroupID:        b[0] & 0x03,
+				DRError:          b[0]&0x04 != 0,
+				FreqError:        b[0]&0x08 != 0,
+				McGroupUndefined: b[0]&0x10 != 0,
+			}
+			b = b[1:]
+			if !pl.DRError && !pl.FreqError && !pl.McGroupUndefined {
+				if len(b) < 3 {
+					return nil, errors.New("McClassCSessionAns: 4 bytes expected")
+				}
+				tts := uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+				pl.TimeToStart = &tts
+				b = b[3:]
+			}
+			out = append(out, pl)
+		default:
+			return nil, fmt.Errorf("unknown CID: %d", cid)
+		}
+	}
+
+	return out, nil
+}
+
+// GetMcRootKeyForAppKey returns the McRootKey for the given (LoRaWAN 1.0.x)
+// GenAppKey.
+func GetMcRootKeyForAppKey(appKey lorawan.AES128Key) (lorawan.AES128Key, error) {
+	return encrypt(appKey, [16]byte{0x00})
+}
+
+// GetMcKEKey returns the McKEKey for the given McRootKey.
+func GetMcKEKey(mcRootKey lorawan.AES128Key) (lorawan.AES128Key, error) {
+	return encrypt(mcRootKey, [16]byte{0x00})
+}
+
+// EncryptMcKey returns the McKey encrypted for transmission in the
+// McGroupSetupReq, using the given McKEKey.
+func EncryptMcKey(mcKEKey, mcKey lorawan.AES128Key) (lorawan.AES128Key, error) {
+	var out lorawan.AES128Key
+
+	block, err := aes.NewCipher(mcKEKey[:])
+	if err != nil {
+		return out, errors.Wrap(err, "new cipher error")
+	}
+	// the end-device computes the McKey as aes128_encrypt(McKEKey, McKey_encrypted)
+	block.Decrypt(out[:], mcKey[:])
+	return out, nil
+}
+
+// GetMcAppSKey returns the McAppSKey given the McKey and McAddr.
+func GetMcAppSKey(mcKey lorawan.AES128Key, mcAddr lorawan.DevAddr) (lorawan.AES128Key, error) {
+	return getMcSKey(0x01, mcKey, mcAddr)
+}
+
+// GetMcNwkSKey returns the McNwkSKey given the McKey and McAddr.
+func GetMcNwkSKey(mcKey lorawan.AES128Key, mcAddr lorawan.DevAddr) (lorawan.AES128Key, error) {
+	return getMcSKey(0x02, mcKey, mcAddr)
+}
+
+func getMcSKey(typ byte, mcKey lorawan.AES128Key, mcAddr lorawan.DevAddr) (lorawan.AES128Key, error) {
+	b, err := mcAddr.MarshalBinary()
+	if err != nil {
+		return lorawan.AES128Key{}, errors.Wrap(err, "marshal mcaddr error")
+	}
+
+	var block [16]byte
+	block[0] = typ
+	copy(block[1:5], b)
+
+	return encrypt(mcKey, block)
+}
+
+func encrypt(key lorawan.AES128Key, b [16]byte) (lorawan.AES128Key, error) {
+	var out lorawan.AES128Key
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return out, errors.Wrap(err, "new cipher error")
+	}
+	block.Encrypt(out[:], b[:])
+	return out, nil
+}
@@ -0,0 +1,137 @@
+package multicastsetup
+
+import (
+	"crypto/aes"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	Convey("Given a McGroupSetupReqPayload", t, func() {
+		pl := McGroupSetupReqPayload{
+			McGroupID:      1,
+			McAddr:         lorawan.DevAddr{1, 2, 3, 4},
+			McKeyEncrypted: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			MinMcFCnt:      10,
+			MaxMcFCnt:      20,
+		}
+
+		Convey("Then MarshalBinary returns the expected bytes", func() {
+			b, err := pl.MarshalBinary()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{
+				0x02, 0x01,
+				4, 3, 2, 1,
+				1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16,
+				10, 0, 0, 0,
+				20, 0, 0, 0,
+			})
+		})
+
+		Convey("Then an invalid McGroupID returns an error", func() {
+			pl.McGroupID = 4
+			_, err := pl.MarshalBinary()
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a McClassCSessionReqPayload", t, func() {
+		pl := McClassCSessionReqPayload{
+			McGroupID:      0,
+			SessionTime:    0x01020304,
+			SessionTimeOut: 5,
+			DLFrequency:    869525000,
+			DR:             3,
+		}
+
+		Convey("Then MarshalBinary returns the expected bytes", func() {
+			b, err := pl.MarshalBinary()
+			So(err, ShouldBeNil)
+			So(b, ShouldResemble, []byte{0x04, 0x00, 0x04, 0x03, 0x02, 0x01, 0x05, 0xd2, 0xad, 0x84, 0x03})
+		})
+
+		Convey("Then a frequency which is not a multiple of 100 returns an error", func() {
+			pl.DLFrequency = 869525050
+			_, err := pl.MarshalBinary()
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestUnmarshalAnswers(t *testing.T) {
+	Convey("Given a payload containing multiple answers", t, func() {
+		b := []byte{
+			0x01, 0x11, 0x01, 4, 3, 2, 1, // McGroupStatusAns
+			0x02, 0x05, // McGroupSetupAns
+			0x04, 0x01, 0x10, 0x00, 0x00, // McClassCSessionAns
+		}
+
+		Convey("Then UnmarshalAnswers returns the expected answers", func() {
+			answers, err := UnmarshalAnswers(b)
+			So(err, ShouldBeNil)
+
+			tts := uint32(16)
+			So(answers, ShouldResemble, []interface{}{
+				McGroupStatusAnsPayload{
+					NbTotalGroups: 1,
+					AnsGroupMask:  1,
+					Items: []McGroupStatusAnsItem{
+						{McGroupID: 1, McAddr: lorawan.DevAddr{1, 2, 3, 4}},
+					},
+				},
+				McGroupSetupAnsPayload{McGroupID: 1, IDError: true},
+				McClassCSessionAnsPayload{McGroupID: 1, TimeToStart: &tts},
+			})
+		})
+	})
+
+	Convey("Given a truncated McClassCSessionAns", t, func() {
+		b := []byte{0x04, 0x01, 0x10}
+
+		Convey("Then UnmarshalAnswers returns an error", func() {
+			_, err := UnmarshalAnswers(b)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestKeys(t *testing.T) {
+	Convey("Given a McKEKey and McKey", t, func() {
+		mcKEKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+		mcKey := lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("Then the device can recover the McKey from the encrypted McKey", func() {
+			enc, err := EncryptMcKey(mcKEKey, mcKey)
+			So(err, ShouldBeNil)
+			So(enc, ShouldNotEqual, mcKey)
+
+			block, err := aes.NewCipher(mcKEKey[:])
+			So(err, ShouldBeNil)
+			var out lorawan.AES128Key
+			block.Encrypt(out[:], enc[:])
+			So(out, ShouldEqual, mcKey)
+		})
+
+		Convey("Then the McAppSKey and McNwkSKey are derived from the McKey and McAddr", func() {
+			mcAddr := lorawan.DevAddr{1, 2, 3, 4}
+
+			block, err := aes.NewCipher(mcKey[:])
+			So(err, ShouldBeNil)
+
+			var expAppSKey, expNwkSKey lorawan.AES128Key
+			block.Encrypt(expAppSKey[:], []byte{0x01, 4, 3, 2, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+			block.Encrypt(expNwkSKey[:], []byte{0x02, 4, 3, 2, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+
+			appSKey, err := GetMcAppSKey(mcKey, mcAddr)
+			So(err, ShouldBeNil)
+			So(appSKey, ShouldEqual, expAppSKey)
+
+			nwkSKey, err := GetMcNwkSKey(mcKey, mcAddr)
+			So(err, ShouldBeNil)
+			So(nwkSKey, ShouldEqual, expNwkSKey)
+		})
+	})
+}
@@ -7,14 +7,24 @@ import (
 
 	"github.com/gusseleet/lora-app-server/internal/common"
 	"github.com/gusseleet/lora-app-server/internal/handler"
+	"github.com/gusseleet/lora-app-server/internal/mailer"
+	"github.com/gusseleet/lora-app-server/internal/multicast"
 	"github.com/gusseleet/lora-app-server/internal/nsclient"
 )
 
 // Config defines the configuration structure.
 type Config struct {
 	General struct {
-		LogLevel               int `mapstructure:"log_level"`
-		PasswordHashIterations int `mapstructure:"password_hash_iterations"`
+		LogLevel               int    `mapstructure:"log_level"`
+		PasswordHashAlgorithm  string `mapstructure:"password_hash_algorithm"`
+		PasswordHashIterations int    `mapstructure:"password_hash_iterations"`
+		BcryptCost             int    `mapstructure:"bcrypt_cost"`
+
+		PasswordPolicy struct {
+			MinLength             int    `mapstructure:"min_length"`
+			MinCharacterClasses   int    `mapstructure:"min_character_classes"`
+			BreachedPasswordsFile string `mapstructure:"breached_passwords_file"`
+		} `mapstructure:"password_policy"`
 	}
 
 	PostgreSQL struct {
@@ -54,10 +64,12 @@ type Config struct {
 
 		ExternalAPI struct {
 			Bind                       string
-			TLSCert                    string `mapstructure:"tls_cert"`
-			TLSKey                     string `mapstructure:"tls_key"`
-			JWTSecret                  string `mapstructure:"jwt_secret"`
-			DisableAssignExistingUsers bool   `mapstructure:"disable_assign_existing_users"`
+			TLSCert                    string        `mapstructure:"tls_cert"`
+			TLSKey                     string        `mapstructure:"tls_key"`
+			JWTSecret                  string        `mapstructure:"jwt_secret"`
+			AccessTokenTTL             time.Duration `mapstructure:"access_token_ttl"`
+			DisableAssignExistingUsers bool          `mapstructure:"disable_assign_existing_users"`
+			TrustedProxies             []string      `mapstructure:"trusted_proxies"`
 		} `mapstructure:"external_api"`
 
 		Branding struct {
@@ -72,6 +84,85 @@ type Config struct {
 			Frequency int
 			DR        int `mapstructure:"dr"`
 		} `mapstructure:"gateway_discovery"`
+
+		BulkEnqueue struct {
+			BatchSize int `mapstructure:"batch_size"`
+			Rate      int
+		} `mapstructure:"bulk_enqueue"`
+
+		Usage struct {
+			FlushInterval time.Duration `mapstructure:"flush_interval"`
+		}
+
+		Webhook struct {
+			Timeout       time.Duration
+			MaxAttempts   int           `mapstructure:"max_attempts"`
+			RetryInterval time.Duration `mapstructure:"retry_interval"`
+		}
+
+		Multicast struct {
+			Sender    multicast.Sender
+			SenderURL string `mapstructure:"sender_url"`
+		}
+
+		OIDC struct {
+			Enabled       bool
+			ProviderURL   string         `mapstructure:"provider_url"`
+			ClientID      string         `mapstructure:"client_id"`
+			ClientSecret  string         `mapstructure:"client_secret"`
+			RedirectURL   string         `mapstructure:"redirect_url"`
+			LoginLabel    string         `mapstructure:"login_label"`
+			UsernameClaim string         `mapstructure:"username_claim"`
+			EmailClaim    string         `mapstructure:"email_claim"`
+			GroupsClaim   string         `mapstructure:"groups_claim"`
+			AdminGroup    string         `mapstructure:"admin_group"`
+			GroupMapping  []GroupMapping `mapstructure:"group_mapping"`
+		} `mapstructure:"oidc"`
+
+		LDAP struct {
+			Enabled            bool
+			Server             string
+			TLS                bool           `mapstructure:"tls"`
+			StartTLS           bool           `mapstructure:"start_tls"`
+			CACert             string         `mapstructure:"ca_cert"`
+			InsecureSkipVerify bool           `mapstructure:"insecure_skip_verify"`
+			BindDN             string         `mapstructure:"bind_dn"`
+			BindPassword       string         `mapstructure:"bind_password"`
+			BaseDN             string         `mapstructure:"base_dn"`
+			UserFilter         string         `mapstructure:"user_filter"`
+			EmailAttribute     string         `mapstructure:"email_attribute"`
+			GroupAttribute     string         `mapstructure:"group_attribute"`
+			AdminGroup         string         `mapstructure:"admin_group"`
+			GroupMapping       []GroupMapping `mapstructure:"group_mapping"`
+		} `mapstructure:"ldap"`
+
+		LoginLockout struct {
+			MaxAttempts   int `mapstructure:"max_attempts"`
+			IPMaxAttempts int `mapstructure:"ip_max_attempts"`
+			Window        time.Duration
+			Duration      time.Duration
+			Delay         time.Duration
+			MaxDelay      time.Duration `mapstructure:"max_delay"`
+
+			PasswordResetMaxRequests   int `mapstructure:"password_reset_max_requests"`
+			PasswordResetIPMaxRequests int `mapstructure:"password_reset_ip_max_requests"`
+		} `mapstructure:"login_lockout"`
+
+		Mailer struct {
+			Mailer                         mailer.Mailer
+			Server                         string
+			Username                       string
+			Password                       string
+			From                           string
+			BaseURL                        string        `mapstructure:"base_url"`
+			EmailVerification              bool          `mapstructure:"email_verification"`
+			PasswordResetTTL               time.Duration `mapstructure:"password_reset_ttl"`
+			EmailVerificationTTL           time.Duration `mapstructure:"email_verification_ttl"`
+			OrganizationInvitationTTL      time.Duration `mapstructure:"organization_invitation_ttl"`
+			PasswordResetTemplate          string        `mapstructure:"password_reset_template"`
+			EmailVerificationTemplate      string        `mapstructure:"email_verification_template"`
+			OrganizationInvitationTemplate string        `mapstructure:"organization_invitation_template"`
+		}
 	} `mapstructure:"application_server"`
 
 	JoinServer struct {
@@ -81,11 +172,31 @@ type Config struct {
 		TLSKey  string `mapstructure:"tls_key"`
 	} `mapstructure:"join_server"`
 
+	Metrics struct {
+		Prometheus struct {
+			EndpointEnabled    bool   `mapstructure:"endpoint_enabled"`
+			Bind               string `mapstructure:"bind"`
+			APITimingHistogram bool   `mapstructure:"api_timing_histogram"`
+		} `mapstructure:"prometheus"`
+	} `mapstructure:"metrics"`
+
+	Health struct {
+		Bind string `mapstructure:"bind"`
+	} `mapstructure:"health"`
+
 	NetworkServer struct {
 		Server string
 		Pool   nsclient.Pool
 	} `mapstructure:"network_server"`
 }
 
+// GroupMapping maps a group of an external identity provider to an
+// organization membership.
+type GroupMapping struct {
+	Group          string
+	OrganizationID int64 `mapstructure:"organization_id"`
+	IsAdmin        bool  `mapstructure:"is_admin"`
+}
+
 // C holds the global configuration.
 var C Config
@@ -0,0 +1,231 @@
+// Package cron implements parsing of cron expressions and the calculation
+// of the next activation time.
+//
+// The standard five-field format is supported (minute, hour, day of month,
+// month and day of week), including ranges (1-5), steps (*/15, 0-30/10),
+// lists (1,15), month and day names (jan, mon) and the @yearly, @annually,
+// @monthly, @weekly, @daily, @midnight and @hourly descriptors.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxYears defines the number of years Next will look ahead before giving
+// up (e.g. for expressions like "0 0 30 2 *" which never match).
+const maxYears = 5
+
+type bounds struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteBounds = bounds{0, 59, nil}
+	hourBounds   = bounds{0, 23, nil}
+	domBounds    = bounds{1, 31, nil}
+	monthBounds  = bounds{1, 12, map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowBounds = bounds{0, 7, map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Schedule represents a parsed cron expression.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar are set when the day of month or day of week
+	// field was a wildcard. When both are restricted, a day matches when
+	// either of them matches (as in the original cron implementation).
+	domStar, dowStar bool
+}
+
+// Parse parses the given cron expression.
+func Parse(expr string) (Schedule, error) {
+	var s Schedule
+
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		e, ok := descriptors[strings.ToLower(expr)]
+		if !ok {
+			return s, fmt.Errorf("unknown descriptor: %s", expr)
+		}
+		expr = e
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return s, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	var err error
+	if s.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return s, fmt.Errorf("minute: %s", err)
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return s, fmt.Errorf("hour: %s", err)
+	}
+	if s.dom, err = parseField(fields[2], domBounds); err != nil {
+		return s, fmt.Errorf("day of month: %s", err)
+	}
+	if s.month, err = parseField(fields[3], monthBounds); err != nil {
+		return s, fmt.Errorf("month: %s", err)
+	}
+	if s.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return s, fmt.Errorf("day of week: %s", err)
+	}
+
+	// both 0 and 7 mean sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	s.domStar = strings.HasPrefix(fields[2], "*")
+	s.dowStar = strings.HasPrefix(fields[4], "*")
+
+	return s, nil
+}
+
+// Next returns the first activation time after the given time. The returned
+// time is truncated to the minute and uses the location of the given time.
+// A zero time is returned when the schedule does not match within the next
+// five years.
+func (s Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+	yearLimit := t.Year() + maxYears
+
+	for t.Year() <= yearLimit {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// parseField parses a comma-separated list of ranges into a bitmask.
+func parseField(field string, b bounds) (uint64, error) {
+	var out uint64
+	for _, part := range strings.Split(field, ",") {
+		bits, err := parseRange(part, b)
+		if err != nil {
+			return 0, err
+		}
+		out |= bits
+	}
+	return out, nil
+}
+
+// parseRange parses a single range with optional step (e.g. "*", "*/5",
+// "1-10", "1-10/2" or "5").
+func parseRange(expr string, b bounds) (uint64, error) {
+	var start, end, step int
+	var err error
+
+	rangeAndStep := strings.Split(expr, "/")
+	if len(rangeAndStep) > 2 {
+		return 0, fmt.Errorf("invalid range: %s", expr)
+	}
+
+	lowAndHigh := strings.Split(rangeAndStep[0], "-")
+	switch {
+	case lowAndHigh[0] == "*" && len(lowAndHigh) == 1:
+		start, end = b.min, b.max
+	case len(lowAndHigh) == 1:
+		if start, err = parseValue(lowAndHigh[0], b); err != nil {
+			return 0, err
+		}
+		end = start
+	case len(lowAndHigh) == 2:
+		if start, err = parseValue(lowAndHigh[0], b); err != nil {
+			return 0, err
+		}
+		if end, err = parseValue(lowAndHigh[1], b); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("invalid range: %s", expr)
+	}
+
+	step = 1
+	if len(rangeAndStep) == 2 {
+		if step, err = strconv.Atoi(rangeAndStep[1]); err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step: %s", expr)
+		}
+
+		// "5/10" means "5-max/10"
+		if len(lowAndHigh) == 1 && lowAndHigh[0] != "*" {
+			end = b.max
+		}
+	}
+
+	if start > end {
+		return 0, fmt.Errorf("start of range is beyond end of range: %s", expr)
+	}
+
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << uint(i)
+	}
+	return bits, nil
+}
+
+func parseValue(expr string, b bounds) (int, error) {
+	if v, ok := b.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(expr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value: %s", expr)
+	}
+	if v < b.min || v > b.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, b.min, b.max)
+	}
+	return v, nil
+}
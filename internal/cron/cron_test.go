@@ -0,0 +1,65 @@
+package cron
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSchedule(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		now := time.Date(2018, 2, 14, 13, 37, 20, 0, time.UTC)
+
+		tests := []struct {
+			Expr string
+			Next time.Time
+		}{
+			{"* * * * *", time.Date(2018, 2, 14, 13, 38, 0, 0, time.UTC)},
+			{"*/15 * * * *", time.Date(2018, 2, 14, 13, 45, 0, 0, time.UTC)},
+			{"30 2 * * *", time.Date(2018, 2, 15, 2, 30, 0, 0, time.UTC)},
+			{"0 0 1 * *", time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)},
+			{"0 9-17/4 * * *", time.Date(2018, 2, 14, 17, 0, 0, 0, time.UTC)},
+			{"0,30 14 * * *", time.Date(2018, 2, 14, 14, 0, 0, 0, time.UTC)},
+			{"0 12 * * mon", time.Date(2018, 2, 19, 12, 0, 0, 0, time.UTC)},
+			{"0 12 * * 7", time.Date(2018, 2, 18, 12, 0, 0, 0, time.UTC)},
+			{"0 0 29 feb *", time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+			{"0 0 13 * fri", time.Date(2018, 2, 16, 0, 0, 0, 0, time.UTC)},
+			{"@hourly", time.Date(2018, 2, 14, 14, 0, 0, 0, time.UTC)},
+			{"@daily", time.Date(2018, 2, 15, 0, 0, 0, 0, time.UTC)},
+			{"@yearly", time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{"0 0 30 2 *", time.Time{}},
+		}
+
+		for i, test := range tests {
+			Convey(fmt.Sprintf("Testing: %s [%d]", test.Expr, i), func() {
+				s, err := Parse(test.Expr)
+				So(err, ShouldBeNil)
+				So(s.Next(now), ShouldResemble, test.Next)
+			})
+		}
+	})
+
+	Convey("Given a set of invalid expressions", t, func() {
+		for _, expr := range []string{
+			"",
+			"* * * *",
+			"* * * * * *",
+			"60 * * * *",
+			"* 24 * * *",
+			"* * 0 * *",
+			"* * * 13 *",
+			"* * * * 8",
+			"*/0 * * * *",
+			"10-5 * * * *",
+			"foo * * * *",
+			"@fortnightly",
+		} {
+			Convey("Then parsing "+expr+" returns an error", func() {
+				_, err := Parse(expr)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package downlink
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// BulkEnqueueLoop is a never returning function processing the pending
+// devices of the device-queue bulk jobs. The number of devices processed
+// per second is limited by the configured rate.
+func BulkEnqueueLoop() {
+	for {
+		start := time.Now()
+
+		n, err := processBulkEnqueueBatch(bulkEnqueueBatchSize())
+		if err != nil {
+			log.Errorf("process bulk enqueue batch error: %s", err)
+		}
+
+		if n == 0 {
+			time.Sleep(time.Second)
+			continue
+		}
+
+		if rate := config.C.ApplicationServer.BulkEnqueue.Rate; rate > 0 {
+			wait := time.Duration(n)*time.Second/time.Duration(rate) - time.Since(start)
+			if wait > 0 {
+				time.Sleep(wait)
+			}
+		}
+	}
+}
+
+// bulkEnqueueBatchSize returns the number of devices to process per batch.
+// When a rate is configured, the batch size never exceeds the rate so that
+// a single batch does not result in a burst of enqueues.
+func bulkEnqueueBatchSize() int {
+	size := config.C.ApplicationServer.BulkEnqueue.BatchSize
+	if size <= 0 {
+		size = 100
+	}
+	if rate := config.C.ApplicationServer.BulkEnqueue.Rate; rate > 0 && rate < size {
+		size = rate
+	}
+	return size
+}
+
+// processBulkEnqueueBatch enqueues the payload for the next batch of
+// pending devices. It returns the number of processed devices.
+func processBulkEnqueueBatch(size int) (int, error) {
+	jobs := make(map[int64]storage.DeviceQueueBulkJob)
+
+	for count := 0; count < size; count++ {
+		processed, err := processBulkEnqueueDevice(jobs)
+		if err != nil {
+			return count, err
+		}
+		if !processed {
+			return count, nil
+		}
+	}
+
+	return size, nil
+}
+
+// processBulkEnqueueDevice enqueues the payload for the next pending device.
+// The status of the device is stored in the transaction locking the device,
+// which is committed right after the enqueue so that a failure for an other
+// device can not reset the device to pending (and enqueue it again). It
+// returns false when there are no pending devices.
+func processBulkEnqueueDevice(jobs map[int64]storage.DeviceQueueBulkJob) (bool, error) {
+	var processed bool
+
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		devices, err := storage.GetPendingDeviceQueueBulkJobDevices(tx, 1)
+		if err != nil {
+			return errors.Wrap(err, "get pending device-queue bulk job devices error")
+		}
+		if len(devices) == 0 {
+			return nil
+		}
+		d := &devices[0]
+
+		job, ok := jobs[d.JobID]
+		if !ok {
+			job, err = storage.GetDeviceQueueBulkJob(tx, d.JobID)
+			if err != nil {
+				return errors.Wrap(err, "get device-queue bulk job error")
+			}
+			jobs[d.JobID] = job
+		}
+
+		if err = enqueueBulkJobDevice(d.DevEUI, job); err != nil {
+			log.WithFields(log.Fields{
+				"job_id":  d.JobID,
+				"dev_eui": d.DevEUI,
+			}).Errorf("bulk enqueue downlink payload error: %s", err)
+
+			d.Status = storage.DeviceQueueBulkJobDeviceFailed
+			d.Error = err.Error()
+		} else {
+			d.Status = storage.DeviceQueueBulkJobDeviceQueued
+		}
+
+		if err := storage.UpdateDeviceQueueBulkJobDevice(tx, d); err != nil {
+			return errors.Wrap(err, "update device-queue bulk job device error")
+		}
+
+		processed = true
+		return nil
+	})
+
+	return processed, err
+}
+
+// enqueueBulkJobDevice validates and enqueues the payload of the given job
+// for the given device. The enqueue has its own transaction so that a
+// failing enqueue does not abort the transaction holding the locks.
+func enqueueBulkJobDevice(devEUI lorawan.EUI64, job storage.DeviceQueueBulkJob) error {
+	d, err := storage.GetDevice(config.C.PostgreSQL.DB, devEUI)
+	if err != nil {
+		return errors.Wrap(err, "get device error")
+	}
+
+	if err := ValidateDevicePayload(config.C.PostgreSQL.DB, d, job.FPort, job.Data); err != nil {
+		return errors.Wrap(err, "validate payload error")
+	}
+
+	return storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		return EnqueueDownlinkPayload(tx, devEUI, job.Reference, job.Confirmed, job.FPort, job.Data)
+	})
+}
@@ -0,0 +1,171 @@
+package downlink
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/backend"
+)
+
+func TestBulkEnqueue(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database, an organization, application + device and a second device without activation", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetNextDownlinkFCntForDevEUIResponse = ns.GetNextDownlinkFCntForDevEUIResponse{
+			FCnt: 12,
+		}
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		device := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-node",
+			DevEUI:          [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(config.C.PostgreSQL.DB, &device), ShouldBeNil)
+
+		da := storage.DeviceActivation{
+			DevEUI:  [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
+			DevAddr: [4]byte{1, 2, 3, 4},
+			AppSKey: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		}
+		So(storage.CreateDeviceActivation(config.C.PostgreSQL.DB, &da), ShouldBeNil)
+
+		b, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, 12, []byte{1, 2, 3, 4})
+		So(err, ShouldBeNil)
+
+		device2 := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-node-2",
+			DevEUI:          [8]byte{2, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(config.C.PostgreSQL.DB, &device2), ShouldBeNil)
+
+		job := storage.DeviceQueueBulkJob{
+			ApplicationID: app.ID,
+			FPort:         2,
+			Data:          []byte{1, 2, 3, 4},
+		}
+		So(storage.CreateDeviceQueueBulkJob(config.C.PostgreSQL.DB, &job), ShouldBeNil)
+
+		Convey("Given a second job with a reserved fPort", func() {
+			job2 := storage.DeviceQueueBulkJob{
+				ApplicationID: app.ID,
+				FPort:         224,
+				Data:          []byte{1, 2, 3, 4},
+			}
+			So(storage.CreateDeviceQueueBulkJob(config.C.PostgreSQL.DB, &job2), ShouldBeNil)
+
+			Convey("When processing all pending devices", func() {
+				n, err := processBulkEnqueueBatch(10)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 4)
+
+				Convey("Then the devices of the second job have failed without enqueueing", func() {
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+
+					count, err := storage.GetDeviceQueueBulkJobCount(config.C.PostgreSQL.DB, job2.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldResemble, storage.DeviceQueueBulkJobCount{Failed: 2})
+				})
+			})
+		})
+
+		Convey("When processing a batch of size 1", func() {
+			n, err := processBulkEnqueueBatch(1)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 1)
+
+			Convey("Then the payload has been enqueued for the first device", func() {
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+				So(<-nsClient.CreateDeviceQueueItemChan, ShouldResemble, ns.CreateDeviceQueueItemRequest{
+					Item: &ns.DeviceQueueItem{
+						DevEUI:     device.DevEUI[:],
+						FrmPayload: b,
+						FCnt:       12,
+						FPort:      2,
+					},
+				})
+			})
+
+			Convey("Then one device is queued and one is pending", func() {
+				count, err := storage.GetDeviceQueueBulkJobCount(config.C.PostgreSQL.DB, job.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldResemble, storage.DeviceQueueBulkJobCount{Pending: 1, Queued: 1})
+			})
+
+			Convey("When processing the next batch", func() {
+				n, err := processBulkEnqueueBatch(10)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 1)
+
+				Convey("Then the device without activation has failed", func() {
+					count, err := storage.GetDeviceQueueBulkJobCount(config.C.PostgreSQL.DB, job.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldResemble, storage.DeviceQueueBulkJobCount{Queued: 1, Failed: 1})
+
+					devices, err := storage.GetDeviceQueueBulkJobDevices(config.C.PostgreSQL.DB, job.ID, 10, 0)
+					So(err, ShouldBeNil)
+					So(devices[1].DevEUI, ShouldEqual, device2.DevEUI)
+					So(devices[1].Error, ShouldNotEqual, "")
+				})
+
+				Convey("Then a next batch has nothing to process", func() {
+					n, err := processBulkEnqueueBatch(10)
+					So(err, ShouldBeNil)
+					So(n, ShouldEqual, 0)
+				})
+			})
+		})
+	})
+}
@@ -4,17 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 
-	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
-	"golang.org/x/net/context"
 
 	"github.com/gusseleet/lora-app-server/internal/codec"
 	"github.com/gusseleet/lora-app-server/internal/config"
 	"github.com/gusseleet/lora-app-server/internal/handler"
 	"github.com/gusseleet/lora-app-server/internal/storage"
-	"github.com/brocaar/loraserver/api/ns"
-	"github.com/brocaar/lorawan"
 )
 
 // HandleDataDownPayloads handles received downlink payloads to be emitted to the
@@ -75,80 +71,47 @@ func handleDataDownPayload(pl handler.DataDownPayload) error {
 		}
 	}
 
-	return storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
-		if err := EnqueueDownlinkPayload(tx, pl.DevEUI, pl.Reference, pl.Confirmed, pl.FPort, pl.Data); err != nil {
-			return errors.Wrap(err, "enqueue downlink device-queue item error")
+	if err := ValidateDevicePayload(config.C.PostgreSQL.DB, d, pl.FPort, pl.Data); err != nil {
+		if IsValidationError(err) {
+			sendValidationErrorNotification(d, err)
 		}
-		return nil
-	})
-}
-
-// EnqueueDownlinkPayload adds the downlink payload to the network-server
-// device-queue.
-func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, reference string, confirmed bool, fPort uint8, data []byte) error {
-	// get network-server and network-server api client
-	n, err := storage.GetNetworkServerForDevEUI(db, devEUI)
-	if err != nil {
-		return errors.Wrap(err, "get network-server error")
-	}
-	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
-	if err != nil {
-		return errors.Wrap(err, "get network-server client error")
+		return errors.Wrap(err, "validate payload error")
 	}
 
-	// get fCnt to use for encrypting and enqueueing
-	resp, err := nsClient.GetNextDownlinkFCntForDevEUI(context.Background(), &ns.GetNextDownlinkFCntForDevEUIRequest{
-		DevEUI: devEUI[:],
-	})
-	if err != nil {
-		return errors.Wrap(err, "get next downlink fcnt for deveui error")
+	opts := QueueItemOptions{
+		ExpiresAt: pl.ExpiresAt,
+		Priority:  pl.Priority,
+		DedupKey:  pl.DedupKey,
 	}
-
-	// get current device-activation for AppSKey
-	da, err := storage.GetLastDeviceActivationForDevEUI(db, devEUI)
-	if err != nil {
-		return errors.Wrap(err, "get last device-activation error")
+	if err := EnqueueDownlinkPayloadWithOptions(pl.DevEUI, pl.Reference, pl.Confirmed, pl.FPort, pl.Data, opts); err != nil {
+		return errors.Wrap(err, "enqueue downlink device-queue item error")
 	}
+	return nil
+}
 
-	// encrypt payload
-	b, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, resp.FCnt, data)
-	if err != nil {
-		return errors.Wrap(err, "encrypt frmpayload error")
+// sendValidationErrorNotification publishes the given payload validation
+// error to the integrations, so that the application (which might have
+// published the payload over MQTT) is notified.
+func sendValidationErrorNotification(d storage.Device, err error) {
+	app, appErr := storage.GetApplication(config.C.PostgreSQL.DB, d.ApplicationID)
+	if appErr != nil {
+		log.WithField("id", d.ApplicationID).WithError(appErr).Error("get application error")
+		return
 	}
 
-	// create device-queue mapping (for mapping a device-queue item to an
-	// user-given reference)
-	if confirmed == true {
-		err = storage.CreateDeviceQueueMapping(db, &storage.DeviceQueueMapping{
-			Reference: reference,
-			DevEUI:    devEUI,
-			FCnt:      resp.FCnt,
-		})
-		if err != nil {
-			return errors.Wrap(err, "create device-queue mapping error")
-		}
+	typ := "DOWNLINK_PAYLOAD_SIZE"
+	if errors.Cause(err) == ErrFPortReserved {
+		typ = "DOWNLINK_FPORT"
 	}
 
-	// enqueue device-queue item
-	_, err = nsClient.CreateDeviceQueueItem(context.Background(), &ns.CreateDeviceQueueItemRequest{
-		Item: &ns.DeviceQueueItem{
-			DevEUI:     devEUI[:],
-			FrmPayload: b,
-			FCnt:       resp.FCnt,
-			FPort:      uint32(fPort),
-			Confirmed:  confirmed,
-		},
-	})
-	if err != nil {
-		return errors.Wrap(err, "create device-queue item error")
+	if err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(handler.ErrorNotification{
+		ApplicationID:   app.ID,
+		ApplicationName: app.Name,
+		DeviceName:      d.Name,
+		DevEUI:          d.DevEUI,
+		Type:            typ,
+		Error:           err.Error(),
+	}); err != nil {
+		log.WithField("dev_eui", d.DevEUI).WithError(err).Error("send error notification to handler error")
 	}
-
-	log.WithFields(log.Fields{
-		"f_cnt":     resp.FCnt,
-		"dev_eui":   devEUI,
-		"reference": reference,
-		"confirmed": confirmed,
-	}).Info("downlink device-queue item handled")
-
-	return nil
 }
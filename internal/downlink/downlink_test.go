@@ -14,6 +14,7 @@ import (
 
 	"github.com/gusseleet/lora-app-server/internal/storage"
 	"github.com/gusseleet/lora-app-server/internal/test"
+	"github.com/gusseleet/lora-app-server/internal/test/testhandler"
 	"github.com/brocaar/loraserver/api/ns"
 	"github.com/brocaar/lorawan"
 	"github.com/brocaar/lorawan/backend"
@@ -26,6 +27,7 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 		t.Fatal(err)
 	}
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
 
 	Convey("Given a clean database an organization, application + node", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
@@ -216,5 +218,52 @@ func TestHandleDownlinkQueueItem(t *testing.T) {
 				})
 			}
 		})
+
+		Convey("Given the device sent its last uplink at SF12BW125 in EU868", func() {
+			h := testhandler.NewTestHandler()
+			config.C.ApplicationServer.Integration.Handler = h
+
+			device.LastUplinkDataRate = "SF12BW125"
+			So(storage.UpdateDevice(config.C.PostgreSQL.DB, &device), ShouldBeNil)
+			nsClient.GetDeviceProfileResponse = ns.GetDeviceProfileResponse{
+				DeviceProfile: &ns.DeviceProfile{
+					RfRegion: "EU868",
+				},
+			}
+
+			Convey("When handling a payload exceeding the maximum payload size", func() {
+				err := handleDataDownPayload(handler.DataDownPayload{
+					ApplicationID: app.ID,
+					DevEUI:        device.DevEUI,
+					FPort:         2,
+					Data:          make([]byte, 52),
+				})
+				So(errors.Cause(err), ShouldEqual, ErrPayloadTooLarge)
+
+				Convey("Then nothing was enqueued and an error notification was sent", func() {
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+					So(h.SendErrorNotificationChan, ShouldHaveLength, 1)
+					n := <-h.SendErrorNotificationChan
+					So(n.Type, ShouldEqual, "DOWNLINK_PAYLOAD_SIZE")
+					So(n.DevEUI, ShouldEqual, device.DevEUI)
+					So(n.ApplicationID, ShouldEqual, app.ID)
+				})
+			})
+
+			Convey("When handling a payload on fPort 0", func() {
+				err := handleDataDownPayload(handler.DataDownPayload{
+					ApplicationID: app.ID,
+					DevEUI:        device.DevEUI,
+					FPort:         0,
+					Data:          []byte{1, 2, 3, 4},
+				})
+				So(errors.Cause(err), ShouldEqual, ErrFPortReserved)
+
+				Convey("Then an error notification was sent", func() {
+					So(h.SendErrorNotificationChan, ShouldHaveLength, 1)
+					So((<-h.SendErrorNotificationChan).Type, ShouldEqual, "DOWNLINK_FPORT")
+				})
+			})
+		})
 	})
 }
@@ -0,0 +1,21 @@
+package downlink
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var enqueueCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "lora_app_server_downlink_enqueue_total",
+	Help: "The number of downlink payloads enqueued, per type (device or multicast) and result (success or failure).",
+}, []string{"type", "result"})
+
+// countEnqueue increments the enqueue counter for the given type and the
+// result matching the given error.
+func countEnqueue(typ string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	enqueueCounter.WithLabelValues(typ, result).Inc()
+}
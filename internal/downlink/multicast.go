@@ -0,0 +1,68 @@
+package downlink
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/multicast"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+// ErrMulticastNotConfigured is returned when no multicast sender has been
+// configured.
+var ErrMulticastNotConfigured = errors.New("multicast sender is not configured")
+
+// EnqueueMulticastPayload encrypts the given payload with the session keys
+// of the multicast group and hands it over to the configured multicast
+// sender. It returns the frame-counter used.
+func EnqueueMulticastPayload(db sqlx.Ext, multicastGroupID int64, fPort uint8, data []byte) (uint32, error) {
+	fCnt, err := enqueueMulticastPayload(db, multicastGroupID, fPort, data)
+	countEnqueue("multicast", err)
+	return fCnt, err
+}
+
+func enqueueMulticastPayload(db sqlx.Ext, multicastGroupID int64, fPort uint8, data []byte) (uint32, error) {
+	sender := config.C.ApplicationServer.Multicast.Sender
+	if sender == nil {
+		return 0, ErrMulticastNotConfigured
+	}
+
+	g, err := storage.GetMulticastGroup(db, multicastGroupID)
+	if err != nil {
+		return 0, errors.Wrap(err, "get multicast group error")
+	}
+
+	fCnt, err := storage.IncrementMulticastGroupFCnt(db, g.ID)
+	if err != nil {
+		return 0, errors.Wrap(err, "increment multicast group fcnt error")
+	}
+
+	b, err := multicast.NewPHYPayload(g.McAddr, g.McAppSKey, g.McNwkSKey, fCnt, fPort, data)
+	if err != nil {
+		return 0, errors.Wrap(err, "new phypayload error")
+	}
+
+	err = sender.SendMulticast(multicast.Item{
+		MulticastGroupID: g.ID,
+		ApplicationID:    g.ApplicationID,
+		McAddr:           g.McAddr,
+		FCnt:             fCnt,
+		FPort:            fPort,
+		Frequency:        g.Frequency,
+		DR:               g.DR,
+		PHYPayload:       b,
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "send multicast error")
+	}
+
+	log.WithFields(log.Fields{
+		"multicast_group_id": g.ID,
+		"mc_addr":            g.McAddr,
+		"f_cnt":              fCnt,
+	}).Info("multicast downlink handled")
+
+	return fCnt, nil
+}
@@ -0,0 +1,112 @@
+package downlink
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/multicast"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/backend"
+)
+
+func TestEnqueueMulticastPayload(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database, an application and a multicast group", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		g := storage.MulticastGroup{
+			ApplicationID: app.ID,
+			Name:          "test-group",
+			McAddr:        lorawan.DevAddr{1, 2, 3, 4},
+			McAppSKey:     lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+			McNwkSKey:     lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+			FCnt:          5,
+			Frequency:     869525000,
+			DR:            3,
+		}
+		So(storage.CreateMulticastGroup(config.C.PostgreSQL.DB, &g), ShouldBeNil)
+
+		Convey("Given no multicast sender is configured", func() {
+			config.C.ApplicationServer.Multicast.Sender = nil
+
+			Convey("Then EnqueueMulticastPayload returns an error", func() {
+				_, err := EnqueueMulticastPayload(config.C.PostgreSQL.DB, g.ID, 10, []byte{1, 2, 3})
+				So(errors.Cause(err), ShouldEqual, ErrMulticastNotConfigured)
+			})
+		})
+
+		Convey("Given a multicast sender is configured", func() {
+			sender := test.NewMulticastSender()
+			config.C.ApplicationServer.Multicast.Sender = sender
+
+			Convey("When calling EnqueueMulticastPayload", func() {
+				fCnt, err := EnqueueMulticastPayload(config.C.PostgreSQL.DB, g.ID, 10, []byte{1, 2, 3})
+				So(err, ShouldBeNil)
+				So(fCnt, ShouldEqual, 5)
+
+				Convey("Then the encrypted payload has been sent", func() {
+					b, err := multicast.NewPHYPayload(g.McAddr, g.McAppSKey, g.McNwkSKey, 5, 10, []byte{1, 2, 3})
+					So(err, ShouldBeNil)
+
+					So(sender.SendMulticastChan, ShouldHaveLength, 1)
+					So(<-sender.SendMulticastChan, ShouldResemble, multicast.Item{
+						MulticastGroupID: g.ID,
+						ApplicationID:    app.ID,
+						McAddr:           g.McAddr,
+						FCnt:             5,
+						FPort:            10,
+						Frequency:        869525000,
+						DR:               3,
+						PHYPayload:       b,
+					})
+				})
+
+				Convey("Then the frame-counter of the multicast group has been incremented", func() {
+					gGet, err := storage.GetMulticastGroup(config.C.PostgreSQL.DB, g.ID)
+					So(err, ShouldBeNil)
+					So(gGet.FCnt, ShouldEqual, 6)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,145 @@
+package downlink
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+// Downlink payload validation errors.
+var (
+	ErrFPortReserved   = errors.New("fPort 0 and 224-255 are reserved")
+	ErrPayloadTooLarge = errors.New("payload exceeds the maximum size for the data-rate")
+	errUnknownRegion   = errors.New("unknown region")
+	errUnknownDataRate = errors.New("unknown data-rate")
+)
+
+// uplink data-rates (DR0 - DR7) of the regions for which the RX1 downlink
+// uses the same data-rate as the uplink
+var symmetricDataRates = []string{"SF12BW125", "SF11BW125", "SF10BW125", "SF9BW125", "SF8BW125", "SF7BW125", "SF7BW250", "FSK50000"}
+
+// regionDataRates defines per region the uplink data-rates (by index) and
+// the maximum FRMPayload size (N) of the RX1 downlink data-rate which is
+// used in response to an uplink at the given data-rate (RX1DROffset 0,
+// no repeater compatibility).
+type regionDataRates struct {
+	uplink     []string
+	maxPayload []int
+}
+
+var regions = map[string]regionDataRates{
+	"EU868": {
+		uplink:     symmetricDataRates,
+		maxPayload: []int{51, 51, 51, 115, 242, 242, 242, 242},
+	},
+	"EU433": {
+		uplink:     symmetricDataRates,
+		maxPayload: []int{51, 51, 51, 115, 242, 242, 242, 242},
+	},
+	"China779": {
+		uplink:     symmetricDataRates,
+		maxPayload: []int{51, 51, 51, 115, 242, 242, 242, 242},
+	},
+	"India865": {
+		uplink:     symmetricDataRates,
+		maxPayload: []int{51, 51, 51, 115, 242, 242, 242, 242},
+	},
+	// the downlink dwell-time setting of the network-server is not known,
+	// the (lower) dwell-time limited sizes are used. An uplink at DR0 or DR1
+	// is then answered at DR2 and an uplink at DR6 or DR7 at DR5.
+	"AS923": {
+		uplink:     symmetricDataRates,
+		maxPayload: []int{11, 11, 11, 53, 125, 242, 242, 242},
+	},
+	"SouthKorea920": {
+		uplink:     symmetricDataRates[:6],
+		maxPayload: []int{51, 51, 51, 115, 242, 242},
+	},
+	"China470": {
+		uplink:     symmetricDataRates[:6],
+		maxPayload: []int{51, 51, 51, 115, 242, 242},
+	},
+	// uplink DR0 - DR4 map to downlink DR10 - DR13
+	"US902": {
+		uplink:     []string{"SF10BW125", "SF9BW125", "SF8BW125", "SF7BW125", "SF8BW500"},
+		maxPayload: []int{242, 242, 242, 242, 242},
+	},
+	// uplink DR0 - DR6 map to downlink DR8 - DR13
+	"Australia915": {
+		uplink:     []string{"SF12BW125", "SF11BW125", "SF10BW125", "SF9BW125", "SF8BW125", "SF7BW125", "SF8BW500"},
+		maxPayload: []int{53, 129, 242, 242, 242, 242, 242},
+	},
+}
+
+// DataRateString returns the string representation of the given uplink
+// data-rate as stored for the device (e.g. SF7BW125 or FSK50000).
+func DataRateString(modulation string, spreadFactor, bandwidth, bitrate int) string {
+	if modulation == "FSK" {
+		return fmt.Sprintf("FSK%d", bitrate)
+	}
+	return fmt.Sprintf("SF%dBW%d", spreadFactor, bandwidth)
+}
+
+// GetMaxPayloadSize returns the maximum downlink payload size for the given
+// region and (last) uplink data-rate.
+func GetMaxPayloadSize(region, dataRate string) (int, error) {
+	r, ok := regions[region]
+	if !ok {
+		return 0, errUnknownRegion
+	}
+
+	for i, dr := range r.uplink {
+		if dr == dataRate {
+			return r.maxPayload[i], nil
+		}
+	}
+
+	return 0, errUnknownDataRate
+}
+
+// ValidatePayload validates the given fPort and payload against the
+// LoRaWAN fPort rules and the maximum payload size for the region and
+// data-rate. When the region or data-rate is not known (e.g. no uplink
+// has been received yet), only the fPort is validated.
+func ValidatePayload(region, dataRate string, fPort uint8, data []byte) error {
+	if fPort == 0 || fPort >= 224 {
+		return errors.Wrapf(ErrFPortReserved, "fPort %d", fPort)
+	}
+
+	max, err := GetMaxPayloadSize(region, dataRate)
+	if err != nil {
+		return nil
+	}
+
+	if len(data) > max {
+		return errors.Wrapf(ErrPayloadTooLarge, "%d bytes given, max %d bytes for %s (%s)", len(data), max, dataRate, region)
+	}
+
+	return nil
+}
+
+// ValidateDevicePayload validates the given fPort and payload for the given
+// device, using the region of its device-profile and the data-rate of its
+// last uplink.
+func ValidateDevicePayload(db sqlx.Queryer, d storage.Device, fPort uint8, data []byte) error {
+	if d.LastUplinkDataRate == "" {
+		return ValidatePayload("", "", fPort, data)
+	}
+
+	dp, err := storage.GetDeviceProfile(db, d.DeviceProfileID)
+	if err != nil {
+		return errors.Wrap(err, "get device-profile error")
+	}
+
+	return ValidatePayload(string(dp.DeviceProfile.RFRegion), d.LastUplinkDataRate, fPort, data)
+}
+
+// IsValidationError returns true when the given error is a downlink payload
+// validation error.
+func IsValidationError(err error) bool {
+	cause := errors.Cause(err)
+	return cause == ErrFPortReserved || cause == ErrPayloadTooLarge
+}
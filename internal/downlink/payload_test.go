@@ -0,0 +1,47 @@
+package downlink
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidatePayload(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			Name          string
+			Region        string
+			DataRate      string
+			FPort         uint8
+			Size          int
+			ExpectedError error
+		}{
+			{"valid payload", "EU868", "SF12BW125", 1, 51, nil},
+			{"fPort 0", "EU868", "SF12BW125", 0, 1, ErrFPortReserved},
+			{"fPort 224", "EU868", "SF12BW125", 224, 1, ErrFPortReserved},
+			{"payload too large at SF12", "EU868", "SF12BW125", 1, 52, ErrPayloadTooLarge},
+			{"payload too large at SF7", "EU868", "SF7BW125", 1, 243, ErrPayloadTooLarge},
+			{"max payload at SF7", "EU868", "SF7BW125", 1, 242, nil},
+			{"AS923 dwell-time limited payload at SF12", "AS923", "SF12BW125", 1, 12, ErrPayloadTooLarge},
+			{"AS923 max payload at SF9", "AS923", "SF9BW125", 1, 53, nil},
+			{"US902 uplink at SF10", "US902", "SF10BW125", 1, 242, nil},
+			{"US902 payload too large", "US902", "SF8BW500", 1, 243, ErrPayloadTooLarge},
+			{"Australia915 uplink at SF12", "Australia915", "SF12BW125", 1, 54, ErrPayloadTooLarge},
+			{"unknown data-rate", "US902", "SF12BW125", 1, 255, nil},
+			{"unknown region", "", "", 1, 255, nil},
+		}
+
+		for _, test := range tests {
+			Convey("Testing: "+test.Name, func() {
+				err := ValidatePayload(test.Region, test.DataRate, test.FPort, make([]byte, test.Size))
+				So(errors.Cause(err), ShouldEqual, test.ExpectedError)
+			})
+		}
+	})
+
+	Convey("Then DataRateString returns the expected data-rate strings", t, func() {
+		So(DataRateString("LORA", 7, 125, 0), ShouldEqual, "SF7BW125")
+		So(DataRateString("FSK", 0, 0, 50000), ShouldEqual, "FSK50000")
+	})
+}
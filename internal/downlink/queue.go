@@ -0,0 +1,592 @@
+package downlink
+
+import (
+	"sort"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+
+	"github.com/gusseleet/lora-app-server/internal/common"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/handler"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+)
+
+// QueueItemOptions defines the (optional) options of a device-queue item.
+type QueueItemOptions struct {
+	// ExpiresAt defines the time after which the item is removed from the
+	// device-queue, when it has not been sent by then.
+	ExpiresAt *time.Time
+
+	// Priority defines the priority of the item. Items with a higher
+	// priority are sent first.
+	Priority int
+
+	// DedupKey defines the key by which a pending item is replaced when a
+	// new item with the same key is enqueued.
+	DedupKey string
+}
+
+func (o QueueItemOptions) isZero() bool {
+	return o.ExpiresAt == nil && o.Priority == 0 && o.DedupKey == ""
+}
+
+// queueItem holds a plaintext device-queue item.
+type queueItem struct {
+	reference string
+	confirmed bool
+	fPort     uint8
+	data      []byte
+	options   QueueItemOptions
+
+	// pending is set when the item is pending in the network-server queue,
+	// fCnt then holds its frame-counter
+	pending bool
+	fCnt    uint32
+}
+
+// EnqueueDownlinkPayloadWithOptions adds the downlink payload to the
+// network-server device-queue, taking the given options into account.
+// As the network-server queue only supports appending items, the queue is
+// flushed and re-created when a pending item must be replaced (same dedup
+// key), removed (expired) or when the new item must be sent before pending
+// items (higher priority).
+//
+// The item is enqueued within its own transaction. As a re-created
+// network-server queue can not be rolled back, the integrations are notified
+// about the removed items after this transaction has been committed.
+func EnqueueDownlinkPayloadWithOptions(devEUI lorawan.EUI64, reference string, confirmed bool, fPort uint8, data []byte, opts QueueItemOptions) error {
+	var res rebuildResult
+	err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+		var err error
+		res, err = enqueueDownlinkPayload(tx, devEUI, reference, confirmed, fPort, data, opts)
+		return err
+	})
+	countEnqueue("device", err)
+	if err != nil {
+		return err
+	}
+
+	res.sendNotifications(config.C.PostgreSQL.DB, devEUI)
+
+	return nil
+}
+
+// EnqueueDownlinkPayload adds the downlink payload to the end of the
+// network-server device-queue.
+func EnqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, reference string, confirmed bool, fPort uint8, data []byte) error {
+	// an item without options never re-creates the queue
+	_, err := enqueueDownlinkPayload(db, devEUI, reference, confirmed, fPort, data, QueueItemOptions{})
+	countEnqueue("device", err)
+	return err
+}
+
+// incrDownlinkUsage adds the given downlink to the usage of the application
+// of the device. It must be called once the network-server has accepted the
+// item, as from then on it will be sent, even when the transaction is rolled
+// back.
+func incrDownlinkUsage(db sqlx.Queryer, devEUI lorawan.EUI64, data []byte) {
+	d, err := storage.GetDevice(db, devEUI)
+	if err != nil {
+		log.WithField("dev_eui", devEUI).WithError(err).Error("get device error")
+		return
+	}
+
+	err = storage.IncrUsage(config.C.Redis.Pool, d.ApplicationID, devEUI, storage.UsageCounters{
+		Downlinks:     1,
+		DownlinkBytes: int64(len(data)),
+	})
+	if err != nil {
+		log.WithField("dev_eui", devEUI).WithError(err).Error("increment usage error")
+	}
+}
+
+// rebuildResult holds the items which have been removed from the
+// device-queue when re-creating it.
+type rebuildResult struct {
+	expired []queueItem
+	dropped []queueItem
+}
+
+// sendNotifications sends an error notification to the integrations for
+// each of the removed items. This must be called after the transaction has
+// been committed.
+func (r rebuildResult) sendNotifications(db sqlx.Queryer, devEUI lorawan.EUI64) {
+	sendErrorNotifications(db, devEUI, r.expired, "DOWNLINK_EXPIRED", "device-queue item expired before it was sent")
+	sendErrorNotifications(db, devEUI, r.dropped, "DOWNLINK_DROPPED", "device-queue item could not be re-enqueued")
+}
+
+func enqueueDownlinkPayload(db sqlx.Ext, devEUI lorawan.EUI64, reference string, confirmed bool, fPort uint8, data []byte, opts QueueItemOptions) (rebuildResult, error) {
+	if opts.Priority < 0 {
+		return rebuildResult{}, storage.ErrDeviceQueueItemInvalidPriority
+	}
+	if opts.ExpiresAt != nil && !opts.ExpiresAt.After(time.Now()) {
+		return rebuildResult{}, storage.ErrDeviceQueueItemInvalidExpiresAt
+	}
+
+	qi := queueItem{
+		reference: reference,
+		confirmed: confirmed,
+		fPort:     fPort,
+		data:      data,
+		options:   opts,
+	}
+
+	nsClient, err := getNSClientForDevEUI(db, devEUI)
+	if err != nil {
+		return rebuildResult{}, err
+	}
+
+	// get current device-activation for AppSKey
+	da, err := storage.GetLastDeviceActivationForDevEUI(db, devEUI)
+	if err != nil {
+		return rebuildResult{}, errors.Wrap(err, "get last device-activation error")
+	}
+
+	// an item without options is always added to the end of the queue
+	if opts.isZero() {
+		return rebuildResult{}, enqueueQueueItem(db, nsClient, da, devEUI, qi)
+	}
+
+	if err := storage.LockDeviceQueue(db, devEUI); err != nil {
+		return rebuildResult{}, errors.Wrap(err, "lock device-queue error")
+	}
+
+	pending, err := getPendingQueueItems(db, nsClient, da, devEUI)
+	if err != nil {
+		return rebuildResult{}, errors.Wrap(err, "get pending device-queue items error")
+	}
+
+	now := time.Now()
+	var keep, expired []queueItem
+	var rebuild bool
+
+	for _, p := range pending {
+		if p.options.ExpiresAt != nil && !p.options.ExpiresAt.After(now) {
+			expired = append(expired, p)
+			rebuild = true
+			continue
+		}
+
+		if opts.DedupKey != "" && p.options.DedupKey == opts.DedupKey {
+			log.WithFields(log.Fields{
+				"dev_eui":   devEUI,
+				"f_cnt":     p.fCnt,
+				"dedup_key": opts.DedupKey,
+			}).Info("pending device-queue item replaced")
+			rebuild = true
+			continue
+		}
+
+		if p.options.Priority < opts.Priority {
+			rebuild = true
+		}
+		keep = append(keep, p)
+	}
+
+	if !rebuild {
+		return rebuildResult{}, enqueueQueueItem(db, nsClient, da, devEUI, qi)
+	}
+
+	dropped, err := rebuildQueue(db, nsClient, da, devEUI, append(keep, qi))
+	if err != nil {
+		return rebuildResult{}, errors.Wrap(err, "re-create device-queue error")
+	}
+
+	return rebuildResult{expired: expired, dropped: dropped}, nil
+}
+
+// ExpireQueueItemsLoop is a never returning function removing the expired
+// items from the device-queues.
+func ExpireQueueItemsLoop() {
+	for {
+		if err := expireQueueItems(config.C.PostgreSQL.DB, time.Now()); err != nil {
+			log.Errorf("expire device-queue items error: %s", err)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// expireQueueItems removes the expired items of each device within its own
+// transaction, so that a failing device (e.g. an unreachable network-server)
+// does not block the expiration for the other devices.
+func expireQueueItems(db *common.DBLogger, now time.Time) error {
+	devEUIs, err := storage.GetDevEUIsWithExpiredDeviceQueueItems(db, now, 100)
+	if err != nil {
+		return errors.Wrap(err, "get deveuis with expired device-queue items error")
+	}
+
+	for _, devEUI := range devEUIs {
+		var res rebuildResult
+		err := storage.Transaction(db, func(tx sqlx.Ext) error {
+			var err error
+			res, err = expireQueueItemsForDevEUI(tx, devEUI, now)
+			return err
+		})
+		if err != nil {
+			log.WithField("dev_eui", devEUI).WithError(err).Error("expire device-queue items error")
+			continue
+		}
+
+		res.sendNotifications(db, devEUI)
+	}
+
+	return nil
+}
+
+func expireQueueItemsForDevEUI(db sqlx.Ext, devEUI lorawan.EUI64, now time.Time) (rebuildResult, error) {
+	if err := storage.LockDeviceQueue(db, devEUI); err != nil {
+		return rebuildResult{}, errors.Wrap(err, "lock device-queue error")
+	}
+
+	nsClient, err := getNSClientForDevEUI(db, devEUI)
+	if err != nil {
+		return rebuildResult{}, err
+	}
+
+	da, err := storage.GetLastDeviceActivationForDevEUI(db, devEUI)
+	if err != nil {
+		return rebuildResult{}, errors.Wrap(err, "get last device-activation error")
+	}
+
+	// this also removes the meta-data of the items which have been sent
+	pending, err := getPendingQueueItems(db, nsClient, da, devEUI)
+	if err != nil {
+		return rebuildResult{}, errors.Wrap(err, "get pending device-queue items error")
+	}
+
+	var keep, expired []queueItem
+	for _, p := range pending {
+		if p.options.ExpiresAt != nil && !p.options.ExpiresAt.After(now) {
+			expired = append(expired, p)
+		} else {
+			keep = append(keep, p)
+		}
+	}
+
+	if len(expired) == 0 {
+		return rebuildResult{}, nil
+	}
+
+	dropped, err := rebuildQueue(db, nsClient, da, devEUI, keep)
+	if err != nil {
+		return rebuildResult{}, errors.Wrap(err, "re-create device-queue error")
+	}
+
+	return rebuildResult{expired: expired, dropped: dropped}, nil
+}
+
+// getPendingQueueItems returns the (decrypted) items pending in the
+// network-server queue, together with their options. The meta-data of
+// items which are no longer pending is removed.
+func getPendingQueueItems(db sqlx.Ext, nsClient ns.NetworkServerClient, da storage.DeviceActivation, devEUI lorawan.EUI64) ([]queueItem, error) {
+	resp, err := nsClient.GetDeviceQueueItemsForDevEUI(context.Background(), &ns.GetDeviceQueueItemsForDevEUIRequest{
+		DevEUI: devEUI[:],
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "get device-queue items error")
+	}
+
+	metas, err := storage.GetDeviceQueueItemMetaForDevEUI(db, devEUI)
+	if err != nil {
+		return nil, errors.Wrap(err, "get device-queue item meta error")
+	}
+	metaMap := make(map[uint32]storage.DeviceQueueItemMeta)
+	for _, m := range metas {
+		metaMap[m.FCnt] = m
+	}
+
+	mappings, err := storage.GetDeviceQueueMappingsForDevEUI(db, devEUI)
+	if err != nil {
+		return nil, errors.Wrap(err, "get device-queue mappings error")
+	}
+	refMap := make(map[uint32]string)
+	for _, m := range mappings {
+		refMap[m.FCnt] = m.Reference
+	}
+
+	var out []queueItem
+	for _, item := range resp.Items {
+		b, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, item.FCnt, item.FrmPayload)
+		if err != nil {
+			return nil, errors.Wrap(err, "decrypt frmpayload error")
+		}
+
+		qi := queueItem{
+			reference: refMap[item.FCnt],
+			confirmed: item.Confirmed,
+			fPort:     uint8(item.FPort),
+			data:      b,
+			pending:   true,
+			fCnt:      item.FCnt,
+		}
+
+		if m, ok := metaMap[item.FCnt]; ok {
+			qi.reference = m.Reference
+			qi.options = QueueItemOptions{
+				ExpiresAt: m.ExpiresAt,
+				Priority:  m.Priority,
+				DedupKey:  m.DedupKey,
+			}
+			delete(metaMap, item.FCnt)
+		}
+
+		out = append(out, qi)
+	}
+
+	for fCnt := range metaMap {
+		if err := storage.DeleteDeviceQueueItemMeta(db, devEUI, fCnt); err != nil {
+			return nil, errors.Wrap(err, "delete device-queue item meta error")
+		}
+	}
+
+	return out, nil
+}
+
+// rebuildQueue re-creates the network-server queue with the given items,
+// ordered by priority. Note that the items are encrypted using new frame-
+// counters. It returns the items which could not be re-enqueued.
+//
+// The network-server queue is not part of the database transaction. The
+// meta-data of the pending items is removed before flushing the
+// network-server queue, as only until then an error can safely abort the
+// rebuild. Once flushed, the items are first added to the network-server
+// queue before storing their meta-data, each within its own savepoint so
+// that a failing item does not roll back the re-created queue.
+func rebuildQueue(db sqlx.Ext, nsClient ns.NetworkServerClient, da storage.DeviceActivation, devEUI lorawan.EUI64, items []queueItem) ([]queueItem, error) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].options.Priority > items[j].options.Priority
+	})
+
+	if err := storage.FlushDeviceQueueMappingForDevEUI(db, devEUI); err != nil {
+		return nil, errors.Wrap(err, "flush device-queue mapping error")
+	}
+
+	if err := storage.FlushDeviceQueueItemMetaForDevEUI(db, devEUI); err != nil {
+		return nil, errors.Wrap(err, "flush device-queue item meta error")
+	}
+
+	_, err := nsClient.FlushDeviceQueueForDevEUI(context.Background(), &ns.FlushDeviceQueueForDevEUIRequest{
+		DevEUI: devEUI[:],
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "flush device-queue error")
+	}
+
+	var dropped []queueItem
+
+	for _, qi := range items {
+		fCnt, err := createNSQueueItem(nsClient, da, devEUI, qi)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"dev_eui":   devEUI,
+				"reference": qi.reference,
+			}).WithError(err).Error("re-enqueue device-queue item error")
+			dropped = append(dropped, qi)
+			continue
+		}
+
+		// the pending items have already been counted when they were
+		// first enqueued
+		if !qi.pending {
+			incrDownlinkUsage(db, devEUI, qi.data)
+		}
+
+		err = storage.Savepoint(db, "device_queue_item_meta", func() error {
+			return createQueueItemMeta(db, devEUI, fCnt, qi)
+		})
+		if err != nil {
+			log.WithFields(log.Fields{
+				"dev_eui":   devEUI,
+				"f_cnt":     fCnt,
+				"reference": qi.reference,
+			}).WithError(err).Error("store re-enqueued device-queue item meta-data error")
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui":     devEUI,
+		"queue_items": len(items) - len(dropped),
+		"dropped":     len(dropped),
+	}).Info("device-queue re-created")
+
+	return dropped, nil
+}
+
+// enqueueQueueItem encrypts and adds the given item to the end of the
+// network-server queue.
+func enqueueQueueItem(db sqlx.Ext, nsClient ns.NetworkServerClient, da storage.DeviceActivation, devEUI lorawan.EUI64, qi queueItem) error {
+	// get fCnt to use for encrypting and enqueueing
+	fCnt, err := getNextDownlinkFCnt(nsClient, devEUI)
+	if err != nil {
+		return err
+	}
+
+	if err := createQueueItemMeta(db, devEUI, fCnt, qi); err != nil {
+		return err
+	}
+
+	if err := createNSQueueItemWithFCnt(nsClient, da, devEUI, fCnt, qi); err != nil {
+		return err
+	}
+
+	incrDownlinkUsage(db, devEUI, qi.data)
+
+	return nil
+}
+
+// createNSQueueItem encrypts and adds the given item to the end of the
+// network-server queue, using the next downlink frame-counter. It returns
+// this frame-counter.
+func createNSQueueItem(nsClient ns.NetworkServerClient, da storage.DeviceActivation, devEUI lorawan.EUI64, qi queueItem) (uint32, error) {
+	fCnt, err := getNextDownlinkFCnt(nsClient, devEUI)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := createNSQueueItemWithFCnt(nsClient, da, devEUI, fCnt, qi); err != nil {
+		return 0, err
+	}
+
+	return fCnt, nil
+}
+
+func getNextDownlinkFCnt(nsClient ns.NetworkServerClient, devEUI lorawan.EUI64) (uint32, error) {
+	resp, err := nsClient.GetNextDownlinkFCntForDevEUI(context.Background(), &ns.GetNextDownlinkFCntForDevEUIRequest{
+		DevEUI: devEUI[:],
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "get next downlink fcnt for deveui error")
+	}
+	return resp.FCnt, nil
+}
+
+func createNSQueueItemWithFCnt(nsClient ns.NetworkServerClient, da storage.DeviceActivation, devEUI lorawan.EUI64, fCnt uint32, qi queueItem) error {
+	// encrypt payload
+	b, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, fCnt, qi.data)
+	if err != nil {
+		return errors.Wrap(err, "encrypt frmpayload error")
+	}
+
+	// enqueue device-queue item
+	_, err = nsClient.CreateDeviceQueueItem(context.Background(), &ns.CreateDeviceQueueItemRequest{
+		Item: &ns.DeviceQueueItem{
+			DevEUI:     devEUI[:],
+			FrmPayload: b,
+			FCnt:       fCnt,
+			FPort:      uint32(qi.fPort),
+			Confirmed:  qi.confirmed,
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "create device-queue item error")
+	}
+
+	log.WithFields(log.Fields{
+		"f_cnt":     fCnt,
+		"dev_eui":   devEUI,
+		"reference": qi.reference,
+		"confirmed": qi.confirmed,
+	}).Info("downlink device-queue item handled")
+
+	return nil
+}
+
+// createQueueItemMeta stores the reference (device-queue mapping) and the
+// options of the given item, enqueued using the given frame-counter.
+func createQueueItemMeta(db sqlx.Ext, devEUI lorawan.EUI64, fCnt uint32, qi queueItem) error {
+	// create device-queue mapping (for mapping a device-queue item to an
+	// user-given reference)
+	if qi.confirmed == true {
+		err := storage.CreateDeviceQueueMapping(db, &storage.DeviceQueueMapping{
+			Reference: qi.reference,
+			DevEUI:    devEUI,
+			FCnt:      fCnt,
+		})
+		if err != nil {
+			return errors.Wrap(err, "create device-queue mapping error")
+		}
+	}
+
+	// the frame-counter might be re-used (e.g. after a flush or a new
+	// activation), remove the meta-data of the previous item
+	if err := storage.DeleteDeviceQueueItemMeta(db, devEUI, fCnt); err != nil {
+		return errors.Wrap(err, "delete device-queue item meta error")
+	}
+
+	if !qi.options.isZero() {
+		err := storage.CreateDeviceQueueItemMeta(db, &storage.DeviceQueueItemMeta{
+			DevEUI:    devEUI,
+			FCnt:      fCnt,
+			Reference: qi.reference,
+			Priority:  qi.options.Priority,
+			DedupKey:  qi.options.DedupKey,
+			ExpiresAt: qi.options.ExpiresAt,
+		})
+		if err != nil {
+			return errors.Wrap(err, "create device-queue item meta error")
+		}
+	}
+
+	return nil
+}
+
+func getNSClientForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) (ns.NetworkServerClient, error) {
+	n, err := storage.GetNetworkServerForDevEUI(db, devEUI)
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server error")
+	}
+	nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server client error")
+	}
+	return nsClient, nil
+}
+
+// sendErrorNotifications sends an error notification of the given type to
+// the integrations for each of the given items.
+func sendErrorNotifications(db sqlx.Queryer, devEUI lorawan.EUI64, items []queueItem, typ, errStr string) {
+	if len(items) == 0 {
+		return
+	}
+
+	d, err := storage.GetDevice(db, devEUI)
+	if err != nil {
+		log.WithField("dev_eui", devEUI).WithError(err).Error("get device error")
+		return
+	}
+
+	app, err := storage.GetApplication(db, d.ApplicationID)
+	if err != nil {
+		log.WithField("id", d.ApplicationID).WithError(err).Error("get application error")
+		return
+	}
+
+	for _, qi := range items {
+		log.WithFields(log.Fields{
+			"dev_eui":   devEUI,
+			"f_cnt":     qi.fCnt,
+			"reference": qi.reference,
+			"type":      typ,
+		}).Info(errStr)
+
+		err := config.C.ApplicationServer.Integration.Handler.SendErrorNotification(handler.ErrorNotification{
+			ApplicationID:   app.ID,
+			ApplicationName: app.Name,
+			DeviceName:      d.Name,
+			DevEUI:          devEUI,
+			Type:            typ,
+			Error:           errStr,
+			FCnt:            qi.fCnt,
+			Reference:       qi.reference,
+		})
+		if err != nil {
+			log.WithField("dev_eui", devEUI).WithError(err).Error("send error notification to handler error")
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package downlink
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+	"github.com/gusseleet/lora-app-server/internal/test/testhandler"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/backend"
+)
+
+func TestDeviceQueueOptions(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with a device and a pending device-queue item", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		h := testhandler.NewTestHandler()
+		config.C.ApplicationServer.Integration.Handler = h
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetNextDownlinkFCntForDevEUIResponse = ns.GetNextDownlinkFCntForDevEUIResponse{
+			FCnt: 12,
+		}
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		device := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-node",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(config.C.PostgreSQL.DB, &device), ShouldBeNil)
+
+		da := storage.DeviceActivation{
+			DevEUI:  device.DevEUI,
+			DevAddr: lorawan.DevAddr{1, 2, 3, 4},
+			AppSKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		}
+		So(storage.CreateDeviceActivation(config.C.PostgreSQL.DB, &da), ShouldBeNil)
+
+		pendingB, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, 10, []byte{1, 2, 3})
+		So(err, ShouldBeNil)
+		nsClient.GetDeviceQueueItemsForDevEUIResponse = ns.GetDeviceQueueItemsForDevEUIResponse{
+			Items: []*ns.DeviceQueueItem{
+				{
+					DevEUI:     device.DevEUI[:],
+					FrmPayload: pendingB,
+					FCnt:       10,
+					FPort:      5,
+				},
+			},
+		}
+
+		expiresAt := time.Now().Add(time.Hour)
+		So(storage.CreateDeviceQueueItemMeta(config.C.PostgreSQL.DB, &storage.DeviceQueueItemMeta{
+			DevEUI:    device.DevEUI,
+			FCnt:      10,
+			Reference: "pending-123",
+			DedupKey:  "set-setpoint",
+			ExpiresAt: &expiresAt,
+		}), ShouldBeNil)
+
+		newB, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, 12, []byte{4, 5, 6})
+		So(err, ShouldBeNil)
+		reEncryptedB, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, 12, []byte{1, 2, 3})
+		So(err, ShouldBeNil)
+
+		Convey("When enqueueing an item without options", func() {
+			So(EnqueueDownlinkPayload(config.C.PostgreSQL.DB, device.DevEUI, "", false, 2, []byte{4, 5, 6}), ShouldBeNil)
+
+			Convey("Then the item was appended to the queue", func() {
+				So(nsClient.GetDeviceQueueItemsForDevEUIChan, ShouldHaveLength, 0)
+				So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 0)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When enqueueing an item with an expiration time in the past", func() {
+			ts := time.Now().Add(-time.Minute)
+			err := EnqueueDownlinkPayloadWithOptions(device.DevEUI, "", false, 2, []byte{4, 5, 6}, QueueItemOptions{
+				ExpiresAt: &ts,
+			})
+			So(err, ShouldEqual, storage.ErrDeviceQueueItemInvalidExpiresAt)
+		})
+
+		Convey("When enqueueing an item with the same dedup key", func() {
+			So(EnqueueDownlinkPayloadWithOptions(device.DevEUI, "new-123", false, 2, []byte{4, 5, 6}, QueueItemOptions{
+				DedupKey: "set-setpoint",
+			}), ShouldBeNil)
+
+			Convey("Then the queue was flushed and only the new item was enqueued", func() {
+				So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 1)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+				So(<-nsClient.CreateDeviceQueueItemChan, ShouldResemble, ns.CreateDeviceQueueItemRequest{
+					Item: &ns.DeviceQueueItem{
+						DevEUI:     device.DevEUI[:],
+						FrmPayload: newB,
+						FCnt:       12,
+						FPort:      2,
+					},
+				})
+
+				items, err := storage.GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, device.DevEUI)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].FCnt, ShouldEqual, 12)
+				So(items[0].Reference, ShouldEqual, "new-123")
+			})
+		})
+
+		Convey("When enqueueing an item with an other dedup key", func() {
+			So(EnqueueDownlinkPayloadWithOptions(device.DevEUI, "", false, 2, []byte{4, 5, 6}, QueueItemOptions{
+				DedupKey: "set-interval",
+			}), ShouldBeNil)
+
+			Convey("Then the item was appended to the queue", func() {
+				So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 0)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+
+				items, err := storage.GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, device.DevEUI)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("When enqueueing an item with a higher priority", func() {
+			So(EnqueueDownlinkPayloadWithOptions(device.DevEUI, "", false, 2, []byte{4, 5, 6}, QueueItemOptions{
+				Priority: 1,
+			}), ShouldBeNil)
+
+			Convey("Then the queue was re-created with the new item first", func() {
+				So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 1)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+				So((<-nsClient.CreateDeviceQueueItemChan).Item.FrmPayload, ShouldResemble, newB)
+				So(<-nsClient.CreateDeviceQueueItemChan, ShouldResemble, ns.CreateDeviceQueueItemRequest{
+					Item: &ns.DeviceQueueItem{
+						DevEUI:     device.DevEUI[:],
+						FrmPayload: reEncryptedB,
+						FCnt:       12,
+						FPort:      5,
+					},
+				})
+			})
+		})
+
+		Convey("Given the network-server fails to enqueue items", func() {
+			nsClient.CreateDeviceQueueItemError = errors.New("network-server error")
+
+			Convey("When enqueueing an item with a higher priority", func() {
+				So(EnqueueDownlinkPayloadWithOptions(device.DevEUI, "new-123", false, 2, []byte{4, 5, 6}, QueueItemOptions{
+					Priority: 1,
+				}), ShouldBeNil)
+
+				Convey("Then an error notification was sent for each item which could not be re-enqueued", func() {
+					So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 1)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+
+					So(h.SendErrorNotificationChan, ShouldHaveLength, 2)
+					n := <-h.SendErrorNotificationChan
+					So(n.Type, ShouldEqual, "DOWNLINK_DROPPED")
+					So(n.Reference, ShouldEqual, "new-123")
+					n = <-h.SendErrorNotificationChan
+					So(n.Type, ShouldEqual, "DOWNLINK_DROPPED")
+					So(n.Reference, ShouldEqual, "pending-123")
+					So(n.FCnt, ShouldEqual, 10)
+				})
+			})
+		})
+
+		Convey("Given the pending item has expired", func() {
+			expiresAt := time.Now().Add(-time.Minute)
+			So(storage.FlushDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, device.DevEUI), ShouldBeNil)
+			So(storage.CreateDeviceQueueItemMeta(config.C.PostgreSQL.DB, &storage.DeviceQueueItemMeta{
+				DevEUI:    device.DevEUI,
+				FCnt:      10,
+				Reference: "pending-123",
+				ExpiresAt: &expiresAt,
+			}), ShouldBeNil)
+
+			Convey("Given an other device with an expired item, without activation", func() {
+				device2 := storage.Device{
+					ApplicationID:   app.ID,
+					DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+					Name:            "test-node-2",
+					DevEUI:          lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1},
+				}
+				So(storage.CreateDevice(config.C.PostgreSQL.DB, &device2), ShouldBeNil)
+				So(storage.CreateDeviceQueueItemMeta(config.C.PostgreSQL.DB, &storage.DeviceQueueItemMeta{
+					DevEUI:    device2.DevEUI,
+					FCnt:      1,
+					ExpiresAt: &expiresAt,
+				}), ShouldBeNil)
+
+				Convey("When calling expireQueueItems", func() {
+					So(expireQueueItems(config.C.PostgreSQL.DB, time.Now()), ShouldBeNil)
+
+					Convey("Then the items of the first device have been expired", func() {
+						So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 1)
+						So(h.SendErrorNotificationChan, ShouldHaveLength, 1)
+
+						items, err := storage.GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, device.DevEUI)
+						So(err, ShouldBeNil)
+						So(items, ShouldHaveLength, 0)
+					})
+				})
+			})
+
+			Convey("When calling expireQueueItems", func() {
+				So(expireQueueItems(config.C.PostgreSQL.DB, time.Now()), ShouldBeNil)
+
+				Convey("Then the queue was flushed and an error notification was sent", func() {
+					So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 1)
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+
+					So(h.SendErrorNotificationChan, ShouldHaveLength, 1)
+					n := <-h.SendErrorNotificationChan
+					So(n.Type, ShouldEqual, "DOWNLINK_EXPIRED")
+					So(n.FCnt, ShouldEqual, 10)
+					So(n.Reference, ShouldEqual, "pending-123")
+					So(n.DevEUI, ShouldEqual, device.DevEUI)
+
+					items, err := storage.GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, device.DevEUI)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 0)
+				})
+			})
+		})
+
+		Convey("Given meta-data of an item which has been sent", func() {
+			So(storage.CreateDeviceQueueItemMeta(config.C.PostgreSQL.DB, &storage.DeviceQueueItemMeta{
+				DevEUI:   device.DevEUI,
+				FCnt:     9,
+				Priority: 1,
+			}), ShouldBeNil)
+
+			Convey("When calling expireQueueItems", func() {
+				So(expireQueueItems(config.C.PostgreSQL.DB, time.Now()), ShouldBeNil)
+
+				Convey("Then nothing was flushed", func() {
+					So(nsClient.FlushDeviceQueueForDevEUIChan, ShouldHaveLength, 0)
+				})
+			})
+
+			Convey("When enqueueing an item with options", func() {
+				So(EnqueueDownlinkPayloadWithOptions(device.DevEUI, "", false, 2, []byte{4, 5, 6}, QueueItemOptions{
+					DedupKey: "set-interval",
+				}), ShouldBeNil)
+
+				Convey("Then the meta-data of the sent item was removed", func() {
+					items, err := storage.GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, device.DevEUI)
+					So(err, ShouldBeNil)
+					So(items, ShouldHaveLength, 2)
+					So(items[0].FCnt, ShouldEqual, 10)
+					So(items[1].FCnt, ShouldEqual, 12)
+				})
+			})
+		})
+	})
+}
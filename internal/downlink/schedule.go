@@ -0,0 +1,137 @@
+package downlink
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/handler"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+const (
+	scheduleLockTempl  = "lora:as:downlink:schedule:%d:%d"
+	scheduleLockExpire = time.Hour
+)
+
+// ScheduleLoop is a never returning function enqueueing the payloads of the
+// downlink schedules that are due.
+func ScheduleLoop() {
+	for {
+		if err := handleDueSchedules(time.Now()); err != nil {
+			log.Errorf("handle downlink schedules error: %s", err)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func handleDueSchedules(now time.Time) error {
+	schedules, err := storage.GetDueDownlinkSchedules(config.C.PostgreSQL.DB, now)
+	if err != nil {
+		return errors.Wrap(err, "get due downlink schedules error")
+	}
+
+	for _, s := range schedules {
+		if err := handleSchedule(s, now); err != nil {
+			log.WithField("id", s.ID).Errorf("handle downlink schedule error: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// handleSchedule enqueues the payload of the given schedule for all the
+// targeted devices. In case of multiple application-server instances, only
+// the instance obtaining the lock for the scheduled run will enqueue.
+func handleSchedule(s storage.DownlinkSchedule, now time.Time) error {
+	locked, err := lockScheduleRun(s.ID, s.NextRunAt)
+	if err != nil {
+		return errors.Wrap(err, "lock schedule run error")
+	}
+	if !locked {
+		return nil
+	}
+
+	// Update the next run before enqueueing, so that a failing enqueue
+	// (e.g. network-server not available) does not result in a retry on
+	// every tick. Runs that were missed are skipped.
+	next, err := s.NextRun(now)
+	if err != nil {
+		return errors.Wrap(err, "get next run error")
+	}
+	if err = storage.SetDownlinkScheduleRun(config.C.PostgreSQL.DB, s.ID, now, next); err != nil {
+		// release the lock so that the run is retried on the next tick,
+		// instead of once the lock has expired
+		if unlockErr := unlockScheduleRun(s.ID, s.NextRunAt); unlockErr != nil {
+			log.WithField("id", s.ID).WithError(unlockErr).Error("unlock schedule run error")
+		}
+		return errors.Wrap(err, "set downlink schedule run error")
+	}
+
+	devEUIs, err := storage.GetDevEUIsForDownlinkSchedule(config.C.PostgreSQL.DB, s)
+	if err != nil {
+		return errors.Wrap(err, "get deveuis for downlink schedule error")
+	}
+
+	// handleDataDownPayload encodes the object (if set) and validates the
+	// fPort and payload size for each device before enqueueing
+	for _, devEUI := range devEUIs {
+		pl := handler.DataDownPayload{
+			ApplicationID: s.ApplicationID,
+			DevEUI:        devEUI,
+			Reference:     fmt.Sprintf("schedule:%d", s.ID),
+			Confirmed:     s.Confirmed,
+			FPort:         s.FPort,
+			Data:          s.Data,
+			Object:        s.JSONObject,
+		}
+
+		if err := handleDataDownPayload(pl); err != nil {
+			log.WithFields(log.Fields{
+				"id":      s.ID,
+				"dev_eui": devEUI,
+			}).Errorf("enqueue scheduled downlink payload error: %s", err)
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"id":          s.ID,
+		"devices":     len(devEUIs),
+		"next_run_at": next,
+	}).Info("scheduled downlink payload enqueued")
+
+	return nil
+}
+
+// lockScheduleRun obtains the lock for the given schedule id and run. It
+// returns false when the lock was already obtained (e.g. by an other
+// application-server instance).
+func lockScheduleRun(id int64, run time.Time) (bool, error) {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	_, err := redis.String(c.Do("SET", fmt.Sprintf(scheduleLockTempl, id, run.Unix()), "lock", "PX", int64(scheduleLockExpire)/int64(time.Millisecond), "NX"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "set schedule lock error")
+	}
+	return true, nil
+}
+
+// unlockScheduleRun releases the lock for the given schedule id and run.
+func unlockScheduleRun(id int64, run time.Time) error {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	_, err := c.Do("DEL", fmt.Sprintf(scheduleLockTempl, id, run.Unix()))
+	if err != nil {
+		return errors.Wrap(err, "delete schedule lock error")
+	}
+	return nil
+}
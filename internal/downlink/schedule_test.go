@@ -0,0 +1,155 @@
+package downlink
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+	"github.com/gusseleet/lora-app-server/internal/test/testhandler"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/backend"
+)
+
+func TestDownlinkSchedule(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database, an organization, application + device and a due schedule", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+		test.MustFlushRedis(config.C.Redis.Pool)
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetNextDownlinkFCntForDevEUIResponse = ns.GetNextDownlinkFCntForDevEUIResponse{
+			FCnt: 12,
+		}
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		device := storage.Device{
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+			Name:            "test-node",
+			DevEUI:          [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
+		}
+		So(storage.CreateDevice(config.C.PostgreSQL.DB, &device), ShouldBeNil)
+
+		da := storage.DeviceActivation{
+			DevEUI:  [8]byte{1, 2, 3, 4, 5, 6, 7, 8},
+			DevAddr: [4]byte{1, 2, 3, 4},
+			AppSKey: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		}
+		So(storage.CreateDeviceActivation(config.C.PostgreSQL.DB, &da), ShouldBeNil)
+
+		b, err := lorawan.EncryptFRMPayload(da.AppSKey, false, da.DevAddr, 12, []byte{1, 2, 3, 4})
+		So(err, ShouldBeNil)
+
+		s := storage.DownlinkSchedule{
+			ApplicationID: app.ID,
+			Name:          "test-schedule",
+			Cron:          "*/5 * * * *",
+			FPort:         2,
+			Data:          []byte{1, 2, 3, 4},
+		}
+		So(storage.CreateDownlinkSchedule(config.C.PostgreSQL.DB, &s), ShouldBeNil)
+
+		now := s.NextRunAt.Add(time.Second)
+
+		Convey("Given the schedule uses a reserved fPort", func() {
+			h := testhandler.NewTestHandler()
+			config.C.ApplicationServer.Integration.Handler = h
+			s.FPort = 224
+
+			Convey("Then handling the schedule does not enqueue and sends an error notification", func() {
+				So(handleSchedule(s, now), ShouldBeNil)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+				So(h.SendErrorNotificationChan, ShouldHaveLength, 1)
+				So((<-h.SendErrorNotificationChan).Type, ShouldEqual, "DOWNLINK_FPORT")
+			})
+		})
+
+		Convey("When handling the due schedules", func() {
+			So(handleDueSchedules(now), ShouldBeNil)
+
+			Convey("Then the payload has been enqueued", func() {
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+				So(<-nsClient.CreateDeviceQueueItemChan, ShouldResemble, ns.CreateDeviceQueueItemRequest{
+					Item: &ns.DeviceQueueItem{
+						DevEUI:     device.DevEUI[:],
+						FrmPayload: b,
+						FCnt:       12,
+						FPort:      2,
+					},
+				})
+			})
+
+			Convey("Then the last and next run have been updated", func() {
+				sGet, err := storage.GetDownlinkSchedule(config.C.PostgreSQL.DB, s.ID)
+				So(err, ShouldBeNil)
+				So(sGet.LastRunAt, ShouldNotBeNil)
+				So(sGet.NextRunAt.Equal(s.NextRunAt.Add(5*time.Minute)), ShouldBeTrue)
+			})
+
+			Convey("Then handling the same run again (e.g. by an other instance) does not enqueue", func() {
+				<-nsClient.CreateDeviceQueueItemChan
+				So(handleSchedule(s, now), ShouldBeNil)
+				So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("When the schedule run has been locked and unlocked", func() {
+			locked, err := lockScheduleRun(s.ID, s.NextRunAt)
+			So(err, ShouldBeNil)
+			So(locked, ShouldBeTrue)
+			So(unlockScheduleRun(s.ID, s.NextRunAt), ShouldBeNil)
+
+			Convey("Then the run can be locked again", func() {
+				locked, err := lockScheduleRun(s.ID, s.NextRunAt)
+				So(err, ShouldBeNil)
+				So(locked, ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -0,0 +1,173 @@
+// Package eventlog implements the publishing of and subscribing to the
+// application events (uplink, join, ack, error and status), using Redis
+// pub/sub so that all application-server instances receive all events.
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/handler"
+	"github.com/brocaar/lorawan"
+)
+
+const applicationEventsTempl = "lora:as:application:%d:events"
+
+// EventType defines the event type.
+type EventType string
+
+// Available event types.
+const (
+	Uplink EventType = "uplink"
+	Join   EventType = "join"
+	ACK    EventType = "ack"
+	Error  EventType = "error"
+	Status EventType = "status"
+)
+
+// Event defines an application event.
+type Event struct {
+	Type    EventType       `json:"type"`
+	DevEUI  lorawan.EUI64   `json:"devEUI"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// StatusPayload defines the payload of a status event, published when an
+// uplink contains the device-status.
+type StatusPayload struct {
+	ApplicationID   int64         `json:"applicationID,string"`
+	ApplicationName string        `json:"applicationName"`
+	DeviceName      string        `json:"deviceName"`
+	DevEUI          lorawan.EUI64 `json:"devEUI"`
+	Battery         *int          `json:"battery"`
+	Margin          *int          `json:"margin"`
+}
+
+// Handler implements an integration handler publishing the events.
+type Handler struct{}
+
+// NewHandler creates a new Handler.
+func NewHandler() *Handler {
+	return &Handler{}
+}
+
+// SendDataUp publishes the uplink event and, when the uplink contains the
+// device-status, the status event.
+func (h *Handler) SendDataUp(pl handler.DataUpPayload) error {
+	if err := publish(pl.ApplicationID, Uplink, pl.DevEUI, pl); err != nil {
+		return err
+	}
+
+	if pl.DeviceStatusBattery == nil && pl.DeviceStatusMargin == nil {
+		return nil
+	}
+
+	return publish(pl.ApplicationID, Status, pl.DevEUI, StatusPayload{
+		ApplicationID:   pl.ApplicationID,
+		ApplicationName: pl.ApplicationName,
+		DeviceName:      pl.DeviceName,
+		DevEUI:          pl.DevEUI,
+		Battery:         pl.DeviceStatusBattery,
+		Margin:          pl.DeviceStatusMargin,
+	})
+}
+
+// SendJoinNotification publishes the join event.
+func (h *Handler) SendJoinNotification(pl handler.JoinNotification) error {
+	return publish(pl.ApplicationID, Join, pl.DevEUI, pl)
+}
+
+// SendACKNotification publishes the ack event.
+func (h *Handler) SendACKNotification(pl handler.ACKNotification) error {
+	return publish(pl.ApplicationID, ACK, pl.DevEUI, pl)
+}
+
+// SendErrorNotification publishes the error event.
+func (h *Handler) SendErrorNotification(pl handler.ErrorNotification) error {
+	return publish(pl.ApplicationID, Error, pl.DevEUI, pl)
+}
+
+// Close closes the handler.
+func (h *Handler) Close() error {
+	return nil
+}
+
+func publish(applicationID int64, typ EventType, devEUI lorawan.EUI64, pl interface{}) error {
+	plB, err := json.Marshal(pl)
+	if err != nil {
+		return errors.Wrap(err, "marshal payload error")
+	}
+
+	b, err := json.Marshal(Event{
+		Type:    typ,
+		DevEUI:  devEUI,
+		Payload: plB,
+	})
+	if err != nil {
+		return errors.Wrap(err, "marshal event error")
+	}
+
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	if _, err := c.Do("PUBLISH", fmt.Sprintf(applicationEventsTempl, applicationID), b); err != nil {
+		return errors.Wrap(err, "publish event error")
+	}
+
+	return nil
+}
+
+// GetEvents subscribes to the events of the given application and sends
+// them to the given channel, until the context is cancelled.
+func GetEvents(ctx context.Context, applicationID int64, eventsChan chan Event) error {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	psc := redis.PubSubConn{Conn: c}
+	if err := psc.Subscribe(fmt.Sprintf(applicationEventsTempl, applicationID)); err != nil {
+		return errors.Wrap(err, "subscribe error")
+	}
+
+	done := make(chan error, 1)
+
+	go func() {
+		for {
+			switch v := psc.Receive().(type) {
+			case redis.Message:
+				var e Event
+				if err := json.Unmarshal(v.Data, &e); err != nil {
+					log.WithField("application_id", applicationID).WithError(err).Error("unmarshal event error")
+					continue
+				}
+				select {
+				case eventsChan <- e:
+				case <-ctx.Done():
+				}
+			case redis.Subscription:
+				if v.Count == 0 {
+					done <- nil
+					return
+				}
+			case error:
+				done <- v
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		if err := psc.Unsubscribe(); err != nil {
+			return errors.Wrap(err, "unsubscribe error")
+		}
+		return <-done
+	case err := <-done:
+		return err
+	}
+}
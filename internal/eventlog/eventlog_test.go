@@ -0,0 +1,85 @@
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/handler"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+	"github.com/brocaar/lorawan"
+)
+
+func TestEventLog(t *testing.T) {
+	conf := test.GetConfig()
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean Redis database and a subscriber for application 1", t, func() {
+		test.MustFlushRedis(config.C.Redis.Pool)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		eventsChan := make(chan Event, 10)
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- GetEvents(ctx, 1, eventsChan)
+		}()
+		time.Sleep(100 * time.Millisecond) // give the subscriber some time to subscribe
+
+		devEUI := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		h := NewHandler()
+
+		Convey("When publishing an uplink with device-status", func() {
+			battery := 100
+			So(h.SendDataUp(handler.DataUpPayload{
+				ApplicationID:       1,
+				DevEUI:              devEUI,
+				FPort:               10,
+				DeviceStatusBattery: &battery,
+			}), ShouldBeNil)
+
+			Convey("Then the uplink and status events are received", func() {
+				e := <-eventsChan
+				So(e.Type, ShouldEqual, Uplink)
+				So(e.DevEUI, ShouldEqual, devEUI)
+				var up handler.DataUpPayload
+				So(json.Unmarshal(e.Payload, &up), ShouldBeNil)
+				So(up.FPort, ShouldEqual, 10)
+
+				e = <-eventsChan
+				So(e.Type, ShouldEqual, Status)
+				var status StatusPayload
+				So(json.Unmarshal(e.Payload, &status), ShouldBeNil)
+				So(*status.Battery, ShouldEqual, 100)
+				So(status.Margin, ShouldBeNil)
+			})
+		})
+
+		Convey("When publishing a join notification for an other application", func() {
+			So(h.SendJoinNotification(handler.JoinNotification{
+				ApplicationID: 2,
+				DevEUI:        devEUI,
+			}), ShouldBeNil)
+			So(h.SendErrorNotification(handler.ErrorNotification{
+				ApplicationID: 1,
+				DevEUI:        devEUI,
+				Type:          "DOWNLINK_EXPIRED",
+			}), ShouldBeNil)
+
+			Convey("Then only the event of application 1 is received", func() {
+				e := <-eventsChan
+				So(e.Type, ShouldEqual, Error)
+				So(eventsChan, ShouldHaveLength, 0)
+			})
+		})
+
+		Reset(func() {
+			cancel()
+			So(<-errChan, ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,449 @@
+// Package fuota implements the firmware update over the air deployments,
+// using the Remote Multicast Setup and Fragmented Data Block Transport
+// application layer packages.
+package fuota
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/applayer/fragmentation"
+	"github.com/gusseleet/lora-app-server/internal/applayer/multicastsetup"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/downlink"
+	"github.com/gusseleet/lora-app-server/internal/gps"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// The multicast group and fragmentation session index used on the devices.
+const (
+	mcGroupID = 0
+	fragIndex = 0
+)
+
+// CreateDeployment creates a multicast group with a random McAddr and
+// McKey for the given devices, and the FUOTA deployment using this
+// multicast group.
+func CreateDeployment(db sqlx.Ext, d *storage.FUOTADeployment, frequency, dr int, devEUIs []lorawan.EUI64) error {
+	if err := d.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	g := storage.MulticastGroup{
+		ApplicationID: d.ApplicationID,
+		Name:          fmt.Sprintf("fuota: %s", d.Name),
+		Frequency:     frequency,
+		DR:            dr,
+	}
+
+	if _, err := rand.Read(g.McAddr[:]); err != nil {
+		return errors.Wrap(err, "read random bytes error")
+	}
+	if _, err := rand.Read(d.McKey[:]); err != nil {
+		return errors.Wrap(err, "read random bytes error")
+	}
+
+	var err error
+	g.McAppSKey, err = multicastsetup.GetMcAppSKey(d.McKey, g.McAddr)
+	if err != nil {
+		return errors.Wrap(err, "get McAppSKey error")
+	}
+	g.McNwkSKey, err = multicastsetup.GetMcNwkSKey(d.McKey, g.McAddr)
+	if err != nil {
+		return errors.Wrap(err, "get McNwkSKey error")
+	}
+
+	if err := storage.CreateMulticastGroup(db, &g); err != nil {
+		return errors.Wrap(err, "create multicast group error")
+	}
+
+	for _, devEUI := range devEUIs {
+		if err := storage.AddDeviceToMulticastGroup(db, g.ID, devEUI); err != nil {
+			return errors.Wrap(err, "add device to multicast group error")
+		}
+	}
+
+	d.MulticastGroupID = g.ID
+	d.State = storage.FUOTADeploymentMulticastGroupSetup
+	d.NextStepAfter = time.Now()
+
+	if err := storage.CreateFUOTADeployment(db, d, devEUIs); err != nil {
+		return errors.Wrap(err, "create fuota deployment error")
+	}
+
+	return nil
+}
+
+// DeploymentLoop is a never returning function executing the next step of
+// the FUOTA deployments.
+func DeploymentLoop() {
+	for {
+		if err := handlePendingDeployments(); err != nil {
+			log.Errorf("handle fuota deployments error: %s", err)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+// handlePendingDeployments executes the next step of the pending FUOTA
+// deployments. Each deployment is handled within its own transaction, so
+// that a failing deployment does not roll back or block the others.
+func handlePendingDeployments() error {
+	deployments, err := storage.GetPendingFUOTADeployments(config.C.PostgreSQL.DB, 10)
+	if err != nil {
+		return errors.Wrap(err, "get pending fuota deployments error")
+	}
+
+	for _, d := range deployments {
+		err := storage.Transaction(config.C.PostgreSQL.DB, func(tx sqlx.Ext) error {
+			// lock the deployment and make sure it has not been handled in
+			// the meantime (e.g. by an other instance)
+			d, err := storage.GetPendingFUOTADeployment(tx, d.ID)
+			if err != nil {
+				return errors.Wrap(err, "get pending fuota deployment error")
+			}
+			return handleDeployment(tx, d)
+		})
+		if err != nil {
+			if errors.Cause(err) == storage.ErrDoesNotExist {
+				continue
+			}
+			log.WithField("id", d.ID).Errorf("handle fuota deployment error: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func handleDeployment(db sqlx.Ext, d storage.FUOTADeployment) error {
+	switch d.State {
+	case storage.FUOTADeploymentMulticastGroupSetup:
+		return stepMulticastGroupSetup(db, d)
+	case storage.FUOTADeploymentFragmentationSessionSetup:
+		return stepFragmentationSessionSetup(db, d)
+	case storage.FUOTADeploymentMulticastSessionSetup:
+		return stepMulticastSessionSetup(db, d)
+	case storage.FUOTADeploymentEnqueue:
+		return stepEnqueue(db, d)
+	case storage.FUOTADeploymentStatusRequest:
+		return stepStatusRequest(db, d)
+	case storage.FUOTADeploymentSetDeviceStatus:
+		return stepSetDeviceStatus(db, d)
+	default:
+		return fmt.Errorf("unexpected state: %s", d.State)
+	}
+}
+
+// stepMulticastGroupSetup sends the McGroupSetupReq to the devices. As the
+// McKey is encrypted using a key derived from the device AppKey, the
+// devices must have been activated over the air.
+func stepMulticastGroupSetup(db sqlx.Ext, d storage.FUOTADeployment) error {
+	g, err := storage.GetMulticastGroup(db, d.MulticastGroupID)
+	if err != nil {
+		return errors.Wrap(err, "get multicast group error")
+	}
+
+	devices, err := storage.GetPendingFUOTADeploymentDevices(db, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "get pending fuota deployment devices error")
+	}
+
+	for i := range devices {
+		dev := &devices[i]
+
+		b, err := mcGroupSetupReq(db, d, g, dev.DevEUI)
+		if err == nil {
+			err = downlink.EnqueueDownlinkPayload(db, dev.DevEUI, "", false, multicastsetup.DefaultFPort, b)
+		}
+		if err != nil {
+			if err := setDeviceError(db, dev, fmt.Sprintf("enqueue McGroupSetupReq error: %s", err)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nextStep(db, &d, storage.FUOTADeploymentFragmentationSessionSetup, time.Now().Add(unicastTimeout(d)))
+}
+
+func mcGroupSetupReq(db sqlx.Queryer, d storage.FUOTADeployment, g storage.MulticastGroup, devEUI lorawan.EUI64) ([]byte, error) {
+	dk, err := storage.GetDeviceKeys(db, devEUI)
+	if err != nil {
+		return nil, errors.Wrap(err, "get device-keys error")
+	}
+
+	mcRootKey, err := multicastsetup.GetMcRootKeyForAppKey(dk.AppKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "get McRootKey error")
+	}
+	mcKEKey, err := multicastsetup.GetMcKEKey(mcRootKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "get McKEKey error")
+	}
+	mcKeyEncrypted, err := multicastsetup.EncryptMcKey(mcKEKey, d.McKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "encrypt McKey error")
+	}
+
+	return multicastsetup.McGroupSetupReqPayload{
+		McGroupID:      mcGroupID,
+		McAddr:         g.McAddr,
+		McKeyEncrypted: mcKeyEncrypted,
+		MinMcFCnt:      g.FCnt,
+		MaxMcFCnt:      g.FCnt + uint32(nbFrag(d)+d.Redundancy),
+	}.MarshalBinary()
+}
+
+// stepFragmentationSessionSetup sends the FragSessionSetupReq to the
+// devices which completed the multicast group setup.
+func stepFragmentationSessionSetup(db sqlx.Ext, d storage.FUOTADeployment) error {
+	devices, err := storage.GetPendingFUOTADeploymentDevices(db, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "get pending fuota deployment devices error")
+	}
+
+	b, err := fragmentation.FragSessionSetupReqPayload{
+		FragIndex:      fragIndex,
+		McGroupBitMask: 1 << mcGroupID,
+		NbFrag:         uint16(nbFrag(d)),
+		FragSize:       uint8(d.FragSize),
+		Padding:        uint8(fragmentation.Padding(len(d.Payload), d.FragSize)),
+	}.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal FragSessionSetupReq error")
+	}
+
+	for i := range devices {
+		dev := &devices[i]
+
+		if dev.McGroupSetupCompletedAt == nil {
+			if err := setDeviceError(db, dev, "multicast group setup timeout"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := downlink.EnqueueDownlinkPayload(db, dev.DevEUI, "", false, fragmentation.DefaultFPort, b); err != nil {
+			if err := setDeviceError(db, dev, fmt.Sprintf("enqueue FragSessionSetupReq error: %s", err)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nextStep(db, &d, storage.FUOTADeploymentMulticastSessionSetup, time.Now().Add(unicastTimeout(d)))
+}
+
+// stepMulticastSessionSetup sends the McClassCSessionReq to the devices
+// which completed the fragmentation session setup. The session starts
+// after the unicast timeout, so that the devices have the opportunity to
+// receive the request.
+func stepMulticastSessionSetup(db sqlx.Ext, d storage.FUOTADeployment) error {
+	g, err := storage.GetMulticastGroup(db, d.MulticastGroupID)
+	if err != nil {
+		return errors.Wrap(err, "get multicast group error")
+	}
+
+	devices, err := storage.GetPendingFUOTADeploymentDevices(db, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "get pending fuota deployment devices error")
+	}
+
+	sessionStart := time.Now().Add(unicastTimeout(d)).Truncate(time.Second)
+
+	b, err := multicastsetup.McClassCSessionReqPayload{
+		McGroupID:      mcGroupID,
+		SessionTime:    uint32(gps.TimeSinceGPSEpoch(sessionStart) / time.Second),
+		SessionTimeOut: uint8(d.MulticastTimeout),
+		DLFrequency:    g.Frequency,
+		DR:             uint8(g.DR),
+	}.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal McClassCSessionReq error")
+	}
+
+	for i := range devices {
+		dev := &devices[i]
+
+		if dev.FragSessionSetupCompletedAt == nil {
+			if err := setDeviceError(db, dev, "fragmentation session setup timeout"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := downlink.EnqueueDownlinkPayload(db, dev.DevEUI, "", false, multicastsetup.DefaultFPort, b); err != nil {
+			if err := setDeviceError(db, dev, fmt.Sprintf("enqueue McClassCSessionReq error: %s", err)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nextStep(db, &d, storage.FUOTADeploymentEnqueue, sessionStart)
+}
+
+// stepEnqueue sends the (FEC encoded) fragments over the multicast group.
+func stepEnqueue(db sqlx.Ext, d storage.FUOTADeployment) error {
+	devices, err := storage.GetPendingFUOTADeploymentDevices(db, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "get pending fuota deployment devices error")
+	}
+
+	var active int
+	for i := range devices {
+		dev := &devices[i]
+
+		if dev.McSessionCompletedAt == nil {
+			if err := setDeviceError(db, dev, "multicast session setup timeout"); err != nil {
+				return err
+			}
+			continue
+		}
+		active++
+	}
+
+	if active == 0 {
+		return nextStep(db, &d, storage.FUOTADeploymentDone, time.Now())
+	}
+
+	if err := enqueueFragments(d); err != nil {
+		log.WithField("id", d.ID).Errorf("enqueue fuota fragments error: %s", err)
+
+		for i := range devices {
+			dev := &devices[i]
+			if dev.State != storage.FUOTADeploymentDevicePending {
+				continue
+			}
+			if err := setDeviceError(db, dev, fmt.Sprintf("enqueue fragments error: %s", err)); err != nil {
+				return err
+			}
+		}
+		return nextStep(db, &d, storage.FUOTADeploymentDone, time.Now())
+	}
+
+	sessionTimeout := time.Duration(1<<uint(d.MulticastTimeout)) * time.Second
+	return nextStep(db, &d, storage.FUOTADeploymentStatusRequest, time.Now().Add(sessionTimeout))
+}
+
+// enqueueFragments sends the fragments over the multicast group. The
+// frame-counter of the multicast group is incremented outside the
+// transaction of the deployment, so that it is committed before each
+// fragment is sent and is never re-used, even when the transaction is
+// rolled back afterwards.
+func enqueueFragments(d storage.FUOTADeployment) error {
+	frags, err := fragmentation.Encode(d.Payload, d.FragSize, d.Redundancy)
+	if err != nil {
+		return errors.Wrap(err, "fragmentation encode error")
+	}
+
+	for i, frag := range frags {
+		b, err := fragmentation.DataFragmentPayload{
+			FragIndex: fragIndex,
+			N:         uint16(i + 1),
+			Payload:   frag,
+		}.MarshalBinary()
+		if err != nil {
+			return errors.Wrap(err, "marshal DataFragment error")
+		}
+
+		if _, err := downlink.EnqueueMulticastPayload(config.C.PostgreSQL.DB, d.MulticastGroupID, fragmentation.DefaultFPort, b); err != nil {
+			return errors.Wrap(err, "enqueue multicast payload error")
+		}
+	}
+
+	return nil
+}
+
+// stepStatusRequest sends the FragSessionStatusReq to the devices.
+func stepStatusRequest(db sqlx.Ext, d storage.FUOTADeployment) error {
+	devices, err := storage.GetPendingFUOTADeploymentDevices(db, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "get pending fuota deployment devices error")
+	}
+
+	b, err := fragmentation.FragSessionStatusReqPayload{
+		FragIndex:    fragIndex,
+		Participants: true,
+	}.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal FragSessionStatusReq error")
+	}
+
+	for i := range devices {
+		dev := &devices[i]
+
+		if err := downlink.EnqueueDownlinkPayload(db, dev.DevEUI, "", false, fragmentation.DefaultFPort, b); err != nil {
+			if err := setDeviceError(db, dev, fmt.Sprintf("enqueue FragSessionStatusReq error: %s", err)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nextStep(db, &d, storage.FUOTADeploymentSetDeviceStatus, time.Now().Add(unicastTimeout(d)))
+}
+
+// stepSetDeviceStatus sets the final state of the devices, based on the
+// received FragSessionStatusAns.
+func stepSetDeviceStatus(db sqlx.Ext, d storage.FUOTADeployment) error {
+	devices, err := storage.GetPendingFUOTADeploymentDevices(db, d.ID)
+	if err != nil {
+		return errors.Wrap(err, "get pending fuota deployment devices error")
+	}
+
+	for i := range devices {
+		dev := &devices[i]
+
+		switch {
+		case dev.FragStatusCompletedAt == nil:
+			err = setDeviceError(db, dev, "fragmentation session status timeout")
+		case dev.MissingFrag > 0:
+			err = setDeviceError(db, dev, fmt.Sprintf("%d fragments missing", dev.MissingFrag))
+		default:
+			dev.State = storage.FUOTADeploymentDeviceSuccess
+			if err = storage.UpdateFUOTADeploymentDevice(db, dev); err != nil {
+				err = errors.Wrap(err, "update fuota deployment device error")
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nextStep(db, &d, storage.FUOTADeploymentDone, time.Now())
+}
+
+// nbFrag returns the number of uncoded fragments of the deployment payload.
+func nbFrag(d storage.FUOTADeployment) int {
+	return (len(d.Payload) + d.FragSize - 1) / d.FragSize
+}
+
+func unicastTimeout(d storage.FUOTADeployment) time.Duration {
+	return time.Duration(d.UnicastTimeout) * time.Second
+}
+
+func nextStep(db sqlx.Execer, d *storage.FUOTADeployment, state storage.FUOTADeploymentState, after time.Time) error {
+	d.State = state
+	d.NextStepAfter = after
+	if err := storage.UpdateFUOTADeploymentState(db, d); err != nil {
+		return errors.Wrap(err, "update fuota deployment state error")
+	}
+	return nil
+}
+
+func setDeviceError(db sqlx.Execer, dev *storage.FUOTADeploymentDevice, msg string) error {
+	log.WithFields(log.Fields{
+		"fuota_deployment_id": dev.FUOTADeploymentID,
+		"dev_eui":             dev.DevEUI,
+	}).Warningf("fuota deployment device error: %s", msg)
+
+	dev.State = storage.FUOTADeploymentDeviceError
+	dev.ErrorMessage = msg
+	if err := storage.UpdateFUOTADeploymentDevice(db, dev); err != nil {
+		return errors.Wrap(err, "update fuota deployment device error")
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package fuota
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/applayer/fragmentation"
+	"github.com/gusseleet/lora-app-server/internal/applayer/multicastsetup"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/brocaar/lorawan"
+	"github.com/brocaar/lorawan/backend"
+)
+
+func TestFUOTADeployment(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database and an application with two activated devices", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		nsClient.GetNextDownlinkFCntForDevEUIResponse = ns.GetNextDownlinkFCntForDevEUIResponse{
+			FCnt: 12,
+		}
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		sender := test.NewMulticastSender()
+		config.C.ApplicationServer.Multicast.Sender = sender
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := storage.ServiceProfile{
+			Name:            "test-sp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			ServiceProfile:  backend.ServiceProfile{},
+		}
+		So(storage.CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		dp := storage.DeviceProfile{
+			Name:            "test-dp",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			DeviceProfile:   backend.DeviceProfile{},
+		}
+		So(storage.CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+		app := storage.Application{
+			OrganizationID:   org.ID,
+			Name:             "test-app",
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+		}
+		So(storage.CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		var devEUIs []lorawan.EUI64
+		for i := byte(1); i <= 2; i++ {
+			d := storage.Device{
+				ApplicationID:   app.ID,
+				DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+				Name:            fmt.Sprintf("test-device-%d", i),
+				DevEUI:          lorawan.EUI64{i, i, i, i, i, i, i, i},
+			}
+			So(storage.CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+			So(storage.CreateDeviceKeys(config.C.PostgreSQL.DB, &storage.DeviceKeys{
+				DevEUI: d.DevEUI,
+				AppKey: lorawan.AES128Key{i, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+			}), ShouldBeNil)
+
+			So(storage.CreateDeviceActivation(config.C.PostgreSQL.DB, &storage.DeviceActivation{
+				DevEUI:  d.DevEUI,
+				DevAddr: lorawan.DevAddr{1, 2, 3, i},
+				AppSKey: lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			}), ShouldBeNil)
+
+			devEUIs = append(devEUIs, d.DevEUI)
+		}
+
+		Convey("When creating a FUOTA deployment for both devices", func() {
+			d := storage.FUOTADeployment{
+				ApplicationID:    app.ID,
+				Name:             "test-deployment",
+				Payload:          []byte{1, 2, 3, 4, 5},
+				FragSize:         2,
+				Redundancy:       1,
+				MulticastTimeout: 0,
+				UnicastTimeout:   60,
+			}
+			So(CreateDeployment(config.C.PostgreSQL.DB, &d, 869525000, 3, devEUIs), ShouldBeNil)
+
+			Convey("Then a multicast group has been created with the derived session keys", func() {
+				g, err := storage.GetMulticastGroup(config.C.PostgreSQL.DB, d.MulticastGroupID)
+				So(err, ShouldBeNil)
+				So(g.ApplicationID, ShouldEqual, app.ID)
+				So(g.Frequency, ShouldEqual, 869525000)
+				So(g.DR, ShouldEqual, 3)
+
+				appSKey, err := multicastsetup.GetMcAppSKey(d.McKey, g.McAddr)
+				So(err, ShouldBeNil)
+				So(g.McAppSKey, ShouldEqual, appSKey)
+
+				count, err := storage.GetMulticastGroupDeviceCount(config.C.PostgreSQL.DB, g.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+			})
+
+			Convey("When handling the pending deployments", func() {
+				So(handlePendingDeployments(), ShouldBeNil)
+
+				Convey("Then the multicast group setup step has been executed", func() {
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+
+					dd, err := storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+					So(err, ShouldBeNil)
+					So(dd.State, ShouldEqual, storage.FUOTADeploymentFragmentationSessionSetup)
+				})
+
+				Convey("Then the deployment is no longer pending", func() {
+					_, err := storage.GetPendingFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+					So(errors.Cause(err), ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
+			Convey("When executing the multicast group setup step", func() {
+				So(handleDeployment(config.C.PostgreSQL.DB, d), ShouldBeNil)
+
+				Convey("Then the McGroupSetupReq has been enqueued for both devices", func() {
+					So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 2)
+					for i := 0; i < 2; i++ {
+						req := <-nsClient.CreateDeviceQueueItemChan
+						So(req.Item.FPort, ShouldEqual, multicastsetup.DefaultFPort)
+					}
+				})
+
+				Convey("Then the deployment is in the fragmentation session setup state", func() {
+					dd, err := storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+					So(err, ShouldBeNil)
+					So(dd.State, ShouldEqual, storage.FUOTADeploymentFragmentationSessionSetup)
+				})
+
+				Convey("When only the first device answers the McGroupSetupReq", func() {
+					So(HandleRemoteMulticastSetupCommand(config.C.PostgreSQL.DB, devEUIs[0], []byte{0x02, 0x00}), ShouldBeNil)
+
+					dev, err := storage.GetFUOTADeploymentDevice(config.C.PostgreSQL.DB, d.ID, devEUIs[0])
+					So(err, ShouldBeNil)
+					So(dev.McGroupSetupCompletedAt, ShouldNotBeNil)
+
+					Convey("When executing the remaining steps with successful answers", func() {
+						for len(nsClient.CreateDeviceQueueItemChan) > 0 {
+							<-nsClient.CreateDeviceQueueItemChan
+						}
+
+						// fragmentation session setup
+						dep, err := storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+						So(err, ShouldBeNil)
+						So(handleDeployment(config.C.PostgreSQL.DB, dep), ShouldBeNil)
+						So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+						So((<-nsClient.CreateDeviceQueueItemChan).Item.FPort, ShouldEqual, fragmentation.DefaultFPort)
+						So(HandleFragmentationSessionCommand(config.C.PostgreSQL.DB, devEUIs[0], []byte{0x02, 0x00}), ShouldBeNil)
+
+						// multicast session setup
+						dep, err = storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+						So(err, ShouldBeNil)
+						So(handleDeployment(config.C.PostgreSQL.DB, dep), ShouldBeNil)
+						So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+						So((<-nsClient.CreateDeviceQueueItemChan).Item.FPort, ShouldEqual, multicastsetup.DefaultFPort)
+						So(HandleRemoteMulticastSetupCommand(config.C.PostgreSQL.DB, devEUIs[0], []byte{0x04, 0x00, 0x3c, 0x00, 0x00}), ShouldBeNil)
+
+						// enqueue
+						dep, err = storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+						So(err, ShouldBeNil)
+						So(handleDeployment(config.C.PostgreSQL.DB, dep), ShouldBeNil)
+
+						// status request
+						dep, err = storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+						So(err, ShouldBeNil)
+						So(handleDeployment(config.C.PostgreSQL.DB, dep), ShouldBeNil)
+						So(nsClient.CreateDeviceQueueItemChan, ShouldHaveLength, 1)
+						So((<-nsClient.CreateDeviceQueueItemChan).Item.FPort, ShouldEqual, fragmentation.DefaultFPort)
+						So(HandleFragmentationSessionCommand(config.C.PostgreSQL.DB, devEUIs[0], []byte{0x01, 0x04, 0x00, 0x00, 0x00}), ShouldBeNil)
+
+						// set device status
+						dep, err = storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+						So(err, ShouldBeNil)
+						So(handleDeployment(config.C.PostgreSQL.DB, dep), ShouldBeNil)
+
+						Convey("Then the uncoded and redundancy fragments have been sent over the multicast group", func() {
+							So(sender.SendMulticastChan, ShouldHaveLength, 4)
+							item := <-sender.SendMulticastChan
+							So(item.MulticastGroupID, ShouldEqual, d.MulticastGroupID)
+							So(item.FPort, ShouldEqual, fragmentation.DefaultFPort)
+						})
+
+						Convey("Then the deployment is done", func() {
+							dd, err := storage.GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+							So(err, ShouldBeNil)
+							So(dd.State, ShouldEqual, storage.FUOTADeploymentDone)
+						})
+
+						Convey("Then the first device succeeded and the second device failed", func() {
+							dev, err := storage.GetFUOTADeploymentDevice(config.C.PostgreSQL.DB, d.ID, devEUIs[0])
+							So(err, ShouldBeNil)
+							So(dev.State, ShouldEqual, storage.FUOTADeploymentDeviceSuccess)
+							So(dev.NbFragReceived, ShouldEqual, 4)
+
+							dev, err = storage.GetFUOTADeploymentDevice(config.C.PostgreSQL.DB, d.ID, devEUIs[1])
+							So(err, ShouldBeNil)
+							So(dev.State, ShouldEqual, storage.FUOTADeploymentDeviceError)
+							So(dev.ErrorMessage, ShouldEqual, "multicast group setup timeout")
+						})
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,111 @@
+package fuota
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/applayer/fragmentation"
+	"github.com/gusseleet/lora-app-server/internal/applayer/multicastsetup"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/brocaar/lorawan"
+)
+
+// HandleRemoteMulticastSetupCommand handles the given (decrypted) Remote
+// Multicast Setup uplink payload, received from the given device.
+func HandleRemoteMulticastSetupCommand(db sqlx.Ext, devEUI lorawan.EUI64, b []byte) error {
+	answers, err := multicastsetup.UnmarshalAnswers(b)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal answers error")
+	}
+
+	dev, err := storage.GetPendingFUOTADeploymentDevice(db, devEUI)
+	if err != nil {
+		if err == storage.ErrDoesNotExist {
+			log.WithField("dev_eui", devEUI).Info("remote multicast setup answer received for device without pending fuota deployment")
+			return nil
+		}
+		return errors.Wrap(err, "get pending fuota deployment device error")
+	}
+
+	now := time.Now()
+
+	for _, ans := range answers {
+		switch pl := ans.(type) {
+		case multicastsetup.McGroupSetupAnsPayload:
+			if pl.IDError {
+				dev.State = storage.FUOTADeploymentDeviceError
+				dev.ErrorMessage = "McGroupSetupAns: McGroupID error"
+			} else {
+				dev.McGroupSetupCompletedAt = &now
+			}
+		case multicastsetup.McClassCSessionAnsPayload:
+			if pl.DRError || pl.FreqError || pl.McGroupUndefined {
+				dev.State = storage.FUOTADeploymentDeviceError
+				dev.ErrorMessage = fmt.Sprintf("McClassCSessionAns: DR error: %t, frequency error: %t, McGroup undefined: %t", pl.DRError, pl.FreqError, pl.McGroupUndefined)
+			} else {
+				dev.McSessionCompletedAt = &now
+			}
+		default:
+			log.WithField("dev_eui", devEUI).Infof("unhandled remote multicast setup answer: %T", ans)
+		}
+	}
+
+	if err := storage.UpdateFUOTADeploymentDevice(db, &dev); err != nil {
+		return errors.Wrap(err, "update fuota deployment device error")
+	}
+
+	return nil
+}
+
+// HandleFragmentationSessionCommand handles the given (decrypted)
+// Fragmented Data Block Transport uplink payload, received from the given
+// device.
+func HandleFragmentationSessionCommand(db sqlx.Ext, devEUI lorawan.EUI64, b []byte) error {
+	answers, err := fragmentation.UnmarshalAnswers(b)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal answers error")
+	}
+
+	dev, err := storage.GetPendingFUOTADeploymentDevice(db, devEUI)
+	if err != nil {
+		if err == storage.ErrDoesNotExist {
+			log.WithField("dev_eui", devEUI).Info("fragmentation session answer received for device without pending fuota deployment")
+			return nil
+		}
+		return errors.Wrap(err, "get pending fuota deployment device error")
+	}
+
+	now := time.Now()
+
+	for _, ans := range answers {
+		switch pl := ans.(type) {
+		case fragmentation.FragSessionSetupAnsPayload:
+			if pl.WrongDescriptor || pl.FragSessionIndexNotSupported || pl.NotEnoughMemory || pl.EncodingUnsupported {
+				dev.State = storage.FUOTADeploymentDeviceError
+				dev.ErrorMessage = fmt.Sprintf("FragSessionSetupAns: wrong descriptor: %t, session index not supported: %t, not enough memory: %t, encoding unsupported: %t", pl.WrongDescriptor, pl.FragSessionIndexNotSupported, pl.NotEnoughMemory, pl.EncodingUnsupported)
+			} else {
+				dev.FragSessionSetupCompletedAt = &now
+			}
+		case fragmentation.FragSessionStatusAnsPayload:
+			dev.FragStatusCompletedAt = &now
+			dev.NbFragReceived = int(pl.NbFragReceived)
+			dev.MissingFrag = int(pl.MissingFrag)
+			if pl.NotEnoughMatrixMemory {
+				dev.State = storage.FUOTADeploymentDeviceError
+				dev.ErrorMessage = "FragSessionStatusAns: not enough matrix memory"
+			}
+		default:
+			log.WithField("dev_eui", devEUI).Infof("unhandled fragmentation session answer: %T", ans)
+		}
+	}
+
+	if err := storage.UpdateFUOTADeploymentDevice(db, &dev); err != nil {
+		return errors.Wrap(err, "update fuota deployment device error")
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+// Package gps implements the conversion between time and the time since
+// the GPS epoch, as used by the LoRaWAN application layer packages.
+package gps
+
+import "time"
+
+var gpsEpochTime = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+// leapSeconds contains the number of leap seconds between the GPS epoch
+// and UTC (valid since 2017-01-01).
+const leapSeconds = 18 * time.Second
+
+// TimeSinceGPSEpoch returns the duration since the GPS epoch for the given
+// time.
+func TimeSinceGPSEpoch(t time.Time) time.Duration {
+	return t.Sub(gpsEpochTime) + leapSeconds
+}
+
+// Time returns the time for the given duration since the GPS epoch.
+func Time(d time.Duration) time.Time {
+	return gpsEpochTime.Add(d - leapSeconds)
+}
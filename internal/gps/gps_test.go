@@ -0,0 +1,22 @@
+package gps
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGPS(t *testing.T) {
+	Convey("Given 2018-01-01 00:00:00 UTC", t, func() {
+		ts := time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		Convey("Then TimeSinceGPSEpoch includes the leap seconds", func() {
+			So(TimeSinceGPSEpoch(ts), ShouldEqual, 1198800018*time.Second)
+		})
+
+		Convey("Then Time returns the original time", func() {
+			So(Time(1198800018*time.Second).Equal(ts), ShouldBeTrue)
+		})
+	})
+}
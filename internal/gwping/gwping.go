@@ -87,6 +87,7 @@ func HandleReceivedPing(req *as.HandleProprietaryUplinkRequest) error {
 			if err != nil {
 				return errors.Wrap(err, "create gateway ping rx error")
 			}
+			pingReceivedCounter.Inc()
 		}
 		return nil
 	})
@@ -198,6 +199,7 @@ func sendPing(mic lorawan.MIC, ping storage.GatewayPing) error {
 	if err != nil {
 		return errors.Wrap(err, "send proprietary payload error")
 	}
+	pingSentCounter.Inc()
 
 	log.WithFields(log.Fields{
 		"gateway_mac": ping.GatewayMAC,
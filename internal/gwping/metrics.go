@@ -0,0 +1,18 @@
+package gwping
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	pingSentCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "lora_app_server_gateway_pings_sent_total",
+		Help: "The number of gateway pings sent.",
+	})
+
+	pingReceivedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "lora_app_server_gateway_pings_received_total",
+		Help: "The number of times a gateway ping has been received by a gateway (other than the sending gateway).",
+	})
+)
@@ -19,3 +19,9 @@ type IntegrationHandler interface {
 	SendErrorNotification(payload ErrorNotification) error // send error notification
 	Close() error                                          // closes the handler
 }
+
+// HealthChecker defines the interface of a handler which is able to report
+// its health, e.g. the state of its connection to the MQTT broker.
+type HealthChecker interface {
+	HealthCheck() error // returns an error when the handler is unhealthy
+}
@@ -61,6 +61,9 @@ type DataDownPayload struct {
 	FPort         uint8           `json:"fPort"`
 	Data          []byte          `json:"data"`
 	Object        json.RawMessage `json:"object"`
+	ExpiresAt     *time.Time      `json:"expiresAt,omitempty"`
+	Priority      int             `json:"priority"`
+	DedupKey      string          `json:"dedupKey"`
 }
 
 // JoinNotification defines the payload sent to the application on
@@ -95,4 +98,5 @@ type ErrorNotification struct {
 	Type            string        `json:"type"`
 	Error           string        `json:"error"`
 	FCnt            uint32        `json:"fCnt"`
+	Reference       string        `json:"reference,omitempty"`
 }
@@ -33,6 +33,11 @@ type MQTTHandler struct {
 	dataDownChan chan handler.DataDownPayload
 	wg           sync.WaitGroup
 	redisPool    *redis.Pool
+
+	// connErr holds the reason of the lost connection, until the
+	// connection has been re-established
+	connMu  sync.RWMutex
+	connErr error
 }
 
 // NewHandler creates a new MQTTHandler.
@@ -256,8 +261,24 @@ func (h *MQTTHandler) txPayloadHandler(c mqtt.Client, msg mqtt.Message) {
 	h.dataDownChan <- pl
 }
 
+// HealthCheck returns an error when the connection to the MQTT broker has
+// been lost and has not been re-established yet.
+func (h *MQTTHandler) HealthCheck() error {
+	h.connMu.RLock()
+	defer h.connMu.RUnlock()
+
+	if h.connErr != nil {
+		return fmt.Errorf("mqtt connection lost: %s", h.connErr)
+	}
+	return nil
+}
+
 func (h *MQTTHandler) onConnected(c mqtt.Client) {
 	log.Info("handler/mqtt: connected to mqtt broker")
+	h.connMu.Lock()
+	h.connErr = nil
+	h.connMu.Unlock()
+
 	for {
 		log.WithField("topic", txTopic).Info("handler/mqtt: subscribling to tx topic")
 		if token := h.conn.Subscribe(txTopic, 2, h.txPayloadHandler); token.Wait() && token.Error() != nil {
@@ -271,4 +292,7 @@ func (h *MQTTHandler) onConnected(c mqtt.Client) {
 
 func (h *MQTTHandler) onConnectionLost(c mqtt.Client, reason error) {
 	log.Errorf("handler/mqtt: mqtt connection error: %s", reason)
+	h.connMu.Lock()
+	h.connErr = reason
+	h.connMu.Unlock()
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/gusseleet/lora-app-server/internal/config"
@@ -34,6 +35,27 @@ func TestMQTTHandler(t *testing.T) {
 			defer h.Close()
 			time.Sleep(time.Millisecond * 100) // give the backend some time to connect
 
+			Convey("Then the handler is healthy", func() {
+				So(h.(handler.HealthChecker).HealthCheck(), ShouldBeNil)
+			})
+
+			Convey("When the connection is lost", func() {
+				mh := h.(*MQTTHandler)
+				mh.onConnectionLost(mh.conn, errors.New("connection reset"))
+
+				Convey("Then the handler is unhealthy", func() {
+					So(mh.HealthCheck(), ShouldResemble, errors.New("mqtt connection lost: connection reset"))
+				})
+
+				Convey("When the connection is re-established", func() {
+					mh.onConnected(mh.conn)
+
+					Convey("Then the handler is healthy", func() {
+						So(mh.HealthCheck(), ShouldBeNil)
+					})
+				})
+			})
+
 			Convey("Given the MQTT client is subscribed to application/123/node/0102030405060708/rx", func() {
 				dataUpChan := make(chan handler.DataUpPayload, 1)
 				token := c.Subscribe("application/123/node/0102030405060708/rx", 0, func(c mqtt.Client, msg mqtt.Message) {
@@ -0,0 +1,11 @@
+package multihandler
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var integrationDeliveryCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "lora_app_server_integration_deliveries_total",
+	Help: "The number of payloads sent to the integrations, per integration kind, event (up, join, ack or error) and result (success or failure).",
+}, []string{"kind", "event", "result"})
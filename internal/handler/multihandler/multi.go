@@ -10,14 +10,18 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/eventlog"
 	"github.com/gusseleet/lora-app-server/internal/handler"
 	"github.com/gusseleet/lora-app-server/internal/handler/httphandler"
+	"github.com/gusseleet/lora-app-server/internal/handler/mqtthandler"
 	"github.com/gusseleet/lora-app-server/internal/storage"
 )
 
 // Handler kinds
 const (
-	HTTPHandlerKind = "HTTP"
+	HTTPHandlerKind     = "HTTP"
+	MQTTHandlerKind     = "MQTT"
+	EventLogHandlerKind = "EVENT_LOG"
 )
 
 // Handler wraps multiple handlers inside a single handler so that
@@ -25,6 +29,7 @@ const (
 // Note that errors are logged, but not returned.
 type Handler struct {
 	defaultHandler handler.Handler
+	extraHandlers  []handler.IntegrationHandler
 }
 
 // SendDataUp sends a data-up payload.
@@ -32,11 +37,13 @@ func (w Handler) SendDataUp(pl handler.DataUpPayload) error {
 	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
 	if err != nil {
 		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
+		handlers = w.getDefaultHandlers()
 	}
 
 	for _, h := range handlers {
-		if err := h.SendDataUp(pl); err != nil {
+		err := h.SendDataUp(pl)
+		countDelivery(h, "up", err)
+		if err != nil {
 			log.Errorf("handler %T error: %s", h, err)
 		}
 	}
@@ -48,11 +55,13 @@ func (w Handler) SendJoinNotification(pl handler.JoinNotification) error {
 	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
 	if err != nil {
 		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
+		handlers = w.getDefaultHandlers()
 	}
 
 	for _, h := range handlers {
-		if err := h.SendJoinNotification(pl); err != nil {
+		err := h.SendJoinNotification(pl)
+		countDelivery(h, "join", err)
+		if err != nil {
 			log.Errorf("handler %T error: %s", h, err)
 		}
 	}
@@ -64,11 +73,13 @@ func (w Handler) SendACKNotification(pl handler.ACKNotification) error {
 	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
 	if err != nil {
 		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
+		handlers = w.getDefaultHandlers()
 	}
 
 	for _, h := range handlers {
-		if err := h.SendACKNotification(pl); err != nil {
+		err := h.SendACKNotification(pl)
+		countDelivery(h, "ack", err)
+		if err != nil {
 			log.Errorf("handler %T error: %s", h, err)
 		}
 	}
@@ -80,26 +91,44 @@ func (w Handler) SendErrorNotification(pl handler.ErrorNotification) error {
 	handlers, err := w.getHandlersForApplicationID(pl.ApplicationID)
 	if err != nil {
 		log.Errorf("get handlers for application-id error: %s", err)
-		handlers = []handler.IntegrationHandler{w.defaultHandler}
+		handlers = w.getDefaultHandlers()
 	}
 
 	for _, h := range handlers {
-		if err := h.SendErrorNotification(pl); err != nil {
+		err := h.SendErrorNotification(pl)
+		countDelivery(h, "error", err)
+		if err != nil {
 			log.Errorf("handler %T error: %s", h, err)
 		}
 	}
 	return nil
 }
 
+// HealthCheck returns the health of the default handler, when it implements
+// the handler.HealthChecker interface.
+func (w Handler) HealthCheck() error {
+	if hc, ok := w.defaultHandler.(handler.HealthChecker); ok {
+		return hc.HealthCheck()
+	}
+	return nil
+}
+
 // Close closes the handlers.
 func (w Handler) Close() error {
 	return w.defaultHandler.Close()
 }
 
+// getDefaultHandlers returns the default handler and the handlers which
+// are used for all applications.
+func (w Handler) getDefaultHandlers() []handler.IntegrationHandler {
+	return append([]handler.IntegrationHandler{w.defaultHandler}, w.extraHandlers...)
+}
+
 // getHandlersForApplicationID returns all handlers (including the default
-// handler for the given application ID.
+// handler for the given application ID. This includes the integrations of
+// the organization, unless these are disabled for the application.
 func (w Handler) getHandlersForApplicationID(id int64) ([]handler.IntegrationHandler, error) {
-	handlers := []handler.IntegrationHandler{w.defaultHandler}
+	handlers := w.getDefaultHandlers()
 
 	// read integrations
 	integrations, err := storage.GetIntegrationsForApplicationID(config.C.PostgreSQL.DB, id)
@@ -107,35 +136,83 @@ func (w Handler) getHandlersForApplicationID(id int64) ([]handler.IntegrationHan
 		return nil, errors.Wrap(err, "get integrtions for application id error")
 	}
 
-	// map integration to handler + config
 	for _, intg := range integrations {
-		switch intg.Kind {
-		case HTTPHandlerKind:
-			var conf httphandler.HandlerConfig
-			if err := json.NewDecoder(bytes.NewReader(intg.Settings)).Decode(&conf); err != nil {
-				return nil, errors.Wrap(err, "decode http handler config error")
-			}
-			h, err := httphandler.NewHandler(conf)
-			if err != nil {
-				return nil, err
-			}
-			handlers = append(handlers, h)
-		default:
-			return nil, fmt.Errorf("unknown integration %s", intg.Kind)
+		h, err := getIntegrationHandler(intg.Kind, intg.Settings)
+		if err != nil {
+			return nil, err
+		}
+		handlers = append(handlers, h)
+	}
+
+	// read organization integrations
+	orgIntegrations, err := storage.GetOrganizationIntegrationsForApplicationID(config.C.PostgreSQL.DB, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "get organization integrations for application id error")
+	}
+
+	for _, intg := range orgIntegrations {
+		h, err := getIntegrationHandler(intg.Kind, intg.Settings)
+		if err != nil {
+			return nil, err
 		}
+		handlers = append(handlers, h)
 	}
 
 	return handlers, nil
 }
 
+// getIntegrationHandler maps the integration kind and settings to a handler.
+func getIntegrationHandler(kind string, settings json.RawMessage) (handler.IntegrationHandler, error) {
+	switch kind {
+	case HTTPHandlerKind:
+		var conf httphandler.HandlerConfig
+		if err := json.NewDecoder(bytes.NewReader(settings)).Decode(&conf); err != nil {
+			return nil, errors.Wrap(err, "decode http handler config error")
+		}
+		h, err := httphandler.NewHandler(conf)
+		if err != nil {
+			return nil, err
+		}
+		return h, nil
+	default:
+		return nil, fmt.Errorf("unknown integration %s", kind)
+	}
+}
+
+// handlerKind returns the kind of the given handler.
+func handlerKind(h handler.IntegrationHandler) string {
+	switch h.(type) {
+	case *httphandler.Handler:
+		return HTTPHandlerKind
+	case *mqtthandler.MQTTHandler:
+		return MQTTHandlerKind
+	case *eventlog.Handler:
+		return EventLogHandlerKind
+	default:
+		return "OTHER"
+	}
+}
+
+// countDelivery increments the integration delivery counter for the kind
+// of the given handler and the given event.
+func countDelivery(h handler.IntegrationHandler, event string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	integrationDeliveryCounter.WithLabelValues(handlerKind(h), event, result).Inc()
+}
+
 // DataDownChan returns the channel containing the received DataDownPayload.
 func (w Handler) DataDownChan() chan handler.DataDownPayload {
 	return w.defaultHandler.DataDownChan()
 }
 
-// NewHandler returns a new MultiHandler.
-func NewHandler(defaultHandler handler.Handler) handler.Handler {
+// NewHandler returns a new MultiHandler. The extra handlers are used for
+// all applications, next to the default handler.
+func NewHandler(defaultHandler handler.Handler, extraHandlers ...handler.IntegrationHandler) handler.Handler {
 	return Handler{
 		defaultHandler: defaultHandler,
+		extraHandlers:  extraHandlers,
 	}
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/brocaar/lorawan"
 	"github.com/brocaar/lorawan/backend"
 	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -132,6 +133,9 @@ func TestHandler(t *testing.T) {
 				defer multiHandler.Close()
 
 				Convey("Calling SendDataUp", func() {
+					mqttDeliveries := testutil.ToFloat64(integrationDeliveryCounter.WithLabelValues(MQTTHandlerKind, "up", "success"))
+					httpDeliveries := testutil.ToFloat64(integrationDeliveryCounter.WithLabelValues(HTTPHandlerKind, "up", "success"))
+
 					So(multiHandler.SendDataUp(handler.DataUpPayload{
 						ApplicationID: app.ID,
 						DevEUI:        device.DevEUI,
@@ -144,6 +148,11 @@ func TestHandler(t *testing.T) {
 						req := <-h.requests
 						So(req.URL.Path, ShouldEqual, "/rx")
 					})
+
+					Convey("Then the deliveries have been counted per integration kind", func() {
+						So(testutil.ToFloat64(integrationDeliveryCounter.WithLabelValues(MQTTHandlerKind, "up", "success")), ShouldEqual, mqttDeliveries+1)
+						So(testutil.ToFloat64(integrationDeliveryCounter.WithLabelValues(HTTPHandlerKind, "up", "success")), ShouldEqual, httpDeliveries+1)
+					})
 				})
 
 				Convey("Calling SendJoinNotification", func() {
@@ -191,6 +200,39 @@ func TestHandler(t *testing.T) {
 					})
 				})
 			})
+
+			Convey("Given an organization integration", func() {
+				orgConfigJSON, err := json.Marshal(httphandler.HandlerConfig{
+					DataUpURL: server.URL + "/org/rx",
+				})
+				So(err, ShouldBeNil)
+
+				So(storage.CreateOrganizationIntegration(db, &storage.OrganizationIntegration{
+					OrganizationID: org.ID,
+					Kind:           HTTPHandlerKind,
+					Settings:       orgConfigJSON,
+				}), ShouldBeNil)
+
+				multiHandler := NewHandler(mqttHandler)
+				defer multiHandler.Close()
+
+				Convey("Then the handlers for the application include the organization integration", func() {
+					handlers, err := multiHandler.(Handler).getHandlersForApplicationID(app.ID)
+					So(err, ShouldBeNil)
+					So(handlers, ShouldHaveLength, 3)
+				})
+
+				Convey("When the application disabled the organization integrations", func() {
+					app.DisableOrganizationIntegrations = true
+					So(storage.UpdateApplication(db, app), ShouldBeNil)
+
+					Convey("Then the organization integration is not used", func() {
+						handlers, err := multiHandler.(Handler).getHandlersForApplicationID(app.ID)
+						So(err, ShouldBeNil)
+						So(handlers, ShouldHaveLength, 2)
+					})
+				})
+			})
 		})
 	})
 }
@@ -0,0 +1,40 @@
+package health
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Server implements the standard gRPC health service (grpc.health.v1.Health).
+// The status of the server ("" service) and of each service registered on
+// the gRPC server reflect the readiness of LoRa App Server.
+type Server struct {
+	server *grpc.Server
+}
+
+// NewServer creates a new health Server for the given gRPC server.
+func NewServer(server *grpc.Server) *Server {
+	return &Server{
+		server: server,
+	}
+}
+
+// Check returns the serving status of the given service.
+func (s *Server) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if req.Service != "" {
+		if _, ok := s.server.GetServiceInfo()[req.Service]; !ok {
+			return nil, grpc.Errorf(codes.NotFound, "unknown service: %s", req.Service)
+		}
+	}
+
+	resp := grpc_health_v1.HealthCheckResponse{
+		Status: grpc_health_v1.HealthCheckResponse_SERVING,
+	}
+	if CheckReadiness(ctx).Status != StatusOK {
+		resp.Status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+
+	return &resp, nil
+}
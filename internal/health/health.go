@@ -0,0 +1,208 @@
+// Package health implements the liveness and readiness checks of LoRa App
+// Server, exposed as HTTP endpoints and as the standard gRPC health service.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/loraserver/api/ns"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/handler"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+// Status values.
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+// checkTimeout defines the max. duration of the readiness checks.
+const checkTimeout = 5 * time.Second
+
+// DependencyStatus contains the status of a single dependency.
+type DependencyStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Readiness contains the overall readiness status and the status of each
+// dependency. The overall status is only ok when all dependencies are ok.
+type Readiness struct {
+	Status       string             `json:"status"`
+	Dependencies []DependencyStatus `json:"dependencies"`
+}
+
+type check struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// CheckReadiness checks PostgreSQL, Redis, the integration handler (e.g. the
+// MQTT connection) and each configured network-server. The checks are
+// executed concurrently.
+func CheckReadiness(ctx context.Context) Readiness {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	checks := []check{
+		{name: "postgresql", fn: checkPostgreSQL},
+		{name: "redis", fn: checkRedis},
+	}
+
+	if hc, ok := config.C.ApplicationServer.Integration.Handler.(handler.HealthChecker); ok {
+		checks = append(checks, check{name: "mqtt", fn: func(ctx context.Context) error {
+			return hc.HealthCheck()
+		}})
+	}
+
+	nsChecks, err := getNetworkServerChecks()
+	if err != nil {
+		checks = append(checks, check{name: "network_server", fn: func(ctx context.Context) error {
+			return err
+		}})
+	}
+	checks = append(checks, nsChecks...)
+
+	r := Readiness{
+		Status:       StatusOK,
+		Dependencies: make([]DependencyStatus, len(checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i := range checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r.Dependencies[i] = runCheck(ctx, checks[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for _, d := range r.Dependencies {
+		if d.Status != StatusOK {
+			r.Status = StatusError
+		}
+	}
+
+	return r
+}
+
+// LiveHandler responds with the liveness status. It does not check the
+// dependencies, the process being able to respond means it is alive.
+func LiveHandler(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": StatusOK})
+}
+
+// ReadyHandler responds with the readiness status. The status code is 503
+// when one of the dependencies is not ok.
+func ReadyHandler(w http.ResponseWriter, r *http.Request) {
+	readiness := CheckReadiness(r.Context())
+
+	code := http.StatusOK
+	if readiness.Status != StatusOK {
+		code = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, code, readiness)
+}
+
+// runCheck runs the given check, giving up when the context is done.
+func runCheck(ctx context.Context, c check) DependencyStatus {
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- c.fn(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errChan:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		log.WithField("dependency", c.name).WithError(err).Warning("health: readiness check failed")
+		return DependencyStatus{
+			Name:   c.name,
+			Status: StatusError,
+			Error:  err.Error(),
+		}
+	}
+
+	return DependencyStatus{
+		Name:   c.name,
+		Status: StatusOK,
+	}
+}
+
+func checkPostgreSQL(ctx context.Context) error {
+	if err := config.C.PostgreSQL.DB.PingContext(ctx); err != nil {
+		return errors.Wrap(err, "ping postgresql error")
+	}
+	return nil
+}
+
+func checkRedis(ctx context.Context) error {
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	if _, err := c.Do("PING"); err != nil {
+		return errors.Wrap(err, "ping redis error")
+	}
+	return nil
+}
+
+// getNetworkServerChecks returns a check for each network-server. As the
+// network-server API does not provide a dedicated health method,
+// GetRandomDevAddr is used which is cheap and does not modify any state.
+func getNetworkServerChecks() ([]check, error) {
+	count, err := storage.GetNetworkServerCount(config.C.PostgreSQL.DB)
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-server count error")
+	}
+
+	nss, err := storage.GetNetworkServers(config.C.PostgreSQL.DB, count, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "get network-servers error")
+	}
+
+	var checks []check
+	for i := range nss {
+		n := nss[i]
+		checks = append(checks, check{name: "network_server:" + n.Server, fn: func(ctx context.Context) error {
+			nsClient, err := config.C.NetworkServer.Pool.Get(n.Server, []byte(n.CACert), []byte(n.TLSCert), []byte(n.TLSKey))
+			if err != nil {
+				return errors.Wrap(err, "get network-server client error")
+			}
+
+			if _, err := nsClient.GetRandomDevAddr(ctx, &ns.GetRandomDevAddrRequest{}); err != nil {
+				return errors.Wrap(err, "get random devaddr error")
+			}
+			return nil
+		}})
+	}
+
+	return checks, nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.WithError(err).Error("health: marshal json error")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(b)
+}
@@ -0,0 +1,142 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+	"github.com/gusseleet/lora-app-server/internal/test/testhandler"
+)
+
+type testHandler struct {
+	*testhandler.TestHandler
+	err error
+}
+
+func (h *testHandler) HealthCheck() error {
+	return h.err
+}
+
+func TestHealth(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with a network-server and a test handler", t, func() {
+		test.MustResetDB(config.C.PostgreSQL.DB)
+
+		nsClient := test.NewNetworkServerClient()
+		config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+		h := testHandler{
+			TestHandler: testhandler.NewTestHandler(),
+		}
+		config.C.ApplicationServer.Integration.Handler = &h
+
+		n := storage.NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(storage.CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		Convey("When calling the liveness endpoint", func() {
+			w := httptest.NewRecorder()
+			LiveHandler(w, httptest.NewRequest("GET", "/health/live", nil))
+
+			Convey("Then the process is reported alive", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+				So(w.Body.String(), ShouldEqual, `{"status":"ok"}`)
+			})
+		})
+
+		Convey("When calling the readiness endpoint", func() {
+			w := httptest.NewRecorder()
+			ReadyHandler(w, httptest.NewRequest("GET", "/health/ready", nil))
+
+			Convey("Then all dependencies are reported ok", func() {
+				So(w.Code, ShouldEqual, http.StatusOK)
+
+				var r Readiness
+				So(json.Unmarshal(w.Body.Bytes(), &r), ShouldBeNil)
+				So(r, ShouldResemble, Readiness{
+					Status: StatusOK,
+					Dependencies: []DependencyStatus{
+						{Name: "postgresql", Status: StatusOK},
+						{Name: "redis", Status: StatusOK},
+						{Name: "mqtt", Status: StatusOK},
+						{Name: "network_server:test-ns:1234", Status: StatusOK},
+					},
+				})
+				So(nsClient.GetRandomDevAddrChan, ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("Given the handler lost its connection", func() {
+			h.err = errors.New("connection lost")
+
+			Convey("When calling the readiness endpoint", func() {
+				w := httptest.NewRecorder()
+				ReadyHandler(w, httptest.NewRequest("GET", "/health/ready", nil))
+
+				Convey("Then the mqtt dependency is reported as failing", func() {
+					So(w.Code, ShouldEqual, http.StatusServiceUnavailable)
+
+					var r Readiness
+					So(json.Unmarshal(w.Body.Bytes(), &r), ShouldBeNil)
+					So(r.Status, ShouldEqual, StatusError)
+					So(r.Dependencies[2], ShouldResemble, DependencyStatus{
+						Name:   "mqtt",
+						Status: StatusError,
+						Error:  "connection lost",
+					})
+				})
+			})
+
+			Convey("Then the gRPC health service reports not serving", func() {
+				gs := grpc.NewServer()
+				s := NewServer(gs)
+				grpc_health_v1.RegisterHealthServer(gs, s)
+
+				resp, err := s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+				So(err, ShouldBeNil)
+				So(resp.Status, ShouldEqual, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+			})
+		})
+
+		Convey("Given a gRPC server with the health service", func() {
+			gs := grpc.NewServer()
+			s := NewServer(gs)
+			grpc_health_v1.RegisterHealthServer(gs, s)
+
+			Convey("Then the server is serving", func() {
+				resp, err := s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+				So(err, ShouldBeNil)
+				So(resp.Status, ShouldEqual, grpc_health_v1.HealthCheckResponse_SERVING)
+
+				resp, err = s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "grpc.health.v1.Health"})
+				So(err, ShouldBeNil)
+				So(resp.Status, ShouldEqual, grpc_health_v1.HealthCheckResponse_SERVING)
+			})
+
+			Convey("Then an unknown service returns an error", func() {
+				_, err := s.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "api.Unknown"})
+				So(grpc.Code(err), ShouldEqual, codes.NotFound)
+			})
+		})
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/gusseleet/lora-app-server/internal/config"
 	"github.com/gusseleet/lora-app-server/internal/handler"
@@ -59,6 +60,7 @@ var joinFlow = &flow{
 		setSessionKeys,
 		createDeviceActivationRecord,
 		flushDeviceQueueMapping,
+		incrUsage,
 		sendJoinNotification,
 		createJoinAnsPayload,
 	},
@@ -98,6 +100,7 @@ func HandleJoinRequest(pl backend.JoinReqPayload) backend.JoinAnsPayload {
 	}
 
 	jaPL.BasePayload = basePayload
+	joinRequestCounter.WithLabelValues(string(jaPL.Result.ResultCode)).Inc()
 	return jaPL
 }
 
@@ -215,6 +218,16 @@ func flushDeviceQueueMapping(ctx *context) error {
 	return nil
 }
 
+func incrUsage(ctx *context) error {
+	err := storage.IncrUsage(config.C.Redis.Pool, ctx.device.ApplicationID, ctx.device.DevEUI, storage.UsageCounters{
+		Joins: 1,
+	})
+	if err != nil {
+		log.WithField("dev_eui", ctx.device.DevEUI).WithError(err).Error("increment usage error")
+	}
+	return nil
+}
+
 func sendJoinNotification(ctx *context) error {
 	err := config.C.ApplicationServer.Integration.Handler.SendJoinNotification(handler.JoinNotification{
 		ApplicationID:   ctx.device.ApplicationID,
@@ -11,6 +11,7 @@ import (
 	"github.com/gusseleet/lora-app-server/internal/test"
 	"github.com/brocaar/lorawan"
 	"github.com/brocaar/lorawan/backend"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -22,6 +23,7 @@ func TestJoin(t *testing.T) {
 	}
 
 	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
 
 	Convey("Given a clean database with node", t, func() {
 		test.MustResetDB(config.C.PostgreSQL.DB)
@@ -293,8 +295,11 @@ func TestJoin(t *testing.T) {
 						So(test.PreRun(), ShouldBeNil)
 					}
 
+					joinRequests := testutil.ToFloat64(joinRequestCounter.WithLabelValues(string(test.ExpectedPayload.Result.ResultCode)))
+
 					ans := HandleJoinRequest(test.RequestPayload)
 					So(ans, ShouldResemble, test.ExpectedPayload)
+					So(testutil.ToFloat64(joinRequestCounter.WithLabelValues(string(ans.Result.ResultCode))), ShouldEqual, joinRequests+1)
 
 					if ans.Result.ResultCode == backend.Success {
 						_, err := storage.GetLastDeviceActivationForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
@@ -0,0 +1,11 @@
+package join
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var joinRequestCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "lora_app_server_join_requests_total",
+	Help: "The number of join-requests handled, per LoRaWAN backend interfaces result code (e.g. Success or MICFailed).",
+}, []string{"result_code"})
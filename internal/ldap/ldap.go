@@ -0,0 +1,175 @@
+// Package ldap implements the authentication of users against a LDAP server
+// (e.g. Active Directory). Users are created on their first login and their
+// global admin flag and organization memberships are set from the group
+// attribute.
+package ldap
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	ldapclient "gopkg.in/ldap.v2"
+
+	"github.com/gusseleet/lora-app-server/internal/common"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+const timeout = 10 * time.Second
+
+// LDAP errors.
+var (
+	ErrDisabled        = errors.New("ldap login is disabled")
+	ErrInvalidUsername = errors.New("the username is invalid")
+)
+
+var usernameSanitizer = regexp.MustCompile(`[^[:alnum:]]+`)
+
+// Authenticate authenticates the user against the LDAP server. It creates
+// or updates the (local) user and returns this user.
+func Authenticate(db *common.DBLogger, username, password string) (storage.User, error) {
+	conf := config.C.ApplicationServer.LDAP
+	if !conf.Enabled {
+		return storage.User{}, ErrDisabled
+	}
+
+	// most servers accept a bind without password as anonymous bind
+	if username == "" || password == "" {
+		return storage.User{}, storage.ErrInvalidUsernameOrPassword
+	}
+
+	conn, err := dial()
+	if err != nil {
+		return storage.User{}, err
+	}
+	defer conn.Close()
+
+	if conf.BindDN != "" {
+		if err := conn.Bind(conf.BindDN, conf.BindPassword); err != nil {
+			return storage.User{}, errors.Wrap(err, "bind error")
+		}
+	}
+
+	entry, err := searchUser(conn, username)
+	if err != nil {
+		return storage.User{}, err
+	}
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldapclient.IsErrorWithCode(err, ldapclient.LDAPResultInvalidCredentials) {
+			return storage.User{}, storage.ErrInvalidUsernameOrPassword
+		}
+		return storage.User{}, errors.Wrap(err, "bind user error")
+	}
+
+	u := storage.ExternalUser{
+		ExternalID: "ldap#" + entry.DN,
+		Username:   usernameSanitizer.ReplaceAllString(username, ""),
+		Email:      entry.GetAttributeValue(conf.EmailAttribute),
+		Groups:     entry.GetAttributeValues(conf.GroupAttribute),
+	}
+	if u.Username == "" {
+		return storage.User{}, ErrInvalidUsername
+	}
+
+	var user storage.User
+	err = storage.Transaction(db, func(tx sqlx.Ext) error {
+		user, err = storage.SyncExternalUser(tx, u, conf.AdminGroup, conf.GroupMapping)
+		return err
+	})
+	if err != nil {
+		return storage.User{}, err
+	}
+
+	if !user.IsActive {
+		return storage.User{}, storage.ErrInvalidUsernameOrPassword
+	}
+
+	log.WithFields(log.Fields{
+		"username": user.Username,
+		"dn":       entry.DN,
+	}).Info("ldap: user logged in")
+
+	return user, nil
+}
+
+// searchUser returns the entry of the given user. An error is returned when
+// the search does not return exactly one entry.
+func searchUser(conn *ldapclient.Conn, username string) (*ldapclient.Entry, error) {
+	conf := config.C.ApplicationServer.LDAP
+
+	req := ldapclient.NewSearchRequest(
+		conf.BaseDN,
+		ldapclient.ScopeWholeSubtree,
+		ldapclient.NeverDerefAliases,
+		2,
+		int(timeout/time.Second),
+		false,
+		fmt.Sprintf(conf.UserFilter, ldapclient.EscapeFilter(username)),
+		[]string{conf.EmailAttribute, conf.GroupAttribute},
+		nil,
+	)
+
+	res, err := conn.Search(req)
+	if err != nil && !ldapclient.IsErrorWithCode(err, ldapclient.LDAPResultSizeLimitExceeded) {
+		return nil, errors.Wrap(err, "search error")
+	}
+
+	if len(res.Entries) != 1 {
+		return nil, storage.ErrInvalidUsernameOrPassword
+	}
+
+	return res.Entries[0], nil
+}
+
+func dial() (*ldapclient.Conn, error) {
+	conf := config.C.ApplicationServer.LDAP
+
+	host, _, err := net.SplitHostPort(conf.Server)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse server error")
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         host,
+		InsecureSkipVerify: conf.InsecureSkipVerify,
+	}
+	if conf.CACert != "" {
+		b, err := ioutil.ReadFile(conf.CACert)
+		if err != nil {
+			return nil, errors.Wrap(err, "read ca certificate error")
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(b) {
+			return nil, errors.New("append ca certificate error")
+		}
+	}
+
+	var conn *ldapclient.Conn
+	if conf.TLS {
+		conn, err = ldapclient.DialTLS("tcp", conf.Server, tlsConfig)
+	} else {
+		conn, err = ldapclient.Dial("tcp", conf.Server)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "dial error")
+	}
+	conn.SetTimeout(timeout)
+
+	if conf.StartTLS && !conf.TLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "start tls error")
+		}
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,302 @@
+package ldap
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	ber "gopkg.in/asn1-ber.v1"
+	ldapclient "gopkg.in/ldap.v2"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+type testEntry struct {
+	password   string
+	attributes map[string][]string
+}
+
+// testServer implements a minimal LDAP server, supporting simple binds and
+// searches using an equality filter on the uid attribute.
+type testServer struct {
+	sync.Mutex
+	listener net.Listener
+	entries  map[string]testEntry
+}
+
+func newTestServer() (*testServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := testServer{
+		listener: ln,
+		entries:  make(map[string]testEntry),
+	}
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.handle(conn)
+		}
+	}()
+
+	return &s, nil
+}
+
+func (s *testServer) setEntry(dn string, e testEntry) {
+	s.Lock()
+	defer s.Unlock()
+	s.entries[dn] = e
+}
+
+func (s *testServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		req, err := ber.ReadPacket(conn)
+		if err != nil || len(req.Children) < 2 {
+			return
+		}
+		msgID := req.Children[0].Value.(int64)
+		op := req.Children[1]
+
+		var responses []*ber.Packet
+		switch op.Tag {
+		case ldapclient.ApplicationBindRequest:
+			responses = append(responses, s.bind(op))
+		case ldapclient.ApplicationSearchRequest:
+			responses = s.search(op)
+		default:
+			return
+		}
+
+		for _, resp := range responses {
+			packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgID, "MessageID"))
+			packet.AppendChild(resp)
+			if _, err := conn.Write(packet.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *testServer) bind(op *ber.Packet) *ber.Packet {
+	s.Lock()
+	defer s.Unlock()
+
+	dn := op.Children[1].Value.(string)
+	password := op.Children[2].Data.String()
+
+	var code uint8 = ldapclient.LDAPResultInvalidCredentials
+	if e, ok := s.entries[dn]; ok && password != "" && e.password == password {
+		code = ldapclient.LDAPResultSuccess
+	}
+
+	return ldapResult(ldapclient.ApplicationBindResponse, code)
+}
+
+func (s *testServer) search(op *ber.Packet) []*ber.Packet {
+	s.Lock()
+	defer s.Unlock()
+
+	filter, err := ldapclient.DecompileFilter(op.Children[6])
+	if err != nil {
+		return []*ber.Packet{ldapResult(ldapclient.ApplicationSearchResultDone, ldapclient.LDAPResultOperationsError)}
+	}
+
+	var out []*ber.Packet
+	for dn, e := range s.entries {
+		uids := e.attributes["uid"]
+		if len(uids) == 0 || filter != "(uid="+uids[0]+")" {
+			continue
+		}
+
+		entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldapclient.ApplicationSearchResultEntry, nil, "Search Result Entry")
+		entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, dn, "DN"))
+		attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+		for name, values := range e.attributes {
+			attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+			attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Name"))
+			set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+			for _, v := range values {
+				set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+			}
+			attr.AppendChild(set)
+			attrs.AppendChild(attr)
+		}
+		entry.AppendChild(attrs)
+		out = append(out, entry)
+	}
+
+	return append(out, ldapResult(ldapclient.ApplicationSearchResultDone, ldapclient.LDAPResultSuccess))
+}
+
+func ldapResult(tag ber.Tag, code uint8) *ber.Packet {
+	p := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	p.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return p
+}
+
+func TestLDAP(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	s, err := newTestServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.listener.Close()
+
+	Convey("Given a clean database, two organizations and LDAP configured", t, func() {
+		test.MustResetDB(db)
+
+		orgs := []storage.Organization{
+			{Name: "org-1"},
+			{Name: "org-2"},
+		}
+		for i := range orgs {
+			So(storage.CreateOrganization(db, &orgs[i]), ShouldBeNil)
+		}
+
+		s.setEntry("cn=lora,dc=example,dc=com", testEntry{password: "service"})
+		s.setEntry("uid=john.doe,ou=people,dc=example,dc=com", testEntry{
+			password: "secret",
+			attributes: map[string][]string{
+				"uid":  {"john.doe"},
+				"mail": {"john.doe@example.com"},
+				"memberOf": {
+					"cn=lora-admins,ou=groups,dc=example,dc=com",
+					"cn=org-1-admins,ou=groups,dc=example,dc=com",
+				},
+			},
+		})
+
+		ldapConf := &config.C.ApplicationServer.LDAP
+		ldapConf.Enabled = true
+		ldapConf.Server = s.listener.Addr().String()
+		ldapConf.BindDN = "cn=lora,dc=example,dc=com"
+		ldapConf.BindPassword = "service"
+		ldapConf.BaseDN = "dc=example,dc=com"
+		ldapConf.UserFilter = "(uid=%s)"
+		ldapConf.EmailAttribute = "mail"
+		ldapConf.GroupAttribute = "memberOf"
+		ldapConf.AdminGroup = "cn=lora-admins,ou=groups,dc=example,dc=com"
+		ldapConf.GroupMapping = []config.GroupMapping{
+			{Group: "cn=org-1-users,ou=groups,dc=example,dc=com", OrganizationID: orgs[0].ID},
+			{Group: "cn=org-1-admins,ou=groups,dc=example,dc=com", OrganizationID: orgs[0].ID, IsAdmin: true},
+			{Group: "cn=org-2-users,ou=groups,dc=example,dc=com", OrganizationID: orgs[1].ID},
+		}
+
+		Convey("Then authenticating with an invalid password fails", func() {
+			_, err := Authenticate(db, "john.doe", "invalid")
+			So(errors.Cause(err), ShouldEqual, storage.ErrInvalidUsernameOrPassword)
+		})
+
+		Convey("Then authenticating without password fails", func() {
+			_, err := Authenticate(db, "john.doe", "")
+			So(errors.Cause(err), ShouldEqual, storage.ErrInvalidUsernameOrPassword)
+		})
+
+		Convey("Then authenticating with an unknown username fails", func() {
+			_, err := Authenticate(db, "jane", "secret")
+			So(errors.Cause(err), ShouldEqual, storage.ErrInvalidUsernameOrPassword)
+		})
+
+		Convey("Then authenticating with an invalid bind dn password fails", func() {
+			ldapConf.BindPassword = "invalid"
+			_, err := Authenticate(db, "john.doe", "secret")
+			So(err, ShouldNotBeNil)
+			So(errors.Cause(err), ShouldNotEqual, storage.ErrInvalidUsernameOrPassword)
+		})
+
+		Convey("Then authenticating fails when ldap is disabled", func() {
+			ldapConf.Enabled = false
+			_, err := Authenticate(db, "john.doe", "secret")
+			So(errors.Cause(err), ShouldEqual, ErrDisabled)
+		})
+
+		Convey("When authenticating as a new user", func() {
+			user, err := Authenticate(db, "john.doe", "secret")
+			So(err, ShouldBeNil)
+
+			Convey("Then the user is returned", func() {
+				So(user.Username, ShouldEqual, "johndoe")
+			})
+
+			Convey("Then the user has been created", func() {
+				user, err := storage.GetUserByExternalID(db, "ldap#uid=john.doe,ou=people,dc=example,dc=com")
+				So(err, ShouldBeNil)
+				So(user.Username, ShouldEqual, "johndoe")
+				So(user.Email, ShouldEqual, "john.doe@example.com")
+				So(user.IsAdmin, ShouldBeTrue)
+
+				Convey("Then the user is admin of the mapped organization", func() {
+					ou, err := storage.GetOrganizationUser(db, orgs[0].ID, user.ID)
+					So(err, ShouldBeNil)
+					So(ou.IsAdmin, ShouldBeTrue)
+
+					_, err = storage.GetOrganizationUser(db, orgs[1].ID, user.ID)
+					So(errors.Cause(err), ShouldEqual, storage.ErrDoesNotExist)
+				})
+			})
+
+			Convey("When the groups of the user have changed and authenticating again", func() {
+				s.setEntry("uid=john.doe,ou=people,dc=example,dc=com", testEntry{
+					password: "secret",
+					attributes: map[string][]string{
+						"uid":      {"john.doe"},
+						"mail":     {"john.doe@example.com"},
+						"memberOf": {"cn=org-2-users,ou=groups,dc=example,dc=com"},
+					},
+				})
+
+				_, err := Authenticate(db, "john.doe", "secret")
+				So(err, ShouldBeNil)
+
+				Convey("Then the user and memberships have been updated", func() {
+					user, err := storage.GetUserByExternalID(db, "ldap#uid=john.doe,ou=people,dc=example,dc=com")
+					So(err, ShouldBeNil)
+					So(user.IsAdmin, ShouldBeFalse)
+
+					_, err = storage.GetOrganizationUser(db, orgs[0].ID, user.ID)
+					So(errors.Cause(err), ShouldEqual, storage.ErrDoesNotExist)
+
+					ou, err := storage.GetOrganizationUser(db, orgs[1].ID, user.ID)
+					So(err, ShouldBeNil)
+					So(ou.IsAdmin, ShouldBeFalse)
+				})
+			})
+		})
+
+		Convey("When a local user with the same username exists", func() {
+			_, err := storage.CreateUser(db, &storage.User{
+				Username: "johndoe",
+				Email:    "john@example.com",
+				IsActive: true,
+			}, "password123")
+			So(err, ShouldBeNil)
+
+			Convey("Then authenticating fails", func() {
+				_, err := Authenticate(db, "john.doe", "secret")
+				So(errors.Cause(err), ShouldEqual, storage.ErrUsernameConflict)
+			})
+		})
+	})
+}
@@ -0,0 +1,102 @@
+// Package mailer implements the sending of e-mails to users, e.g. for the
+// password reset, e-mail verification and organization invitations.
+//
+// The messages are rendered from text templates, containing the message
+// headers (e.g. Subject), followed by an empty line and the message body.
+package mailer
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrDisabled is returned when no mailer has been configured.
+var ErrDisabled = errors.New("mailer is not configured")
+
+// Mailer defines the interface for sending e-mails.
+type Mailer interface {
+	SendMail(to string, msg []byte) error // send the given message to the given address
+}
+
+// TemplateData defines the data available to the e-mail templates.
+type TemplateData struct {
+	Username         string
+	URL              string
+	ExpiresAt        time.Time
+	OrganizationName string // organization invitations only
+	InvitedBy        string // organization invitations only
+}
+
+// DefaultPasswordResetTemplate defines the default password reset e-mail.
+const DefaultPasswordResetTemplate = `Subject: Reset your password
+
+Hello {{ .Username }},
+
+A password reset has been requested for your account. Use the link below
+to set a new password:
+
+{{ .URL }}
+
+This link is valid until {{ .ExpiresAt.Format "2006-01-02 15:04 MST" }}. When you
+did not request a password reset, you can ignore this e-mail.
+`
+
+// DefaultEmailVerificationTemplate defines the default e-mail verification
+// e-mail.
+const DefaultEmailVerificationTemplate = `Subject: Verify your e-mail address
+
+Hello {{ .Username }},
+
+Please verify your e-mail address by opening the link below:
+
+{{ .URL }}
+
+This link is valid until {{ .ExpiresAt.Format "2006-01-02 15:04 MST" }}.
+`
+
+// DefaultOrganizationInvitationTemplate defines the default organization
+// invitation e-mail.
+const DefaultOrganizationInvitationTemplate = `Subject: You have been invited to join {{ .OrganizationName }}
+
+Hello,
+
+{{ .InvitedBy }} has invited you to join the organization {{ .OrganizationName }}.
+Use the link below to accept the invitation, either with your existing
+account or by creating a new account:
+
+{{ .URL }}
+
+This link is valid until {{ .ExpiresAt.Format "2006-01-02 15:04 MST" }}.
+`
+
+// Render renders the message using the template from the given file, or
+// the given default template when no file is given. Line endings are
+// converted to CRLF, as required by RFC 5322.
+func Render(templateFile, defaultTemplate string, data TemplateData) ([]byte, error) {
+	text := defaultTemplate
+	if templateFile != "" {
+		b, err := ioutil.ReadFile(templateFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "read template file error")
+		}
+		text = string(b)
+	}
+
+	tmpl, err := template.New("mail").Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse template error")
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Wrap(err, "execute template error")
+	}
+
+	msg := strings.Replace(buf.String(), "\r\n", "\n", -1)
+	return []byte(strings.Replace(msg, "\n", "\r\n", -1)), nil
+}
@@ -0,0 +1,147 @@
+package mailer
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// smtpSink implements a minimal SMTP server, recording the received
+// messages.
+type smtpSink struct {
+	ln       net.Listener
+	from     string
+	rcpt     []string
+	messages chan string
+}
+
+func newSMTPSink() (*smtpSink, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := smtpSink{
+		ln:       ln,
+		messages: make(chan string, 1),
+	}
+	go s.serve()
+	return &s, nil
+}
+
+func (s *smtpSink) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.handle(textproto.NewConn(conn))
+	}
+}
+
+func (s *smtpSink) handle(c *textproto.Conn) {
+	defer c.Close()
+	c.PrintfLine("220 localhost ESMTP test")
+
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch cmd {
+		case "EHLO", "HELO":
+			c.PrintfLine("250 localhost")
+		case "MAIL":
+			s.from = line
+			c.PrintfLine("250 OK")
+		case "RCPT":
+			s.rcpt = append(s.rcpt, line)
+			c.PrintfLine("250 OK")
+		case "DATA":
+			c.PrintfLine("354 send data")
+			b, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.messages <- string(b)
+			c.PrintfLine("250 OK")
+		case "QUIT":
+			c.PrintfLine("221 bye")
+			return
+		default:
+			c.PrintfLine("250 OK")
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	Convey("When rendering the default password reset template", t, func() {
+		msg, err := Render("", DefaultPasswordResetTemplate, TemplateData{
+			Username:  "john",
+			URL:       "http://localhost:8080/#/password-reset?token=abc",
+			ExpiresAt: time.Date(2018, 1, 2, 3, 4, 0, 0, time.UTC),
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Then the message contains the subject, username, url and expiration", func() {
+			s := string(msg)
+			So(s, ShouldStartWith, "Subject: Reset your password\r\n\r\n")
+			So(s, ShouldContainSubstring, "Hello john,")
+			So(s, ShouldContainSubstring, "http://localhost:8080/#/password-reset?token=abc")
+			So(s, ShouldContainSubstring, "2018-01-02 03:04 UTC")
+			So(strings.Count(s, "\n"), ShouldEqual, strings.Count(s, "\r\n"))
+		})
+	})
+
+	Convey("When rendering a template file which does not exist", t, func() {
+		_, err := Render("/does/not/exist", DefaultPasswordResetTemplate, TemplateData{})
+
+		Convey("Then an error is returned", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestSMTPMailer(t *testing.T) {
+	Convey("Given a local SMTP sink and mailer", t, func() {
+		sink, err := newSMTPSink()
+		So(err, ShouldBeNil)
+		defer sink.ln.Close()
+
+		m := NewSMTPMailer(sink.ln.Addr().String(), "", "", "LoRa App Server <noreply@example.com>")
+
+		Convey("When sending an e-mail", func() {
+			err := m.SendMail("john@example.com", []byte("Subject: test\r\n\r\nHello!\r\n"))
+			So(err, ShouldBeNil)
+
+			Convey("Then the e-mail has been received by the sink", func() {
+				var msg string
+				select {
+				case msg = <-sink.messages:
+				case <-time.After(time.Second):
+				}
+
+				So(sink.from, ShouldEqual, "MAIL FROM:<noreply@example.com>")
+				So(sink.rcpt, ShouldResemble, []string{"RCPT TO:<john@example.com>"})
+
+				tp := textproto.NewReader(bufio.NewReader(strings.NewReader(msg)))
+				header, err := tp.ReadMIMEHeader()
+				So(err, ShouldBeNil)
+				So(header.Get("From"), ShouldEqual, `"LoRa App Server" <noreply@example.com>`)
+				So(header.Get("To"), ShouldEqual, "<john@example.com>")
+				So(header.Get("Subject"), ShouldEqual, "test")
+
+				body, err := ioutil.ReadAll(tp.R)
+				So(err, ShouldBeNil)
+				So(string(body), ShouldEqual, "Hello!\n")
+			})
+		})
+	})
+}
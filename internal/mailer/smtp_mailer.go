@@ -0,0 +1,69 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SMTPMailer implements a Mailer which sends the e-mails using the
+// configured SMTP server. When the server supports STARTTLS, the connection
+// is encrypted.
+type SMTPMailer struct {
+	server   string
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPMailer creates a new SMTPMailer. The server must be given as
+// hostname:port. Authentication is disabled when no username is given.
+func NewSMTPMailer(server, username, password, from string) *SMTPMailer {
+	return &SMTPMailer{
+		server:   server,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+// SendMail sends the given message to the given address. The From, To,
+// Date and MIME headers are added to the message.
+func (m *SMTPMailer) SendMail(to string, msg []byte) error {
+	from, err := mail.ParseAddress(m.from)
+	if err != nil {
+		return errors.Wrap(err, "parse from address error")
+	}
+	rcpt, err := mail.ParseAddress(to)
+	if err != nil {
+		return errors.Wrap(err, "parse to address error")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", rcpt.String())
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.Write(msg)
+
+	var auth smtp.Auth
+	if m.username != "" {
+		host, _, err := net.SplitHostPort(m.server)
+		if err != nil {
+			return errors.Wrap(err, "split host port error")
+		}
+		auth = smtp.PlainAuth("", m.username, m.password, host)
+	}
+
+	if err := smtp.SendMail(m.server, auth, from.Address, []string{rcpt.Address}, buf.Bytes()); err != nil {
+		return errors.Wrap(err, "send mail error")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package multicast
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// httpClient is used for all requests to the multicast sender endpoint.
+var httpClient = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// HTTPSender implements a Sender which POSTs the multicast downlinks as
+// JSON to the configured endpoint.
+type HTTPSender struct {
+	url string
+}
+
+// NewHTTPSender creates a new HTTPSender.
+func NewHTTPSender(url string) *HTTPSender {
+	return &HTTPSender{
+		url: url,
+	}
+}
+
+// SendMulticast sends the given multicast downlink.
+func (s *HTTPSender) SendMulticast(item Item) error {
+	b, err := json.Marshal(item)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	resp, err := httpClient.Post(s.url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer resp.Body.Close()
+
+	// check that response is in 200 range
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("expected 2XX response, got: %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+// Package multicast implements the delivery of LoRaWAN multicast downlinks.
+//
+// The network-server API does not provide multicast support, therefore the
+// encrypted multicast frames are handed over to a Sender which takes care
+// of scheduling these frames on the gateways.
+package multicast
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/brocaar/lorawan"
+)
+
+// Item contains a multicast downlink ready for transmission.
+type Item struct {
+	MulticastGroupID int64           `json:"multicastGroupID"`
+	ApplicationID    int64           `json:"applicationID"`
+	McAddr           lorawan.DevAddr `json:"mcAddr"`
+	FCnt             uint32          `json:"fCnt"`
+	FPort            uint8           `json:"fPort"`
+	Frequency        int             `json:"frequency"`
+	DR               int             `json:"dr"`
+	PHYPayload       []byte          `json:"phyPayload"`
+}
+
+// Sender defines the interface for sending multicast downlinks.
+type Sender interface {
+	SendMulticast(item Item) error // send the given multicast downlink
+}
+
+// NewPHYPayload returns the (encrypted and signed) PHYPayload bytes for
+// the given multicast downlink.
+func NewPHYPayload(mcAddr lorawan.DevAddr, mcAppSKey, mcNwkSKey lorawan.AES128Key, fCnt uint32, fPort uint8, data []byte) ([]byte, error) {
+	phy := lorawan.PHYPayload{
+		MHDR: lorawan.MHDR{
+			MType: lorawan.UnconfirmedDataDown,
+			Major: lorawan.LoRaWANR1,
+		},
+		MACPayload: &lorawan.MACPayload{
+			FHDR: lorawan.FHDR{
+				DevAddr: mcAddr,
+				FCnt:    fCnt,
+			},
+			FPort: &fPort,
+			FRMPayload: []lorawan.Payload{
+				&lorawan.DataPayload{Bytes: data},
+			},
+		},
+	}
+
+	if err := phy.EncryptFRMPayload(mcAppSKey); err != nil {
+		return nil, errors.Wrap(err, "encrypt frmpayload error")
+	}
+
+	if err := phy.SetMIC(mcNwkSKey); err != nil {
+		return nil, errors.Wrap(err, "set mic error")
+	}
+
+	b, err := phy.MarshalBinary()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal binary error")
+	}
+	return b, nil
+}
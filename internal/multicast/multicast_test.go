@@ -0,0 +1,47 @@
+package multicast
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+)
+
+func TestNewPHYPayload(t *testing.T) {
+	Convey("Given a multicast address, session keys and payload", t, func() {
+		mcAddr := lorawan.DevAddr{1, 2, 3, 4}
+		mcAppSKey := lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8}
+		mcNwkSKey := lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("When calling NewPHYPayload", func() {
+			b, err := NewPHYPayload(mcAddr, mcAppSKey, mcNwkSKey, 12, 10, []byte{1, 2, 3, 4})
+			So(err, ShouldBeNil)
+
+			Convey("Then the PHYPayload is an unconfirmed data-down for the multicast address", func() {
+				var phy lorawan.PHYPayload
+				So(phy.UnmarshalBinary(b), ShouldBeNil)
+				So(phy.MHDR.MType, ShouldEqual, lorawan.UnconfirmedDataDown)
+
+				macPL, ok := phy.MACPayload.(*lorawan.MACPayload)
+				So(ok, ShouldBeTrue)
+				So(macPL.FHDR.DevAddr, ShouldEqual, mcAddr)
+				So(macPL.FHDR.FCnt, ShouldEqual, 12)
+				So(*macPL.FPort, ShouldEqual, 10)
+
+				Convey("Then the MIC is valid for the McNwkSKey", func() {
+					ok, err := phy.ValidateMIC(mcNwkSKey)
+					So(err, ShouldBeNil)
+					So(ok, ShouldBeTrue)
+				})
+
+				Convey("Then the payload can be decrypted with the McAppSKey", func() {
+					So(phy.DecryptFRMPayload(mcAppSKey), ShouldBeNil)
+					So(macPL.FRMPayload, ShouldResemble, []lorawan.Payload{
+						&lorawan.DataPayload{Bytes: []byte{1, 2, 3, 4}},
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,215 @@
+// Package oidc implements the OpenID Connect (authorization code flow)
+// login. Users are created on their first login and their global admin
+// flag and organization memberships are set from the groups claim.
+package oidc
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/common"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+const (
+	stateKeyTempl = "lora:as:oidc:state:%s"
+	stateTTL      = 10 * time.Minute
+)
+
+// OIDC errors.
+var (
+	ErrDisabled        = errors.New("openid connect login is disabled")
+	ErrInvalidState    = errors.New("invalid or expired state")
+	ErrInvalidUsername = errors.New("the username claim is missing or invalid")
+)
+
+var usernameSanitizer = regexp.MustCompile(`[^[:alnum:]]+`)
+
+// GetAuthorizationURL returns the url of the provider to which the user
+// must be redirected to login. The returned url contains a new state,
+// which is valid for a limited time.
+func GetAuthorizationURL() (string, error) {
+	if !config.C.ApplicationServer.OIDC.Enabled {
+		return "", ErrDisabled
+	}
+
+	pc, err := getProviderConfig()
+	if err != nil {
+		return "", err
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+	state := base64.RawURLEncoding.EncodeToString(b)
+
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	if _, err := c.Do("PSETEX", fmt.Sprintf(stateKeyTempl, state), int64(stateTTL/time.Millisecond), ""); err != nil {
+		return "", errors.Wrap(err, "set state error")
+	}
+
+	u, err := url.Parse(pc.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "parse authorization endpoint error")
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", config.C.ApplicationServer.OIDC.ClientID)
+	q.Set("redirect_uri", config.C.ApplicationServer.OIDC.RedirectURL)
+	q.Set("scope", "openid profile email")
+	q.Set("state", state)
+	q.Set("nonce", state)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// Authenticate completes the login using the authorization code and state
+// returned by the provider. It creates or updates the (local) user and
+// returns this user. Creating the session (and the second-factor check) is
+// left to the caller.
+func Authenticate(db *common.DBLogger, code, state string) (storage.User, error) {
+	if !config.C.ApplicationServer.OIDC.Enabled {
+		return storage.User{}, ErrDisabled
+	}
+
+	if err := consumeState(state); err != nil {
+		return storage.User{}, err
+	}
+
+	pc, err := getProviderConfig()
+	if err != nil {
+		return storage.User{}, err
+	}
+
+	idToken, err := exchangeCode(pc, code)
+	if err != nil {
+		return storage.User{}, errors.Wrap(err, "exchange code error")
+	}
+
+	claims, err := verifyIDToken(pc, idToken, state)
+	if err != nil {
+		return storage.User{}, errors.Wrap(err, "verify id token error")
+	}
+
+	u, err := getUserFromClaims(pc.Issuer, claims)
+	if err != nil {
+		return storage.User{}, err
+	}
+
+	var user storage.User
+	err = storage.Transaction(db, func(tx sqlx.Ext) error {
+		user, err = storage.SyncExternalUser(tx, u, config.C.ApplicationServer.OIDC.AdminGroup, config.C.ApplicationServer.OIDC.GroupMapping)
+		return err
+	})
+	if err != nil {
+		return storage.User{}, err
+	}
+
+	if !user.IsActive {
+		return storage.User{}, storage.ErrInvalidUsernameOrPassword
+	}
+
+	return user, nil
+}
+
+// LoginHandler redirects the user to the login page of the provider.
+func LoginHandler(w http.ResponseWriter, r *http.Request) {
+	u, err := GetAuthorizationURL()
+	if err != nil {
+		log.WithError(err).Error("get oidc authorization url error")
+		http.Error(w, "openid connect login error", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, u, http.StatusFound)
+}
+
+// CallbackHandler handles the redirect of the provider after login, by
+// passing the code and state to the web-interface (which completes the
+// login using the API).
+func CallbackHandler(w http.ResponseWriter, r *http.Request) {
+	q := url.Values{}
+	for _, k := range []string{"code", "state", "error", "error_description"} {
+		if v := r.URL.Query().Get(k); v != "" {
+			q.Set(k, v)
+		}
+	}
+
+	http.Redirect(w, r, "/#/login/oidc?"+q.Encode(), http.StatusFound)
+}
+
+// consumeState validates and removes the given state, so that it can only
+// be used once.
+func consumeState(state string) error {
+	if state == "" {
+		return ErrInvalidState
+	}
+
+	c := config.C.Redis.Pool.Get()
+	defer c.Close()
+
+	n, err := redis.Int(c.Do("DEL", fmt.Sprintf(stateKeyTempl, state)))
+	if err != nil {
+		return errors.Wrap(err, "delete state error")
+	}
+	if n == 0 {
+		return ErrInvalidState
+	}
+	return nil
+}
+
+func getUserFromClaims(issuer string, claims jwt.MapClaims) (storage.ExternalUser, error) {
+	conf := config.C.ApplicationServer.OIDC
+
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return storage.ExternalUser{}, errors.New("the sub claim is missing")
+	}
+
+	username, _ := claims[conf.UsernameClaim].(string)
+	// e-mail addresses are commonly used as username, only use the local part
+	if i := strings.Index(username, "@"); i != -1 {
+		username = username[:i]
+	}
+	username = usernameSanitizer.ReplaceAllString(username, "")
+	if username == "" {
+		return storage.ExternalUser{}, ErrInvalidUsername
+	}
+
+	u := storage.ExternalUser{
+		ExternalID: issuer + "#" + sub,
+		Username:   username,
+	}
+	u.Email, _ = claims[conf.EmailClaim].(string)
+
+	switch v := claims[conf.GroupsClaim].(type) {
+	case string:
+		u.Groups = []string{v}
+	case []interface{}:
+		for _, g := range v {
+			if s, ok := g.(string); ok {
+				u.Groups = append(u.Groups, s)
+			}
+		}
+	}
+
+	return u, nil
+}
@@ -0,0 +1,301 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+// testProvider implements a minimal OpenID Connect provider.
+type testProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	claims jwt.MapClaims
+}
+
+func newTestProvider() (*testProvider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	p := testProvider{
+		key: key,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(providerConfig{
+			Issuer:                p.server.URL,
+			AuthorizationEndpoint: p.server.URL + "/authorize",
+			TokenEndpoint:         p.server.URL + "/token",
+			JWKSURI:               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string][]jsonWebKey{
+			"keys": {
+				{
+					Kty: "RSA",
+					Kid: "test-key",
+					N:   base64.RawURLEncoding.EncodeToString(p.key.PublicKey.N.Bytes()),
+					E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.PublicKey.E)).Bytes()),
+				},
+			},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, _ := r.BasicAuth()
+		if clientID != "test-client" || clientSecret != "test-secret" || r.FormValue("code") != "test-code" || r.FormValue("redirect_uri") != config.C.ApplicationServer.OIDC.RedirectURL {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(tokenResponse{Error: "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, p.claims)
+		token.Header["kid"] = "test-key"
+		idToken, err := token.SignedString(p.key)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		json.NewEncoder(w).Encode(tokenResponse{IDToken: idToken})
+	})
+	p.server = httptest.NewServer(mux)
+
+	return &p, nil
+}
+
+func TestOIDC(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.Redis.Pool = storage.NewRedisPool(conf.RedisURL)
+	storage.SetUserSecret("verysecret")
+
+	p, err := newTestProvider()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.server.Close()
+
+	Convey("Given a clean database, two organizations and OpenID Connect configured", t, func() {
+		test.MustResetDB(db)
+		test.MustFlushRedis(config.C.Redis.Pool)
+
+		orgs := []storage.Organization{
+			{Name: "org-1"},
+			{Name: "org-2"},
+		}
+		for i := range orgs {
+			So(storage.CreateOrganization(db, &orgs[i]), ShouldBeNil)
+		}
+
+		oidcConf := &config.C.ApplicationServer.OIDC
+		oidcConf.Enabled = true
+		oidcConf.ProviderURL = p.server.URL
+		oidcConf.ClientID = "test-client"
+		oidcConf.ClientSecret = "test-secret"
+		oidcConf.RedirectURL = "https://lora.example.com/auth/oidc/callback"
+		oidcConf.UsernameClaim = "preferred_username"
+		oidcConf.EmailClaim = "email"
+		oidcConf.GroupsClaim = "groups"
+		oidcConf.AdminGroup = "lora-admins"
+		oidcConf.GroupMapping = nil
+		for _, m := range []struct {
+			group   string
+			orgID   int64
+			isAdmin bool
+		}{
+			{"org-1-users", orgs[0].ID, false},
+			{"org-1-admins", orgs[0].ID, true},
+			{"org-2-users", orgs[1].ID, false},
+		} {
+			oidcConf.GroupMapping = append(oidcConf.GroupMapping, config.GroupMapping{
+				Group:          m.group,
+				OrganizationID: m.orgID,
+				IsAdmin:        m.isAdmin,
+			})
+		}
+
+		Convey("When getting the authorization url", func() {
+			authURL, err := GetAuthorizationURL()
+			So(err, ShouldBeNil)
+
+			u, err := url.Parse(authURL)
+			So(err, ShouldBeNil)
+			q := u.Query()
+			state := q.Get("state")
+
+			Convey("Then the url points to the provider", func() {
+				So(u.Path, ShouldEqual, "/authorize")
+				So(q.Get("response_type"), ShouldEqual, "code")
+				So(q.Get("client_id"), ShouldEqual, "test-client")
+				So(q.Get("redirect_uri"), ShouldEqual, oidcConf.RedirectURL)
+				So(q.Get("scope"), ShouldEqual, "openid profile email")
+				So(state, ShouldNotEqual, "")
+				So(q.Get("nonce"), ShouldEqual, state)
+			})
+
+			Convey("Then login with an invalid state fails", func() {
+				_, err := Authenticate(db, "test-code", "invalid")
+				So(errors.Cause(err), ShouldEqual, ErrInvalidState)
+			})
+
+			Convey("Then login with an invalid code fails", func() {
+				_, err := Authenticate(db, "invalid", state)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then login with an id token for an other audience fails", func() {
+				p.claims = jwt.MapClaims{
+					"iss":                p.server.URL,
+					"aud":                "other-client",
+					"sub":                "user-1",
+					"exp":                time.Now().Add(time.Minute).Unix(),
+					"nonce":              state,
+					"preferred_username": "john.doe",
+				}
+				_, err := Authenticate(db, "test-code", state)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then login with an id token with an invalid nonce fails", func() {
+				p.claims = jwt.MapClaims{
+					"iss":                p.server.URL,
+					"aud":                "test-client",
+					"sub":                "user-1",
+					"exp":                time.Now().Add(time.Minute).Unix(),
+					"nonce":              "invalid",
+					"preferred_username": "john.doe",
+				}
+				_, err := Authenticate(db, "test-code", state)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("When logging in as a new user", func() {
+				p.claims = jwt.MapClaims{
+					"iss":                p.server.URL,
+					"aud":                []string{"test-client"},
+					"sub":                "user-1",
+					"exp":                time.Now().Add(time.Minute).Unix(),
+					"nonce":              state,
+					"preferred_username": "john.doe@example.com",
+					"email":              "john.doe@example.com",
+					"groups":             []string{"lora-admins", "org-1-users", "org-1-admins", "other"},
+				}
+				user, err := Authenticate(db, "test-code", state)
+				So(err, ShouldBeNil)
+
+				Convey("Then the user is returned", func() {
+					So(user.Username, ShouldEqual, "johndoe")
+				})
+
+				Convey("Then the user has been created", func() {
+					user, err := storage.GetUserByExternalID(db, p.server.URL+"#user-1")
+					So(err, ShouldBeNil)
+					So(user.Username, ShouldEqual, "johndoe")
+					So(user.Email, ShouldEqual, "john.doe@example.com")
+					So(user.IsActive, ShouldBeTrue)
+					So(user.IsAdmin, ShouldBeTrue)
+
+					Convey("Then the user can not login with a password", func() {
+						_, err := storage.LoginUser(db, "johndoe", "")
+						So(errors.Cause(err), ShouldEqual, storage.ErrInvalidUsernameOrPassword)
+					})
+
+					Convey("Then the user is admin of the mapped organization", func() {
+						ou, err := storage.GetOrganizationUser(db, orgs[0].ID, user.ID)
+						So(err, ShouldBeNil)
+						So(ou.IsAdmin, ShouldBeTrue)
+
+						_, err = storage.GetOrganizationUser(db, orgs[1].ID, user.ID)
+						So(errors.Cause(err), ShouldEqual, storage.ErrDoesNotExist)
+					})
+				})
+
+				Convey("Then the state can not be used again", func() {
+					_, err := Authenticate(db, "test-code", state)
+					So(errors.Cause(err), ShouldEqual, ErrInvalidState)
+				})
+
+				Convey("When logging in again with other groups", func() {
+					authURL, err := GetAuthorizationURL()
+					So(err, ShouldBeNil)
+					u, err := url.Parse(authURL)
+					So(err, ShouldBeNil)
+					state := u.Query().Get("state")
+
+					p.claims = jwt.MapClaims{
+						"iss":                p.server.URL,
+						"aud":                "test-client",
+						"sub":                "user-1",
+						"exp":                time.Now().Add(time.Minute).Unix(),
+						"nonce":              state,
+						"preferred_username": "john.doe@example.com",
+						"email":              "john@example.com",
+						"groups":             []string{"org-2-users"},
+					}
+					_, err = Authenticate(db, "test-code", state)
+					So(err, ShouldBeNil)
+
+					Convey("Then the user and memberships have been updated", func() {
+						user, err := storage.GetUserByExternalID(db, p.server.URL+"#user-1")
+						So(err, ShouldBeNil)
+						So(user.Email, ShouldEqual, "john@example.com")
+						So(user.IsAdmin, ShouldBeFalse)
+
+						_, err = storage.GetOrganizationUser(db, orgs[0].ID, user.ID)
+						So(errors.Cause(err), ShouldEqual, storage.ErrDoesNotExist)
+
+						ou, err := storage.GetOrganizationUser(db, orgs[1].ID, user.ID)
+						So(err, ShouldBeNil)
+						So(ou.IsAdmin, ShouldBeFalse)
+					})
+				})
+			})
+
+			Convey("When a local user with the same username exists", func() {
+				_, err := storage.CreateUser(db, &storage.User{
+					Username: "johndoe",
+					Email:    "john@example.com",
+					IsActive: true,
+				}, "password123")
+				So(err, ShouldBeNil)
+
+				Convey("Then the login fails", func() {
+					p.claims = jwt.MapClaims{
+						"iss":                p.server.URL,
+						"aud":                "test-client",
+						"sub":                "user-1",
+						"exp":                time.Now().Add(time.Minute).Unix(),
+						"nonce":              state,
+						"preferred_username": "johndoe",
+					}
+					_, err := Authenticate(db, "test-code", state)
+					So(errors.Cause(err), ShouldEqual, storage.ErrUsernameConflict)
+				})
+			})
+		})
+	})
+}
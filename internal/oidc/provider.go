@@ -0,0 +1,234 @@
+package oidc
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/pkg/errors"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+)
+
+// httpClient is used for all requests to the provider.
+var httpClient = &http.Client{
+	Timeout: 10 * time.Second,
+}
+
+// providerConfig contains the (discovered) provider configuration.
+type providerConfig struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// provider caches the provider configuration and keys. The cache is reset
+// when the provider url changes.
+var provider struct {
+	sync.Mutex
+	url    string
+	config *providerConfig
+	keys   map[string]*rsa.PublicKey
+}
+
+func getProviderConfig() (providerConfig, error) {
+	provider.Lock()
+	defer provider.Unlock()
+
+	providerURL := strings.TrimRight(config.C.ApplicationServer.OIDC.ProviderURL, "/")
+	if provider.config != nil && provider.url == providerURL {
+		return *provider.config, nil
+	}
+
+	var pc providerConfig
+	if err := getJSON(providerURL+"/.well-known/openid-configuration", &pc); err != nil {
+		return pc, errors.Wrap(err, "get provider configuration error")
+	}
+
+	// the issuer must match the url used for the discovery
+	if strings.TrimRight(pc.Issuer, "/") != providerURL {
+		return pc, fmt.Errorf("issuer %s does not match provider url %s", pc.Issuer, providerURL)
+	}
+
+	provider.url = providerURL
+	provider.config = &pc
+	provider.keys = nil
+
+	return pc, nil
+}
+
+// getKey returns the public key for the given key id. The keys are
+// fetched again when the key id is unknown, as the provider might have
+// rotated its keys.
+func getKey(pc providerConfig, kid string) (*rsa.PublicKey, error) {
+	provider.Lock()
+	defer provider.Unlock()
+
+	if key, ok := provider.keys[kid]; ok {
+		return key, nil
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := getJSON(pc.JWKSURI, &jwks); err != nil {
+		return nil, errors.Wrap(err, "get keys error")
+	}
+
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range jwks.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, errors.Wrap(err, "decode modulus error")
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, errors.Wrap(err, "decode exponent error")
+		}
+
+		keys[k.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	provider.keys = keys
+
+	key, ok := keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+	return key, nil
+}
+
+// exchangeCode exchanges the given authorization code for the ID token.
+func exchangeCode(pc providerConfig, code string) (string, error) {
+	form := url.Values{
+		"grant_type":   []string{"authorization_code"},
+		"code":         []string{code},
+		"redirect_uri": []string{config.C.ApplicationServer.OIDC.RedirectURL},
+	}
+
+	req, err := http.NewRequest("POST", pc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errors.Wrap(err, "new request error")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(config.C.ApplicationServer.OIDC.ClientID), url.QueryEscape(config.C.ApplicationServer.OIDC.ClientSecret))
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "token request error")
+	}
+	defer resp.Body.Close()
+
+	var tr tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return "", errors.Wrap(err, "decode token response error")
+	}
+
+	if resp.StatusCode != http.StatusOK || tr.Error != "" {
+		return "", fmt.Errorf("token request failed (%d): %s %s", resp.StatusCode, tr.Error, tr.ErrorDescription)
+	}
+
+	if tr.IDToken == "" {
+		return "", errors.New("token response does not contain an id_token")
+	}
+
+	return tr.IDToken, nil
+}
+
+// verifyIDToken verifies the signature and claims of the given ID token
+// and returns its claims.
+func verifyIDToken(pc providerConfig, idToken, nonce string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return getKey(pc, kid)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "parse id token error")
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("id token is expired")
+	}
+
+	if !claims.VerifyIssuer(pc.Issuer, true) {
+		return nil, errors.New("invalid issuer")
+	}
+
+	if !hasAudience(claims, config.C.ApplicationServer.OIDC.ClientID) {
+		return nil, errors.New("invalid audience")
+	}
+
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, errors.New("invalid nonce")
+	}
+
+	return claims, nil
+}
+
+// hasAudience returns true when the aud claim (a string or an array of
+// strings) contains the given audience.
+func hasAudience(claims jwt.MapClaims, aud string) bool {
+	switch v := claims["aud"].(type) {
+	case string:
+		return v == aud
+	case []interface{}:
+		for _, a := range v {
+			if s, ok := a.(string); ok && s == aud {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getJSON(url string, v interface{}) error {
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return errors.Wrap(err, "http get error")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("expected 200, got %d (%s)", resp.StatusCode, string(b))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return errors.Wrap(err, "decode json error")
+	}
+	return nil
+}
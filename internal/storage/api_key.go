@@ -0,0 +1,186 @@
+package storage
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// APIKeyPrefix defines the prefix of the API keys, used to distinguish
+// them from the JWT tokens.
+const APIKeyPrefix = "ak_"
+
+// apiKeySize defines the number of random bytes of an API key.
+const apiKeySize = 32
+
+// APIKey defines an API key, scoped to either an organization or an
+// application. Only the hash of the key itself is stored.
+type APIKey struct {
+	ID             int64      `db:"id"`
+	CreatedAt      time.Time  `db:"created_at"`
+	Name           string     `db:"name"`
+	OrganizationID *int64     `db:"organization_id"`
+	ApplicationID  *int64     `db:"application_id"`
+	KeyHash        []byte     `db:"key_hash"`
+	ExpiresAt      *time.Time `db:"expires_at"`
+}
+
+// Validate validates the API key data.
+func (k APIKey) Validate() error {
+	if strings.TrimSpace(k.Name) == "" {
+		return ErrAPIKeyInvalidName
+	}
+	if (k.OrganizationID == nil) == (k.ApplicationID == nil) {
+		return ErrAPIKeyInvalidScope
+	}
+	if k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now()) {
+		return ErrAPIKeyInvalidExpiresAt
+	}
+	return nil
+}
+
+// IsExpired returns true when the API key has expired.
+func (k APIKey) IsExpired() bool {
+	return k.ExpiresAt != nil && !k.ExpiresAt.After(time.Now())
+}
+
+// CreateAPIKey creates the given API key and returns the key. This is the
+// only time the key is available, as only its hash is stored.
+func CreateAPIKey(db sqlx.Queryer, k *APIKey) (string, error) {
+	if err := k.Validate(); err != nil {
+		return "", errors.Wrap(err, "validate error")
+	}
+
+	b := make([]byte, apiKeySize)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+	key := APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	k.CreatedAt = time.Now()
+	k.KeyHash = hashAPIKey(key)
+
+	err := sqlx.Get(db, &k.ID, `
+		insert into api_key (
+			created_at,
+			name,
+			organization_id,
+			application_id,
+			key_hash,
+			expires_at
+		) values ($1, $2, $3, $4, $5, $6)
+		returning id`,
+		k.CreatedAt,
+		k.Name,
+		k.OrganizationID,
+		k.ApplicationID,
+		k.KeyHash,
+		k.ExpiresAt,
+	)
+	if err != nil {
+		return "", handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":              k.ID,
+		"organization_id": k.OrganizationID,
+		"application_id":  k.ApplicationID,
+	}).Info("api key created")
+
+	return key, nil
+}
+
+// GetAPIKey returns the API key for the given id.
+func GetAPIKey(db sqlx.Queryer, id int64) (APIKey, error) {
+	var k APIKey
+	err := sqlx.Get(db, &k, "select * from api_key where id = $1", id)
+	if err != nil {
+		return k, handlePSQLError(Select, err, "select error")
+	}
+	return k, nil
+}
+
+// GetAPIKeyByKey returns the API key matching the given key.
+func GetAPIKeyByKey(db sqlx.Queryer, key string) (APIKey, error) {
+	var k APIKey
+	err := sqlx.Get(db, &k, "select * from api_key where key_hash = $1", hashAPIKey(key))
+	if err != nil {
+		return k, handlePSQLError(Select, err, "select error")
+	}
+	return k, nil
+}
+
+// GetAPIKeys returns the API keys, optionally filtered on organization
+// and / or application id (use 0 to disable the filter).
+func GetAPIKeys(db sqlx.Queryer, organizationID, applicationID int64, limit, offset int) ([]APIKey, error) {
+	var keys []APIKey
+	err := sqlx.Select(db, &keys, `
+		select *
+		from api_key
+		where
+			($1 = 0 or organization_id = $1)
+			and ($2 = 0 or application_id = $2)
+		order by name
+		limit $3
+		offset $4`,
+		organizationID,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return keys, nil
+}
+
+// GetAPIKeyCount returns the total number of API keys, optionally filtered
+// on organization and / or application id (use 0 to disable the filter).
+func GetAPIKeyCount(db sqlx.Queryer, organizationID, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from api_key
+		where
+			($1 = 0 or organization_id = $1)
+			and ($2 = 0 or application_id = $2)`,
+		organizationID,
+		applicationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// DeleteAPIKey deletes (revokes) the API key for the given id.
+func DeleteAPIKey(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from api_key where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("api key deleted")
+	return nil
+}
+
+// hashAPIKey returns the SHA-256 hash of the given key. As the keys are
+// random, a salt and key-stretching (as used for passwords) is not needed
+// and the hash can be used to lookup the key.
+func hashAPIKey(key string) []byte {
+	h := sha256.Sum256([]byte(key))
+	return h[:]
+}
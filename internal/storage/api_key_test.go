@@ -0,0 +1,173 @@
+package storage
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestAPIKey(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database with an organization and application", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		Convey("Then creating an API key without scope fails", func() {
+			k := APIKey{
+				Name: "test-key",
+			}
+			_, err := CreateAPIKey(config.C.PostgreSQL.DB, &k)
+			So(errors.Cause(err), ShouldEqual, ErrAPIKeyInvalidScope)
+		})
+
+		Convey("Then creating an API key for both an organization and application fails", func() {
+			k := APIKey{
+				Name:           "test-key",
+				OrganizationID: &org.ID,
+				ApplicationID:  &app.ID,
+			}
+			_, err := CreateAPIKey(config.C.PostgreSQL.DB, &k)
+			So(errors.Cause(err), ShouldEqual, ErrAPIKeyInvalidScope)
+		})
+
+		Convey("Then creating an API key which is already expired fails", func() {
+			expiresAt := time.Now().Add(-time.Minute)
+			k := APIKey{
+				Name:           "test-key",
+				OrganizationID: &org.ID,
+				ExpiresAt:      &expiresAt,
+			}
+			_, err := CreateAPIKey(config.C.PostgreSQL.DB, &k)
+			So(errors.Cause(err), ShouldEqual, ErrAPIKeyInvalidExpiresAt)
+		})
+
+		Convey("When creating an organization and application API key", func() {
+			orgKey := APIKey{
+				Name:           "org-key",
+				OrganizationID: &org.ID,
+			}
+			orgKeyStr, err := CreateAPIKey(config.C.PostgreSQL.DB, &orgKey)
+			So(err, ShouldBeNil)
+
+			expiresAt := time.Now().Add(time.Hour)
+			appKey := APIKey{
+				Name:          "app-key",
+				ApplicationID: &app.ID,
+				ExpiresAt:     &expiresAt,
+			}
+			appKeyStr, err := CreateAPIKey(config.C.PostgreSQL.DB, &appKey)
+			So(err, ShouldBeNil)
+
+			Convey("Then the keys are prefixed and unique", func() {
+				So(strings.HasPrefix(orgKeyStr, APIKeyPrefix), ShouldBeTrue)
+				So(strings.HasPrefix(appKeyStr, APIKeyPrefix), ShouldBeTrue)
+				So(orgKeyStr, ShouldNotEqual, appKeyStr)
+			})
+
+			Convey("Then the key itself is not stored", func() {
+				k, err := GetAPIKey(config.C.PostgreSQL.DB, orgKey.ID)
+				So(err, ShouldBeNil)
+				So(string(k.KeyHash), ShouldNotContainSubstring, orgKeyStr)
+				So(k.KeyHash, ShouldHaveLength, 32)
+			})
+
+			Convey("Then GetAPIKeyByKey returns the key", func() {
+				k, err := GetAPIKeyByKey(config.C.PostgreSQL.DB, appKeyStr)
+				So(err, ShouldBeNil)
+				So(k.ID, ShouldEqual, appKey.ID)
+				So(k.Name, ShouldEqual, "app-key")
+				So(k.OrganizationID, ShouldBeNil)
+				So(*k.ApplicationID, ShouldEqual, app.ID)
+				So(k.ExpiresAt.Equal(expiresAt.Truncate(time.Microsecond)), ShouldBeTrue)
+				So(k.IsExpired(), ShouldBeFalse)
+			})
+
+			Convey("Then GetAPIKeyByKey returns an error for an unknown key", func() {
+				_, err := GetAPIKeyByKey(config.C.PostgreSQL.DB, APIKeyPrefix+"foo")
+				So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+			})
+
+			Convey("Then the keys can be listed and filtered", func() {
+				count, err := GetAPIKeyCount(config.C.PostgreSQL.DB, 0, 0)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				keys, err := GetAPIKeys(config.C.PostgreSQL.DB, 0, 0, 10, 0)
+				So(err, ShouldBeNil)
+				So(keys, ShouldHaveLength, 2)
+				So(keys[0].Name, ShouldEqual, "app-key")
+				So(keys[1].Name, ShouldEqual, "org-key")
+
+				count, err = GetAPIKeyCount(config.C.PostgreSQL.DB, org.ID, 0)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				keys, err = GetAPIKeys(config.C.PostgreSQL.DB, 0, app.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(keys, ShouldHaveLength, 1)
+				So(keys[0].ID, ShouldEqual, appKey.ID)
+			})
+
+			Convey("When deleting the application", func() {
+				So(DeleteApplication(config.C.PostgreSQL.DB, app.ID), ShouldBeNil)
+
+				Convey("Then the application API key has been deleted", func() {
+					_, err := GetAPIKey(config.C.PostgreSQL.DB, appKey.ID)
+					So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+				})
+			})
+
+			Convey("When deleting the organization API key", func() {
+				So(DeleteAPIKey(config.C.PostgreSQL.DB, orgKey.ID), ShouldBeNil)
+
+				Convey("Then the key can not be used anymore", func() {
+					_, err := GetAPIKeyByKey(config.C.PostgreSQL.DB, orgKeyStr)
+					So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+				})
+
+				Convey("Then deleting it again returns an error", func() {
+					So(errors.Cause(DeleteAPIKey(config.C.PostgreSQL.DB, orgKey.ID)), ShouldEqual, ErrDoesNotExist)
+				})
+			})
+		})
+	})
+}
@@ -22,6 +22,10 @@ type Application struct {
 	PayloadCodec         codec.Type `db:"payload_codec"`
 	PayloadEncoderScript string     `db:"payload_encoder_script"`
 	PayloadDecoderScript string     `db:"payload_decoder_script"`
+
+	// DisableOrganizationIntegrations disables the integrations of the
+	// organization for this application.
+	DisableOrganizationIntegrations bool `db:"disable_organization_integrations"`
 }
 
 // ApplicationListItem devices the application as a list item.
@@ -53,8 +57,9 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 			service_profile_id,
 			payload_codec,
 			payload_encoder_script,
-			payload_decoder_script
-		) values ($1, $2, $3, $4, $5, $6, $7) returning id`,
+			payload_decoder_script,
+			disable_organization_integrations
+		) values ($1, $2, $3, $4, $5, $6, $7, $8) returning id`,
 		item.Name,
 		item.Description,
 		item.OrganizationID,
@@ -62,6 +67,7 @@ func CreateApplication(db sqlx.Queryer, item *Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.DisableOrganizationIntegrations,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -106,13 +112,14 @@ func GetApplicationCountForUser(db sqlx.Queryer, username string, organizationID
 		select
 			count(a.*)
 		from application a
-		inner join organization_user ou
-			on a.organization_id = ou.organization_id
 		inner join "user" u
-			on u.id = ou.user_id
+			on u.username = $1
 		where
-			u.username = $1
-			and u.is_active = true
+			u.is_active = true
+			and (
+				a.organization_id in (select organization_id from organization_user where user_id = u.id)
+				or a.id in (select application_id from application_user where user_id = u.id)
+			)
 			and (
 				$2 = 0
 				or a.organization_id = $2
@@ -166,7 +173,7 @@ func GetApplications(db sqlx.Queryer, limit, offset int) ([]ApplicationListItem,
 }
 
 // GetApplicationsForUser returns a slice of application of which the given
-// user is a member of.
+// user is a member of, through the organization or the application itself.
 func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int64, limit, offset int) ([]ApplicationListItem, error) {
 	var apps []ApplicationListItem
 	err := sqlx.Select(db, &apps, `
@@ -176,13 +183,14 @@ func GetApplicationsForUser(db sqlx.Queryer, username string, organizationID int
 		from application a
 		inner join service_profile sp
 			on sp.service_profile_id = a.service_profile_id
-		inner join organization_user ou
-			on a.organization_id = ou.organization_id
 		inner join "user" u
-			on u.id = ou.user_id
+			on u.username = $1
 		where
-			u.username = $1
-			and u.is_active = true
+			u.is_active = true
+			and (
+				a.organization_id in (select organization_id from organization_user where user_id = u.id)
+				or a.id in (select application_id from application_user where user_id = u.id)
+			)
 			and (
 				$2 = 0
 				or a.organization_id = $2
@@ -238,7 +246,8 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 			service_profile_id = $5,
 			payload_codec = $6,
 			payload_encoder_script = $7,
-			payload_decoder_script = $8
+			payload_decoder_script = $8,
+			disable_organization_integrations = $9
 		where id = $1`,
 		item.ID,
 		item.Name,
@@ -248,6 +257,7 @@ func UpdateApplication(db sqlx.Execer, item Application) error {
 		item.PayloadCodec,
 		item.PayloadEncoderScript,
 		item.PayloadDecoderScript,
+		item.DisableOrganizationIntegrations,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -0,0 +1,220 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// ApplicationUser represents an application user. Application users have
+// access to a single application, without being a member of the
+// organization.
+type ApplicationUser struct {
+	Roles
+	UserID    int64     `db:"user_id"`
+	Username  string    `db:"username"`
+	IsAdmin   bool      `db:"is_admin"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// CreateApplicationUser adds the given user to the application.
+func CreateApplicationUser(db sqlx.Execer, applicationID, userID int64, isAdmin bool) error {
+	_, err := db.Exec(`
+		insert into application_user (
+			application_id,
+			user_id,
+			is_admin,
+			created_at,
+			updated_at
+		) values ($1, $2, $3, now(), now())`,
+		applicationID,
+		userID,
+		isAdmin,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"user_id":        userID,
+		"application_id": applicationID,
+		"is_admin":       isAdmin,
+	}).Info("user added to application")
+	return nil
+}
+
+// UpdateApplicationUser updates the given user of the application.
+func UpdateApplicationUser(db sqlx.Execer, applicationID, userID int64, isAdmin bool) error {
+	res, err := db.Exec(`
+		update application_user
+		set
+			is_admin = $3,
+			updated_at = now()
+		where
+			application_id = $1
+			and user_id = $2`,
+		applicationID,
+		userID,
+		isAdmin,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"user_id":        userID,
+		"application_id": applicationID,
+		"is_admin":       isAdmin,
+	}).Info("application user updated")
+	return nil
+}
+
+// UpdateApplicationUserRoles updates the roles of the given user of the
+// application.
+func UpdateApplicationUserRoles(db sqlx.Execer, applicationID, userID int64, roles Roles) error {
+	if roles.IsGatewayAdmin {
+		return ErrApplicationUserGatewayAdmin
+	}
+
+	res, err := db.Exec(`
+		update application_user
+		set
+			is_device_admin = $3,
+			is_integration_admin = $4,
+			is_viewer = $5,
+			updated_at = now()
+		where
+			application_id = $1
+			and user_id = $2`,
+		applicationID,
+		userID,
+		roles.IsDeviceAdmin,
+		roles.IsIntegrationAdmin,
+		roles.IsViewer,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"user_id":              userID,
+		"application_id":       applicationID,
+		"is_device_admin":      roles.IsDeviceAdmin,
+		"is_integration_admin": roles.IsIntegrationAdmin,
+		"is_viewer":            roles.IsViewer,
+	}).Info("application user roles updated")
+	return nil
+}
+
+// DeleteApplicationUser deletes the given application user.
+func DeleteApplicationUser(db sqlx.Execer, applicationID, userID int64) error {
+	res, err := db.Exec(`delete from application_user where application_id = $1 and user_id = $2`, applicationID, userID)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"user_id":        userID,
+		"application_id": applicationID,
+	}).Info("application user deleted")
+	return nil
+}
+
+// GetApplicationUser gets the information of the given application user.
+func GetApplicationUser(db sqlx.Queryer, applicationID, userID int64) (ApplicationUser, error) {
+	var u ApplicationUser
+	err := sqlx.Get(db, &u, `
+		select
+			u.id as user_id,
+			u.username as username,
+			au.created_at as created_at,
+			au.updated_at as updated_at,
+			au.is_admin as is_admin,
+			au.is_device_admin as is_device_admin,
+			au.is_integration_admin as is_integration_admin,
+			au.is_viewer as is_viewer
+		from application_user au
+		inner join "user" u
+			on u.id = au.user_id
+		where
+			au.application_id = $1
+			and au.user_id = $2`,
+		applicationID,
+		userID,
+	)
+	if err != nil {
+		return u, handlePSQLError(Select, err, "select error")
+	}
+	return u, nil
+}
+
+// GetApplicationUserCount returns the number of users for the given
+// application.
+func GetApplicationUserCount(db sqlx.Queryer, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from application_user
+		where
+			application_id = $1`,
+		applicationID,
+	)
+	if err != nil {
+		return count, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetApplicationUsers returns the users for the given application.
+func GetApplicationUsers(db sqlx.Queryer, applicationID int64, limit, offset int) ([]ApplicationUser, error) {
+	var users []ApplicationUser
+	err := sqlx.Select(db, &users, `
+		select
+			u.id as user_id,
+			u.username as username,
+			au.created_at as created_at,
+			au.updated_at as updated_at,
+			au.is_admin as is_admin,
+			au.is_device_admin as is_device_admin,
+			au.is_integration_admin as is_integration_admin,
+			au.is_viewer as is_viewer
+		from application_user au
+		inner join "user" u
+			on u.id = au.user_id
+		where
+			au.application_id = $1
+		order by u.username
+		limit $2 offset $3`,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return users, nil
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestApplicationUser(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database with an application and user", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(db, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			Name:            "test-service-profile",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(CreateServiceProfile(db, &sp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-application",
+		}
+		So(CreateApplication(db, &app), ShouldBeNil)
+
+		user := User{
+			Username: "testuser",
+			IsActive: true,
+			Email:    "foo@bar.com",
+		}
+		_, err := CreateUser(db, &user, "password123")
+		So(err, ShouldBeNil)
+
+		Convey("When adding the user to the application", func() {
+			So(CreateApplicationUser(db, app.ID, user.ID, false), ShouldBeNil)
+
+			Convey("Then it can be retrieved", func() {
+				u, err := GetApplicationUser(db, app.ID, user.ID)
+				So(err, ShouldBeNil)
+				So(u.UserID, ShouldEqual, user.ID)
+				So(u.Username, ShouldEqual, "testuser")
+				So(u.IsAdmin, ShouldBeFalse)
+				So(u.Roles, ShouldResemble, Roles{})
+
+				count, err := GetApplicationUserCount(db, app.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				users, err := GetApplicationUsers(db, app.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(users, ShouldHaveLength, 1)
+				So(users[0].UserID, ShouldEqual, user.ID)
+			})
+
+			Convey("Then the application and its organization are returned for the user", func() {
+				count, err := GetApplicationCountForUser(db, user.Username, org.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				apps, err := GetApplicationsForUser(db, user.Username, org.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(apps, ShouldHaveLength, 1)
+				So(apps[0].ID, ShouldEqual, app.ID)
+
+				orgs, err := GetOrganizationsForUser(db, user.Username, 10, 0, "")
+				So(err, ShouldBeNil)
+				So(orgs, ShouldHaveLength, 1)
+				So(orgs[0].ID, ShouldEqual, org.ID)
+			})
+
+			Convey("Then the user and roles can be updated", func() {
+				roles := Roles{
+					IsDeviceAdmin:      true,
+					IsIntegrationAdmin: true,
+				}
+				So(UpdateApplicationUser(db, app.ID, user.ID, true), ShouldBeNil)
+				So(UpdateApplicationUserRoles(db, app.ID, user.ID, roles), ShouldBeNil)
+
+				u, err := GetApplicationUser(db, app.ID, user.ID)
+				So(err, ShouldBeNil)
+				So(u.IsAdmin, ShouldBeTrue)
+				So(u.Roles, ShouldResemble, roles)
+			})
+
+			Convey("Then the gateway admin role can not be assigned", func() {
+				err := UpdateApplicationUserRoles(db, app.ID, user.ID, Roles{IsGatewayAdmin: true})
+				So(err, ShouldEqual, ErrApplicationUserGatewayAdmin)
+			})
+
+			Convey("Then it can be deleted", func() {
+				So(DeleteApplicationUser(db, app.ID, user.ID), ShouldBeNil)
+				So(DeleteApplicationUser(db, app.ID, user.ID), ShouldEqual, ErrDoesNotExist)
+
+				count, err := GetApplicationUserCount(db, app.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+	})
+}
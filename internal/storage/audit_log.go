@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// AuditLog defines an audit log entry, recording a mutating API call.
+// The entries do not reference the user, API key or organization tables
+// so that they are kept after these have been deleted.
+type AuditLog struct {
+	ID             int64           `db:"id"`
+	CreatedAt      time.Time       `db:"created_at"`
+	Username       string          `db:"username"`
+	APIKeyID       *int64          `db:"api_key_id"`
+	OrganizationID *int64          `db:"organization_id"`
+	Method         string          `db:"method"`
+	Target         AuditLogTarget  `db:"target"`
+	RemoteAddr     string          `db:"remote_addr"`
+	Request        json.RawMessage `db:"request"`
+	Changes        json.RawMessage `db:"changes"`
+}
+
+// AuditLogTarget contains the IDs of the objects targeted by an API call,
+// e.g. applicationID => 1, devEUI => 0102030405060708.
+type AuditLogTarget map[string]string
+
+// Value implements the driver.Valuer interface.
+func (t AuditLogTarget) Value() (driver.Value, error) {
+	if t == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(t)
+}
+
+// Scan implements the sql.Scanner interface.
+func (t *AuditLogTarget) Scan(src interface{}) error {
+	b, ok := src.([]byte)
+	if !ok {
+		return fmt.Errorf("expected []byte, got %T", src)
+	}
+	return json.Unmarshal(b, t)
+}
+
+// auditLogOrganizationQueries contains the queries to resolve the
+// organization ID from the target of an API call, in order of precedence.
+// When service is set, the query is only used for calls to that service
+// (e.g. the meaning of id depends on the service).
+var auditLogOrganizationQueries = []struct {
+	field   string
+	service string
+	query   string
+}{
+	{"organizationID", "", `select id from organization where id = $1`},
+	{"applicationID", "", `select organization_id from application where id = $1`},
+	{"devEUI", "", `select a.organization_id from device d inner join application a on a.id = d.application_id where d.dev_eui = decode($1, 'hex')`},
+	{"mac", "", `select organization_id from gateway where mac = decode($1, 'hex')`},
+	{"serviceProfileID", "", `select organization_id from service_profile where service_profile_id = $1`},
+	{"deviceProfileID", "", `select organization_id from device_profile where device_profile_id = $1`},
+	{"id", "api.Organization", `select id from organization where id = $1`},
+	{"id", "api.Application", `select organization_id from application where id = $1`},
+	{"id", "api.MulticastGroupService", `select a.organization_id from multicast_group mg inner join application a on a.id = mg.application_id where mg.id = $1`},
+	{"id", "api.FUOTADeploymentService", `select a.organization_id from fuota_deployment fd inner join application a on a.id = fd.application_id where fd.id = $1`},
+	{"id", "api.DownlinkSchedule", `select a.organization_id from downlink_schedule ds inner join application a on a.id = ds.application_id where ds.id = $1`},
+	{"id", "api.APIKeyService", `select coalesce(k.organization_id, a.organization_id) from api_key k left join application a on a.id = k.application_id where k.id = $1`},
+	{"id", "api.WebhookService", `select organization_id from webhook where id = $1`},
+}
+
+// GetAuditLogOrganizationID returns the ID of the organization the given
+// target (of a call to the given service) belongs to. It returns nil when
+// the target does not belong to an organization.
+func GetAuditLogOrganizationID(db sqlx.Queryer, service string, target AuditLogTarget) (*int64, error) {
+	for _, q := range auditLogOrganizationQueries {
+		if q.service != "" && q.service != service {
+			continue
+		}
+		v, ok := target[q.field]
+		if !ok {
+			continue
+		}
+
+		var id int64
+		err := sqlx.Get(db, &id, q.query, v)
+		if err != nil {
+			if errors.Cause(err) == sql.ErrNoRows {
+				continue
+			}
+			return nil, errors.Wrap(err, "select error")
+		}
+		return &id, nil
+	}
+
+	return nil, nil
+}
+
+// CreateAuditLog creates the given audit log entry.
+func CreateAuditLog(db sqlx.Queryer, l *AuditLog) error {
+	l.CreatedAt = time.Now()
+	if l.Request == nil {
+		l.Request = json.RawMessage("{}")
+	}
+	if l.Changes == nil {
+		l.Changes = json.RawMessage("{}")
+	}
+
+	err := sqlx.Get(db, &l.ID, `
+		insert into audit_log (
+			created_at,
+			username,
+			api_key_id,
+			organization_id,
+			method,
+			target,
+			remote_addr,
+			request,
+			changes
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		returning id`,
+		l.CreatedAt,
+		l.Username,
+		l.APIKeyID,
+		l.OrganizationID,
+		l.Method,
+		l.Target,
+		l.RemoteAddr,
+		l.Request,
+		l.Changes,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":              l.ID,
+		"method":          l.Method,
+		"username":        l.Username,
+		"api_key_id":      l.APIKeyID,
+		"organization_id": l.OrganizationID,
+	}).Info("audit log entry created")
+	return nil
+}
+
+// GetAuditLogCount returns the total number of audit log entries,
+// optionally filtered on organization id (use 0 to disable the filter).
+func GetAuditLogCount(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from audit_log
+		where
+			($1 = 0 or organization_id = $1)`,
+		organizationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetAuditLogs returns the audit log entries, most recent first, optionally
+// filtered on organization id (use 0 to disable the filter).
+func GetAuditLogs(db sqlx.Queryer, organizationID int64, limit, offset int) ([]AuditLog, error) {
+	var logs []AuditLog
+	err := sqlx.Select(db, &logs, `
+		select *
+		from audit_log
+		where
+			($1 = 0 or organization_id = $1)
+		order by created_at desc, id desc
+		limit $2
+		offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return logs, nil
+}
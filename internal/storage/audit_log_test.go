@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestAuditLog(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database with an organization and application", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(db, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(db, &sp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(db, &app), ShouldBeNil)
+
+		Convey("Then the organization id can be resolved from the target", func() {
+			tests := []struct {
+				service string
+				target  AuditLogTarget
+				orgID   *int64
+			}{
+				{"api.Organization", AuditLogTarget{"id": fmt.Sprint(org.ID)}, &org.ID},
+				{"api.Application", AuditLogTarget{"id": fmt.Sprint(app.ID)}, &org.ID},
+				{"api.Device", AuditLogTarget{"applicationID": fmt.Sprint(app.ID)}, &org.ID},
+				{"api.ServiceProfileService", AuditLogTarget{"serviceProfileID": sp.ServiceProfile.ServiceProfileID}, &org.ID},
+				{"api.User", AuditLogTarget{"id": "1"}, nil},
+				{"api.Application", AuditLogTarget{"id": fmt.Sprint(app.ID + 1)}, nil},
+			}
+
+			for _, tst := range tests {
+				orgID, err := GetAuditLogOrganizationID(db, tst.service, tst.target)
+				So(err, ShouldBeNil)
+				So(orgID, ShouldResemble, tst.orgID)
+			}
+		})
+
+		Convey("When creating audit log entries", func() {
+			apiKeyID := int64(10)
+			logs := []AuditLog{
+				{
+					Username:       "admin",
+					OrganizationID: &org.ID,
+					Method:         "/api.Application/Update",
+					Target:         AuditLogTarget{"id": fmt.Sprint(app.ID)},
+					RemoteAddr:     "127.0.0.1",
+					Request:        json.RawMessage(`{"id":"1","name":"test-app"}`),
+					Changes:        json.RawMessage(`{"name":{"old":"app","new":"test-app"}}`),
+				},
+				{
+					APIKeyID:   &apiKeyID,
+					Method:     "/api.User/Create",
+					RemoteAddr: "127.0.0.2",
+				},
+			}
+			for i := range logs {
+				So(CreateAuditLog(db, &logs[i]), ShouldBeNil)
+			}
+
+			Convey("Then all entries can be listed, most recent first", func() {
+				count, err := GetAuditLogCount(db, 0)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				items, err := GetAuditLogs(db, 0, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 2)
+				So(items[0].ID, ShouldEqual, logs[1].ID)
+				So(items[0].APIKeyID, ShouldResemble, &apiKeyID)
+				So(items[0].Target, ShouldResemble, AuditLogTarget{})
+				So(string(items[0].Request), ShouldEqual, "{}")
+				So(string(items[0].Changes), ShouldEqual, "{}")
+			})
+
+			Convey("Then the entries can be filtered on organization", func() {
+				count, err := GetAuditLogCount(db, org.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				items, err := GetAuditLogs(db, org.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].Username, ShouldEqual, "admin")
+				So(items[0].Method, ShouldEqual, "/api.Application/Update")
+				So(items[0].Target, ShouldResemble, logs[0].Target)
+				So(items[0].RemoteAddr, ShouldEqual, "127.0.0.1")
+
+				var req map[string]string
+				So(json.Unmarshal(items[0].Request, &req), ShouldBeNil)
+				So(req, ShouldResemble, map[string]string{"id": "1", "name": "test-app"})
+
+				var changes map[string]map[string]string
+				So(json.Unmarshal(items[0].Changes, &changes), ShouldBeNil)
+				So(changes, ShouldResemble, map[string]map[string]string{"name": {"old": "app", "new": "test-app"}})
+			})
+		})
+	})
+}
@@ -79,3 +79,24 @@ func Transaction(db *common.DBLogger, f func(tx sqlx.Ext) error) error {
 	}
 	return nil
 }
+
+// Savepoint wraps the given function in a savepoint of the current
+// transaction. In case the given function returns an error, the transaction
+// is rolled back to the savepoint so that it can be continued.
+func Savepoint(db sqlx.Execer, name string, f func() error) error {
+	if _, err := db.Exec("savepoint " + name); err != nil {
+		return errors.Wrap(err, "create savepoint error")
+	}
+
+	if err := f(); err != nil {
+		if _, rbErr := db.Exec("rollback to savepoint " + name); rbErr != nil {
+			return errors.Wrap(rbErr, "rollback to savepoint error")
+		}
+		return err
+	}
+
+	if _, err := db.Exec("release savepoint " + name); err != nil {
+		return errors.Wrap(err, "release savepoint error")
+	}
+	return nil
+}
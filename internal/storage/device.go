@@ -29,6 +29,7 @@ type Device struct {
 	Description         string        `db:"description"`
 	DeviceStatusBattery *int          `db:"device_status_battery"`
 	DeviceStatusMargin  *int          `db:"device_status_margin"`
+	LastUplinkDataRate  string        `db:"last_uplink_data_rate"`
 }
 
 // DeviceListItem defines the Device as list item.
@@ -82,8 +83,9 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 			description,
 			device_status_battery,
 			device_status_margin,
-			last_seen_at
-        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			last_seen_at,
+			last_uplink_data_rate
+        ) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
 		d.DevEUI[:],
 		d.CreatedAt,
 		d.UpdatedAt,
@@ -94,6 +96,7 @@ func CreateDevice(db sqlx.Ext, d *Device) error {
 		d.DeviceStatusBattery,
 		d.DeviceStatusMargin,
 		d.LastSeenAt,
+		d.LastUplinkDataRate,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -219,7 +222,8 @@ func UpdateDevice(db sqlx.Ext, d *Device) error {
 			description = $6,
 			device_status_battery = $7,
 			device_status_margin = $8,
-			last_seen_at = $9
+			last_seen_at = $9,
+			last_uplink_data_rate = $10
         where
             dev_eui = $1`,
 		d.DevEUI[:],
@@ -231,6 +235,7 @@ func UpdateDevice(db sqlx.Ext, d *Device) error {
 		d.DeviceStatusBattery,
 		d.DeviceStatusMargin,
 		d.LastSeenAt,
+		d.LastUplinkDataRate,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -26,6 +26,14 @@ type DeviceProfile struct {
 	UpdatedAt       time.Time             `db:"updated_at"`
 	Name            string                `db:"name"`
 	DeviceProfile   backend.DeviceProfile `db:"-"`
+
+	// ClockSyncEnabled enables the handling of the Application Layer Clock
+	// Synchronization package (fPort 202) by the application-server.
+	ClockSyncEnabled bool `db:"clock_sync_enabled"`
+
+	// ClockSyncForwardUplinks forwards the handled clock synchronization
+	// uplinks to the integrations.
+	ClockSyncForwardUplinks bool `db:"clock_sync_forward_uplinks"`
 }
 
 // DeviceProfileMeta defines the device-profile meta record.
@@ -63,14 +71,18 @@ func CreateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
             organization_id,
             created_at,
             updated_at,
-            name
-        ) values ($1, $2, $3, $4, $5, $6)`,
+            name,
+            clock_sync_enabled,
+            clock_sync_forward_uplinks
+        ) values ($1, $2, $3, $4, $5, $6, $7, $8)`,
 		dp.DeviceProfile.DeviceProfileID,
 		dp.NetworkServerID,
 		dp.OrganizationID,
 		dp.CreatedAt,
 		dp.UpdatedAt,
 		dp.Name,
+		dp.ClockSyncEnabled,
+		dp.ClockSyncForwardUplinks,
 	)
 	if err != nil {
 		log.WithField("device_profile_id", dp.DeviceProfile.DeviceProfileID).Errorf("create device-profile error: %s", err)
@@ -137,7 +149,9 @@ func GetDeviceProfile(db sqlx.Queryer, id string) (DeviceProfile, error) {
 			organization_id,
 			created_at,
 			updated_at,
-			name
+			name,
+			clock_sync_enabled,
+			clock_sync_forward_uplinks
 		from device_profile
 		where
 			device_profile_id = $1`,
@@ -147,7 +161,7 @@ func GetDeviceProfile(db sqlx.Queryer, id string) (DeviceProfile, error) {
 		return dp, handlePSQLError(Select, err, "select error")
 	}
 
-	err := row.Scan(&dp.DeviceProfile.DeviceProfileID, &dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name)
+	err := row.Scan(&dp.DeviceProfile.DeviceProfileID, &dp.NetworkServerID, &dp.OrganizationID, &dp.CreatedAt, &dp.UpdatedAt, &dp.Name, &dp.ClockSyncEnabled, &dp.ClockSyncForwardUplinks)
 	if err != nil {
 		return dp, handlePSQLError(Scan, err, "scan error")
 	}
@@ -258,11 +272,15 @@ func UpdateDeviceProfile(db sqlx.Ext, dp *DeviceProfile) error {
         update device_profile
         set
             updated_at = $2,
-            name = $3
+            name = $3,
+            clock_sync_enabled = $4,
+            clock_sync_forward_uplinks = $5
         where device_profile_id = $1`,
 		dp.DeviceProfile.DeviceProfileID,
 		dp.UpdatedAt,
 		dp.Name,
+		dp.ClockSyncEnabled,
+		dp.ClockSyncForwardUplinks,
 	)
 	if err != nil {
 		return handlePSQLError(Update, err, "update error")
@@ -48,9 +48,10 @@ func TestDeviceProfile(t *testing.T) {
 
 		Convey("Then CreateDeviceProfile creates the device-profile", func() {
 			dp := DeviceProfile{
-				NetworkServerID: n.ID,
-				OrganizationID:  org.ID,
-				Name:            "device-profile",
+				NetworkServerID:  n.ID,
+				OrganizationID:   org.ID,
+				Name:             "device-profile",
+				ClockSyncEnabled: true,
 				DeviceProfile: backend.DeviceProfile{
 					SupportsClassB:     true,
 					ClassBTimeout:      10,
@@ -139,6 +140,8 @@ func TestDeviceProfile(t *testing.T) {
 
 			Convey("Then UpdateDeviceProfile updates the device-profile", func() {
 				dp.Name = "updated-device-profile"
+				dp.ClockSyncEnabled = false
+				dp.ClockSyncForwardUplinks = true
 				dp.DeviceProfile = backend.DeviceProfile{
 					DeviceProfileID:    dp.DeviceProfile.DeviceProfileID,
 					SupportsClassB:     true,
@@ -193,6 +196,8 @@ func TestDeviceProfile(t *testing.T) {
 				So(err, ShouldBeNil)
 				dpGet.UpdatedAt = dpGet.UpdatedAt.UTC().Truncate(time.Millisecond)
 				So(dpGet.Name, ShouldEqual, "updated-device-profile")
+				So(dpGet.ClockSyncEnabled, ShouldBeFalse)
+				So(dpGet.ClockSyncForwardUplinks, ShouldBeTrue)
 				So(dpGet.UpdatedAt, ShouldResemble, dp.UpdatedAt)
 			})
 
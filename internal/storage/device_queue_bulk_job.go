@@ -0,0 +1,228 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceQueueBulkJobDeviceStatus defines the status of a device within a
+// device-queue bulk job.
+type DeviceQueueBulkJobDeviceStatus string
+
+// Possible device-queue bulk job device statuses.
+const (
+	DeviceQueueBulkJobDevicePending DeviceQueueBulkJobDeviceStatus = "PENDING"
+	DeviceQueueBulkJobDeviceQueued  DeviceQueueBulkJobDeviceStatus = "QUEUED"
+	DeviceQueueBulkJobDeviceFailed  DeviceQueueBulkJobDeviceStatus = "FAILED"
+)
+
+// DeviceQueueBulkJob defines a downlink payload which must be enqueued for
+// all the devices of an application, or when set, for all the devices of
+// the application using the given device-profile.
+type DeviceQueueBulkJob struct {
+	ID              int64     `db:"id"`
+	CreatedAt       time.Time `db:"created_at"`
+	ApplicationID   int64     `db:"application_id"`
+	DeviceProfileID *string   `db:"device_profile_id"`
+	Reference       string    `db:"reference"`
+	Confirmed       bool      `db:"confirmed"`
+	FPort           uint8     `db:"f_port"`
+	Data            []byte    `db:"data"`
+}
+
+// DeviceQueueBulkJobDevice defines the enqueue status of a single device
+// within a device-queue bulk job.
+type DeviceQueueBulkJobDevice struct {
+	JobID     int64                          `db:"job_id"`
+	DevEUI    lorawan.EUI64                  `db:"dev_eui"`
+	UpdatedAt time.Time                      `db:"updated_at"`
+	Status    DeviceQueueBulkJobDeviceStatus `db:"status"`
+	Error     string                         `db:"error"`
+}
+
+// DeviceQueueBulkJobCount contains the number of devices per status of a
+// device-queue bulk job.
+type DeviceQueueBulkJobCount struct {
+	Pending int `db:"pending"`
+	Queued  int `db:"queued"`
+	Failed  int `db:"failed"`
+}
+
+// CreateDeviceQueueBulkJob creates the given device-queue bulk job, and a
+// pending item for each device matching the job selector.
+func CreateDeviceQueueBulkJob(db sqlx.Ext, j *DeviceQueueBulkJob) error {
+	now := time.Now()
+
+	err := sqlx.Get(db, &j.ID, `
+		insert into device_queue_bulk_job (
+			created_at,
+			application_id,
+			device_profile_id,
+			reference,
+			confirmed,
+			f_port,
+			data
+		) values ($1, $2, $3, $4, $5, $6, $7)
+		returning id`,
+		now,
+		j.ApplicationID,
+		j.DeviceProfileID,
+		j.Reference,
+		j.Confirmed,
+		j.FPort,
+		j.Data,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	j.CreatedAt = now
+
+	res, err := db.Exec(`
+		insert into device_queue_bulk_job_device (
+			job_id,
+			dev_eui,
+			updated_at,
+			status
+		)
+		select $1, dev_eui, $2, $3
+		from device
+		where
+			application_id = $4
+			and ($5::uuid is null or device_profile_id = $5)`,
+		j.ID,
+		now,
+		DeviceQueueBulkJobDevicePending,
+		j.ApplicationID,
+		j.DeviceProfileID,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":             j.ID,
+		"application_id": j.ApplicationID,
+		"devices":        ra,
+	}).Info("device-queue bulk job created")
+	return nil
+}
+
+// GetDeviceQueueBulkJob returns the device-queue bulk job for the given id.
+func GetDeviceQueueBulkJob(db sqlx.Queryer, id int64) (DeviceQueueBulkJob, error) {
+	var j DeviceQueueBulkJob
+	err := sqlx.Get(db, &j, "select * from device_queue_bulk_job where id = $1", id)
+	if err != nil {
+		return j, handlePSQLError(Select, err, "select error")
+	}
+	return j, nil
+}
+
+// GetDeviceQueueBulkJobCount returns the number of devices per status for
+// the given device-queue bulk job id.
+func GetDeviceQueueBulkJobCount(db sqlx.Queryer, id int64) (DeviceQueueBulkJobCount, error) {
+	var c DeviceQueueBulkJobCount
+	err := sqlx.Get(db, &c, `
+		select
+			count(*) filter (where status = $2) as pending,
+			count(*) filter (where status = $3) as queued,
+			count(*) filter (where status = $4) as failed
+		from device_queue_bulk_job_device
+		where job_id = $1`,
+		id,
+		DeviceQueueBulkJobDevicePending,
+		DeviceQueueBulkJobDeviceQueued,
+		DeviceQueueBulkJobDeviceFailed,
+	)
+	if err != nil {
+		return c, handlePSQLError(Select, err, "select error")
+	}
+	return c, nil
+}
+
+// GetDeviceQueueBulkJobDevices returns the devices (and their status) for
+// the given device-queue bulk job id.
+func GetDeviceQueueBulkJobDevices(db sqlx.Queryer, id int64, limit, offset int) ([]DeviceQueueBulkJobDevice, error) {
+	var devices []DeviceQueueBulkJobDevice
+	err := sqlx.Select(db, &devices, `
+		select *
+		from device_queue_bulk_job_device
+		where job_id = $1
+		order by dev_eui
+		limit $2
+		offset $3`,
+		id,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devices, nil
+}
+
+// GetPendingDeviceQueueBulkJobDevices returns the given number of pending
+// device-queue bulk job devices, oldest job first. The returned rows are
+// locked, this function must be called within a transaction. Rows locked by
+// other transactions are skipped so that multiple instances can process
+// the pending devices concurrently.
+func GetPendingDeviceQueueBulkJobDevices(db sqlx.Queryer, limit int) ([]DeviceQueueBulkJobDevice, error) {
+	var devices []DeviceQueueBulkJobDevice
+	err := sqlx.Select(db, &devices, `
+		select *
+		from device_queue_bulk_job_device
+		where status = $1
+		order by job_id, dev_eui
+		limit $2
+		for update skip locked`,
+		DeviceQueueBulkJobDevicePending,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devices, nil
+}
+
+// UpdateDeviceQueueBulkJobDevice updates the status of the given
+// device-queue bulk job device.
+func UpdateDeviceQueueBulkJobDevice(db sqlx.Execer, d *DeviceQueueBulkJobDevice) error {
+	now := time.Now()
+
+	res, err := db.Exec(`
+		update device_queue_bulk_job_device
+		set
+			updated_at = $3,
+			status = $4,
+			error = $5
+		where
+			job_id = $1
+			and dev_eui = $2`,
+		d.JobID,
+		d.DevEUI[:],
+		now,
+		d.Status,
+		d.Error,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	d.UpdatedAt = now
+	return nil
+}
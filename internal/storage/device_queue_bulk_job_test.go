@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/brocaar/lorawan"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestDeviceQueueBulkJob(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and two devices with different device-profiles", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		dp1 := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp-1",
+		}
+		So(CreateDeviceProfile(config.C.PostgreSQL.DB, &dp1), ShouldBeNil)
+
+		dp2 := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp-2",
+		}
+		So(CreateDeviceProfile(config.C.PostgreSQL.DB, &dp2), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		d1 := Device{
+			Name:            "test-device-1",
+			DevEUI:          lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp1.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(config.C.PostgreSQL.DB, &d1), ShouldBeNil)
+
+		d2 := Device{
+			Name:            "test-device-2",
+			DevEUI:          lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp2.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(config.C.PostgreSQL.DB, &d2), ShouldBeNil)
+
+		Convey("When creating a bulk job for all devices of the application", func() {
+			j := DeviceQueueBulkJob{
+				ApplicationID: app.ID,
+				Reference:     "test-123",
+				FPort:         10,
+				Data:          []byte{1, 2, 3, 4},
+			}
+			So(CreateDeviceQueueBulkJob(config.C.PostgreSQL.DB, &j), ShouldBeNil)
+
+			Convey("Then GetDeviceQueueBulkJob returns the job", func() {
+				jGet, err := GetDeviceQueueBulkJob(config.C.PostgreSQL.DB, j.ID)
+				So(err, ShouldBeNil)
+				So(jGet.Reference, ShouldEqual, "test-123")
+				So(jGet.FPort, ShouldEqual, 10)
+				So(jGet.Data, ShouldResemble, []byte{1, 2, 3, 4})
+				So(jGet.DeviceProfileID, ShouldBeNil)
+			})
+
+			Convey("Then all devices are pending", func() {
+				count, err := GetDeviceQueueBulkJobCount(config.C.PostgreSQL.DB, j.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldResemble, DeviceQueueBulkJobCount{Pending: 2})
+
+				devices, err := GetDeviceQueueBulkJobDevices(config.C.PostgreSQL.DB, j.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(devices, ShouldHaveLength, 2)
+				So(devices[0].DevEUI, ShouldEqual, d1.DevEUI)
+				So(devices[0].Status, ShouldEqual, DeviceQueueBulkJobDevicePending)
+				So(devices[1].DevEUI, ShouldEqual, d2.DevEUI)
+			})
+
+			Convey("When updating the status of the pending devices", func() {
+				tx, err := db.Beginx()
+				So(err, ShouldBeNil)
+				defer tx.Rollback()
+
+				devices, err := GetPendingDeviceQueueBulkJobDevices(tx, 10)
+				So(err, ShouldBeNil)
+				So(devices, ShouldHaveLength, 2)
+
+				devices[0].Status = DeviceQueueBulkJobDeviceQueued
+				So(UpdateDeviceQueueBulkJobDevice(tx, &devices[0]), ShouldBeNil)
+				devices[1].Status = DeviceQueueBulkJobDeviceFailed
+				devices[1].Error = "boom"
+				So(UpdateDeviceQueueBulkJobDevice(tx, &devices[1]), ShouldBeNil)
+				So(tx.Commit(), ShouldBeNil)
+
+				Convey("Then the counts have been updated", func() {
+					count, err := GetDeviceQueueBulkJobCount(config.C.PostgreSQL.DB, j.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldResemble, DeviceQueueBulkJobCount{Queued: 1, Failed: 1})
+
+					devices, err := GetDeviceQueueBulkJobDevices(config.C.PostgreSQL.DB, j.ID, 10, 0)
+					So(err, ShouldBeNil)
+					So(devices[1].Error, ShouldEqual, "boom")
+				})
+
+				Convey("Then there are no pending devices left", func() {
+					devices, err := GetPendingDeviceQueueBulkJobDevices(config.C.PostgreSQL.DB, 10)
+					So(err, ShouldBeNil)
+					So(devices, ShouldHaveLength, 0)
+				})
+			})
+		})
+
+		Convey("When creating a bulk job for a device-profile", func() {
+			j := DeviceQueueBulkJob{
+				ApplicationID:   app.ID,
+				DeviceProfileID: &dp2.DeviceProfile.DeviceProfileID,
+				FPort:           10,
+				Data:            []byte{1, 2, 3, 4},
+			}
+			So(CreateDeviceQueueBulkJob(config.C.PostgreSQL.DB, &j), ShouldBeNil)
+
+			Convey("Then only the devices using the device-profile are selected", func() {
+				devices, err := GetDeviceQueueBulkJobDevices(config.C.PostgreSQL.DB, j.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(devices, ShouldHaveLength, 1)
+				So(devices[0].DevEUI, ShouldEqual, d2.DevEUI)
+			})
+		})
+	})
+}
@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// DeviceQueueItemMeta holds the application-server options of a
+// device-queue item. The item itself is stored by the network-server and
+// is identified by the DevEUI and FCnt.
+type DeviceQueueItemMeta struct {
+	DevEUI    lorawan.EUI64 `db:"dev_eui"`
+	FCnt      uint32        `db:"f_cnt"`
+	CreatedAt time.Time     `db:"created_at"`
+	Reference string        `db:"reference"`
+	Priority  int           `db:"priority"`
+	DedupKey  string        `db:"dedup_key"`
+	ExpiresAt *time.Time    `db:"expires_at"`
+}
+
+// Validate validates the device-queue item meta data.
+func (m DeviceQueueItemMeta) Validate() error {
+	if m.Priority < 0 {
+		return ErrDeviceQueueItemInvalidPriority
+	}
+	return nil
+}
+
+// CreateDeviceQueueItemMeta creates the given device-queue item meta data.
+func CreateDeviceQueueItemMeta(db sqlx.Execer, m *DeviceQueueItemMeta) error {
+	if err := m.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	m.CreatedAt = time.Now()
+
+	_, err := db.Exec(`
+		insert into device_queue_item_meta (
+			dev_eui,
+			f_cnt,
+			created_at,
+			reference,
+			priority,
+			dedup_key,
+			expires_at
+		) values ($1, $2, $3, $4, $5, $6, $7)`,
+		m.DevEUI[:],
+		m.FCnt,
+		m.CreatedAt,
+		m.Reference,
+		m.Priority,
+		m.DedupKey,
+		m.ExpiresAt,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"dev_eui": m.DevEUI,
+		"f_cnt":   m.FCnt,
+	}).Info("device-queue item meta created")
+
+	return nil
+}
+
+// GetDeviceQueueItemMetaForDevEUI returns the device-queue item meta data
+// for the given DevEUI.
+func GetDeviceQueueItemMetaForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) ([]DeviceQueueItemMeta, error) {
+	var items []DeviceQueueItemMeta
+	err := sqlx.Select(db, &items, `
+		select *
+		from device_queue_item_meta
+		where
+			dev_eui = $1
+		order by f_cnt`,
+		devEUI[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return items, nil
+}
+
+// DeleteDeviceQueueItemMeta deletes the device-queue item meta data for the
+// given DevEUI and FCnt (if it exists).
+func DeleteDeviceQueueItemMeta(db sqlx.Execer, devEUI lorawan.EUI64, fCnt uint32) error {
+	_, err := db.Exec("delete from device_queue_item_meta where dev_eui = $1 and f_cnt = $2", devEUI[:], fCnt)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	return nil
+}
+
+// FlushDeviceQueueItemMetaForDevEUI deletes all the device-queue item meta
+// data for the given DevEUI.
+func FlushDeviceQueueItemMetaForDevEUI(db sqlx.Execer, devEUI lorawan.EUI64) error {
+	_, err := db.Exec("delete from device_queue_item_meta where dev_eui = $1", devEUI[:])
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	return nil
+}
+
+// GetDevEUIsWithExpiredDeviceQueueItems returns the DevEUIs of the devices
+// having expired device-queue items. The devices are locked for update
+// and devices locked by an other transaction are skipped.
+func GetDevEUIsWithExpiredDeviceQueueItems(db sqlx.Queryer, now time.Time, limit int) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := sqlx.Select(db, &devEUIs, `
+		select
+			dev_eui
+		from device
+		where
+			dev_eui in (
+				select dev_eui
+				from device_queue_item_meta
+				where
+					expires_at <= $1
+			)
+		limit $2
+		for update skip locked`,
+		now,
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return devEUIs, nil
+}
+
+// LockDeviceQueue locks the device-queue of the given device for update
+// until the end of the transaction.
+func LockDeviceQueue(db sqlx.Queryer, devEUI lorawan.EUI64) error {
+	var b []byte
+	err := sqlx.Get(db, &b, "select dev_eui from device where dev_eui = $1 for update", devEUI[:])
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	return nil
+}
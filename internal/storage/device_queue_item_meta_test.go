@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/pkg/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestDeviceQueueItemMeta(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and a device", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		d := Device{
+			Name:            "test-device",
+			DevEUI:          lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+		Convey("When creating device-queue item meta-data", func() {
+			expiresAt := time.Now().Add(-time.Minute).UTC().Truncate(time.Millisecond)
+			m1 := DeviceQueueItemMeta{
+				DevEUI:    d.DevEUI,
+				FCnt:      10,
+				Reference: "test-123",
+				Priority:  1,
+				DedupKey:  "set-setpoint",
+				ExpiresAt: &expiresAt,
+			}
+			So(CreateDeviceQueueItemMeta(config.C.PostgreSQL.DB, &m1), ShouldBeNil)
+			m1.CreatedAt = m1.CreatedAt.UTC().Truncate(time.Millisecond)
+
+			m2 := DeviceQueueItemMeta{
+				DevEUI:   d.DevEUI,
+				FCnt:     11,
+				Priority: 2,
+			}
+			So(CreateDeviceQueueItemMeta(config.C.PostgreSQL.DB, &m2), ShouldBeNil)
+			m2.CreatedAt = m2.CreatedAt.UTC().Truncate(time.Millisecond)
+
+			Convey("Then a negative priority is rejected", func() {
+				m := DeviceQueueItemMeta{
+					DevEUI:   d.DevEUI,
+					FCnt:     12,
+					Priority: -1,
+				}
+				err := CreateDeviceQueueItemMeta(config.C.PostgreSQL.DB, &m)
+				So(errors.Cause(err), ShouldEqual, ErrDeviceQueueItemInvalidPriority)
+			})
+
+			Convey("Then GetDeviceQueueItemMetaForDevEUI returns the items", func() {
+				items, err := GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 2)
+				for i := range items {
+					items[i].CreatedAt = items[i].CreatedAt.UTC().Truncate(time.Millisecond)
+					if items[i].ExpiresAt != nil {
+						ts := items[i].ExpiresAt.UTC()
+						items[i].ExpiresAt = &ts
+					}
+				}
+				So(items[0], ShouldResemble, m1)
+				So(items[1], ShouldResemble, m2)
+			})
+
+			Convey("Then GetDevEUIsWithExpiredDeviceQueueItems returns the device", func() {
+				devEUIs, err := GetDevEUIsWithExpiredDeviceQueueItems(config.C.PostgreSQL.DB, time.Now(), 10)
+				So(err, ShouldBeNil)
+				So(devEUIs, ShouldResemble, []lorawan.EUI64{d.DevEUI})
+
+				devEUIs, err = GetDevEUIsWithExpiredDeviceQueueItems(config.C.PostgreSQL.DB, expiresAt.Add(-time.Second), 10)
+				So(err, ShouldBeNil)
+				So(devEUIs, ShouldHaveLength, 0)
+			})
+
+			Convey("Then DeleteDeviceQueueItemMeta deletes the item", func() {
+				So(DeleteDeviceQueueItemMeta(config.C.PostgreSQL.DB, d.DevEUI, 10), ShouldBeNil)
+				items, err := GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 1)
+				So(items[0].FCnt, ShouldEqual, 11)
+			})
+
+			Convey("Then FlushDeviceQueueItemMetaForDevEUI deletes all items", func() {
+				So(FlushDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, d.DevEUI), ShouldBeNil)
+				items, err := GetDeviceQueueItemMetaForDevEUI(config.C.PostgreSQL.DB, d.DevEUI)
+				So(err, ShouldBeNil)
+				So(items, ShouldHaveLength, 0)
+			})
+		})
+	})
+}
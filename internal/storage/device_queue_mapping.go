@@ -105,6 +105,25 @@ func GetDeviceQueueMappingForDevEUIAndFCnt(db sqlx.Ext, devEUI lorawan.EUI64, fC
 	}
 }
 
+// GetDeviceQueueMappingsForDevEUI returns the device-queue mappings for the
+// given DevEUI.
+func GetDeviceQueueMappingsForDevEUI(db sqlx.Queryer, devEUI lorawan.EUI64) ([]DeviceQueueMapping, error) {
+	var mappings []DeviceQueueMapping
+	err := sqlx.Select(db, &mappings, `
+		select *
+		from device_queue_mapping
+		where
+			dev_eui = $1
+		order by id`,
+		devEUI[:],
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+
+	return mappings, nil
+}
+
 // FlushDeviceQueueMappingForDevEUI flushes the device-queue mapping for the
 // given DevEUI.
 func FlushDeviceQueueMappingForDevEUI(db sqlx.Execer, devEUI lorawan.EUI64) error {
@@ -156,6 +156,7 @@ func TestDevice(t *testing.T) {
 
 					d.Name = "updated-test-device"
 					d.DeviceProfileID = dp2.DeviceProfile.DeviceProfileID
+					d.LastUplinkDataRate = "SF7BW125"
 					So(UpdateDevice(config.C.PostgreSQL.DB, &d), ShouldBeNil)
 					d.UpdatedAt = d.UpdatedAt.UTC().Truncate(time.Millisecond)
 
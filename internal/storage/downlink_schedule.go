@@ -0,0 +1,324 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/cron"
+	"github.com/brocaar/lorawan"
+)
+
+// DownlinkSchedule defines a recurring downlink payload. The schedule
+// targets all devices of the application, or when set, only the devices
+// using the given device-profile or the single given device.
+type DownlinkSchedule struct {
+	ID              int64           `db:"id"`
+	CreatedAt       time.Time       `db:"created_at"`
+	UpdatedAt       time.Time       `db:"updated_at"`
+	ApplicationID   int64           `db:"application_id"`
+	DeviceProfileID *string         `db:"device_profile_id"`
+	DevEUI          *lorawan.EUI64  `db:"dev_eui"`
+	Name            string          `db:"name"`
+	Cron            string          `db:"cron"`
+	FPort           uint8           `db:"f_port"`
+	Confirmed       bool            `db:"confirmed"`
+	Data            []byte          `db:"data"`
+	JSONObject      json.RawMessage `db:"json_object"`
+	NextRunAt       time.Time       `db:"next_run_at"`
+	LastRunAt       *time.Time      `db:"last_run_at"`
+}
+
+// Validate validates the downlink schedule data.
+func (s DownlinkSchedule) Validate() error {
+	if _, err := s.schedule(); err != nil {
+		return err
+	}
+	if s.FPort == 0 {
+		return ErrDownlinkScheduleInvalidFPort
+	}
+	if len(s.JSONObject) != 0 && !json.Valid(s.JSONObject) {
+		return ErrDownlinkScheduleInvalidJSONObject
+	}
+	return nil
+}
+
+// NextRun returns the first run of the schedule after the given time.
+func (s DownlinkSchedule) NextRun(after time.Time) (time.Time, error) {
+	cs, err := s.schedule()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cs.Next(after), nil
+}
+
+func (s DownlinkSchedule) schedule() (cron.Schedule, error) {
+	cs, err := cron.Parse(s.Cron)
+	if err != nil {
+		return cs, ErrDownlinkScheduleInvalidCron
+	}
+	if cs.Next(time.Now()).IsZero() {
+		return cs, ErrDownlinkScheduleInvalidCron
+	}
+	return cs, nil
+}
+
+// devEUIBytes returns the DevEUI as byte-slice or nil when not set.
+func (s DownlinkSchedule) devEUIBytes() []byte {
+	if s.DevEUI == nil {
+		return nil
+	}
+	return s.DevEUI[:]
+}
+
+// CreateDownlinkSchedule creates the given downlink schedule.
+func CreateDownlinkSchedule(db sqlx.Queryer, s *DownlinkSchedule) error {
+	if err := s.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	next, err := s.NextRun(now)
+	if err != nil {
+		return err
+	}
+
+	err = sqlx.Get(db, &s.ID, `
+		insert into downlink_schedule (
+			created_at,
+			updated_at,
+			application_id,
+			device_profile_id,
+			dev_eui,
+			name,
+			cron,
+			f_port,
+			confirmed,
+			data,
+			json_object,
+			next_run_at
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		returning id`,
+		now,
+		now,
+		s.ApplicationID,
+		s.DeviceProfileID,
+		s.devEUIBytes(),
+		s.Name,
+		s.Cron,
+		s.FPort,
+		s.Confirmed,
+		s.Data,
+		s.JSONObject,
+		next,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	s.NextRunAt = next
+
+	log.WithFields(log.Fields{
+		"id":             s.ID,
+		"application_id": s.ApplicationID,
+		"cron":           s.Cron,
+		"next_run_at":    s.NextRunAt,
+	}).Info("downlink schedule created")
+	return nil
+}
+
+// GetDownlinkSchedule returns the downlink schedule for the given id.
+func GetDownlinkSchedule(db sqlx.Queryer, id int64) (DownlinkSchedule, error) {
+	var s DownlinkSchedule
+	err := sqlx.Get(db, &s, "select * from downlink_schedule where id = $1", id)
+	if err != nil {
+		return s, handlePSQLError(Select, err, "select error")
+	}
+	return s, nil
+}
+
+// GetDownlinkSchedulesForApplicationID returns the downlink schedules for
+// the given application id.
+func GetDownlinkSchedulesForApplicationID(db sqlx.Queryer, applicationID int64, limit, offset int) ([]DownlinkSchedule, error) {
+	var schedules []DownlinkSchedule
+	err := sqlx.Select(db, &schedules, `
+		select *
+		from downlink_schedule
+		where application_id = $1
+		order by name
+		limit $2
+		offset $3`,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return schedules, nil
+}
+
+// GetDownlinkScheduleCountForApplicationID returns the total number of
+// downlink schedules for the given application id.
+func GetDownlinkScheduleCountForApplicationID(db sqlx.Queryer, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from downlink_schedule
+		where application_id = $1`,
+		applicationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetDueDownlinkSchedules returns the downlink schedules which are due
+// at the given time.
+func GetDueDownlinkSchedules(db sqlx.Queryer, t time.Time) ([]DownlinkSchedule, error) {
+	var schedules []DownlinkSchedule
+	err := sqlx.Select(db, &schedules, `
+		select *
+		from downlink_schedule
+		where next_run_at <= $1
+		order by next_run_at`,
+		t,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return schedules, nil
+}
+
+// GetDevEUIsForDownlinkSchedule returns the DevEUIs of the devices targeted
+// by the given downlink schedule.
+func GetDevEUIsForDownlinkSchedule(db sqlx.Queryer, s DownlinkSchedule) ([]lorawan.EUI64, error) {
+	var devEUIs []lorawan.EUI64
+	err := sqlx.Select(db, &devEUIs, `
+		select dev_eui
+		from device
+		where
+			application_id = $1
+			and ($2::uuid is null or device_profile_id = $2)
+			and ($3::bytea is null or dev_eui = $3)
+		order by dev_eui`,
+		s.ApplicationID,
+		s.DeviceProfileID,
+		s.devEUIBytes(),
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devEUIs, nil
+}
+
+// UpdateDownlinkSchedule updates the given downlink schedule. As the cron
+// expression might have changed, the next run is re-calculated.
+func UpdateDownlinkSchedule(db sqlx.Execer, s *DownlinkSchedule) error {
+	if err := s.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	next, err := s.NextRun(now)
+	if err != nil {
+		return err
+	}
+
+	res, err := db.Exec(`
+		update downlink_schedule
+		set
+			updated_at = $2,
+			device_profile_id = $3,
+			dev_eui = $4,
+			name = $5,
+			cron = $6,
+			f_port = $7,
+			confirmed = $8,
+			data = $9,
+			json_object = $10,
+			next_run_at = $11
+		where id = $1`,
+		s.ID,
+		now,
+		s.DeviceProfileID,
+		s.devEUIBytes(),
+		s.Name,
+		s.Cron,
+		s.FPort,
+		s.Confirmed,
+		s.Data,
+		s.JSONObject,
+		next,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	s.UpdatedAt = now
+	s.NextRunAt = next
+
+	log.WithFields(log.Fields{
+		"id":          s.ID,
+		"cron":        s.Cron,
+		"next_run_at": s.NextRunAt,
+	}).Info("downlink schedule updated")
+	return nil
+}
+
+// SetDownlinkScheduleRun stores the last and next run of the downlink
+// schedule matching the given id.
+func SetDownlinkScheduleRun(db sqlx.Execer, id int64, lastRunAt, nextRunAt time.Time) error {
+	res, err := db.Exec(`
+		update downlink_schedule
+		set
+			last_run_at = $2,
+			next_run_at = $3
+		where id = $1`,
+		id,
+		lastRunAt,
+		nextRunAt,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+	return nil
+}
+
+// DeleteDownlinkSchedule deletes the downlink schedule matching the given id.
+func DeleteDownlinkSchedule(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from downlink_schedule where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("downlink schedule deleted")
+	return nil
+}
@@ -0,0 +1,219 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/pkg/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestDownlinkSchedule(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and two devices with different device-profiles", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		dp1 := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp-1",
+		}
+		So(CreateDeviceProfile(config.C.PostgreSQL.DB, &dp1), ShouldBeNil)
+
+		dp2 := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp-2",
+		}
+		So(CreateDeviceProfile(config.C.PostgreSQL.DB, &dp2), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		d1 := Device{
+			Name:            "test-device-1",
+			DevEUI:          lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp1.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(config.C.PostgreSQL.DB, &d1), ShouldBeNil)
+
+		d2 := Device{
+			Name:            "test-device-2",
+			DevEUI:          lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp2.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(config.C.PostgreSQL.DB, &d2), ShouldBeNil)
+
+		Convey("Then creating a schedule with an invalid cron expression fails", func() {
+			s := DownlinkSchedule{
+				ApplicationID: app.ID,
+				Name:          "test-schedule",
+				Cron:          "* * *",
+				FPort:         10,
+			}
+			err := CreateDownlinkSchedule(config.C.PostgreSQL.DB, &s)
+			So(errors.Cause(err), ShouldEqual, ErrDownlinkScheduleInvalidCron)
+		})
+
+		Convey("Then creating a schedule with fPort 0 fails", func() {
+			s := DownlinkSchedule{
+				ApplicationID: app.ID,
+				Name:          "test-schedule",
+				Cron:          "@daily",
+			}
+			err := CreateDownlinkSchedule(config.C.PostgreSQL.DB, &s)
+			So(errors.Cause(err), ShouldEqual, ErrDownlinkScheduleInvalidFPort)
+		})
+
+		Convey("When creating an application-wide schedule", func() {
+			s := DownlinkSchedule{
+				ApplicationID: app.ID,
+				Name:          "test-schedule",
+				Cron:          "0 3 * * *",
+				FPort:         10,
+				Confirmed:     true,
+				JSONObject:    json.RawMessage(`{"reset": true}`),
+			}
+			So(CreateDownlinkSchedule(config.C.PostgreSQL.DB, &s), ShouldBeNil)
+
+			Convey("Then the next run has been set", func() {
+				So(s.NextRunAt.After(time.Now()), ShouldBeTrue)
+				So(s.NextRunAt.UTC().Hour(), ShouldEqual, 3)
+				So(s.NextRunAt.Minute(), ShouldEqual, 0)
+			})
+
+			Convey("Then GetDownlinkSchedule returns the schedule", func() {
+				sGet, err := GetDownlinkSchedule(config.C.PostgreSQL.DB, s.ID)
+				So(err, ShouldBeNil)
+				So(sGet.Name, ShouldEqual, s.Name)
+				So(sGet.Cron, ShouldEqual, s.Cron)
+				So(sGet.FPort, ShouldEqual, s.FPort)
+				So(sGet.Confirmed, ShouldBeTrue)
+				So(sGet.DevEUI, ShouldBeNil)
+				So(sGet.DeviceProfileID, ShouldBeNil)
+				So(sGet.NextRunAt.Equal(s.NextRunAt.Truncate(time.Microsecond)), ShouldBeTrue)
+				So(sGet.LastRunAt, ShouldBeNil)
+
+				var obj map[string]interface{}
+				So(json.Unmarshal(sGet.JSONObject, &obj), ShouldBeNil)
+				So(obj, ShouldResemble, map[string]interface{}{"reset": true})
+			})
+
+			Convey("Then the schedule is listed for the application", func() {
+				count, err := GetDownlinkScheduleCountForApplicationID(config.C.PostgreSQL.DB, app.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				schedules, err := GetDownlinkSchedulesForApplicationID(config.C.PostgreSQL.DB, app.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(schedules, ShouldHaveLength, 1)
+				So(schedules[0].ID, ShouldEqual, s.ID)
+			})
+
+			Convey("Then the schedule targets all devices of the application", func() {
+				devEUIs, err := GetDevEUIsForDownlinkSchedule(config.C.PostgreSQL.DB, s)
+				So(err, ShouldBeNil)
+				So(devEUIs, ShouldResemble, []lorawan.EUI64{d1.DevEUI, d2.DevEUI})
+			})
+
+			Convey("Then the schedule is only due after its next run", func() {
+				schedules, err := GetDueDownlinkSchedules(config.C.PostgreSQL.DB, time.Now())
+				So(err, ShouldBeNil)
+				So(schedules, ShouldHaveLength, 0)
+
+				schedules, err = GetDueDownlinkSchedules(config.C.PostgreSQL.DB, s.NextRunAt)
+				So(err, ShouldBeNil)
+				So(schedules, ShouldHaveLength, 1)
+			})
+
+			Convey("When updating the schedule to target a device-profile", func() {
+				s.DeviceProfileID = &dp2.DeviceProfile.DeviceProfileID
+				s.Cron = "*/5 * * * *"
+				So(UpdateDownlinkSchedule(config.C.PostgreSQL.DB, &s), ShouldBeNil)
+
+				Convey("Then only the devices using the device-profile are targeted", func() {
+					sGet, err := GetDownlinkSchedule(config.C.PostgreSQL.DB, s.ID)
+					So(err, ShouldBeNil)
+					So(sGet.Cron, ShouldEqual, "*/5 * * * *")
+
+					devEUIs, err := GetDevEUIsForDownlinkSchedule(config.C.PostgreSQL.DB, sGet)
+					So(err, ShouldBeNil)
+					So(devEUIs, ShouldResemble, []lorawan.EUI64{d2.DevEUI})
+				})
+			})
+
+			Convey("When updating the schedule to target a single device", func() {
+				s.DevEUI = &d1.DevEUI
+				So(UpdateDownlinkSchedule(config.C.PostgreSQL.DB, &s), ShouldBeNil)
+
+				Convey("Then only this device is targeted", func() {
+					sGet, err := GetDownlinkSchedule(config.C.PostgreSQL.DB, s.ID)
+					So(err, ShouldBeNil)
+					So(sGet.DevEUI, ShouldResemble, &d1.DevEUI)
+
+					devEUIs, err := GetDevEUIsForDownlinkSchedule(config.C.PostgreSQL.DB, sGet)
+					So(err, ShouldBeNil)
+					So(devEUIs, ShouldResemble, []lorawan.EUI64{d1.DevEUI})
+				})
+			})
+
+			Convey("When calling SetDownlinkScheduleRun", func() {
+				lastRun := time.Now().Truncate(time.Millisecond)
+				nextRun := lastRun.Add(time.Hour)
+				So(SetDownlinkScheduleRun(config.C.PostgreSQL.DB, s.ID, lastRun, nextRun), ShouldBeNil)
+
+				Convey("Then the last and next run have been updated", func() {
+					sGet, err := GetDownlinkSchedule(config.C.PostgreSQL.DB, s.ID)
+					So(err, ShouldBeNil)
+					So(sGet.LastRunAt, ShouldNotBeNil)
+					So(sGet.LastRunAt.Equal(lastRun), ShouldBeTrue)
+					So(sGet.NextRunAt.Equal(nextRun), ShouldBeTrue)
+				})
+			})
+
+			Convey("Then DeleteDownlinkSchedule deletes the schedule", func() {
+				So(DeleteDownlinkSchedule(config.C.PostgreSQL.DB, s.ID), ShouldBeNil)
+				_, err := GetDownlinkSchedule(config.C.PostgreSQL.DB, s.ID)
+				So(err, ShouldEqual, ErrDoesNotExist)
+			})
+		})
+	})
+}
@@ -29,11 +29,65 @@ var (
 	ErrNodeMaxRXDelay            = errors.New("max value of RXDelay is 15")
 	ErrCFListTooManyChannels     = errors.New("too many channels in channel-list")
 	ErrUserInvalidUsername       = errors.New("username name may only be composed of upper and lower case characters and digits")
-	ErrUserPasswordLength        = errors.New("passwords must be at least 6 characters long")
+	ErrUserPasswordLength        = errors.New("password is shorter than the minimum password length")
 	ErrInvalidUsernameOrPassword = errors.New("invalid username or password")
 	ErrOrganizationInvalidName   = errors.New("invalid organization name")
 	ErrGatewayInvalidName        = errors.New("invalid gateway name")
 	ErrInvalidEmail              = errors.New("invalid e-mail")
+	ErrUsernameConflict          = errors.New("a user with this username already exists")
+
+	ErrOrganizationInvalidQuota      = errors.New("quota values must be greater than or equal to 0")
+	ErrOrganizationMaxApplications   = errors.New("the organization has reached its max. number of applications")
+	ErrOrganizationMaxDevices        = errors.New("the organization has reached its max. number of devices")
+	ErrOrganizationMaxGateways       = errors.New("the organization has reached its max. number of gateways")
+	ErrOrganizationMaxDeviceProfiles = errors.New("the organization has reached its max. number of device-profiles")
+	ErrOrganizationMaxIntegrations   = errors.New("the organization has reached its max. number of integrations")
+	ErrOrganizationMaxUplinksPerDay  = errors.New("the organization has reached its max. number of uplinks per day")
+
+	ErrApplicationUserGatewayAdmin = errors.New("the gateway admin role can not be assigned to application users")
+
+	ErrDownlinkScheduleInvalidCron       = errors.New("invalid cron expression")
+	ErrDownlinkScheduleInvalidFPort      = errors.New("fPort must be greater than 0")
+	ErrDownlinkScheduleInvalidJSONObject = errors.New("jsonObject must be valid JSON")
+
+	ErrMulticastGroupInvalidFrequency = errors.New("frequency must be greater than 0")
+	ErrMulticastGroupInvalidDR        = errors.New("dr must be between 0 and 15")
+
+	ErrFUOTADeploymentInvalidPayload          = errors.New("payload must not be empty")
+	ErrFUOTADeploymentInvalidFragSize         = errors.New("fragSize must be between 1 and 239")
+	ErrFUOTADeploymentTooManyFragments        = errors.New("the number of fragments (including redundancy) must be less than 16384")
+	ErrFUOTADeploymentInvalidMulticastTimeout = errors.New("multicastTimeout must be between 0 and 15")
+	ErrFUOTADeploymentInvalidUnicastTimeout   = errors.New("unicastTimeout must be greater than 0")
+
+	ErrDeviceQueueItemInvalidPriority  = errors.New("priority must be greater than or equal to 0")
+	ErrDeviceQueueItemInvalidExpiresAt = errors.New("expiresAt must be in the future")
+
+	ErrAPIKeyInvalidName      = errors.New("invalid api key name")
+	ErrAPIKeyInvalidScope     = errors.New("api key must be scoped to either an organization or an application")
+	ErrAPIKeyInvalidExpiresAt = errors.New("expiresAt must be in the future")
+
+	ErrWebhookInvalidName   = errors.New("invalid webhook name")
+	ErrWebhookInvalidURL    = errors.New("webhook url must be an absolute http or https url")
+	ErrWebhookInvalidSecret = errors.New("webhook secret must not be empty")
+
+	ErrUserTOTPAlreadyEnabled       = errors.New("two-factor authentication is already enabled")
+	ErrUserTOTPNotEnabled           = errors.New("two-factor authentication is not enabled")
+	ErrUserTOTPInvalidCode          = errors.New("invalid two-factor authentication code")
+	ErrInvalidSecondFactorChallenge = errors.New("invalid or expired second factor challenge")
+
+	ErrLoginLockedOut      = errors.New("too many failed login attempts, try again later")
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+
+	ErrInvalidPasswordResetToken     = errors.New("invalid or expired password reset token")
+	ErrInvalidEmailVerificationToken = errors.New("invalid or expired e-mail verification token")
+	ErrEmailNotVerified              = errors.New("the e-mail address has not been verified")
+	ErrPasswordResetLimited          = errors.New("too many password reset requests, try again later")
+
+	ErrInvalidOrganizationInvitationToken = errors.New("invalid or expired organization invitation token")
+
+	ErrUserPasswordCharacterClasses = errors.New("password must contain more types of characters (lower case, upper case, digits and symbols)")
+	ErrUserPasswordBreached         = errors.New("password is known from a data breach, choose another password")
+	ErrUserPasswordTooLong          = errors.New("password exceeds the maximum length of 72 bytes")
 )
 
 func handlePSQLError(action Action, err error, description string) error {
@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+)
+
+// ExternalUser contains the user details provided by an external identity
+// provider (OpenID Connect or LDAP).
+type ExternalUser struct {
+	ExternalID string
+	Username   string
+	Email      string
+	Groups     []string
+}
+
+// SyncExternalUser creates the user on its first login or updates the
+// e-mail of the existing user. When adminGroup is set, the global admin flag
+// is set from the groups of the user. The memberships of the organizations
+// in the group mapping are set from the groups of the user, memberships of
+// other organizations are left as-is.
+func SyncExternalUser(db sqlx.Ext, eu ExternalUser, adminGroup string, mapping []config.GroupMapping) (User, error) {
+	user, err := GetUserByExternalID(db, eu.ExternalID)
+	if err != nil && err != ErrDoesNotExist {
+		return user, errors.Wrap(err, "get user error")
+	}
+
+	if err == ErrDoesNotExist {
+		user = User{
+			Username: eu.Username,
+			Email:    eu.Email,
+			IsActive: true,
+			IsAdmin:  adminGroup != "" && hasGroup(eu.Groups, adminGroup),
+		}
+		if _, err := CreateExternalUser(db, &user, eu.ExternalID); err != nil {
+			if errors.Cause(err) == ErrAlreadyExists {
+				return user, ErrUsernameConflict
+			}
+			return user, errors.Wrap(err, "create user error")
+		}
+	} else {
+		update := UserUpdate{
+			ID:         user.ID,
+			Username:   user.Username,
+			IsAdmin:    user.IsAdmin,
+			IsActive:   user.IsActive,
+			SessionTTL: user.SessionTTL,
+			Email:      user.Email,
+			Note:       user.Note,
+		}
+		if eu.Email != "" {
+			update.Email = eu.Email
+		}
+		if adminGroup != "" {
+			update.IsAdmin = hasGroup(eu.Groups, adminGroup)
+		}
+
+		if update.Email != user.Email || update.IsAdmin != user.IsAdmin {
+			if err := UpdateUser(db, update); err != nil {
+				return user, errors.Wrap(err, "update user error")
+			}
+			user.Email = update.Email
+			user.IsAdmin = update.IsAdmin
+		}
+	}
+
+	if err := syncExternalUserOrganizations(db, user.ID, eu.Groups, mapping); err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
+
+func syncExternalUserOrganizations(db sqlx.Ext, userID int64, groups []string, mapping []config.GroupMapping) error {
+	// per organization, nil when not a member, else if the user is admin
+	memberships := make(map[int64]*bool)
+	for _, m := range mapping {
+		if _, ok := memberships[m.OrganizationID]; !ok {
+			memberships[m.OrganizationID] = nil
+		}
+		if !hasGroup(groups, m.Group) {
+			continue
+		}
+		isAdmin := m.IsAdmin
+		if memberships[m.OrganizationID] != nil {
+			isAdmin = isAdmin || *memberships[m.OrganizationID]
+		}
+		memberships[m.OrganizationID] = &isAdmin
+	}
+
+	for orgID, isAdmin := range memberships {
+		ou, err := GetOrganizationUser(db, orgID, userID)
+		if err != nil && errors.Cause(err) != ErrDoesNotExist {
+			return errors.Wrap(err, "get organization user error")
+		}
+		exists := err == nil
+
+		switch {
+		case isAdmin == nil && exists:
+			err = DeleteOrganizationUser(db, orgID, userID)
+		case isAdmin != nil && !exists:
+			err = CreateOrganizationUser(db, orgID, userID, *isAdmin)
+		case isAdmin != nil && ou.IsAdmin != *isAdmin:
+			err = UpdateOrganizationUser(db, orgID, userID, *isAdmin)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "set organization %d membership error", orgID)
+		}
+	}
+
+	return nil
+}
+
+func hasGroup(groups []string, group string) bool {
+	for _, g := range groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestExternalUser(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with three organizations", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		orgs := []Organization{
+			{Name: "org-1"},
+			{Name: "org-2"},
+			{Name: "org-3"},
+		}
+		for i := range orgs {
+			So(CreateOrganization(db, &orgs[i]), ShouldBeNil)
+		}
+
+		mapping := []config.GroupMapping{
+			{Group: "org-1-users", OrganizationID: orgs[0].ID},
+			{Group: "org-1-admins", OrganizationID: orgs[0].ID, IsAdmin: true},
+			{Group: "org-2-users", OrganizationID: orgs[1].ID},
+		}
+
+		eu := ExternalUser{
+			ExternalID: "test#user-1",
+			Username:   "johndoe",
+			Email:      "john@example.com",
+			Groups:     []string{"admins", "org-1-users", "org-1-admins"},
+		}
+
+		Convey("When syncing a new external user", func() {
+			user, err := SyncExternalUser(db, eu, "admins", mapping)
+			So(err, ShouldBeNil)
+
+			Convey("Then the user has been created without password", func() {
+				u, err := GetUserByExternalID(db, "test#user-1")
+				So(err, ShouldBeNil)
+				So(u.ID, ShouldEqual, user.ID)
+				So(u.Username, ShouldEqual, "johndoe")
+				So(u.Email, ShouldEqual, "john@example.com")
+				So(u.IsActive, ShouldBeTrue)
+				So(u.IsAdmin, ShouldBeTrue)
+
+				_, err = LoginUser(db, "johndoe", "")
+				So(errors.Cause(err), ShouldEqual, ErrInvalidUsernameOrPassword)
+			})
+
+			Convey("Then the user is admin of the mapped organization", func() {
+				ou, err := GetOrganizationUser(db, orgs[0].ID, user.ID)
+				So(err, ShouldBeNil)
+				So(ou.IsAdmin, ShouldBeTrue)
+
+				_, err = GetOrganizationUser(db, orgs[1].ID, user.ID)
+				So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+			})
+
+			Convey("When the user is added to an unmapped organization and synced with other groups", func() {
+				So(CreateOrganizationUser(db, orgs[2].ID, user.ID, false), ShouldBeNil)
+
+				eu.Email = ""
+				eu.Groups = []string{"org-2-users"}
+				_, err := SyncExternalUser(db, eu, "admins", mapping)
+				So(err, ShouldBeNil)
+
+				Convey("Then the admin flag and mapped memberships have been updated", func() {
+					u, err := GetUser(db, user.ID)
+					So(err, ShouldBeNil)
+					So(u.IsAdmin, ShouldBeFalse)
+					So(u.Email, ShouldEqual, "john@example.com")
+
+					_, err = GetOrganizationUser(db, orgs[0].ID, user.ID)
+					So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+
+					_, err = GetOrganizationUser(db, orgs[1].ID, user.ID)
+					So(err, ShouldBeNil)
+				})
+
+				Convey("Then the membership of the unmapped organization is left as-is", func() {
+					_, err := GetOrganizationUser(db, orgs[2].ID, user.ID)
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+
+		Convey("When syncing an external user and the username is taken by a local user", func() {
+			_, err := CreateUser(db, &User{
+				Username: "johndoe",
+				Email:    "john@example.com",
+				IsActive: true,
+			}, "password123")
+			So(err, ShouldBeNil)
+
+			_, err = SyncExternalUser(db, eu, "admins", mapping)
+
+			Convey("Then ErrUsernameConflict is returned", func() {
+				So(err, ShouldEqual, ErrUsernameConflict)
+			})
+		})
+	})
+}
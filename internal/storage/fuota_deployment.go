@@ -0,0 +1,470 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// FUOTADeploymentState defines the state of a FUOTA deployment.
+type FUOTADeploymentState string
+
+// Possible FUOTA deployment states, in the order in which they are
+// executed.
+const (
+	FUOTADeploymentMulticastGroupSetup       FUOTADeploymentState = "MC_GROUP_SETUP"
+	FUOTADeploymentFragmentationSessionSetup FUOTADeploymentState = "FRAG_SESSION_SETUP"
+	FUOTADeploymentMulticastSessionSetup     FUOTADeploymentState = "MC_SESSION_SETUP"
+	FUOTADeploymentEnqueue                   FUOTADeploymentState = "ENQUEUE"
+	FUOTADeploymentStatusRequest             FUOTADeploymentState = "STATUS_REQUEST"
+	FUOTADeploymentSetDeviceStatus           FUOTADeploymentState = "SET_DEVICE_STATUS"
+	FUOTADeploymentDone                      FUOTADeploymentState = "DONE"
+)
+
+// FUOTADeploymentDeviceState defines the state of a device within a FUOTA
+// deployment.
+type FUOTADeploymentDeviceState string
+
+// Possible FUOTA deployment device states.
+const (
+	FUOTADeploymentDevicePending FUOTADeploymentDeviceState = "PENDING"
+	FUOTADeploymentDeviceSuccess FUOTADeploymentDeviceState = "SUCCESS"
+	FUOTADeploymentDeviceError   FUOTADeploymentDeviceState = "ERROR"
+)
+
+// FUOTADeployment defines a firmware update over the air deployment. The
+// payload is sent as fragmented data-block over the multicast group, after
+// the multicast and fragmentation sessions have been setup on the devices.
+type FUOTADeployment struct {
+	ID               int64                `db:"id"`
+	CreatedAt        time.Time            `db:"created_at"`
+	UpdatedAt        time.Time            `db:"updated_at"`
+	ApplicationID    int64                `db:"application_id"`
+	MulticastGroupID int64                `db:"multicast_group_id"`
+	Name             string               `db:"name"`
+	Payload          []byte               `db:"payload"`
+	FragSize         int                  `db:"frag_size"`
+	Redundancy       int                  `db:"redundancy"`
+	MulticastTimeout int                  `db:"multicast_timeout"`
+	UnicastTimeout   int                  `db:"unicast_timeout"`
+	McKey            lorawan.AES128Key    `db:"mc_key"`
+	State            FUOTADeploymentState `db:"state"`
+	NextStepAfter    time.Time            `db:"next_step_after"`
+}
+
+// FUOTADeploymentDevice defines the state of a device within a FUOTA
+// deployment.
+type FUOTADeploymentDevice struct {
+	FUOTADeploymentID           int64                      `db:"fuota_deployment_id"`
+	DevEUI                      lorawan.EUI64              `db:"dev_eui"`
+	CreatedAt                   time.Time                  `db:"created_at"`
+	UpdatedAt                   time.Time                  `db:"updated_at"`
+	State                       FUOTADeploymentDeviceState `db:"state"`
+	ErrorMessage                string                     `db:"error_message"`
+	McGroupSetupCompletedAt     *time.Time                 `db:"mc_group_setup_completed_at"`
+	FragSessionSetupCompletedAt *time.Time                 `db:"frag_session_setup_completed_at"`
+	McSessionCompletedAt        *time.Time                 `db:"mc_session_completed_at"`
+	FragStatusCompletedAt       *time.Time                 `db:"frag_status_completed_at"`
+	NbFragReceived              int                        `db:"nb_frag_received"`
+	MissingFrag                 int                        `db:"missing_frag"`
+}
+
+// FUOTADeploymentDeviceListItem defines the FUOTADeploymentDevice as list
+// item.
+type FUOTADeploymentDeviceListItem struct {
+	FUOTADeploymentDevice
+	DeviceName string `db:"device_name"`
+}
+
+// Validate validates the FUOTA deployment data.
+func (d FUOTADeployment) Validate() error {
+	if len(d.Payload) == 0 {
+		return ErrFUOTADeploymentInvalidPayload
+	}
+	// the DataFragment command adds 3 bytes of overhead
+	if d.FragSize <= 0 || d.FragSize > 242-3 {
+		return ErrFUOTADeploymentInvalidFragSize
+	}
+	// the fragment number is a 14 bit field
+	if (len(d.Payload)+d.FragSize-1)/d.FragSize+d.Redundancy >= 1<<14 || d.Redundancy < 0 {
+		return ErrFUOTADeploymentTooManyFragments
+	}
+	if d.MulticastTimeout < 0 || d.MulticastTimeout > 15 {
+		return ErrFUOTADeploymentInvalidMulticastTimeout
+	}
+	if d.UnicastTimeout <= 0 {
+		return ErrFUOTADeploymentInvalidUnicastTimeout
+	}
+	return nil
+}
+
+// CreateFUOTADeployment creates the given FUOTA deployment for the given
+// devices.
+func CreateFUOTADeployment(db sqlx.Ext, d *FUOTADeployment, devEUIs []lorawan.EUI64) error {
+	if err := d.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	if d.State == "" {
+		d.State = FUOTADeploymentMulticastGroupSetup
+	}
+	if d.NextStepAfter.IsZero() {
+		d.NextStepAfter = now
+	}
+
+	err := sqlx.Get(db, &d.ID, `
+		insert into fuota_deployment (
+			created_at,
+			updated_at,
+			application_id,
+			multicast_group_id,
+			name,
+			payload,
+			frag_size,
+			redundancy,
+			multicast_timeout,
+			unicast_timeout,
+			mc_key,
+			state,
+			next_step_after
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		returning id`,
+		now,
+		now,
+		d.ApplicationID,
+		d.MulticastGroupID,
+		d.Name,
+		d.Payload,
+		d.FragSize,
+		d.Redundancy,
+		d.MulticastTimeout,
+		d.UnicastTimeout,
+		d.McKey[:],
+		d.State,
+		d.NextStepAfter,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	d.CreatedAt = now
+	d.UpdatedAt = now
+
+	for _, devEUI := range devEUIs {
+		_, err := db.Exec(`
+			insert into fuota_deployment_device (
+				fuota_deployment_id,
+				dev_eui,
+				created_at,
+				updated_at,
+				state
+			) values ($1, $2, $3, $4, $5)`,
+			d.ID,
+			devEUI[:],
+			now,
+			now,
+			FUOTADeploymentDevicePending,
+		)
+		if err != nil {
+			return handlePSQLError(Insert, err, "insert error")
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"id":             d.ID,
+		"application_id": d.ApplicationID,
+		"devices":        len(devEUIs),
+	}).Info("fuota deployment created")
+	return nil
+}
+
+// GetFUOTADeployment returns the FUOTA deployment for the given id.
+func GetFUOTADeployment(db sqlx.Queryer, id int64) (FUOTADeployment, error) {
+	var d FUOTADeployment
+	err := sqlx.Get(db, &d, "select * from fuota_deployment where id = $1", id)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+	return d, nil
+}
+
+// GetFUOTADeploymentsForApplicationID returns the FUOTA deployments for
+// the given application id.
+func GetFUOTADeploymentsForApplicationID(db sqlx.Queryer, applicationID int64, limit, offset int) ([]FUOTADeployment, error) {
+	var deployments []FUOTADeployment
+	err := sqlx.Select(db, &deployments, `
+		select *
+		from fuota_deployment
+		where application_id = $1
+		order by created_at desc
+		limit $2
+		offset $3`,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return deployments, nil
+}
+
+// GetFUOTADeploymentCountForApplicationID returns the total number of
+// FUOTA deployments for the given application id.
+func GetFUOTADeploymentCountForApplicationID(db sqlx.Queryer, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from fuota_deployment
+		where application_id = $1`,
+		applicationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetPendingFUOTADeployments returns the given number of FUOTA deployments
+// for which the next step must be executed. The returned rows are locked,
+// this function must be called within a transaction. Rows locked by other
+// transactions are skipped.
+func GetPendingFUOTADeployments(db sqlx.Queryer, limit int) ([]FUOTADeployment, error) {
+	var deployments []FUOTADeployment
+	err := sqlx.Select(db, &deployments, `
+		select *
+		from fuota_deployment
+		where
+			state != $1
+			and next_step_after <= $2
+		order by next_step_after
+		limit $3
+		for update skip locked`,
+		FUOTADeploymentDone,
+		time.Now(),
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return deployments, nil
+}
+
+// GetPendingFUOTADeployment returns and locks the given FUOTA deployment,
+// when its next step is due. It returns ErrDoesNotExist when the deployment
+// is not (or no longer) pending, or when it is locked (e.g. by an other
+// application-server instance).
+func GetPendingFUOTADeployment(db sqlx.Queryer, id int64) (FUOTADeployment, error) {
+	var d FUOTADeployment
+	err := sqlx.Get(db, &d, `
+		select *
+		from fuota_deployment
+		where
+			id = $1
+			and state != $2
+			and next_step_after <= $3
+		for update skip locked`,
+		id,
+		FUOTADeploymentDone,
+		time.Now(),
+	)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+	return d, nil
+}
+
+// UpdateFUOTADeploymentState updates the state and next step of the given
+// FUOTA deployment.
+func UpdateFUOTADeploymentState(db sqlx.Execer, d *FUOTADeployment) error {
+	now := time.Now()
+
+	res, err := db.Exec(`
+		update fuota_deployment
+		set
+			updated_at = $2,
+			state = $3,
+			next_step_after = $4
+		where id = $1`,
+		d.ID,
+		now,
+		d.State,
+		d.NextStepAfter,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	d.UpdatedAt = now
+
+	log.WithFields(log.Fields{
+		"id":              d.ID,
+		"state":           d.State,
+		"next_step_after": d.NextStepAfter,
+	}).Info("fuota deployment state updated")
+	return nil
+}
+
+// GetFUOTADeploymentDevice returns the FUOTA deployment device for the
+// given deployment id and DevEUI.
+func GetFUOTADeploymentDevice(db sqlx.Queryer, fuotaDeploymentID int64, devEUI lorawan.EUI64) (FUOTADeploymentDevice, error) {
+	var d FUOTADeploymentDevice
+	err := sqlx.Get(db, &d, `
+		select *
+		from fuota_deployment_device
+		where
+			fuota_deployment_id = $1
+			and dev_eui = $2`,
+		fuotaDeploymentID,
+		devEUI[:],
+	)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+	return d, nil
+}
+
+// GetPendingFUOTADeploymentDevice returns the pending FUOTA deployment
+// device for the given DevEUI, of the most recent deployment which is not
+// yet done.
+func GetPendingFUOTADeploymentDevice(db sqlx.Queryer, devEUI lorawan.EUI64) (FUOTADeploymentDevice, error) {
+	var d FUOTADeploymentDevice
+	err := sqlx.Get(db, &d, `
+		select dd.*
+		from fuota_deployment_device dd
+		inner join fuota_deployment fd
+			on fd.id = dd.fuota_deployment_id
+		where
+			dd.dev_eui = $1
+			and dd.state = $2
+			and fd.state != $3
+		order by fd.created_at desc
+		limit 1`,
+		devEUI[:],
+		FUOTADeploymentDevicePending,
+		FUOTADeploymentDone,
+	)
+	if err != nil {
+		return d, handlePSQLError(Select, err, "select error")
+	}
+	return d, nil
+}
+
+// GetPendingFUOTADeploymentDevices returns all the pending devices of the
+// given FUOTA deployment.
+func GetPendingFUOTADeploymentDevices(db sqlx.Queryer, fuotaDeploymentID int64) ([]FUOTADeploymentDevice, error) {
+	var devices []FUOTADeploymentDevice
+	err := sqlx.Select(db, &devices, `
+		select *
+		from fuota_deployment_device
+		where
+			fuota_deployment_id = $1
+			and state = $2
+		order by dev_eui`,
+		fuotaDeploymentID,
+		FUOTADeploymentDevicePending,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devices, nil
+}
+
+// GetFUOTADeploymentDevices returns the devices (and their state) of the
+// given FUOTA deployment.
+func GetFUOTADeploymentDevices(db sqlx.Queryer, fuotaDeploymentID int64, limit, offset int) ([]FUOTADeploymentDeviceListItem, error) {
+	var devices []FUOTADeploymentDeviceListItem
+	err := sqlx.Select(db, &devices, `
+		select
+			dd.*,
+			d.name as device_name
+		from fuota_deployment_device dd
+		inner join device d
+			on d.dev_eui = dd.dev_eui
+		where
+			dd.fuota_deployment_id = $1
+		order by d.name
+		limit $2
+		offset $3`,
+		fuotaDeploymentID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devices, nil
+}
+
+// GetFUOTADeploymentDeviceCount returns the number of devices of the given
+// FUOTA deployment.
+func GetFUOTADeploymentDeviceCount(db sqlx.Queryer, fuotaDeploymentID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from fuota_deployment_device
+		where fuota_deployment_id = $1`,
+		fuotaDeploymentID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// UpdateFUOTADeploymentDevice updates the given FUOTA deployment device.
+func UpdateFUOTADeploymentDevice(db sqlx.Execer, d *FUOTADeploymentDevice) error {
+	now := time.Now()
+
+	res, err := db.Exec(`
+		update fuota_deployment_device
+		set
+			updated_at = $3,
+			state = $4,
+			error_message = $5,
+			mc_group_setup_completed_at = $6,
+			frag_session_setup_completed_at = $7,
+			mc_session_completed_at = $8,
+			frag_status_completed_at = $9,
+			nb_frag_received = $10,
+			missing_frag = $11
+		where
+			fuota_deployment_id = $1
+			and dev_eui = $2`,
+		d.FUOTADeploymentID,
+		d.DevEUI[:],
+		now,
+		d.State,
+		d.ErrorMessage,
+		d.McGroupSetupCompletedAt,
+		d.FragSessionSetupCompletedAt,
+		d.McSessionCompletedAt,
+		d.FragStatusCompletedAt,
+		d.NbFragReceived,
+		d.MissingFrag,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	d.UpdatedAt = now
+	return nil
+}
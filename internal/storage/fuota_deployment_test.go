@@ -0,0 +1,224 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/pkg/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestFUOTADeployment(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and an application with two devices", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		d1 := Device{
+			Name:            "test-device-1",
+			DevEUI:          lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(config.C.PostgreSQL.DB, &d1), ShouldBeNil)
+
+		d2 := Device{
+			Name:            "test-device-2",
+			DevEUI:          lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(config.C.PostgreSQL.DB, &d2), ShouldBeNil)
+
+		g := MulticastGroup{
+			ApplicationID: app.ID,
+			Name:          "test-group",
+			Frequency:     868100000,
+		}
+		So(CreateMulticastGroup(config.C.PostgreSQL.DB, &g), ShouldBeNil)
+
+		Convey("Then creating a FUOTA deployment without payload fails", func() {
+			d := FUOTADeployment{
+				ApplicationID:    app.ID,
+				MulticastGroupID: g.ID,
+				Name:             "test-deployment",
+				FragSize:         10,
+				UnicastTimeout:   60,
+			}
+			err := CreateFUOTADeployment(config.C.PostgreSQL.DB, &d, nil)
+			So(errors.Cause(err), ShouldEqual, ErrFUOTADeploymentInvalidPayload)
+		})
+
+		Convey("Then creating a FUOTA deployment with a too large fragment size fails", func() {
+			d := FUOTADeployment{
+				ApplicationID:    app.ID,
+				MulticastGroupID: g.ID,
+				Name:             "test-deployment",
+				Payload:          []byte{1, 2, 3},
+				FragSize:         240,
+				UnicastTimeout:   60,
+			}
+			err := CreateFUOTADeployment(config.C.PostgreSQL.DB, &d, nil)
+			So(errors.Cause(err), ShouldEqual, ErrFUOTADeploymentInvalidFragSize)
+		})
+
+		Convey("When creating a FUOTA deployment for both devices", func() {
+			d := FUOTADeployment{
+				ApplicationID:    app.ID,
+				MulticastGroupID: g.ID,
+				Name:             "test-deployment",
+				Payload:          []byte{1, 2, 3, 4, 5},
+				FragSize:         2,
+				Redundancy:       1,
+				MulticastTimeout: 5,
+				UnicastTimeout:   60,
+				McKey:            lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+			}
+			So(CreateFUOTADeployment(config.C.PostgreSQL.DB, &d, []lorawan.EUI64{d1.DevEUI, d2.DevEUI}), ShouldBeNil)
+			d.CreatedAt = d.CreatedAt.UTC().Truncate(time.Millisecond)
+			d.UpdatedAt = d.UpdatedAt.UTC().Truncate(time.Millisecond)
+			d.NextStepAfter = d.NextStepAfter.UTC().Truncate(time.Millisecond)
+
+			Convey("Then the deployment is in the multicast group setup state", func() {
+				So(d.State, ShouldEqual, FUOTADeploymentMulticastGroupSetup)
+			})
+
+			Convey("Then GetFUOTADeployment returns the deployment", func() {
+				dd, err := GetFUOTADeployment(config.C.PostgreSQL.DB, d.ID)
+				So(err, ShouldBeNil)
+				dd.CreatedAt = dd.CreatedAt.UTC().Truncate(time.Millisecond)
+				dd.UpdatedAt = dd.UpdatedAt.UTC().Truncate(time.Millisecond)
+				dd.NextStepAfter = dd.NextStepAfter.UTC().Truncate(time.Millisecond)
+				So(dd, ShouldResemble, d)
+			})
+
+			Convey("Then the deployments can be listed and counted for the application", func() {
+				count, err := GetFUOTADeploymentCountForApplicationID(config.C.PostgreSQL.DB, app.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				deployments, err := GetFUOTADeploymentsForApplicationID(config.C.PostgreSQL.DB, app.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(deployments, ShouldHaveLength, 1)
+				So(deployments[0].ID, ShouldEqual, d.ID)
+			})
+
+			Convey("Then GetPendingFUOTADeployments returns the deployment", func() {
+				deployments, err := GetPendingFUOTADeployments(config.C.PostgreSQL.DB, 10)
+				So(err, ShouldBeNil)
+				So(deployments, ShouldHaveLength, 1)
+				So(deployments[0].ID, ShouldEqual, d.ID)
+			})
+
+			Convey("Then the devices are pending", func() {
+				count, err := GetFUOTADeploymentDeviceCount(config.C.PostgreSQL.DB, d.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				devices, err := GetFUOTADeploymentDevices(config.C.PostgreSQL.DB, d.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(devices, ShouldHaveLength, 2)
+				So(devices[0].DeviceName, ShouldEqual, "test-device-1")
+				So(devices[0].State, ShouldEqual, FUOTADeploymentDevicePending)
+
+				pending, err := GetPendingFUOTADeploymentDevices(config.C.PostgreSQL.DB, d.ID)
+				So(err, ShouldBeNil)
+				So(pending, ShouldHaveLength, 2)
+
+				dev, err := GetPendingFUOTADeploymentDevice(config.C.PostgreSQL.DB, d1.DevEUI)
+				So(err, ShouldBeNil)
+				So(dev.FUOTADeploymentID, ShouldEqual, d.ID)
+			})
+
+			Convey("When updating the state of a device", func() {
+				dev, err := GetFUOTADeploymentDevice(config.C.PostgreSQL.DB, d.ID, d1.DevEUI)
+				So(err, ShouldBeNil)
+
+				now := time.Now().UTC().Truncate(time.Millisecond)
+				dev.State = FUOTADeploymentDeviceError
+				dev.ErrorMessage = "boom"
+				dev.McGroupSetupCompletedAt = &now
+				dev.NbFragReceived = 3
+				dev.MissingFrag = 1
+				So(UpdateFUOTADeploymentDevice(config.C.PostgreSQL.DB, &dev), ShouldBeNil)
+
+				Convey("Then the device has been updated", func() {
+					dev2, err := GetFUOTADeploymentDevice(config.C.PostgreSQL.DB, d.ID, d1.DevEUI)
+					So(err, ShouldBeNil)
+					So(dev2.State, ShouldEqual, FUOTADeploymentDeviceError)
+					So(dev2.ErrorMessage, ShouldEqual, "boom")
+					So(dev2.McGroupSetupCompletedAt.Equal(now), ShouldBeTrue)
+					So(dev2.NbFragReceived, ShouldEqual, 3)
+					So(dev2.MissingFrag, ShouldEqual, 1)
+				})
+
+				Convey("Then it is no longer returned as pending device", func() {
+					_, err := GetPendingFUOTADeploymentDevice(config.C.PostgreSQL.DB, d1.DevEUI)
+					So(err, ShouldEqual, ErrDoesNotExist)
+
+					pending, err := GetPendingFUOTADeploymentDevices(config.C.PostgreSQL.DB, d.ID)
+					So(err, ShouldBeNil)
+					So(pending, ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("When the deployment is done", func() {
+				d.State = FUOTADeploymentDone
+				So(UpdateFUOTADeploymentState(config.C.PostgreSQL.DB, &d), ShouldBeNil)
+
+				Convey("Then it is no longer pending", func() {
+					deployments, err := GetPendingFUOTADeployments(config.C.PostgreSQL.DB, 10)
+					So(err, ShouldBeNil)
+					So(deployments, ShouldHaveLength, 0)
+
+					_, err = GetPendingFUOTADeploymentDevice(config.C.PostgreSQL.DB, d1.DevEUI)
+					So(err, ShouldEqual, ErrDoesNotExist)
+				})
+			})
+		})
+	})
+}
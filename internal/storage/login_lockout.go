@@ -0,0 +1,290 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Login brute-force protection settings. A max. number of attempts of 0
+// disables the lockout for the username or ip address.
+var (
+	LoginMaxAttempts     = 5
+	LoginIPMaxAttempts   = 50
+	LoginAttemptsWindow  = 15 * time.Minute
+	LoginLockoutDuration = 15 * time.Minute
+	LoginDelay           = time.Second
+	LoginMaxDelay        = 8 * time.Second
+)
+
+// Password reset request limits, counted within the LoginAttemptsWindow.
+// A max. number of requests of 0 disables the limit for the e-mail address
+// or ip address.
+var (
+	PasswordResetMaxRequests   = 3
+	PasswordResetIPMaxRequests = 20
+)
+
+const (
+	loginAttemptsKeyTempl = "lora:as:login:attempts:%s:%s"
+	loginLockoutKeyPrefix = "lora:as:login:lockout:"
+	loginLockoutKeyTempl  = loginLockoutKeyPrefix + "%s:%s"
+
+	loginLockoutUsername = "username"
+	loginLockoutIP       = "ip"
+
+	passwordResetEmail = "password_reset_email"
+	passwordResetIP    = "password_reset_ip"
+)
+
+// LoginLockout defines a username or ip address which is locked out after
+// too many failed login attempts.
+type LoginLockout struct {
+	Username    string
+	IP          string
+	LockedUntil time.Time
+}
+
+// CheckLoginLockout returns ErrLoginLockedOut when the given username or
+// ip address is locked out. The ip address is ignored when empty.
+func CheckLoginLockout(p *redis.Pool, username, ip string) error {
+	c := p.Get()
+	defer c.Close()
+
+	keys := []interface{}{fmt.Sprintf(loginLockoutKeyTempl, loginLockoutUsername, username)}
+	if ip != "" {
+		keys = append(keys, fmt.Sprintf(loginLockoutKeyTempl, loginLockoutIP, ip))
+	}
+
+	count, err := redis.Int(c.Do("EXISTS", keys...))
+	if err != nil {
+		return errors.Wrap(err, "get lockout error")
+	}
+	if count > 0 {
+		return ErrLoginLockedOut
+	}
+	return nil
+}
+
+// RegisterFailedLogin registers a failed login attempt for the given
+// username and ip address and locks them out when the max. number of
+// attempts has been reached. It returns the delay to apply before
+// responding, which doubles with every failed attempt of the username.
+func RegisterFailedLogin(p *redis.Pool, username, ip string) (time.Duration, error) {
+	attempts, err := incrLoginAttempts(p, loginLockoutUsername, username, LoginMaxAttempts)
+	if err != nil {
+		return 0, err
+	}
+
+	if ip != "" {
+		if _, err := incrLoginAttempts(p, loginLockoutIP, ip, LoginIPMaxAttempts); err != nil {
+			return 0, err
+		}
+	}
+
+	delay := LoginDelay
+	for i := 1; i < attempts && delay < LoginMaxDelay; i++ {
+		delay = delay * 2
+	}
+	if delay > LoginMaxDelay {
+		delay = LoginMaxDelay
+	}
+
+	return delay, nil
+}
+
+// ResetFailedLogins resets the failed login attempts of the given username,
+// e.g. after a successful login. The attempts of the ip address are not
+// reset, as an attacker could otherwise reset these by logging in using
+// its own account.
+func ResetFailedLogins(p *redis.Pool, username string) error {
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("DEL", fmt.Sprintf(loginAttemptsKeyTempl, loginLockoutUsername, username)); err != nil {
+		return errors.Wrap(err, "delete attempts error")
+	}
+	return nil
+}
+
+// RegisterPasswordResetRequest registers a password reset request for the
+// given e-mail address and ip address. It returns ErrPasswordResetLimited
+// when the max. number of requests of the e-mail address or ip address has
+// been exceeded. The ip address is ignored when empty.
+func RegisterPasswordResetRequest(p *redis.Pool, email, ip string) error {
+	c := p.Get()
+	defer c.Close()
+
+	// the requests are counted regardless of the e-mail address being known,
+	// the response must not reveal the registered e-mail addresses
+	requests, err := incrAttempts(c, passwordResetEmail, strings.ToLower(email))
+	if err != nil {
+		return err
+	}
+	limited := PasswordResetMaxRequests != 0 && requests > PasswordResetMaxRequests
+
+	if ip != "" {
+		requests, err := incrAttempts(c, passwordResetIP, ip)
+		if err != nil {
+			return err
+		}
+		limited = limited || (PasswordResetIPMaxRequests != 0 && requests > PasswordResetIPMaxRequests)
+	}
+
+	if limited {
+		log.WithFields(log.Fields{
+			"email": email,
+			"ip":    ip,
+		}).Warning("password reset requests limited")
+		return ErrPasswordResetLimited
+	}
+	return nil
+}
+
+// GetLoginLockouts returns the usernames and ip addresses which are
+// currently locked out.
+func GetLoginLockouts(p *redis.Pool) ([]LoginLockout, error) {
+	c := p.Get()
+	defer c.Close()
+
+	var lockouts []LoginLockout
+	cursor := 0
+
+	for {
+		values, err := redis.Values(c.Do("SCAN", cursor, "MATCH", loginLockoutKeyPrefix+"*"))
+		if err != nil {
+			return nil, errors.Wrap(err, "scan lockouts error")
+		}
+		var keys []string
+		if _, err := redis.Scan(values, &cursor, &keys); err != nil {
+			return nil, errors.Wrap(err, "scan lockouts error")
+		}
+
+		for _, key := range keys {
+			ttl, err := redis.Int64(c.Do("PTTL", key))
+			if err != nil {
+				return nil, errors.Wrap(err, "get lockout ttl error")
+			}
+			// the lockout expired after the scan
+			if ttl < 0 {
+				continue
+			}
+
+			l := LoginLockout{
+				LockedUntil: time.Now().Add(time.Duration(ttl) * time.Millisecond),
+			}
+			// ip v6 addresses contain colons
+			parts := strings.SplitN(strings.TrimPrefix(key, loginLockoutKeyPrefix), ":", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			switch parts[0] {
+			case loginLockoutUsername:
+				l.Username = parts[1]
+			case loginLockoutIP:
+				l.IP = parts[1]
+			default:
+				continue
+			}
+			lockouts = append(lockouts, l)
+		}
+
+		if cursor == 0 {
+			break
+		}
+	}
+
+	return lockouts, nil
+}
+
+// DeleteLoginLockout clears the lockout and the failed login attempts of the
+// given username and / or ip address (leave empty to ignore).
+func DeleteLoginLockout(p *redis.Pool, username, ip string) error {
+	var keys []interface{}
+	if username != "" {
+		keys = append(keys,
+			fmt.Sprintf(loginLockoutKeyTempl, loginLockoutUsername, username),
+			fmt.Sprintf(loginAttemptsKeyTempl, loginLockoutUsername, username),
+		)
+	}
+	if ip != "" {
+		keys = append(keys,
+			fmt.Sprintf(loginLockoutKeyTempl, loginLockoutIP, ip),
+			fmt.Sprintf(loginAttemptsKeyTempl, loginLockoutIP, ip),
+		)
+	}
+	if len(keys) == 0 {
+		return ErrDoesNotExist
+	}
+
+	c := p.Get()
+	defer c.Close()
+
+	count, err := redis.Int(c.Do("DEL", keys...))
+	if err != nil {
+		return errors.Wrap(err, "delete lockout error")
+	}
+	if count == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"username": username,
+		"ip":       ip,
+	}).Info("login lockout cleared")
+	return nil
+}
+
+// incrLoginAttempts increments the failed login attempts of the given kind
+// (username or ip) and value and returns the number of attempts within the
+// window. It locks out the value when the max. number of attempts has been
+// reached.
+func incrLoginAttempts(p *redis.Pool, kind, value string, maxAttempts int) (int, error) {
+	c := p.Get()
+	defer c.Close()
+
+	attempts, err := incrAttempts(c, kind, value)
+	if err != nil {
+		return 0, err
+	}
+
+	if maxAttempts == 0 || attempts < maxAttempts {
+		return attempts, nil
+	}
+
+	_, err = c.Do("PSETEX", fmt.Sprintf(loginLockoutKeyTempl, kind, value), int64(LoginLockoutDuration/time.Millisecond), time.Now().Unix())
+	if err != nil {
+		return 0, errors.Wrap(err, "set lockout error")
+	}
+
+	log.WithFields(log.Fields{
+		kind:           value,
+		"attempts":     attempts,
+		"locked_until": time.Now().Add(LoginLockoutDuration),
+	}).Warning("login locked out after too many failed attempts")
+
+	return attempts, nil
+}
+
+// incrAttempts increments the attempts of the given kind and value and
+// returns the number of attempts within the LoginAttemptsWindow.
+func incrAttempts(c redis.Conn, kind, value string) (int, error) {
+	key := fmt.Sprintf(loginAttemptsKeyTempl, kind, value)
+
+	c.Send("MULTI")
+	c.Send("INCR", key)
+	c.Send("PEXPIRE", key, int64(LoginAttemptsWindow/time.Millisecond))
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return 0, errors.Wrap(err, "increment attempts error")
+	}
+	attempts, err := redis.Int(values[0], nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "increment attempts error")
+	}
+	return attempts, nil
+}
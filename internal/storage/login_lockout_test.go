@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestLoginLockout(t *testing.T) {
+	conf := test.GetConfig()
+	p := NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean Redis database and lockout settings", t, func() {
+		test.MustFlushRedis(p)
+
+		LoginMaxAttempts = 3
+		LoginIPMaxAttempts = 5
+		LoginDelay = time.Second
+		LoginMaxDelay = 3 * time.Second
+
+		Convey("Then the username and ip address are not locked out", func() {
+			So(CheckLoginLockout(p, "testuser", "127.0.0.1"), ShouldBeNil)
+		})
+
+		Convey("Then the delay doubles with every failed attempt, up to the max. delay", func() {
+			for _, exp := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second} {
+				delay, err := RegisterFailedLogin(p, "testuser", "")
+				So(err, ShouldBeNil)
+				So(delay, ShouldEqual, exp)
+			}
+		})
+
+		Convey("Then resetting the failed attempts resets the delay", func() {
+			for i := 0; i < 2; i++ {
+				_, err := RegisterFailedLogin(p, "testuser", "")
+				So(err, ShouldBeNil)
+			}
+			So(ResetFailedLogins(p, "testuser"), ShouldBeNil)
+
+			delay, err := RegisterFailedLogin(p, "testuser", "")
+			So(err, ShouldBeNil)
+			So(delay, ShouldEqual, time.Second)
+		})
+
+		Convey("When registering the max. number of failed attempts for the username", func() {
+			for i := 0; i < LoginMaxAttempts; i++ {
+				_, err := RegisterFailedLogin(p, "testuser", "127.0.0.1")
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the username is locked out", func() {
+				So(CheckLoginLockout(p, "testuser", ""), ShouldEqual, ErrLoginLockedOut)
+				So(CheckLoginLockout(p, "otheruser", "127.0.0.1"), ShouldBeNil)
+
+				lockouts, err := GetLoginLockouts(p)
+				So(err, ShouldBeNil)
+				So(lockouts, ShouldHaveLength, 1)
+				So(lockouts[0].Username, ShouldEqual, "testuser")
+				So(lockouts[0].LockedUntil, ShouldHappenAfter, time.Now())
+			})
+
+			Convey("Then the lockout can be cleared", func() {
+				So(DeleteLoginLockout(p, "testuser", ""), ShouldBeNil)
+				So(CheckLoginLockout(p, "testuser", ""), ShouldBeNil)
+				So(DeleteLoginLockout(p, "testuser", ""), ShouldEqual, ErrDoesNotExist)
+			})
+		})
+
+		Convey("When registering the max. number of failed attempts for the ip address", func() {
+			for i := 0; i < LoginIPMaxAttempts; i++ {
+				_, err := RegisterFailedLogin(p, "user", "::1")
+				So(err, ShouldBeNil)
+				So(ResetFailedLogins(p, "user"), ShouldBeNil)
+			}
+
+			Convey("Then the ip address is locked out for all usernames", func() {
+				So(CheckLoginLockout(p, "otheruser", "::1"), ShouldEqual, ErrLoginLockedOut)
+
+				lockouts, err := GetLoginLockouts(p)
+				So(err, ShouldBeNil)
+				So(lockouts, ShouldHaveLength, 1)
+				So(lockouts[0].IP, ShouldEqual, "::1")
+			})
+		})
+
+		Convey("Given password reset request limits", func() {
+			PasswordResetMaxRequests = 2
+			PasswordResetIPMaxRequests = 3
+
+			Convey("Then the requests for an e-mail address are limited", func() {
+				So(RegisterPasswordResetRequest(p, "foo@example.com", ""), ShouldBeNil)
+				So(RegisterPasswordResetRequest(p, "Foo@example.com", ""), ShouldBeNil)
+				So(RegisterPasswordResetRequest(p, "foo@example.com", ""), ShouldEqual, ErrPasswordResetLimited)
+				So(RegisterPasswordResetRequest(p, "bar@example.com", ""), ShouldBeNil)
+			})
+
+			Convey("Then the requests from an ip address are limited", func() {
+				So(RegisterPasswordResetRequest(p, "a@example.com", "::1"), ShouldBeNil)
+				So(RegisterPasswordResetRequest(p, "b@example.com", "::1"), ShouldBeNil)
+				So(RegisterPasswordResetRequest(p, "c@example.com", "::1"), ShouldBeNil)
+				So(RegisterPasswordResetRequest(p, "d@example.com", "::1"), ShouldEqual, ErrPasswordResetLimited)
+				So(RegisterPasswordResetRequest(p, "d@example.com", "127.0.0.1"), ShouldBeNil)
+			})
+
+			Convey("Then the requests are not shown as login lockouts", func() {
+				So(RegisterPasswordResetRequest(p, "foo@example.com", "::1"), ShouldBeNil)
+				lockouts, err := GetLoginLockouts(p)
+				So(err, ShouldBeNil)
+				So(lockouts, ShouldHaveLength, 0)
+			})
+		})
+	})
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"github.com/garyburd/redigo/redis"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gusseleet/lora-app-server/internal/common"
+)
+
+// RegisterPoolMetrics registers the PostgreSQL and Redis connection pool
+// gauges on the default Prometheus registry.
+func RegisterPoolMetrics(db *common.DBLogger, p *redis.Pool) error {
+	gauges := []prometheus.GaugeFunc{
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "lora_app_server_postgresql_open_connections",
+			Help: "The number of open PostgreSQL connections (in use and idle).",
+		}, func() float64 {
+			return float64(db.Stats().OpenConnections)
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "lora_app_server_redis_active_connections",
+			Help: "The number of Redis connections of the pool (in use and idle).",
+		}, func() float64 {
+			return float64(p.ActiveCount())
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "lora_app_server_redis_idle_connections",
+			Help: "The number of idle Redis connections of the pool.",
+		}, func() float64 {
+			return float64(p.IdleCount())
+		}),
+	}
+
+	for _, g := range gauges {
+		if err := prometheus.Register(g); err != nil {
+			return errors.Wrap(err, "register metric error")
+		}
+	}
+
+	return nil
+}
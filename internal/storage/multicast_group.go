@@ -0,0 +1,322 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/brocaar/lorawan"
+)
+
+// MulticastGroup defines a LoRaWAN multicast group. All devices within the
+// group share the same multicast address, session keys and frame-counter.
+type MulticastGroup struct {
+	ID            int64             `db:"id"`
+	CreatedAt     time.Time         `db:"created_at"`
+	UpdatedAt     time.Time         `db:"updated_at"`
+	ApplicationID int64             `db:"application_id"`
+	Name          string            `db:"name"`
+	McAddr        lorawan.DevAddr   `db:"mc_addr"`
+	McAppSKey     lorawan.AES128Key `db:"mc_app_s_key"`
+	McNwkSKey     lorawan.AES128Key `db:"mc_nwk_s_key"`
+	FCnt          uint32            `db:"f_cnt"`
+	Frequency     int               `db:"frequency"`
+	DR            int               `db:"dr"`
+}
+
+// MulticastGroupDevice defines a device which is member of a multicast
+// group.
+type MulticastGroupDevice struct {
+	MulticastGroupID int64         `db:"multicast_group_id"`
+	DevEUI           lorawan.EUI64 `db:"dev_eui"`
+	CreatedAt        time.Time     `db:"created_at"`
+	DeviceName       string        `db:"device_name"`
+}
+
+// Validate validates the multicast group data.
+func (g MulticastGroup) Validate() error {
+	if g.Frequency <= 0 {
+		return ErrMulticastGroupInvalidFrequency
+	}
+	if g.DR < 0 || g.DR > 15 {
+		return ErrMulticastGroupInvalidDR
+	}
+	return nil
+}
+
+// CreateMulticastGroup creates the given multicast group.
+func CreateMulticastGroup(db sqlx.Queryer, g *MulticastGroup) error {
+	if err := g.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+
+	err := sqlx.Get(db, &g.ID, `
+		insert into multicast_group (
+			created_at,
+			updated_at,
+			application_id,
+			name,
+			mc_addr,
+			mc_app_s_key,
+			mc_nwk_s_key,
+			f_cnt,
+			frequency,
+			dr
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		returning id`,
+		now,
+		now,
+		g.ApplicationID,
+		g.Name,
+		g.McAddr[:],
+		g.McAppSKey[:],
+		g.McNwkSKey[:],
+		g.FCnt,
+		g.Frequency,
+		g.DR,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	g.CreatedAt = now
+	g.UpdatedAt = now
+
+	log.WithFields(log.Fields{
+		"id":             g.ID,
+		"application_id": g.ApplicationID,
+		"mc_addr":        g.McAddr,
+	}).Info("multicast group created")
+	return nil
+}
+
+// GetMulticastGroup returns the multicast group for the given id.
+func GetMulticastGroup(db sqlx.Queryer, id int64) (MulticastGroup, error) {
+	var g MulticastGroup
+	err := sqlx.Get(db, &g, "select * from multicast_group where id = $1", id)
+	if err != nil {
+		return g, handlePSQLError(Select, err, "select error")
+	}
+	return g, nil
+}
+
+// GetMulticastGroupsForApplicationID returns the multicast groups for the
+// given application id.
+func GetMulticastGroupsForApplicationID(db sqlx.Queryer, applicationID int64, limit, offset int) ([]MulticastGroup, error) {
+	var groups []MulticastGroup
+	err := sqlx.Select(db, &groups, `
+		select *
+		from multicast_group
+		where application_id = $1
+		order by name
+		limit $2
+		offset $3`,
+		applicationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return groups, nil
+}
+
+// GetMulticastGroupCountForApplicationID returns the total number of
+// multicast groups for the given application id.
+func GetMulticastGroupCountForApplicationID(db sqlx.Queryer, applicationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from multicast_group
+		where application_id = $1`,
+		applicationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// UpdateMulticastGroup updates the given multicast group.
+func UpdateMulticastGroup(db sqlx.Execer, g *MulticastGroup) error {
+	if err := g.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+
+	res, err := db.Exec(`
+		update multicast_group
+		set
+			updated_at = $2,
+			name = $3,
+			mc_addr = $4,
+			mc_app_s_key = $5,
+			mc_nwk_s_key = $6,
+			f_cnt = $7,
+			frequency = $8,
+			dr = $9
+		where id = $1`,
+		g.ID,
+		now,
+		g.Name,
+		g.McAddr[:],
+		g.McAppSKey[:],
+		g.McNwkSKey[:],
+		g.FCnt,
+		g.Frequency,
+		g.DR,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	g.UpdatedAt = now
+
+	log.WithFields(log.Fields{
+		"id":      g.ID,
+		"mc_addr": g.McAddr,
+	}).Info("multicast group updated")
+	return nil
+}
+
+// IncrementMulticastGroupFCnt increments the frame-counter of the multicast
+// group matching the given id. It returns the frame-counter value to use
+// for the next multicast downlink.
+func IncrementMulticastGroupFCnt(db sqlx.Queryer, id int64) (uint32, error) {
+	var fCnt uint32
+	err := sqlx.Get(db, &fCnt, `
+		update multicast_group
+		set f_cnt = f_cnt + 1
+		where id = $1
+		returning f_cnt - 1`,
+		id,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Update, err, "update error")
+	}
+	return fCnt, nil
+}
+
+// DeleteMulticastGroup deletes the multicast group matching the given id.
+func DeleteMulticastGroup(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from multicast_group where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("multicast group deleted")
+	return nil
+}
+
+// AddDeviceToMulticastGroup adds the given device to the multicast group.
+func AddDeviceToMulticastGroup(db sqlx.Execer, multicastGroupID int64, devEUI lorawan.EUI64) error {
+	_, err := db.Exec(`
+		insert into multicast_group_device (
+			multicast_group_id,
+			dev_eui,
+			created_at
+		) values ($1, $2, $3)`,
+		multicastGroupID,
+		devEUI[:],
+		time.Now(),
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"multicast_group_id": multicastGroupID,
+		"dev_eui":            devEUI,
+	}).Info("device added to multicast group")
+	return nil
+}
+
+// RemoveDeviceFromMulticastGroup removes the given device from the
+// multicast group.
+func RemoveDeviceFromMulticastGroup(db sqlx.Execer, multicastGroupID int64, devEUI lorawan.EUI64) error {
+	res, err := db.Exec(`
+		delete from multicast_group_device
+		where
+			multicast_group_id = $1
+			and dev_eui = $2`,
+		multicastGroupID,
+		devEUI[:],
+	)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"multicast_group_id": multicastGroupID,
+		"dev_eui":            devEUI,
+	}).Info("device removed from multicast group")
+	return nil
+}
+
+// GetMulticastGroupDevices returns the devices of the given multicast
+// group.
+func GetMulticastGroupDevices(db sqlx.Queryer, multicastGroupID int64, limit, offset int) ([]MulticastGroupDevice, error) {
+	var devices []MulticastGroupDevice
+	err := sqlx.Select(db, &devices, `
+		select
+			mgd.*,
+			d.name as device_name
+		from multicast_group_device mgd
+		inner join device d
+			on d.dev_eui = mgd.dev_eui
+		where
+			mgd.multicast_group_id = $1
+		order by d.name
+		limit $2
+		offset $3`,
+		multicastGroupID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return devices, nil
+}
+
+// GetMulticastGroupDeviceCount returns the number of devices of the given
+// multicast group.
+func GetMulticastGroupDeviceCount(db sqlx.Queryer, multicastGroupID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from multicast_group_device
+		where multicast_group_id = $1`,
+		multicastGroupID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
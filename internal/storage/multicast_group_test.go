@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/brocaar/lorawan"
+	"github.com/pkg/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestMulticastGroup(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database and an application with two devices", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(config.C.PostgreSQL.DB, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(config.C.PostgreSQL.DB, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(config.C.PostgreSQL.DB, &sp), ShouldBeNil)
+
+		dp := DeviceProfile{
+			NetworkServerID: n.ID,
+			OrganizationID:  org.ID,
+			Name:            "test-dp",
+		}
+		So(CreateDeviceProfile(config.C.PostgreSQL.DB, &dp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(config.C.PostgreSQL.DB, &app), ShouldBeNil)
+
+		d1 := Device{
+			Name:            "test-device-1",
+			DevEUI:          lorawan.EUI64{1, 1, 1, 1, 1, 1, 1, 1},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(config.C.PostgreSQL.DB, &d1), ShouldBeNil)
+
+		d2 := Device{
+			Name:            "test-device-2",
+			DevEUI:          lorawan.EUI64{2, 2, 2, 2, 2, 2, 2, 2},
+			ApplicationID:   app.ID,
+			DeviceProfileID: dp.DeviceProfile.DeviceProfileID,
+		}
+		So(CreateDevice(config.C.PostgreSQL.DB, &d2), ShouldBeNil)
+
+		Convey("Then creating a multicast group without frequency fails", func() {
+			g := MulticastGroup{
+				ApplicationID: app.ID,
+				Name:          "test-group",
+			}
+			err := CreateMulticastGroup(config.C.PostgreSQL.DB, &g)
+			So(errors.Cause(err), ShouldEqual, ErrMulticastGroupInvalidFrequency)
+		})
+
+		Convey("When creating a multicast group", func() {
+			g := MulticastGroup{
+				ApplicationID: app.ID,
+				Name:          "test-group",
+				McAddr:        lorawan.DevAddr{1, 2, 3, 4},
+				McAppSKey:     lorawan.AES128Key{1, 2, 3, 4, 5, 6, 7, 8, 1, 2, 3, 4, 5, 6, 7, 8},
+				McNwkSKey:     lorawan.AES128Key{8, 7, 6, 5, 4, 3, 2, 1, 8, 7, 6, 5, 4, 3, 2, 1},
+				FCnt:          10,
+				Frequency:     869525000,
+				DR:            3,
+			}
+			So(CreateMulticastGroup(config.C.PostgreSQL.DB, &g), ShouldBeNil)
+
+			Convey("Then GetMulticastGroup returns the multicast group", func() {
+				gGet, err := GetMulticastGroup(config.C.PostgreSQL.DB, g.ID)
+				So(err, ShouldBeNil)
+				So(gGet.Name, ShouldEqual, g.Name)
+				So(gGet.McAddr, ShouldEqual, g.McAddr)
+				So(gGet.McAppSKey, ShouldEqual, g.McAppSKey)
+				So(gGet.McNwkSKey, ShouldEqual, g.McNwkSKey)
+				So(gGet.FCnt, ShouldEqual, g.FCnt)
+				So(gGet.Frequency, ShouldEqual, g.Frequency)
+				So(gGet.DR, ShouldEqual, g.DR)
+			})
+
+			Convey("Then the multicast group is listed for the application", func() {
+				count, err := GetMulticastGroupCountForApplicationID(config.C.PostgreSQL.DB, app.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				groups, err := GetMulticastGroupsForApplicationID(config.C.PostgreSQL.DB, app.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(groups, ShouldHaveLength, 1)
+				So(groups[0].ID, ShouldEqual, g.ID)
+			})
+
+			Convey("Then IncrementMulticastGroupFCnt returns the frame-counter to use and increments it", func() {
+				fCnt, err := IncrementMulticastGroupFCnt(config.C.PostgreSQL.DB, g.ID)
+				So(err, ShouldBeNil)
+				So(fCnt, ShouldEqual, 10)
+
+				gGet, err := GetMulticastGroup(config.C.PostgreSQL.DB, g.ID)
+				So(err, ShouldBeNil)
+				So(gGet.FCnt, ShouldEqual, 11)
+			})
+
+			Convey("Then the multicast group can be updated", func() {
+				g.Name = "updated-group"
+				g.McAddr = lorawan.DevAddr{4, 3, 2, 1}
+				g.DR = 5
+				So(UpdateMulticastGroup(config.C.PostgreSQL.DB, &g), ShouldBeNil)
+
+				gGet, err := GetMulticastGroup(config.C.PostgreSQL.DB, g.ID)
+				So(err, ShouldBeNil)
+				So(gGet.Name, ShouldEqual, "updated-group")
+				So(gGet.McAddr, ShouldEqual, lorawan.DevAddr{4, 3, 2, 1})
+				So(gGet.DR, ShouldEqual, 5)
+			})
+
+			Convey("When adding the devices to the multicast group", func() {
+				So(AddDeviceToMulticastGroup(config.C.PostgreSQL.DB, g.ID, d1.DevEUI), ShouldBeNil)
+				So(AddDeviceToMulticastGroup(config.C.PostgreSQL.DB, g.ID, d2.DevEUI), ShouldBeNil)
+
+				Convey("Then adding the same device twice fails", func() {
+					err := AddDeviceToMulticastGroup(config.C.PostgreSQL.DB, g.ID, d1.DevEUI)
+					So(errors.Cause(err), ShouldEqual, ErrAlreadyExists)
+				})
+
+				Convey("Then the devices are listed", func() {
+					count, err := GetMulticastGroupDeviceCount(config.C.PostgreSQL.DB, g.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 2)
+
+					devices, err := GetMulticastGroupDevices(config.C.PostgreSQL.DB, g.ID, 10, 0)
+					So(err, ShouldBeNil)
+					So(devices, ShouldHaveLength, 2)
+					So(devices[0].DevEUI, ShouldEqual, d1.DevEUI)
+					So(devices[0].DeviceName, ShouldEqual, d1.Name)
+					So(devices[1].DevEUI, ShouldEqual, d2.DevEUI)
+				})
+
+				Convey("When removing a device from the multicast group", func() {
+					So(RemoveDeviceFromMulticastGroup(config.C.PostgreSQL.DB, g.ID, d1.DevEUI), ShouldBeNil)
+
+					Convey("Then only the other device is listed", func() {
+						devices, err := GetMulticastGroupDevices(config.C.PostgreSQL.DB, g.ID, 10, 0)
+						So(err, ShouldBeNil)
+						So(devices, ShouldHaveLength, 1)
+						So(devices[0].DevEUI, ShouldEqual, d2.DevEUI)
+					})
+
+					Convey("Then removing it again returns an error", func() {
+						err := RemoveDeviceFromMulticastGroup(config.C.PostgreSQL.DB, g.ID, d1.DevEUI)
+						So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+					})
+				})
+			})
+
+			Convey("When deleting the multicast group", func() {
+				So(DeleteMulticastGroup(config.C.PostgreSQL.DB, g.ID), ShouldBeNil)
+
+				Convey("Then the multicast group has been deleted", func() {
+					_, err := GetMulticastGroup(config.C.PostgreSQL.DB, g.ID)
+					So(errors.Cause(err), ShouldEqual, ErrDoesNotExist)
+				})
+			})
+		})
+	})
+}
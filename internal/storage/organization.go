@@ -13,12 +13,14 @@ var organizationNameRegexp = regexp.MustCompile(`^[\w-]+$`)
 
 // Organization represents an organization.
 type Organization struct {
-	ID              int64     `db:"id"`
-	CreatedAt       time.Time `db:"created_at"`
-	UpdatedAt       time.Time `db:"updated_at"`
-	Name            string    `db:"name"`
-	DisplayName     string    `db:"display_name"`
-	CanHaveGateways bool      `db:"can_have_gateways"`
+	OrganizationQuota
+	ID                  int64     `db:"id"`
+	CreatedAt           time.Time `db:"created_at"`
+	UpdatedAt           time.Time `db:"updated_at"`
+	Name                string    `db:"name"`
+	DisplayName         string    `db:"display_name"`
+	CanHaveGateways     bool      `db:"can_have_gateways"`
+	RequireSecondFactor bool      `db:"require_second_factor"`
 }
 
 // Validate validates the data of the Organization.
@@ -26,11 +28,25 @@ func (o Organization) Validate() error {
 	if !organizationNameRegexp.MatchString(o.Name) {
 		return ErrOrganizationInvalidName
 	}
-	return nil
+	return o.OrganizationQuota.Validate()
+}
+
+// Roles defines the roles of an organization or application user, next to
+// the admin flag. The device, gateway and integration admin roles grant
+// write access to these resources. The viewer role revokes the write access
+// which members have by default (e.g. enqueueing downlinks) and the access
+// to the device activation. Gateways are organization resources, the
+// gateway admin role can not be assigned to application users.
+type Roles struct {
+	IsDeviceAdmin      bool `db:"is_device_admin"`
+	IsGatewayAdmin     bool `db:"is_gateway_admin"`
+	IsIntegrationAdmin bool `db:"is_integration_admin"`
+	IsViewer           bool `db:"is_viewer"`
 }
 
 // OrganizationUser represents an organization user.
 type OrganizationUser struct {
+	Roles
 	UserID    int64     `db:"user_id"`
 	Username  string    `db:"username"`
 	IsAdmin   bool      `db:"is_admin"`
@@ -52,13 +68,27 @@ func CreateOrganization(db sqlx.Queryer, org *Organization) error {
 			updated_at,
 			name,
 			display_name,
-			can_have_gateways
-		) values ($1, $2, $3, $4, $5) returning id`,
+			can_have_gateways,
+			require_second_factor,
+			max_applications,
+			max_devices,
+			max_gateways,
+			max_device_profiles,
+			max_integrations,
+			max_uplinks_per_day
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) returning id`,
 		now,
 		now,
 		org.Name,
 		org.DisplayName,
 		org.CanHaveGateways,
+		org.RequireSecondFactor,
+		org.MaxApplications,
+		org.MaxDevices,
+		org.MaxGateways,
+		org.MaxDeviceProfiles,
+		org.MaxIntegrations,
+		org.MaxUplinksPerDay,
 	)
 	if err != nil {
 		return handlePSQLError(Insert, err, "insert error")
@@ -105,7 +135,7 @@ func GetOrganizationCount(db sqlx.Queryer, search string) (int, error) {
 }
 
 // GetOrganizationCountForUser returns the number of organizations to which
-// the given user is member of.
+// the given user is member of, directly or through one of its applications.
 func GetOrganizationCountForUser(db sqlx.Queryer, username string, search string) (int, error) {
 	var count int
 
@@ -117,12 +147,13 @@ func GetOrganizationCountForUser(db sqlx.Queryer, username string, search string
 		select
 			count(o.*)
 		from organization o
-		inner join organization_user ou
-			on ou.organization_id = o.id
 		inner join "user" u
-			on u.id = ou.user_id
+			on u.username = $1
 		where
-			u.username = $1
+			(
+				o.id in (select organization_id from organization_user where user_id = u.id)
+				or o.id in (select a.organization_id from application a inner join application_user au on au.application_id = a.id where au.user_id = u.id)
+			)
 			and (
 				($2 != '' and o.display_name ilike $2)
 				or ($2 = '')
@@ -160,7 +191,7 @@ func GetOrganizations(db sqlx.Queryer, limit, offset int, search string) ([]Orga
 }
 
 // GetOrganizationsForUser returns a slice of organizations to which the given
-// user is member of.
+// user is member of, directly or through one of its applications.
 func GetOrganizationsForUser(db sqlx.Queryer, username string, limit, offset int, search string) ([]Organization, error) {
 	var orgs []Organization
 
@@ -172,12 +203,13 @@ func GetOrganizationsForUser(db sqlx.Queryer, username string, limit, offset int
 		select
 			o.*
 		from organization o
-		inner join organization_user ou
-			on ou.organization_id = o.id
 		inner join "user" u
-			on u.id = ou.user_id
+			on u.username = $1
 		where
-			u.username = $1
+			(
+				o.id in (select organization_id from organization_user where user_id = u.id)
+				or o.id in (select a.organization_id from application a inner join application_user au on au.application_id = a.id where au.user_id = u.id)
+			)
 			and (
 				($4 != '' and o.display_name ilike $4)
 				or ($4 = '')
@@ -208,13 +240,27 @@ func UpdateOrganization(db sqlx.Execer, org *Organization) error {
 			name = $2,
 			display_name = $3,
 			can_have_gateways = $4,
-			updated_at = $5
+			updated_at = $5,
+			require_second_factor = $6,
+			max_applications = $7,
+			max_devices = $8,
+			max_gateways = $9,
+			max_device_profiles = $10,
+			max_integrations = $11,
+			max_uplinks_per_day = $12
 		where id = $1`,
 		org.ID,
 		org.Name,
 		org.DisplayName,
 		org.CanHaveGateways,
 		now,
+		org.RequireSecondFactor,
+		org.MaxApplications,
+		org.MaxDevices,
+		org.MaxGateways,
+		org.MaxDeviceProfiles,
+		org.MaxIntegrations,
+		org.MaxUplinksPerDay,
 	)
 
 	if err != nil {
@@ -324,6 +370,49 @@ func UpdateOrganizationUser(db sqlx.Execer, organizationID, userID int64, isAdmi
 	return nil
 }
 
+// UpdateOrganizationUserRoles updates the roles of the given user of the
+// organization.
+func UpdateOrganizationUserRoles(db sqlx.Execer, organizationID, userID int64, roles Roles) error {
+	res, err := db.Exec(`
+		update organization_user
+		set
+			is_device_admin = $3,
+			is_gateway_admin = $4,
+			is_integration_admin = $5,
+			is_viewer = $6,
+			updated_at = now()
+		where
+			organization_id = $1
+			and user_id = $2`,
+		organizationID,
+		userID,
+		roles.IsDeviceAdmin,
+		roles.IsGatewayAdmin,
+		roles.IsIntegrationAdmin,
+		roles.IsViewer,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithFields(log.Fields{
+		"user_id":              userID,
+		"organization_id":      organizationID,
+		"is_device_admin":      roles.IsDeviceAdmin,
+		"is_gateway_admin":     roles.IsGatewayAdmin,
+		"is_integration_admin": roles.IsIntegrationAdmin,
+		"is_viewer":            roles.IsViewer,
+	}).Info("organization user roles updated")
+	return nil
+}
+
 // DeleteOrganizationUser deletes the given organization user.
 func DeleteOrganizationUser(db sqlx.Execer, organizationID, userID int64) error {
 	res, err := db.Exec(`delete from organization_user where organization_id = $1 and user_id = $2`, organizationID, userID)
@@ -354,7 +443,11 @@ func GetOrganizationUser(db sqlx.Queryer, organizationID, userID int64) (Organiz
 			u.username as username,
 			ou.created_at as created_at,
 			ou.updated_at as updated_at,
-			ou.is_admin as is_admin
+			ou.is_admin as is_admin,
+			ou.is_device_admin as is_device_admin,
+			ou.is_gateway_admin as is_gateway_admin,
+			ou.is_integration_admin as is_integration_admin,
+			ou.is_viewer as is_viewer
 		from organization_user ou
 		inner join "user" u
 			on u.id = ou.user_id
@@ -370,6 +463,24 @@ func GetOrganizationUser(db sqlx.Queryer, organizationID, userID int64) (Organiz
 	return u, nil
 }
 
+// GetOrganizationIDsForUser returns the IDs of the organizations the given
+// user is a member of.
+func GetOrganizationIDsForUser(db sqlx.Queryer, userID int64) ([]int64, error) {
+	var ids []int64
+	err := sqlx.Select(db, &ids, `
+		select organization_id
+		from organization_user
+		where
+			user_id = $1
+		order by organization_id`,
+		userID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return ids, nil
+}
+
 // GetOrganizationUserCount returns the number of users for the given organization.
 func GetOrganizationUserCount(db sqlx.Queryer, organizationID int64) (int, error) {
 	var count int
@@ -395,7 +506,11 @@ func GetOrganizationUsers(db sqlx.Queryer, organizationID int64, limit, offset i
 			u.username as username,
 			ou.created_at as created_at,
 			ou.updated_at as updated_at,
-			ou.is_admin as is_admin
+			ou.is_admin as is_admin,
+			ou.is_device_admin as is_device_admin,
+			ou.is_gateway_admin as is_gateway_admin,
+			ou.is_integration_admin as is_integration_admin,
+			ou.is_viewer as is_viewer
 		from organization_user ou
 		inner join "user" u
 			on u.id = ou.user_id
@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// OrganizationIntegration represents an integration which is used by all
+// applications of the organization, except for the applications which
+// disabled the organization integrations.
+type OrganizationIntegration struct {
+	ID             int64           `db:"id"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+	OrganizationID int64           `db:"organization_id"`
+	Kind           string          `db:"kind"`
+	Settings       json.RawMessage `db:"settings"`
+}
+
+// CreateOrganizationIntegration creates the given OrganizationIntegration.
+func CreateOrganizationIntegration(db sqlx.Queryer, i *OrganizationIntegration) error {
+	now := time.Now()
+	err := sqlx.Get(db, &i.ID, `
+		insert into organization_integration (
+			created_at,
+			updated_at,
+			organization_id,
+			kind,
+			settings
+		) values ($1, $2, $3, $4, $5) returning id`,
+		now,
+		now,
+		i.OrganizationID,
+		i.Kind,
+		i.Settings,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	i.CreatedAt = now
+	i.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":              i.ID,
+		"kind":            i.Kind,
+		"organization_id": i.OrganizationID,
+	}).Info("organization integration created")
+	return nil
+}
+
+// GetOrganizationIntegrationByOrganizationID returns the
+// OrganizationIntegration for the given organization id and kind.
+func GetOrganizationIntegrationByOrganizationID(db sqlx.Queryer, organizationID int64, kind string) (OrganizationIntegration, error) {
+	var i OrganizationIntegration
+	err := sqlx.Get(db, &i, "select * from organization_integration where organization_id = $1 and kind = $2", organizationID, kind)
+	if err != nil {
+		return i, handlePSQLError(Select, err, "select error")
+	}
+	return i, nil
+}
+
+// GetOrganizationIntegrationsForOrganizationID returns the integrations for
+// the given organization id.
+func GetOrganizationIntegrationsForOrganizationID(db sqlx.Queryer, organizationID int64) ([]OrganizationIntegration, error) {
+	var is []OrganizationIntegration
+	err := sqlx.Select(db, &is, `
+		select *
+		from organization_integration
+		where organization_id = $1
+		order by kind`,
+		organizationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return is, nil
+}
+
+// GetOrganizationIntegrationsForApplicationID returns the organization
+// integrations which apply to the given application id. No integrations
+// are returned when the application disabled the organization
+// integrations.
+func GetOrganizationIntegrationsForApplicationID(db sqlx.Queryer, applicationID int64) ([]OrganizationIntegration, error) {
+	var is []OrganizationIntegration
+	err := sqlx.Select(db, &is, `
+		select oi.*
+		from organization_integration oi
+		inner join application a
+			on a.organization_id = oi.organization_id
+		where
+			a.id = $1
+			and a.disable_organization_integrations = false
+		order by oi.kind`,
+		applicationID,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return is, nil
+}
+
+// UpdateOrganizationIntegration updates the given OrganizationIntegration.
+func UpdateOrganizationIntegration(db sqlx.Execer, i *OrganizationIntegration) error {
+	now := time.Now()
+	res, err := db.Exec(`
+		update organization_integration
+		set
+			updated_at = $2,
+			kind = $3,
+			settings = $4
+		where
+			id = $1`,
+		i.ID,
+		now,
+		i.Kind,
+		i.Settings,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	i.UpdatedAt = now
+	log.WithFields(log.Fields{
+		"id":              i.ID,
+		"kind":            i.Kind,
+		"organization_id": i.OrganizationID,
+	}).Info("organization integration updated")
+	return nil
+}
+
+// DeleteOrganizationIntegration deletes the organization integration
+// matching the given id.
+func DeleteOrganizationIntegration(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from organization_integration where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("organization integration deleted")
+	return nil
+}
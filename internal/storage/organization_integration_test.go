@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestOrganizationIntegration(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+
+	Convey("Given a clean database with an organization, network-server, service-profile and two applications", t, func() {
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(db, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+			Name:            "test-sp",
+		}
+		So(CreateServiceProfile(db, &sp), ShouldBeNil)
+
+		app1 := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app-1",
+		}
+		So(CreateApplication(db, &app1), ShouldBeNil)
+
+		app2 := Application{
+			OrganizationID:                  org.ID,
+			ServiceProfileID:                sp.ServiceProfile.ServiceProfileID,
+			Name:                            "test-app-2",
+			DisableOrganizationIntegrations: true,
+		}
+		So(CreateApplication(db, &app2), ShouldBeNil)
+
+		Convey("When creating an organization integration", func() {
+			settings := testIntegrationSettings{
+				URL: "http://foo.bar/",
+				Key: 12345,
+			}
+			intgr := OrganizationIntegration{
+				OrganizationID: org.ID,
+				Kind:           "REST",
+			}
+			intgr.Settings, err = json.Marshal(settings)
+			So(err, ShouldBeNil)
+			So(CreateOrganizationIntegration(db, &intgr), ShouldBeNil)
+
+			Convey("Then it can be retrieved by the organization id and kind", func() {
+				i, err := GetOrganizationIntegrationByOrganizationID(db, org.ID, "REST")
+				So(err, ShouldBeNil)
+				So(i.ID, ShouldEqual, intgr.ID)
+
+				var s testIntegrationSettings
+				So(json.Unmarshal(i.Settings, &s), ShouldBeNil)
+				So(s, ShouldResemble, settings)
+			})
+
+			Convey("Then it can be retrieved by the organization id", func() {
+				ints, err := GetOrganizationIntegrationsForOrganizationID(db, org.ID)
+				So(err, ShouldBeNil)
+				So(ints, ShouldHaveLength, 1)
+				So(ints[0].ID, ShouldEqual, intgr.ID)
+			})
+
+			Convey("Then it applies to the application which did not disable the organization integrations", func() {
+				ints, err := GetOrganizationIntegrationsForApplicationID(db, app1.ID)
+				So(err, ShouldBeNil)
+				So(ints, ShouldHaveLength, 1)
+				So(ints[0].ID, ShouldEqual, intgr.ID)
+
+				ints, err = GetOrganizationIntegrationsForApplicationID(db, app2.ID)
+				So(err, ShouldBeNil)
+				So(ints, ShouldHaveLength, 0)
+			})
+
+			Convey("Then it counts towards the integrations quota", func() {
+				usage, err := GetOrganizationUsage(db, org.ID)
+				So(err, ShouldBeNil)
+				So(usage.Integrations, ShouldEqual, 1)
+			})
+
+			Convey("Then it can be updated", func() {
+				settings.URL = "http://foo.bar/updated"
+				intgr.Settings, err = json.Marshal(settings)
+				So(err, ShouldBeNil)
+				So(UpdateOrganizationIntegration(db, &intgr), ShouldBeNil)
+
+				i, err := GetOrganizationIntegrationByOrganizationID(db, org.ID, "REST")
+				So(err, ShouldBeNil)
+
+				var s testIntegrationSettings
+				So(json.Unmarshal(i.Settings, &s), ShouldBeNil)
+				So(s, ShouldResemble, settings)
+			})
+
+			Convey("Then it can be deleted", func() {
+				So(DeleteOrganizationIntegration(db, intgr.ID), ShouldBeNil)
+				_, err := GetOrganizationIntegrationByOrganizationID(db, org.ID, "REST")
+				So(err, ShouldResemble, ErrDoesNotExist)
+			})
+		})
+	})
+}
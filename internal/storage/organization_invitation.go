@@ -0,0 +1,259 @@
+package storage
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// OrganizationInvitationTTL defines the lifetime of an organization
+// invitation. Resending the invitation renews it.
+var OrganizationInvitationTTL = 7 * 24 * time.Hour
+
+// OrganizationInvitation defines a pending invitation of an e-mail address
+// to an organization. The invitation is accepted with the token sent to the
+// e-mail address, only the hash of this token is stored.
+type OrganizationInvitation struct {
+	Roles
+	ID             int64     `db:"id"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+	ExpiresAt      time.Time `db:"expires_at"`
+	OrganizationID int64     `db:"organization_id"`
+	Email          string    `db:"email"`
+	IsAdmin        bool      `db:"is_admin"`
+	TokenHash      []byte    `db:"token_hash"`
+	InvitedBy      string    `db:"invited_by"`
+}
+
+// Validate validates the organization invitation data.
+func (i OrganizationInvitation) Validate() error {
+	return ValidateEmail(i.Email)
+}
+
+// IsExpired returns true when the invitation has expired.
+func (i OrganizationInvitation) IsExpired() bool {
+	return !i.ExpiresAt.After(time.Now())
+}
+
+// CreateOrganizationInvitation creates the given organization invitation
+// and returns its token. This is the only time the token is available.
+func CreateOrganizationInvitation(db sqlx.Queryer, inv *OrganizationInvitation) (string, error) {
+	inv.Email = strings.TrimSpace(inv.Email)
+	if err := inv.Validate(); err != nil {
+		return "", errors.Wrap(err, "validate error")
+	}
+
+	token, err := newRefreshToken()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	inv.CreatedAt = now
+	inv.UpdatedAt = now
+	inv.ExpiresAt = now.Add(OrganizationInvitationTTL)
+	inv.TokenHash = hashRefreshToken(token)
+
+	err = sqlx.Get(db, &inv.ID, `
+		insert into organization_invitation (
+			created_at,
+			updated_at,
+			expires_at,
+			organization_id,
+			email,
+			is_admin,
+			is_device_admin,
+			is_gateway_admin,
+			is_integration_admin,
+			is_viewer,
+			token_hash,
+			invited_by
+		) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		returning id`,
+		inv.CreatedAt,
+		inv.UpdatedAt,
+		inv.ExpiresAt,
+		inv.OrganizationID,
+		inv.Email,
+		inv.IsAdmin,
+		inv.IsDeviceAdmin,
+		inv.IsGatewayAdmin,
+		inv.IsIntegrationAdmin,
+		inv.IsViewer,
+		inv.TokenHash,
+		inv.InvitedBy,
+	)
+	if err != nil {
+		return "", handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":              inv.ID,
+		"organization_id": inv.OrganizationID,
+		"email":           inv.Email,
+		"invited_by":      inv.InvitedBy,
+	}).Info("organization invitation created")
+	return token, nil
+}
+
+// GetOrganizationInvitation returns the organization invitation for the
+// given id.
+func GetOrganizationInvitation(db sqlx.Queryer, id int64) (OrganizationInvitation, error) {
+	var inv OrganizationInvitation
+	err := sqlx.Get(db, &inv, "select * from organization_invitation where id = $1", id)
+	if err != nil {
+		return inv, handlePSQLError(Select, err, "select error")
+	}
+	return inv, nil
+}
+
+// GetOrganizationInvitationForToken returns the pending (not expired)
+// organization invitation for the given token.
+func GetOrganizationInvitationForToken(db sqlx.Queryer, token string) (OrganizationInvitation, error) {
+	var inv OrganizationInvitation
+	err := sqlx.Get(db, &inv, `
+		select *
+		from organization_invitation
+		where
+			token_hash = $1
+			and expires_at > now()`,
+		hashRefreshToken(token),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return inv, ErrInvalidOrganizationInvitationToken
+		}
+		return inv, handlePSQLError(Select, err, "select error")
+	}
+	return inv, nil
+}
+
+// GetOrganizationInvitationCount returns the number of invitations of the
+// given organization, including the expired invitations.
+func GetOrganizationInvitationCount(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from organization_invitation where organization_id = $1", organizationID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetOrganizationInvitations returns the invitations of the given
+// organization, including the expired invitations.
+func GetOrganizationInvitations(db sqlx.Queryer, organizationID int64, limit, offset int) ([]OrganizationInvitation, error) {
+	var invitations []OrganizationInvitation
+	err := sqlx.Select(db, &invitations, `
+		select *
+		from organization_invitation
+		where
+			organization_id = $1
+		order by email
+		limit $2
+		offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return invitations, nil
+}
+
+// RenewOrganizationInvitation replaces the token of the given organization
+// invitation and resets its expiration. It returns the renewed invitation
+// and the new token, the previous token is no longer valid.
+func RenewOrganizationInvitation(db sqlx.Queryer, id int64) (OrganizationInvitation, string, error) {
+	var inv OrganizationInvitation
+
+	token, err := newRefreshToken()
+	if err != nil {
+		return inv, "", err
+	}
+
+	now := time.Now()
+	err = sqlx.Get(db, &inv, `
+		update organization_invitation
+		set
+			token_hash = $2,
+			expires_at = $3,
+			updated_at = $4
+		where
+			id = $1
+		returning *`,
+		id,
+		hashRefreshToken(token),
+		now.Add(OrganizationInvitationTTL),
+		now,
+	)
+	if err != nil {
+		return inv, "", handlePSQLError(Update, err, "update error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":         inv.ID,
+		"expires_at": inv.ExpiresAt,
+	}).Info("organization invitation renewed")
+	return inv, token, nil
+}
+
+// DeleteOrganizationInvitation deletes (revokes) the organization invitation
+// for the given id.
+func DeleteOrganizationInvitation(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from organization_invitation where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("organization invitation deleted")
+	return nil
+}
+
+// AcceptOrganizationInvitation adds the given user to the organization of
+// the invitation matching the given token, with the roles of the invitation.
+// The invitation is deleted, so that the token can only be used once. It
+// must be called within a transaction.
+func AcceptOrganizationInvitation(db sqlx.Ext, token string, userID int64) (OrganizationInvitation, error) {
+	var inv OrganizationInvitation
+	err := sqlx.Get(db, &inv, `
+		delete from organization_invitation
+		where
+			token_hash = $1
+			and expires_at > now()
+		returning *`,
+		hashRefreshToken(token),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return inv, ErrInvalidOrganizationInvitationToken
+		}
+		return inv, handlePSQLError(Delete, err, "delete error")
+	}
+
+	if err := CreateOrganizationUser(db, inv.OrganizationID, userID, inv.IsAdmin); err != nil {
+		return inv, err
+	}
+	if err := UpdateOrganizationUserRoles(db, inv.OrganizationID, userID, inv.Roles); err != nil {
+		return inv, err
+	}
+
+	log.WithFields(log.Fields{
+		"id":              inv.ID,
+		"organization_id": inv.OrganizationID,
+		"user_id":         userID,
+	}).Info("organization invitation accepted")
+	return inv, nil
+}
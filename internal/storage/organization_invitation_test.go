@@ -0,0 +1,133 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestOrganizationInvitation(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a clean database with an organization and a user", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		user := User{
+			Username: "testuser",
+			IsActive: true,
+			Email:    "foo@bar.com",
+		}
+		user.ID, err = CreateUser(db, &user, "password123")
+		So(err, ShouldBeNil)
+
+		Convey("When creating an invitation with an invalid e-mail address", func() {
+			_, err := CreateOrganizationInvitation(db, &OrganizationInvitation{
+				OrganizationID: org.ID,
+				Email:          "foo",
+			})
+
+			Convey("Then an error is returned", func() {
+				So(errors.Cause(err), ShouldEqual, ErrInvalidEmail)
+			})
+		})
+
+		Convey("When creating an invitation", func() {
+			inv := OrganizationInvitation{
+				Roles: Roles{
+					IsGatewayAdmin: true,
+				},
+				OrganizationID: org.ID,
+				Email:          "invitee@example.com",
+				InvitedBy:      "admin",
+			}
+			token, err := CreateOrganizationInvitation(db, &inv)
+			So(err, ShouldBeNil)
+			So(token, ShouldNotEqual, "")
+
+			Convey("Then it can be retrieved by id and token", func() {
+				i, err := GetOrganizationInvitation(db, inv.ID)
+				So(err, ShouldBeNil)
+				So(i.Email, ShouldEqual, inv.Email)
+				So(i.IsGatewayAdmin, ShouldBeTrue)
+				So(i.IsExpired(), ShouldBeFalse)
+
+				i, err = GetOrganizationInvitationForToken(db, token)
+				So(err, ShouldBeNil)
+				So(i.ID, ShouldEqual, inv.ID)
+
+				count, err := GetOrganizationInvitationCount(db, org.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				invitations, err := GetOrganizationInvitations(db, org.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(invitations, ShouldHaveLength, 1)
+			})
+
+			Convey("When the invitation has expired", func() {
+				_, err := db.Exec("update organization_invitation set expires_at = now() - interval '1 minute' where id = $1", inv.ID)
+				So(err, ShouldBeNil)
+
+				Convey("Then it can not be accepted", func() {
+					_, err := AcceptOrganizationInvitation(db, token, user.ID)
+					So(err, ShouldEqual, ErrInvalidOrganizationInvitationToken)
+				})
+
+				Convey("When renewing the invitation", func() {
+					renewed, newToken, err := RenewOrganizationInvitation(db, inv.ID)
+					So(err, ShouldBeNil)
+					So(renewed.IsExpired(), ShouldBeFalse)
+
+					Convey("Then only the new token is valid", func() {
+						_, err := GetOrganizationInvitationForToken(db, token)
+						So(err, ShouldEqual, ErrInvalidOrganizationInvitationToken)
+
+						_, err = GetOrganizationInvitationForToken(db, newToken)
+						So(err, ShouldBeNil)
+					})
+				})
+			})
+
+			Convey("When accepting the invitation", func() {
+				_, err := AcceptOrganizationInvitation(db, token, user.ID)
+				So(err, ShouldBeNil)
+
+				Convey("Then the user has been added with the roles of the invitation", func() {
+					ou, err := GetOrganizationUser(db, org.ID, user.ID)
+					So(err, ShouldBeNil)
+					So(ou.IsAdmin, ShouldBeFalse)
+					So(ou.IsGatewayAdmin, ShouldBeTrue)
+				})
+
+				Convey("Then the invitation has been deleted", func() {
+					_, err := GetOrganizationInvitation(db, inv.ID)
+					So(err, ShouldEqual, ErrDoesNotExist)
+
+					_, err = AcceptOrganizationInvitation(db, token, user.ID)
+					So(err, ShouldEqual, ErrInvalidOrganizationInvitationToken)
+				})
+			})
+
+			Convey("When deleting the invitation", func() {
+				So(DeleteOrganizationInvitation(db, inv.ID), ShouldBeNil)
+
+				Convey("Then it has been deleted", func() {
+					_, err := GetOrganizationInvitationForToken(db, token)
+					So(err, ShouldEqual, ErrInvalidOrganizationInvitationToken)
+
+					So(DeleteOrganizationInvitation(db, inv.ID), ShouldEqual, ErrDoesNotExist)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const organizationUplinksKeyTempl = "lora:as:org:%d:uplinks:%s"
+
+// organizationUplinksTTL defines how long the daily uplink counters are
+// kept, the counter of the previous day is kept for reporting.
+const organizationUplinksTTL = 48 * time.Hour
+
+// OrganizationQuota defines the max. number of resources an organization
+// is allowed to have. A value of 0 means unlimited.
+type OrganizationQuota struct {
+	MaxApplications   int `db:"max_applications"`
+	MaxDevices        int `db:"max_devices"`
+	MaxGateways       int `db:"max_gateways"`
+	MaxDeviceProfiles int `db:"max_device_profiles"`
+	MaxIntegrations   int `db:"max_integrations"`
+	MaxUplinksPerDay  int `db:"max_uplinks_per_day"`
+}
+
+// Validate validates the quota.
+func (q OrganizationQuota) Validate() error {
+	for _, v := range []int{q.MaxApplications, q.MaxDevices, q.MaxGateways, q.MaxDeviceProfiles, q.MaxIntegrations, q.MaxUplinksPerDay} {
+		if v < 0 {
+			return ErrOrganizationInvalidQuota
+		}
+	}
+	return nil
+}
+
+// OrganizationUsage contains the number of resources used by an
+// organization.
+type OrganizationUsage struct {
+	Applications   int `db:"applications"`
+	Devices        int `db:"devices"`
+	Gateways       int `db:"gateways"`
+	DeviceProfiles int `db:"device_profiles"`
+	Integrations   int `db:"integrations"`
+	UplinksToday   int `db:"-"`
+}
+
+// QuotaResource defines a resource limited by the organization quota.
+type QuotaResource int
+
+// Resources limited by the organization quota.
+const (
+	QuotaApplications QuotaResource = iota
+	QuotaDevices
+	QuotaGateways
+	QuotaDeviceProfiles
+	QuotaIntegrations
+)
+
+var quotaResources = map[QuotaResource]struct {
+	column string
+	count  string
+	err    error
+}{
+	QuotaApplications: {
+		column: "max_applications",
+		count:  "select count(*) from application where organization_id = $1",
+		err:    ErrOrganizationMaxApplications,
+	},
+	QuotaDevices: {
+		column: "max_devices",
+		count:  "select count(*) from device d inner join application a on a.id = d.application_id where a.organization_id = $1",
+		err:    ErrOrganizationMaxDevices,
+	},
+	QuotaGateways: {
+		column: "max_gateways",
+		count:  "select count(*) from gateway where organization_id = $1",
+		err:    ErrOrganizationMaxGateways,
+	},
+	QuotaDeviceProfiles: {
+		column: "max_device_profiles",
+		count:  "select count(*) from device_profile where organization_id = $1",
+		err:    ErrOrganizationMaxDeviceProfiles,
+	},
+	QuotaIntegrations: {
+		column: "max_integrations",
+		count:  "select (select count(*) from integration i inner join application a on a.id = i.application_id where a.organization_id = $1) + (select count(*) from organization_integration where organization_id = $1)",
+		err:    ErrOrganizationMaxIntegrations,
+	},
+}
+
+// CheckOrganizationQuota returns an error when the organization has reached
+// its quota for the given resource. It locks the organization row, call
+// it within the transaction creating the resource so that concurrent
+// creates can not exceed the quota.
+func CheckOrganizationQuota(db sqlx.Queryer, organizationID int64, resource QuotaResource) error {
+	r, ok := quotaResources[resource]
+	if !ok {
+		return fmt.Errorf("unknown quota resource: %d", resource)
+	}
+
+	var max int
+	err := sqlx.Get(db, &max, "select "+r.column+" from organization where id = $1 for update", organizationID)
+	if err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	if max == 0 {
+		return nil
+	}
+
+	var count int
+	if err := sqlx.Get(db, &count, r.count, organizationID); err != nil {
+		return handlePSQLError(Select, err, "select error")
+	}
+	if count >= max {
+		return r.err
+	}
+
+	return nil
+}
+
+// GetOrganizationUsage returns the number of resources used by the given
+// organization. The number of uplinks is not included, see
+// GetOrganizationUplinkCount.
+func GetOrganizationUsage(db sqlx.Queryer, organizationID int64) (OrganizationUsage, error) {
+	var usage OrganizationUsage
+	err := sqlx.Get(db, &usage, `
+		select
+			(`+quotaResources[QuotaApplications].count+`) as applications,
+			(`+quotaResources[QuotaDevices].count+`) as devices,
+			(`+quotaResources[QuotaGateways].count+`) as gateways,
+			(`+quotaResources[QuotaDeviceProfiles].count+`) as device_profiles,
+			(`+quotaResources[QuotaIntegrations].count+`) as integrations`,
+		organizationID,
+	)
+	if err != nil {
+		return usage, handlePSQLError(Select, err, "select error")
+	}
+	return usage, nil
+}
+
+// IncrOrganizationUplinkCount increments the number of uplinks received
+// today (UTC) by the devices of the given organization and returns the new
+// count.
+func IncrOrganizationUplinkCount(p *redis.Pool, organizationID int64) (int, error) {
+	key := organizationUplinksKey(organizationID, time.Now())
+
+	c := p.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("INCR", key)
+	c.Send("PEXPIRE", key, int64(organizationUplinksTTL/time.Millisecond))
+	values, err := redis.Values(c.Do("EXEC"))
+	if err != nil {
+		return 0, errors.Wrap(err, "increment uplink count error")
+	}
+	count, err := redis.Int(values[0], nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "increment uplink count error")
+	}
+	return count, nil
+}
+
+// GetOrganizationUplinkCount returns the number of uplinks received today
+// (UTC) by the devices of the given organization.
+func GetOrganizationUplinkCount(p *redis.Pool, organizationID int64) (int, error) {
+	c := p.Get()
+	defer c.Close()
+
+	count, err := redis.Int(c.Do("GET", organizationUplinksKey(organizationID, time.Now())))
+	if err != nil {
+		if err == redis.ErrNil {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "get uplink count error")
+	}
+	return count, nil
+}
+
+// CheckOrganizationUplinkQuota increments the uplink count of the given
+// organization and returns ErrOrganizationMaxUplinksPerDay when the
+// organization exceeded its max. number of uplinks for today.
+func CheckOrganizationUplinkQuota(p *redis.Pool, org Organization) error {
+	count, err := IncrOrganizationUplinkCount(p, org.ID)
+	if err != nil {
+		return err
+	}
+
+	if org.MaxUplinksPerDay == 0 || count <= org.MaxUplinksPerDay {
+		return nil
+	}
+
+	if count == org.MaxUplinksPerDay+1 {
+		log.WithFields(log.Fields{
+			"organization_id":     org.ID,
+			"max_uplinks_per_day": org.MaxUplinksPerDay,
+		}).Warning("organization exceeded its max. number of uplinks per day")
+	}
+
+	return ErrOrganizationMaxUplinksPerDay
+}
+
+func organizationUplinksKey(organizationID int64, t time.Time) string {
+	return fmt.Sprintf(organizationUplinksKeyTempl, organizationID, t.UTC().Format("2006-01-02"))
+}
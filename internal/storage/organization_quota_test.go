@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestOrganizationQuota(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+	p := NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with an organization, network-server and service-profile", t, func() {
+		test.MustResetDB(db)
+		test.MustFlushRedis(p)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(db, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			Name:            "test-service-profile",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(CreateServiceProfile(db, &sp), ShouldBeNil)
+
+		Convey("When updating the organization with a negative quota", func() {
+			org.MaxDevices = -1
+			err := UpdateOrganization(db, &org)
+
+			Convey("Then an error is returned", func() {
+				So(errors.Cause(err), ShouldEqual, ErrOrganizationInvalidQuota)
+			})
+		})
+
+		Convey("Then without quota, the organization is not limited", func() {
+			So(CheckOrganizationQuota(db, org.ID, QuotaApplications), ShouldBeNil)
+			So(CheckOrganizationQuota(db, org.ID, QuotaDevices), ShouldBeNil)
+			So(CheckOrganizationQuota(db, org.ID, QuotaGateways), ShouldBeNil)
+			So(CheckOrganizationQuota(db, org.ID, QuotaDeviceProfiles), ShouldBeNil)
+			So(CheckOrganizationQuota(db, org.ID, QuotaIntegrations), ShouldBeNil)
+		})
+
+		Convey("Given the organization has a quota of one application and two uplinks per day", func() {
+			org.MaxApplications = 1
+			org.MaxUplinksPerDay = 2
+			So(UpdateOrganization(db, &org), ShouldBeNil)
+
+			o, err := GetOrganization(db, org.ID)
+			So(err, ShouldBeNil)
+			So(o.OrganizationQuota, ShouldResemble, org.OrganizationQuota)
+
+			So(CheckOrganizationQuota(db, org.ID, QuotaApplications), ShouldBeNil)
+
+			Convey("When creating an application", func() {
+				app := Application{
+					OrganizationID:   org.ID,
+					ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+					Name:             "test-app",
+				}
+				So(CreateApplication(db, &app), ShouldBeNil)
+
+				Convey("Then the application quota has been reached", func() {
+					So(CheckOrganizationQuota(db, org.ID, QuotaApplications), ShouldEqual, ErrOrganizationMaxApplications)
+					So(CheckOrganizationQuota(db, org.ID, QuotaDevices), ShouldBeNil)
+				})
+
+				Convey("Then the usage contains the application", func() {
+					usage, err := GetOrganizationUsage(db, org.ID)
+					So(err, ShouldBeNil)
+					So(usage, ShouldResemble, OrganizationUsage{Applications: 1})
+				})
+			})
+
+			Convey("Then the uplinks above the daily quota are rejected", func() {
+				So(CheckOrganizationUplinkQuota(p, org), ShouldBeNil)
+				So(CheckOrganizationUplinkQuota(p, org), ShouldBeNil)
+				So(CheckOrganizationUplinkQuota(p, org), ShouldEqual, ErrOrganizationMaxUplinksPerDay)
+
+				count, err := GetOrganizationUplinkCount(p, org.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 3)
+			})
+		})
+	})
+}
@@ -132,6 +132,20 @@ func TestOrganization(t *testing.T) {
 					So(u.IsAdmin, ShouldBeTrue)
 				})
 
+				Convey("Then the roles can be updated", func() {
+					roles := Roles{
+						IsDeviceAdmin:  true,
+						IsGatewayAdmin: true,
+						IsViewer:       true,
+					}
+					So(UpdateOrganizationUserRoles(db, org.ID, 1, roles), ShouldBeNil) // admin user
+
+					u, err := GetOrganizationUser(db, org.ID, 1)
+					So(err, ShouldBeNil)
+					So(u.IsAdmin, ShouldBeFalse)
+					So(u.Roles, ShouldResemble, roles)
+				})
+
 				Convey("Then it can be deleted", func() {
 					So(DeleteOrganizationUser(db, org.ID, 1), ShouldBeNil) // admin user
 					c, err := GetOrganizationUserCount(db, org.ID)
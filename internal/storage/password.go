@@ -0,0 +1,258 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Password hash algorithms.
+const (
+	PasswordHashBcrypt = "bcrypt"
+	PasswordHashPBKDF2 = "pbkdf2"
+)
+
+// saltSize defines the salt size
+const saltSize = 16
+
+// PasswordHashAlgorithm defines the algorithm used to hash new passwords.
+// Passwords hashed with an other algorithm (or other parameters) are
+// re-hashed on login.
+var PasswordHashAlgorithm = PasswordHashBcrypt
+
+// HashIterations defines the number of hash iterations (PBKDF2).
+var HashIterations = 100000
+
+// BcryptCost defines the bcrypt cost.
+var BcryptCost = 12
+
+// bcryptMaxPasswordLength defines the maximum password length (in bytes)
+// which is taken into account by bcrypt.
+const bcryptMaxPasswordLength = 72
+
+// PasswordPolicy defines the rules which new passwords must meet.
+type PasswordPolicy struct {
+	MinLength           int // minimum number of characters
+	MinCharacterClasses int // minimum number of lower case, upper case, digit and other characters classes
+
+	breachedPasswords map[[sha1.Size]byte]struct{}
+}
+
+var passwordPolicy = PasswordPolicy{
+	MinLength: 6,
+}
+
+// SetPasswordPolicy sets the password policy.
+func SetPasswordPolicy(p PasswordPolicy) {
+	passwordPolicy = p
+}
+
+var sha1LineRegexp = regexp.MustCompile(`^([0-9A-Fa-f]{40})(:\d+)?$`)
+
+// breachedPasswordsMaxEntries defines the maximum number of breached
+// passwords which can be loaded. The list is kept in memory, which takes
+// about 50MB per million entries.
+var breachedPasswordsMaxEntries = 1000000
+
+// LoadBreachedPasswords loads the list of breached passwords from the given
+// file. The file contains one password per line, or the (hex encoded) SHA-1
+// hash of the password, optionally followed by ":" and the number of
+// occurrences (the format of the Pwned Passwords lists). Empty lines and
+// lines starting with "#" are ignored. As the list is kept in memory, an
+// error is returned when it exceeds breachedPasswordsMaxEntries entries
+// (e.g. the full Pwned Passwords list), use a subset (e.g. the most common
+// passwords) instead.
+func (p *PasswordPolicy) LoadBreachedPasswords(file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return errors.Wrap(err, "open file error")
+	}
+	defer f.Close()
+
+	p.breachedPasswords = make(map[[sha1.Size]byte]struct{})
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var h [sha1.Size]byte
+		if m := sha1LineRegexp.FindStringSubmatch(line); m != nil {
+			if _, err := hex.Decode(h[:], []byte(m[1])); err != nil {
+				return errors.Wrap(err, "decode hex error")
+			}
+		} else {
+			h = sha1.Sum([]byte(line))
+		}
+		p.breachedPasswords[h] = struct{}{}
+
+		if len(p.breachedPasswords) > breachedPasswordsMaxEntries {
+			return fmt.Errorf("file exceeds the maximum of %d entries", breachedPasswordsMaxEntries)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "read file error")
+	}
+
+	return nil
+}
+
+// Validate validates the given password against the policy.
+func (p PasswordPolicy) Validate(password string) error {
+	if utf8.RuneCountInString(password) < p.MinLength || password == "" {
+		return ErrUserPasswordLength
+	}
+
+	// bcrypt ignores the bytes beyond the maximum length
+	if PasswordHashAlgorithm == PasswordHashBcrypt && len(password) > bcryptMaxPasswordLength {
+		return ErrUserPasswordTooLong
+	}
+
+	if characterClasses(password) < p.MinCharacterClasses {
+		return ErrUserPasswordCharacterClasses
+	}
+
+	if _, ok := p.breachedPasswords[sha1.Sum([]byte(password))]; ok {
+		return ErrUserPasswordBreached
+	}
+
+	return nil
+}
+
+// characterClasses returns the number of character classes (lower case,
+// upper case, digits and others) used by the given password.
+func characterClasses(password string) int {
+	var lower, upper, digit, other int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
+
+// hashPassword returns the hash of the given password for storage in the
+// database, using the configured password hash algorithm.
+func hashPassword(password string) (string, error) {
+	switch PasswordHashAlgorithm {
+	case PasswordHashBcrypt:
+		h, err := bcrypt.GenerateFromPassword([]byte(password), BcryptCost)
+		if err != nil {
+			return "", errors.Wrap(err, "bcrypt hash error")
+		}
+		return string(h), nil
+	case PasswordHashPBKDF2:
+		return hash(password, saltSize, HashIterations)
+	default:
+		return "", fmt.Errorf("unknown password hash algorithm: %s", PasswordHashAlgorithm)
+	}
+}
+
+// hashCompare verifies that passed password hashes to the same value as the
+// passed passwordHash. All supported hash formats are accepted, regardless
+// of the configured algorithm.
+func hashCompare(password string, passwordHash string) bool {
+	switch {
+	case strings.HasPrefix(passwordHash, "$2"):
+		return bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(password)) == nil
+	case strings.HasPrefix(passwordHash, "PBKDF2$"):
+		return pbkdf2Compare(password, passwordHash)
+	default:
+		return false
+	}
+}
+
+// passwordNeedsRehash returns true when the given password hash was not
+// created by the configured algorithm and parameters.
+func passwordNeedsRehash(passwordHash string) bool {
+	switch PasswordHashAlgorithm {
+	case PasswordHashBcrypt:
+		cost, err := bcrypt.Cost([]byte(passwordHash))
+		return err != nil || cost != BcryptCost
+	case PasswordHashPBKDF2:
+		hashSplit := strings.Split(passwordHash, "$")
+		return len(hashSplit) != 5 || hashSplit[0] != "PBKDF2" || hashSplit[2] != strconv.Itoa(HashIterations)
+	default:
+		return false
+	}
+}
+
+// Generate the hash of a password for storage in the database.
+// NOTE: We store the details of the hashing algorithm with the hash itself,
+// making it easy to recreate the hash for password checking, even if we change
+// the default criteria here.
+func hash(password string, saltSize int, iterations int) (string, error) {
+	// Generate a random salt value, 128 bits.
+	salt := make([]byte, saltSize)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+
+	return hashWithSalt(password, salt, iterations), nil
+}
+
+func hashWithSalt(password string, salt []byte, iterations int) string {
+	// Generate the hash.  This should be a little painful, adjust ITERATIONS
+	// if it needs performance tweeking.  Greatly depends on the hardware.
+	// NOTE: We store these details with the returned hash, so changes will not
+	// affect our ability to do password compares.
+	hash := pbkdf2.Key([]byte(password), salt, iterations, sha512.Size, sha512.New)
+
+	// Build up the parameters and hash into a single string so we can compare
+	// other string to the same hash.  Note that the hash algorithm is hard-
+	// coded here, as it is above.  Introducing alternate encodings must support
+	// old encodings as well, and build this string appropriately.
+	var buffer bytes.Buffer
+
+	buffer.WriteString("PBKDF2$")
+	buffer.WriteString("sha512$")
+	buffer.WriteString(strconv.Itoa(iterations))
+	buffer.WriteString("$")
+	buffer.WriteString(base64.StdEncoding.EncodeToString(salt))
+	buffer.WriteString("$")
+	buffer.WriteString(base64.StdEncoding.EncodeToString(hash))
+
+	return buffer.String()
+}
+
+// pbkdf2Compare verifies the password against the given PBKDF2 hash.
+func pbkdf2Compare(password string, passwordHash string) bool {
+	// SPlit the hash string into its parts.
+	hashSplit := strings.Split(passwordHash, "$")
+	if len(hashSplit) != 5 {
+		return false
+	}
+
+	// Get the iterations and the salt and use them to encode the password
+	// being compared.
+	iterations, _ := strconv.Atoi(hashSplit[2])
+	salt, _ := base64.StdEncoding.DecodeString(hashSplit[3])
+	newHash := hashWithSalt(password, salt, iterations)
+	return subtle.ConstantTimeCompare([]byte(newHash), []byte(passwordHash)) == 1
+}
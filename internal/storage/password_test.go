@@ -0,0 +1,152 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	Convey("Given a password policy", t, func() {
+		p := PasswordPolicy{
+			MinLength:           8,
+			MinCharacterClasses: 3,
+		}
+
+		Convey("Then passwords are validated against the length and character classes", func() {
+			So(p.Validate("aB1"), ShouldEqual, ErrUserPasswordLength)
+			So(p.Validate(""), ShouldEqual, ErrUserPasswordLength)
+			So(p.Validate("abcdefgh"), ShouldEqual, ErrUserPasswordCharacterClasses)
+			So(p.Validate("abcdEFGH"), ShouldEqual, ErrUserPasswordCharacterClasses)
+			So(p.Validate("abcdEF12"), ShouldBeNil)
+			So(p.Validate("abcd!@#1"), ShouldBeNil)
+		})
+
+		Convey("Then passwords exceeding 72 bytes are rejected when using bcrypt", func() {
+			defer func() { PasswordHashAlgorithm = PasswordHashBcrypt }()
+
+			PasswordHashAlgorithm = PasswordHashBcrypt
+			So(p.Validate("aB1"+strings.Repeat("x", 69)), ShouldBeNil)
+			So(p.Validate("aB1"+strings.Repeat("x", 70)), ShouldEqual, ErrUserPasswordTooLong)
+			// multi-byte characters are counted in bytes
+			So(p.Validate("aB1"+strings.Repeat("é", 35)), ShouldEqual, ErrUserPasswordTooLong)
+
+			PasswordHashAlgorithm = PasswordHashPBKDF2
+			So(p.Validate("aB1"+strings.Repeat("x", 70)), ShouldBeNil)
+		})
+
+		Convey("When loading a list of breached passwords", func() {
+			f, err := ioutil.TempFile("", "breached")
+			So(err, ShouldBeNil)
+			defer os.Remove(f.Name())
+
+			_, err = f.WriteString(strings.Join([]string{
+				"# comment",
+				"Passw0rd!",
+				"",
+				// SHA-1 of "Summer2018!" in the Pwned Passwords format
+				"20646E51D0489F449EAFB3858260F1DB7FF6EE0F:12",
+			}, "\n"))
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+
+			So(p.LoadBreachedPasswords(f.Name()), ShouldBeNil)
+
+			Convey("Then breached passwords are rejected", func() {
+				So(p.Validate("Passw0rd!"), ShouldEqual, ErrUserPasswordBreached)
+				So(p.Validate("Summer2018!"), ShouldEqual, ErrUserPasswordBreached)
+				So(p.Validate("Passw0rd!!"), ShouldBeNil)
+				So(p.breachedPasswords, ShouldHaveLength, 2)
+			})
+
+			Convey("When the list exceeds the maximum number of entries", func() {
+				defer func(max int) { breachedPasswordsMaxEntries = max }(breachedPasswordsMaxEntries)
+				breachedPasswordsMaxEntries = 1
+
+				Convey("Then loading the list returns an error", func() {
+					So(p.LoadBreachedPasswords(f.Name()), ShouldNotBeNil)
+				})
+			})
+		})
+	})
+}
+
+func TestPasswordHash(t *testing.T) {
+	conf := test.GetConfig()
+
+	Convey("Given a password hashed using each algorithm", t, func() {
+		defer func() {
+			PasswordHashAlgorithm = PasswordHashBcrypt
+			BcryptCost = 12
+			HashIterations = 100000
+		}()
+		BcryptCost = 4
+		HashIterations = 1000
+
+		PasswordHashAlgorithm = PasswordHashBcrypt
+		bcryptHash, err := hashPassword("password123")
+		So(err, ShouldBeNil)
+		So(bcryptHash, ShouldStartWith, "$2")
+
+		PasswordHashAlgorithm = PasswordHashPBKDF2
+		pbkdf2Hash, err := hashPassword("password123")
+		So(err, ShouldBeNil)
+		So(pbkdf2Hash, ShouldStartWith, "PBKDF2$sha512$1000$")
+
+		Convey("Then both hashes are verified", func() {
+			So(hashCompare("password123", bcryptHash), ShouldBeTrue)
+			So(hashCompare("password1234", bcryptHash), ShouldBeFalse)
+			So(hashCompare("password123", pbkdf2Hash), ShouldBeTrue)
+			So(hashCompare("password1234", pbkdf2Hash), ShouldBeFalse)
+			So(hashCompare("password123", "invalid"), ShouldBeFalse)
+		})
+
+		Convey("Then the hashes not matching the configured algorithm and parameters need a re-hash", func() {
+			PasswordHashAlgorithm = PasswordHashBcrypt
+			So(passwordNeedsRehash(bcryptHash), ShouldBeFalse)
+			So(passwordNeedsRehash(pbkdf2Hash), ShouldBeTrue)
+			BcryptCost = 5
+			So(passwordNeedsRehash(bcryptHash), ShouldBeTrue)
+
+			PasswordHashAlgorithm = PasswordHashPBKDF2
+			So(passwordNeedsRehash(pbkdf2Hash), ShouldBeFalse)
+			So(passwordNeedsRehash(bcryptHash), ShouldBeTrue)
+			HashIterations = 2000
+			So(passwordNeedsRehash(pbkdf2Hash), ShouldBeTrue)
+		})
+
+		Convey("Given a user with a PBKDF2 password hash", func() {
+			db, err := OpenDatabase(conf.PostgresDSN)
+			So(err, ShouldBeNil)
+			test.MustResetDB(db)
+
+			user := User{
+				Username: "testuser",
+				IsActive: true,
+				Email:    "foo@bar.com",
+			}
+			_, err = CreateUser(db, &user, "password123")
+			So(err, ShouldBeNil)
+
+			Convey("When the user logs in with bcrypt configured", func() {
+				PasswordHashAlgorithm = PasswordHashBcrypt
+				_, err := AuthenticateUser(db, user.Username, "password123")
+				So(err, ShouldBeNil)
+
+				Convey("Then the password has been re-hashed using bcrypt", func() {
+					u, err := getUserInternal(db, user.ID)
+					So(err, ShouldBeNil)
+					So(u.PasswordHash, ShouldStartWith, "$2")
+
+					_, err = AuthenticateUser(db, user.Username, "password123")
+					So(err, ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,248 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brocaar/lorawan"
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	usageKeyTempl        = "lora:as:usage:%s:%d"
+	usageDevicesKeyTempl = usageKeyTempl + ":devices"
+	usagePendingKey      = "lora:as:usage:pending"
+	usageDateFormat      = "2006-01-02"
+)
+
+// usageTTL defines how long the usage counters are kept in Redis. The set
+// of active devices must be kept for the whole day, as the number of active
+// devices is not incremental.
+const usageTTL = 48 * time.Hour
+
+// UsageCounters defines the counters to add to the usage of an application.
+type UsageCounters struct {
+	Uplinks       int64
+	UplinkBytes   int64
+	Downlinks     int64
+	DownlinkBytes int64
+	Joins         int64
+}
+
+// UsageRecord contains the usage of an application for a day (UTC).
+type UsageRecord struct {
+	Date             time.Time `db:"date"`
+	OrganizationID   int64     `db:"organization_id"`
+	OrganizationName string    `db:"organization_name"`
+	ApplicationID    int64     `db:"application_id"`
+	ApplicationName  string    `db:"application_name"`
+	Uplinks          int64     `db:"uplinks"`
+	UplinkBytes      int64     `db:"uplink_bytes"`
+	Downlinks        int64     `db:"downlinks"`
+	DownlinkBytes    int64     `db:"downlink_bytes"`
+	Joins            int64     `db:"joins"`
+	ActiveDevices    int64     `db:"active_devices"`
+}
+
+// IncrUsage adds the given counters to the usage of today (UTC) of the
+// given application. The device counts as active when the counters contain
+// an uplink or join. The counters are kept in Redis until they are written
+// to the database by FlushUsage.
+func IncrUsage(p *redis.Pool, applicationID int64, devEUI lorawan.EUI64, counters UsageCounters) error {
+	date := time.Now().UTC().Format(usageDateFormat)
+
+	c := p.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	sendIncrUsage(c, date, applicationID, counters)
+	if counters.Uplinks > 0 || counters.Joins > 0 {
+		key := fmt.Sprintf(usageDevicesKeyTempl, date, applicationID)
+		c.Send("SADD", key, devEUI.String())
+		c.Send("PEXPIRE", key, int64(usageTTL/time.Millisecond))
+	}
+	if _, err := c.Do("EXEC"); err != nil {
+		return errors.Wrap(err, "increment usage error")
+	}
+
+	return nil
+}
+
+// FlushUsage writes the usage counters stored in Redis to the database.
+// The counters are reset in Redis, it is safe to call this function from
+// multiple processes.
+func FlushUsage(db sqlx.Execer, p *redis.Pool) error {
+	c := p.Get()
+	defer c.Close()
+
+	pending, err := redis.Strings(c.Do("SMEMBERS", usagePendingKey))
+	if err != nil {
+		return errors.Wrap(err, "get pending usage error")
+	}
+
+	for _, member := range pending {
+		parts := strings.SplitN(member, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		date, err := time.Parse(usageDateFormat, parts[0])
+		if err != nil {
+			return errors.Wrap(err, "parse date error")
+		}
+		applicationID, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return errors.Wrap(err, "parse application id error")
+		}
+
+		c.Send("MULTI")
+		c.Send("SREM", usagePendingKey, member)
+		c.Send("HGETALL", fmt.Sprintf(usageKeyTempl, parts[0], applicationID))
+		c.Send("DEL", fmt.Sprintf(usageKeyTempl, parts[0], applicationID))
+		c.Send("SCARD", fmt.Sprintf(usageDevicesKeyTempl, parts[0], applicationID))
+		values, err := redis.Values(c.Do("EXEC"))
+		if err != nil {
+			return errors.Wrap(err, "get usage error")
+		}
+
+		// flushed by an other process
+		if removed, _ := redis.Int(values[0], nil); removed == 0 {
+			continue
+		}
+
+		fields, err := redis.Int64Map(values[1], nil)
+		if err != nil {
+			return errors.Wrap(err, "get usage error")
+		}
+		activeDevices, err := redis.Int64(values[3], nil)
+		if err != nil {
+			return errors.Wrap(err, "get usage error")
+		}
+
+		counters := UsageCounters{
+			Uplinks:       fields["uplinks"],
+			UplinkBytes:   fields["uplink_bytes"],
+			Downlinks:     fields["downlinks"],
+			DownlinkBytes: fields["downlink_bytes"],
+			Joins:         fields["joins"],
+		}
+
+		if err := addUsageRecord(db, date, applicationID, counters, activeDevices); err != nil {
+			// put the counters back, so that they are flushed on the next run
+			c.Send("MULTI")
+			sendIncrUsage(c, parts[0], applicationID, counters)
+			if _, rErr := c.Do("EXEC"); rErr != nil {
+				log.WithError(rErr).WithField("application_id", applicationID).Error("restore usage error")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetUsageRecords returns the usage records of the given organization, sorted
+// by date and application id, for the dates within the given interval
+// (start inclusive, end exclusive). When the organization id is 0, the
+// records of all organizations are returned.
+func GetUsageRecords(db sqlx.Queryer, organizationID int64, start, end time.Time) ([]UsageRecord, error) {
+	var records []UsageRecord
+	err := sqlx.Select(db, &records, `
+		select
+			ur.*,
+			coalesce(o.name, '') as organization_name,
+			coalesce(a.name, '') as application_name
+		from usage_record ur
+		left join organization o
+			on o.id = ur.organization_id
+		left join application a
+			on a.id = ur.application_id
+		where
+			($1 = 0 or ur.organization_id = $1)
+			and ur.date >= $2
+			and ur.date < $3
+		order by ur.date, ur.organization_id, ur.application_id`,
+		organizationID,
+		start.Format(usageDateFormat),
+		end.Format(usageDateFormat),
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return records, nil
+}
+
+// addUsageRecord adds the given counters to the usage record of the given
+// date and application. Unlike the counters, the number of active devices
+// is an absolute value.
+func addUsageRecord(db sqlx.Execer, date time.Time, applicationID int64, counters UsageCounters, activeDevices int64) error {
+	res, err := db.Exec(`
+		insert into usage_record (
+			date,
+			organization_id,
+			application_id,
+			uplinks,
+			uplink_bytes,
+			downlinks,
+			downlink_bytes,
+			joins,
+			active_devices
+		)
+		select $1, organization_id, id, $3, $4, $5, $6, $7, $8
+		from application
+		where id = $2
+		on conflict (date, application_id) do update
+		set
+			uplinks = usage_record.uplinks + excluded.uplinks,
+			uplink_bytes = usage_record.uplink_bytes + excluded.uplink_bytes,
+			downlinks = usage_record.downlinks + excluded.downlinks,
+			downlink_bytes = usage_record.downlink_bytes + excluded.downlink_bytes,
+			joins = usage_record.joins + excluded.joins,
+			active_devices = greatest(usage_record.active_devices, excluded.active_devices)`,
+		date.Format(usageDateFormat),
+		applicationID,
+		counters.Uplinks,
+		counters.UplinkBytes,
+		counters.Downlinks,
+		counters.DownlinkBytes,
+		counters.Joins,
+		activeDevices,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		log.WithFields(log.Fields{
+			"application_id": applicationID,
+			"date":           date.Format(usageDateFormat),
+		}).Warning("usage of deleted application discarded")
+	}
+	return nil
+}
+
+// sendIncrUsage queues the commands incrementing the usage counters on the
+// given connection (within a MULTI block).
+func sendIncrUsage(c redis.Conn, date string, applicationID int64, counters UsageCounters) {
+	key := fmt.Sprintf(usageKeyTempl, date, applicationID)
+	for field, value := range map[string]int64{
+		"uplinks":        counters.Uplinks,
+		"uplink_bytes":   counters.UplinkBytes,
+		"downlinks":      counters.Downlinks,
+		"downlink_bytes": counters.DownlinkBytes,
+		"joins":          counters.Joins,
+	} {
+		if value != 0 {
+			c.Send("HINCRBY", key, field, value)
+		}
+	}
+	c.Send("PEXPIRE", key, int64(usageTTL/time.Millisecond))
+	c.Send("SADD", usagePendingKey, fmt.Sprintf("%s:%d", date, applicationID))
+}
@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/brocaar/lorawan"
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestUsage(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	nsClient := test.NewNetworkServerClient()
+	config.C.NetworkServer.Pool = test.NewNetworkServerPool(nsClient)
+	p := NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with an organization and application", t, func() {
+		test.MustResetDB(db)
+		test.MustFlushRedis(p)
+
+		org := Organization{
+			Name: "test-org",
+		}
+		So(CreateOrganization(db, &org), ShouldBeNil)
+
+		n := NetworkServer{
+			Name:   "test-ns",
+			Server: "test-ns:1234",
+		}
+		So(CreateNetworkServer(db, &n), ShouldBeNil)
+
+		sp := ServiceProfile{
+			Name:            "test-service-profile",
+			OrganizationID:  org.ID,
+			NetworkServerID: n.ID,
+		}
+		So(CreateServiceProfile(db, &sp), ShouldBeNil)
+
+		app := Application{
+			OrganizationID:   org.ID,
+			ServiceProfileID: sp.ServiceProfile.ServiceProfileID,
+			Name:             "test-app",
+		}
+		So(CreateApplication(db, &app), ShouldBeNil)
+
+		today := time.Now().UTC().Truncate(24 * time.Hour)
+		devEUI1 := lorawan.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
+		devEUI2 := lorawan.EUI64{8, 7, 6, 5, 4, 3, 2, 1}
+
+		Convey("When incrementing and flushing the usage", func() {
+			So(IncrUsage(p, app.ID, devEUI1, UsageCounters{Uplinks: 1, UplinkBytes: 10}), ShouldBeNil)
+			So(IncrUsage(p, app.ID, devEUI1, UsageCounters{Uplinks: 1, UplinkBytes: 5}), ShouldBeNil)
+			So(IncrUsage(p, app.ID, devEUI1, UsageCounters{Downlinks: 1, DownlinkBytes: 3}), ShouldBeNil)
+			So(IncrUsage(p, app.ID, devEUI2, UsageCounters{Downlinks: 1, DownlinkBytes: 4}), ShouldBeNil)
+			So(FlushUsage(db, p), ShouldBeNil)
+
+			Convey("Then the usage record has been created", func() {
+				records, err := GetUsageRecords(db, org.ID, today, today.AddDate(0, 0, 1))
+				So(err, ShouldBeNil)
+				So(records, ShouldHaveLength, 1)
+				So(records[0].Date.Format("2006-01-02"), ShouldEqual, today.Format("2006-01-02"))
+				records[0].Date = today
+				So(records[0], ShouldResemble, UsageRecord{
+					Date:             today,
+					OrganizationID:   org.ID,
+					OrganizationName: org.Name,
+					ApplicationID:    app.ID,
+					ApplicationName:  app.Name,
+					Uplinks:          2,
+					UplinkBytes:      15,
+					Downlinks:        2,
+					DownlinkBytes:    7,
+					ActiveDevices:    1,
+				})
+			})
+
+			Convey("Then no records are returned for an other interval or organization", func() {
+				records, err := GetUsageRecords(db, org.ID, today.AddDate(0, 0, 1), today.AddDate(0, 0, 2))
+				So(err, ShouldBeNil)
+				So(records, ShouldHaveLength, 0)
+
+				records, err = GetUsageRecords(db, org.ID+1, today, today.AddDate(0, 0, 1))
+				So(err, ShouldBeNil)
+				So(records, ShouldHaveLength, 0)
+			})
+
+			Convey("When incrementing and flushing the usage again", func() {
+				So(IncrUsage(p, app.ID, devEUI2, UsageCounters{Joins: 1}), ShouldBeNil)
+				So(FlushUsage(db, p), ShouldBeNil)
+				So(FlushUsage(db, p), ShouldBeNil)
+
+				Convey("Then the counters have been added to the record", func() {
+					records, err := GetUsageRecords(db, 0, today, today.AddDate(0, 0, 1))
+					So(err, ShouldBeNil)
+					So(records, ShouldHaveLength, 1)
+					So(records[0].Uplinks, ShouldEqual, 2)
+					So(records[0].Downlinks, ShouldEqual, 2)
+					So(records[0].Joins, ShouldEqual, 1)
+					So(records[0].ActiveDevices, ShouldEqual, 2)
+				})
+			})
+
+			Convey("When the application is deleted", func() {
+				So(DeleteApplication(db, app.ID), ShouldBeNil)
+
+				Convey("Then the usage record is kept", func() {
+					records, err := GetUsageRecords(db, org.ID, today, today.AddDate(0, 0, 1))
+					So(err, ShouldBeNil)
+					So(records, ShouldHaveLength, 1)
+					So(records[0].ApplicationName, ShouldEqual, "")
+				})
+			})
+
+			Convey("When the organization is deleted", func() {
+				So(DeleteApplication(db, app.ID), ShouldBeNil)
+				So(DeleteOrganization(db, org.ID), ShouldBeNil)
+
+				Convey("Then the usage record is kept", func() {
+					records, err := GetUsageRecords(db, org.ID, today, today.AddDate(0, 0, 1))
+					So(err, ShouldBeNil)
+					So(records, ShouldHaveLength, 1)
+					So(records[0].OrganizationName, ShouldEqual, "")
+				})
+			})
+		})
+	})
+}
@@ -1,56 +1,40 @@
 package storage
 
 import (
-	"bytes"
-	"crypto/rand"
-	"crypto/sha512"
 	"database/sql"
-	"encoding/base64"
 	"regexp"
-	"strconv"
-	"strings"
 	"time"
 
-	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
-	"golang.org/x/crypto/pbkdf2"
 )
 
-// saltSize defines the salt size
-const saltSize = 16
-
-// HashIterations defines the number of hash iterations.
-var HashIterations = 100000
-
 // defaultSessionTTL defines the default session TTL
 const defaultSessionTTL = time.Hour * 24
 
 // Any upper, lower, digit characters, at least 6 characters.
 var usernameValidator = regexp.MustCompile(`^[[:alnum:]]+$`)
 
-// Any printable characters, at least 6 characters.
-var passwordValidator = regexp.MustCompile(`^.{6,}$`)
-
 // Must contain @ (this is far from perfect)
 var emailValidator = regexp.MustCompile(`.+@.+`)
 
 // User represents a user to external code.
 type User struct {
-	ID           int64     `db:"id"`
-	Username     string    `db:"username"`
-	IsAdmin      bool      `db:"is_admin"`
-	IsActive     bool      `db:"is_active"`
-	SessionTTL   int32     `db:"session_ttl"`
-	CreatedAt    time.Time `db:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at"`
-	PasswordHash string    `db:"password_hash"`
-	Email        string    `db:"email"`
-	Note         string    `db:"note"`
+	ID            int64     `db:"id"`
+	Username      string    `db:"username"`
+	IsAdmin       bool      `db:"is_admin"`
+	IsActive      bool      `db:"is_active"`
+	SessionTTL    int32     `db:"session_ttl"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+	PasswordHash  string    `db:"password_hash"`
+	Email         string    `db:"email"`
+	EmailVerified bool      `db:"email_verified"`
+	Note          string    `db:"note"`
 }
 
-const externalUserFields = "id, username, is_admin, is_active, session_ttl, created_at, updated_at, email, note"
+const externalUserFields = "id, username, is_admin, is_active, session_ttl, created_at, updated_at, email, email_verified, note"
 const internalUserFields = "*"
 
 // UserUpdate represents the user fields that can be "updated" in the simple
@@ -104,16 +88,18 @@ type UserProfileOrganization struct {
 
 // userInternal represents a user as known by the database.
 type userInternal struct {
-	ID           int64     `db:"id"`
-	Username     string    `db:"username"`
-	PasswordHash string    `db:"password_hash"`
-	IsAdmin      bool      `db:"is_admin"`
-	IsActive     bool      `db:"is_active"`
-	SessionTTL   int32     `db:"session_ttl"`
-	CreatedAt    time.Time `db:"created_at"`
-	UpdatedAt    time.Time `db:"updated_at"`
-	Email        string    `db:"email"`
-	Note         string    `db:"note"`
+	ID            int64     `db:"id"`
+	Username      string    `db:"username"`
+	PasswordHash  string    `db:"password_hash"`
+	IsAdmin       bool      `db:"is_admin"`
+	IsActive      bool      `db:"is_active"`
+	SessionTTL    int32     `db:"session_ttl"`
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+	Email         string    `db:"email"`
+	EmailVerified bool      `db:"email_verified"`
+	Note          string    `db:"note"`
+	ExternalID    *string   `db:"external_id"`
 }
 
 var jwtsecret []byte
@@ -131,12 +117,10 @@ func ValidateUsername(username string) error {
 	return nil
 }
 
-// ValidatePassword validates the given password.
+// ValidatePassword validates the given password against the password
+// policy.
 func ValidatePassword(password string) error {
-	if !passwordValidator.MatchString(password) {
-		return ErrUserPasswordLength
-	}
-	return nil
+	return passwordPolicy.Validate(password)
 }
 
 // ValidateEmail validates the given e-mail.
@@ -161,7 +145,7 @@ func CreateUser(db sqlx.Queryer, user *User, password string) (int64, error) {
 		return 0, errors.Wrap(err, "validation error")
 	}
 
-	pwHash, err := hash(password, saltSize, HashIterations)
+	pwHash, err := hashPassword(password)
 	if err != nil {
 		return 0, err
 	}
@@ -180,10 +164,11 @@ func CreateUser(db sqlx.Queryer, user *User, password string) (int64, error) {
 			created_at,
 			updated_at,
 			email,
-			note
+			note,
+			email_verified
 		)
 		values (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9) returning id`,
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10) returning id`,
 		user.Username,
 		pwHash,
 		user.IsAdmin,
@@ -193,6 +178,7 @@ func CreateUser(db sqlx.Queryer, user *User, password string) (int64, error) {
 		user.UpdatedAt,
 		user.Email,
 		user.Note,
+		user.EmailVerified,
 	)
 	if err != nil {
 		return 0, handlePSQLError(Insert, err, "insert error")
@@ -206,57 +192,63 @@ func CreateUser(db sqlx.Queryer, user *User, password string) (int64, error) {
 	return user.ID, nil
 }
 
-// Generate the hash of a password for storage in the database.
-// NOTE: We store the details of the hashing algorithm with the hash itself,
-// making it easy to recreate the hash for password checking, even if we change
-// the default criteria here.
-func hash(password string, saltSize int, iterations int) (string, error) {
-	// Generate a random salt value, 128 bits.
-	salt := make([]byte, saltSize)
-	_, err := rand.Read(salt)
-	if err != nil {
-		return "", errors.Wrap(err, "read random bytes error")
+// CreateExternalUser creates the given user, authenticated by an external
+// identity provider (e.g. OpenID Connect). The user does not have a password
+// and can not login using the username and password.
+func CreateExternalUser(db sqlx.Queryer, user *User, externalID string) (int64, error) {
+	if err := ValidateUsername(user.Username); err != nil {
+		return 0, errors.Wrap(err, "validation error")
 	}
 
-	return hashWithSalt(password, salt, iterations), nil
-}
+	if user.Email != "" {
+		if err := ValidateEmail(user.Email); err != nil {
+			return 0, errors.Wrap(err, "validation error")
+		}
+	}
 
-func hashWithSalt(password string, salt []byte, iterations int) string {
-	// Generate the hash.  This should be a little painful, adjust ITERATIONS
-	// if it needs performance tweeking.  Greatly depends on the hardware.
-	// NOTE: We store these details with the returned hash, so changes will not
-	// affect our ability to do password compares.
-	hash := pbkdf2.Key([]byte(password), salt, iterations, sha512.Size, sha512.New)
-
-	// Build up the parameters and hash into a single string so we can compare
-	// other string to the same hash.  Note that the hash algorithm is hard-
-	// coded here, as it is above.  Introducing alternate encodings must support
-	// old encodings as well, and build this string appropriately.
-	var buffer bytes.Buffer
-
-	buffer.WriteString("PBKDF2$")
-	buffer.WriteString("sha512$")
-	buffer.WriteString(strconv.Itoa(iterations))
-	buffer.WriteString("$")
-	buffer.WriteString(base64.StdEncoding.EncodeToString(salt))
-	buffer.WriteString("$")
-	buffer.WriteString(base64.StdEncoding.EncodeToString(hash))
-
-	return buffer.String()
-}
+	user.CreatedAt = time.Now()
+	user.UpdatedAt = time.Now()
+
+	// the e-mail address is verified by the identity provider
+	user.EmailVerified = true
+
+	err := sqlx.Get(db, &user.ID, `
+		insert into "user" (
+			username,
+			password_hash,
+			is_admin,
+			is_active,
+			session_ttl,
+			created_at,
+			updated_at,
+			email,
+			note,
+			external_id,
+			email_verified
+		)
+		values (
+			$1, '', $2, $3, $4, $5, $6, $7, $8, $9, $10) returning id`,
+		user.Username,
+		user.IsAdmin,
+		user.IsActive,
+		user.SessionTTL,
+		user.CreatedAt,
+		user.UpdatedAt,
+		user.Email,
+		user.Note,
+		externalID,
+		user.EmailVerified,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Insert, err, "insert error")
+	}
 
-// HashCompare verifies that passed password hashes to the same value as the
-// passed passwordHash.
-func hashCompare(password string, passwordHash string) bool {
-	// SPlit the hash string into its parts.
-	hashSplit := strings.Split(passwordHash, "$")
-
-	// Get the iterations and the salt and use them to encode the password
-	// being compared.cre
-	iterations, _ := strconv.Atoi(hashSplit[2])
-	salt, _ := base64.StdEncoding.DecodeString(hashSplit[3])
-	newHash := hashWithSalt(password, salt, iterations)
-	return newHash == passwordHash
+	log.WithFields(log.Fields{
+		"username":    user.Username,
+		"external_id": externalID,
+		"is_admin":    user.IsAdmin,
+	}).Info("external user created")
+	return user.ID, nil
 }
 
 // GetUser returns the User for the given id.
@@ -287,6 +279,20 @@ func GetUserByUsername(db sqlx.Queryer, username string) (User, error) {
 	return user, nil
 }
 
+// GetUserByExternalID returns the User for the given external id.
+func GetUserByExternalID(db sqlx.Queryer, externalID string) (User, error) {
+	var user User
+	err := sqlx.Get(db, &user, "select "+externalUserFields+" from \"user\" where external_id = $1", externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return user, ErrDoesNotExist
+		}
+		return user, errors.Wrap(err, "select error")
+	}
+
+	return user, nil
+}
+
 // GetUserCount returns the total number of users.
 func GetUserCount(db sqlx.Queryer, search string) (int32, error) {
 	var count int32
@@ -339,7 +345,8 @@ func UpdateUser(db sqlx.Execer, item UserUpdate) error {
 			session_ttl = $5,
 			updated_at = now(),
 			email = $6,
-			note = $7
+			note = $7,
+			email_verified = email_verified and email = $6
 		where id = $1`,
 		item.ID,
 		item.Username,
@@ -360,6 +367,13 @@ func UpdateUser(db sqlx.Execer, item UserUpdate) error {
 		return ErrDoesNotExist
 	}
 
+	// a deactivated user must not be able to continue its sessions
+	if !item.IsActive {
+		if err := DeleteUserSessions(db, item.ID); err != nil {
+			return errors.Wrap(err, "delete user sessions error")
+		}
+	}
+
 	log.WithFields(log.Fields{
 		"id":          item.ID,
 		"username":    item.Username,
@@ -390,47 +404,65 @@ func DeleteUser(db sqlx.Execer, id int64) error {
 	return nil
 }
 
-// LoginUser returns a JWT token for the user matching the given username
-// and password.
-func LoginUser(db sqlx.Queryer, username string, password string) (string, error) {
+// LoginUser creates a new session for the user matching the given username
+// and password and returns its JWT token.
+func LoginUser(db sqlx.Ext, username string, password string) (string, error) {
+	user, err := AuthenticateUser(db, username, password)
+	if err != nil {
+		return "", err
+	}
+
+	tokens, err := CreateUserSession(db, user, "")
+	if err != nil {
+		return "", err
+	}
+	return tokens.JWT, nil
+}
+
+// AuthenticateUser returns the user matching the given username and
+// password. When the password hash was not created using the configured
+// algorithm and parameters, the password is re-hashed.
+func AuthenticateUser(db sqlx.Ext, username string, password string) (User, error) {
 	// Find the user by username
 	var user userInternal
 	err := sqlx.Get(db, &user, "select "+internalUserFields+" from \"user\" where username = $1", username)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return "", ErrInvalidUsernameOrPassword
+			return User{}, ErrInvalidUsernameOrPassword
 		}
-		return "", errors.Wrap(err, "select error")
+		return User{}, errors.Wrap(err, "select error")
+	}
+
+	// Users created by an external identity provider do not have a password.
+	if user.PasswordHash == "" {
+		return User{}, ErrInvalidUsernameOrPassword
 	}
 
 	// Compare the passed in password with the hash in the database.
 	if !hashCompare(password, user.PasswordHash) {
-		return "", ErrInvalidUsernameOrPassword
-	}
-
-	// Generate the token.
-	now := time.Now()
-	nowSecondsSinceEpoch := now.Unix()
-	var expSecondsSinceEpoch int64
-	if user.SessionTTL > 0 {
-		expSecondsSinceEpoch = nowSecondsSinceEpoch + (60 * int64(user.SessionTTL))
-	} else {
-		expSecondsSinceEpoch = nowSecondsSinceEpoch + int64(defaultSessionTTL/time.Second)
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"iss":      "lora-app-server",
-		"aud":      "lora-app-server",
-		"nbf":      nowSecondsSinceEpoch,
-		"exp":      expSecondsSinceEpoch,
-		"sub":      "user",
-		"username": user.Username,
-	})
-
-	jwt, err := token.SignedString(jwtsecret)
-	if nil != err {
-		return jwt, errors.Wrap(err, "get jwt signed string error")
-	}
-	return jwt, err
+		return User{}, ErrInvalidUsernameOrPassword
+	}
+
+	// A failing re-hash must not prevent the user from logging in, it will
+	// be retried on the next login.
+	if passwordNeedsRehash(user.PasswordHash) {
+		if err := rehashPassword(db, user.ID, user.PasswordHash, password); err != nil {
+			log.WithError(err).WithField("id", user.ID).Error("rehash password error")
+		}
+	}
+
+	return User{
+		ID:            user.ID,
+		Username:      user.Username,
+		IsAdmin:       user.IsAdmin,
+		IsActive:      user.IsActive,
+		SessionTTL:    user.SessionTTL,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+		Note:          user.Note,
+	}, nil
 }
 
 // UpdatePassword updates the user with the new password.
@@ -439,7 +471,7 @@ func UpdatePassword(db sqlx.Execer, id int64, newpassword string) error {
 		return errors.Wrap(err, "validation error")
 	}
 
-	pwHash, err := hash(newpassword, saltSize, HashIterations)
+	pwHash, err := hashPassword(newpassword)
 	if err != nil {
 		return err
 	}
@@ -451,6 +483,10 @@ func UpdatePassword(db sqlx.Execer, id int64, newpassword string) error {
 		return errors.Wrap(err, "update error")
 	}
 
+	if err := DeleteUserSessions(db, id); err != nil {
+		return errors.Wrap(err, "delete user sessions error")
+	}
+
 	log.WithFields(log.Fields{
 		"id": id,
 	}).Info("user password updated")
@@ -458,6 +494,26 @@ func UpdatePassword(db sqlx.Execer, id int64, newpassword string) error {
 
 }
 
+// rehashPassword replaces the given password hash of the user by a hash
+// using the configured algorithm and parameters. Unlike UpdatePassword, the
+// sessions of the user are not revoked, but outstanding password reset
+// tokens become invalid.
+func rehashPassword(db sqlx.Execer, id int64, passwordHash, password string) error {
+	pwHash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(`update "user" set password_hash = $1 where id = $2 and password_hash = $3`,
+		pwHash, id, passwordHash)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+
+	log.WithField("id", id).Info("user password re-hashed")
+	return nil
+}
+
 // GetProfile returns the user profile (user, applications and organizations
 // to which the user is linked).
 func GetProfile(db sqlx.Queryer, id int64) (UserProfile, error) {
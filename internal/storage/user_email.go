@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Lifetime of the tokens sent by e-mail.
+var (
+	PasswordResetTokenTTL     = time.Hour
+	EmailVerificationTokenTTL = 72 * time.Hour
+)
+
+const (
+	passwordResetSubject     = "password_reset"
+	emailVerificationSubject = "email_verification"
+)
+
+// userEmailClaims defines the claims of the tokens sent by e-mail. The
+// fingerprint binds the token to the state of the user at the time the
+// token was issued (the password hash for password reset tokens and the
+// e-mail address for e-mail verification tokens), so that a token can only
+// be used once.
+type userEmailClaims struct {
+	jwt.StandardClaims
+
+	UserID      int64  `json:"uid"`
+	Fingerprint string `json:"fp"`
+}
+
+// GetUsersByEmail returns the active users with the given e-mail address.
+func GetUsersByEmail(db sqlx.Queryer, email string) ([]User, error) {
+	var users []User
+	err := sqlx.Select(db, &users, "select "+externalUserFields+` from "user" where lower(email) = lower($1) and is_active = true order by username`, email)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return users, nil
+}
+
+// GetPasswordResetToken returns a password reset token for the given user
+// and its expiration. The token is invalidated by a password change. It
+// returns ErrDoesNotExist for users without password (e.g. users created
+// by an external identity provider).
+func GetPasswordResetToken(db sqlx.Queryer, userID int64) (string, time.Time, error) {
+	user, err := getUserInternal(db, userID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if user.PasswordHash == "" {
+		return "", time.Time{}, ErrDoesNotExist
+	}
+
+	return getUserEmailToken(passwordResetSubject, user.ID, user.PasswordHash, PasswordResetTokenTTL)
+}
+
+// ResetPassword sets the password of the user matching the given password
+// reset token and returns the ID of this user.
+func ResetPassword(db sqlx.Ext, token, password string) (int64, error) {
+	claims, err := parseUserEmailToken(passwordResetSubject, token)
+	if err != nil {
+		return 0, ErrInvalidPasswordResetToken
+	}
+
+	user, err := getUserInternal(db, claims.UserID)
+	if err != nil {
+		if err == ErrDoesNotExist {
+			return 0, ErrInvalidPasswordResetToken
+		}
+		return 0, err
+	}
+	if !user.IsActive || user.PasswordHash == "" || !hmac.Equal([]byte(claims.Fingerprint), []byte(userEmailFingerprint(user.PasswordHash))) {
+		return 0, ErrInvalidPasswordResetToken
+	}
+
+	if err := UpdatePassword(db, user.ID, password); err != nil {
+		return 0, err
+	}
+
+	log.WithField("id", user.ID).Info("user password reset")
+	return user.ID, nil
+}
+
+// GetEmailVerificationToken returns an e-mail verification token for the
+// e-mail address of the given user and its expiration.
+func GetEmailVerificationToken(user User) (string, time.Time, error) {
+	if user.Email == "" {
+		return "", time.Time{}, ErrInvalidEmail
+	}
+
+	return getUserEmailToken(emailVerificationSubject, user.ID, user.Email, EmailVerificationTokenTTL)
+}
+
+// VerifyUserEmail marks the e-mail address of the user matching the given
+// e-mail verification token as verified and returns the ID of this user.
+func VerifyUserEmail(db sqlx.Ext, token string) (int64, error) {
+	claims, err := parseUserEmailToken(emailVerificationSubject, token)
+	if err != nil {
+		return 0, ErrInvalidEmailVerificationToken
+	}
+
+	user, err := GetUser(db, claims.UserID)
+	if err != nil {
+		if err == ErrDoesNotExist {
+			return 0, ErrInvalidEmailVerificationToken
+		}
+		return 0, err
+	}
+
+	// the e-mail address has been changed after the token was issued
+	if !hmac.Equal([]byte(claims.Fingerprint), []byte(userEmailFingerprint(user.Email))) {
+		return 0, ErrInvalidEmailVerificationToken
+	}
+
+	_, err = db.Exec(`update "user" set email_verified = true, updated_at = now() where id = $1`, user.ID)
+	if err != nil {
+		return 0, handlePSQLError(Update, err, "update error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":    user.ID,
+		"email": user.Email,
+	}).Info("user e-mail verified")
+	return user.ID, nil
+}
+
+func getUserInternal(db sqlx.Queryer, id int64) (userInternal, error) {
+	var user userInternal
+	err := sqlx.Get(db, &user, "select "+internalUserFields+" from \"user\" where id = $1", id)
+	if err != nil {
+		return user, handlePSQLError(Select, err, "select error")
+	}
+	return user, nil
+}
+
+func getUserEmailToken(subject string, userID int64, state string, ttl time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	exp := now.Add(ttl)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, userEmailClaims{
+		StandardClaims: jwt.StandardClaims{
+			Issuer:    "lora-app-server",
+			Audience:  "lora-app-server",
+			Subject:   subject,
+			NotBefore: now.Unix(),
+			ExpiresAt: exp.Unix(),
+		},
+		UserID:      userID,
+		Fingerprint: userEmailFingerprint(state),
+	})
+
+	signed, err := token.SignedString(userEmailTokenKey(subject))
+	if err != nil {
+		return "", time.Time{}, errors.Wrap(err, "get jwt signed string error")
+	}
+	return signed, exp, nil
+}
+
+func parseUserEmailToken(subject, tokenStr string) (*userEmailClaims, error) {
+	var claims userEmailClaims
+	token, err := jwt.ParseWithClaims(tokenStr, &claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method: %s", token.Header["alg"])
+		}
+		return userEmailTokenKey(subject), nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "jwt parse error")
+	}
+	if !token.Valid || claims.Subject != subject {
+		return nil, errors.New("invalid token")
+	}
+	return &claims, nil
+}
+
+// userEmailTokenKey returns the key for signing the tokens of the given
+// subject. The key is derived from the JWT secret, so that these tokens
+// can not be used as API tokens (or the other way around).
+func userEmailTokenKey(subject string) []byte {
+	mac := hmac.New(sha256.New, jwtsecret)
+	mac.Write([]byte(subject))
+	return mac.Sum(nil)
+}
+
+func userEmailFingerprint(state string) string {
+	h := sha256.Sum256([]byte(state))
+	return base64.RawURLEncoding.EncodeToString(h[:16])
+}
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestUserEmail(t *testing.T) {
+	conf := test.GetConfig()
+	SetUserSecret("DoWahDiddy")
+
+	Convey("Given a clean database with a user", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		user := User{
+			Username: "testuser",
+			IsActive: true,
+			Email:    "Foo@Bar.com",
+		}
+		_, err = CreateUser(db, &user, "password123")
+		So(err, ShouldBeNil)
+
+		Convey("Then the user can be retrieved by e-mail address (case-insensitive)", func() {
+			users, err := GetUsersByEmail(db, "foo@bar.com")
+			So(err, ShouldBeNil)
+			So(users, ShouldHaveLength, 1)
+			So(users[0].ID, ShouldEqual, user.ID)
+			So(users[0].EmailVerified, ShouldBeFalse)
+		})
+
+		Convey("Given a password reset token", func() {
+			token, expiresAt, err := GetPasswordResetToken(db, user.ID)
+			So(err, ShouldBeNil)
+			So(expiresAt, ShouldHappenAfter, user.CreatedAt)
+
+			Convey("Then the token can not be used as e-mail verification token", func() {
+				_, err := VerifyUserEmail(db, token)
+				So(err, ShouldEqual, ErrInvalidEmailVerificationToken)
+			})
+
+			Convey("When resetting the password", func() {
+				userID, err := ResetPassword(db, token, "newpassword123")
+				So(err, ShouldBeNil)
+				So(userID, ShouldEqual, user.ID)
+
+				Convey("Then the user can login with the new password", func() {
+					_, err := AuthenticateUser(db, user.Username, "newpassword123")
+					So(err, ShouldBeNil)
+				})
+
+				Convey("Then the token can not be used again", func() {
+					_, err := ResetPassword(db, token, "otherpassword123")
+					So(err, ShouldEqual, ErrInvalidPasswordResetToken)
+				})
+			})
+		})
+
+		Convey("Given an e-mail verification token", func() {
+			token, _, err := GetEmailVerificationToken(user)
+			So(err, ShouldBeNil)
+
+			Convey("When verifying the e-mail address", func() {
+				userID, err := VerifyUserEmail(db, token)
+				So(err, ShouldBeNil)
+				So(userID, ShouldEqual, user.ID)
+
+				Convey("Then the e-mail address has been verified", func() {
+					u, err := GetUser(db, user.ID)
+					So(err, ShouldBeNil)
+					So(u.EmailVerified, ShouldBeTrue)
+				})
+
+				Convey("When changing the e-mail address", func() {
+					So(UpdateUser(db, UserUpdate{
+						ID:       user.ID,
+						Username: user.Username,
+						IsActive: true,
+						Email:    "bar@foo.com",
+					}), ShouldBeNil)
+
+					Convey("Then the e-mail address must be verified again", func() {
+						u, err := GetUser(db, user.ID)
+						So(err, ShouldBeNil)
+						So(u.EmailVerified, ShouldBeFalse)
+
+						_, err = VerifyUserEmail(db, token)
+						So(err, ShouldEqual, ErrInvalidEmailVerificationToken)
+					})
+				})
+			})
+		})
+
+		Convey("Then an invalid token is rejected", func() {
+			_, err := ResetPassword(db, "invalid", "newpassword123")
+			So(err, ShouldEqual, ErrInvalidPasswordResetToken)
+		})
+	})
+}
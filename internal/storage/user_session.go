@@ -0,0 +1,285 @@
+package storage
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// refreshTokenSize defines the number of random bytes of a refresh token.
+const refreshTokenSize = 32
+
+// AccessTokenTTL defines the lifetime of the access tokens (JWT) issued for
+// a session. Once expired, a new access token must be requested using the
+// refresh token of the session.
+var AccessTokenTTL = 15 * time.Minute
+
+// UserSession defines a login session of a user. The session expires after
+// the session TTL of the user (or the default session TTL) and is revoked
+// by deleting it.
+type UserSession struct {
+	ID               int64     `db:"id"`
+	CreatedAt        time.Time `db:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at"`
+	ExpiresAt        time.Time `db:"expires_at"`
+	UserID           int64     `db:"user_id"`
+	RefreshTokenHash []byte    `db:"refresh_token_hash"`
+	RemoteAddr       string    `db:"remote_addr"`
+}
+
+// IsExpired returns true when the session has expired.
+func (s UserSession) IsExpired() bool {
+	return !s.ExpiresAt.After(time.Now())
+}
+
+// UserSessionTokens contains the tokens issued for a session.
+type UserSessionTokens struct {
+	JWT          string
+	RefreshToken string
+}
+
+// CreateUserSession creates a new session for the given user and returns
+// the access and refresh tokens. This is the only time the refresh token is
+// available, as only its hash is stored.
+func CreateUserSession(db sqlx.Queryer, user User, remoteAddr string) (UserSessionTokens, error) {
+	refreshToken, err := newRefreshToken()
+	if err != nil {
+		return UserSessionTokens{}, err
+	}
+
+	now := time.Now()
+	ttl := defaultSessionTTL
+	if user.SessionTTL > 0 {
+		ttl = time.Duration(user.SessionTTL) * time.Minute
+	}
+
+	s := UserSession{
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		ExpiresAt:        now.Add(ttl),
+		UserID:           user.ID,
+		RefreshTokenHash: hashRefreshToken(refreshToken),
+		RemoteAddr:       remoteAddr,
+	}
+
+	err = sqlx.Get(db, &s.ID, `
+		insert into user_session (
+			created_at,
+			updated_at,
+			expires_at,
+			user_id,
+			refresh_token_hash,
+			remote_addr
+		) values ($1, $2, $3, $4, $5, $6)
+		returning id`,
+		s.CreatedAt,
+		s.UpdatedAt,
+		s.ExpiresAt,
+		s.UserID,
+		s.RefreshTokenHash,
+		s.RemoteAddr,
+	)
+	if err != nil {
+		return UserSessionTokens{}, handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithFields(log.Fields{
+		"id":         s.ID,
+		"user_id":    s.UserID,
+		"expires_at": s.ExpiresAt,
+	}).Info("user session created")
+
+	token, err := getUserToken(user, s)
+	if err != nil {
+		return UserSessionTokens{}, err
+	}
+
+	return UserSessionTokens{
+		JWT:          token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// RefreshUserSession returns a new access token for the session matching
+// the given refresh token. The refresh token is rotated, the returned
+// refresh token must be used for the next refresh.
+func RefreshUserSession(db sqlx.Queryer, refreshToken string) (UserSessionTokens, error) {
+	newToken, err := newRefreshToken()
+	if err != nil {
+		return UserSessionTokens{}, err
+	}
+
+	var s UserSession
+	err = sqlx.Get(db, &s, `
+		update user_session
+		set
+			refresh_token_hash = $2,
+			updated_at = $3
+		where
+			refresh_token_hash = $1
+			and expires_at > $3
+		returning *`,
+		hashRefreshToken(refreshToken),
+		hashRefreshToken(newToken),
+		time.Now(),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return UserSessionTokens{}, ErrInvalidRefreshToken
+		}
+		return UserSessionTokens{}, handlePSQLError(Update, err, "update error")
+	}
+
+	user, err := GetUser(db, s.UserID)
+	if err != nil {
+		return UserSessionTokens{}, errors.Wrap(err, "get user error")
+	}
+	if !user.IsActive {
+		return UserSessionTokens{}, ErrInvalidRefreshToken
+	}
+
+	token, err := getUserToken(user, s)
+	if err != nil {
+		return UserSessionTokens{}, err
+	}
+
+	return UserSessionTokens{
+		JWT:          token,
+		RefreshToken: newToken,
+	}, nil
+}
+
+// GetUserSession returns the session for the given id.
+func GetUserSession(db sqlx.Queryer, id int64) (UserSession, error) {
+	var s UserSession
+	err := sqlx.Get(db, &s, "select * from user_session where id = $1", id)
+	if err != nil {
+		return s, handlePSQLError(Select, err, "select error")
+	}
+	return s, nil
+}
+
+// GetUserSessionCount returns the number of active (not expired) sessions
+// of the given user.
+func GetUserSessionCount(db sqlx.Queryer, userID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from user_session
+		where
+			user_id = $1
+			and expires_at > now()`,
+		userID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// GetUserSessions returns the active (not expired) sessions of the given
+// user, most recently used first.
+func GetUserSessions(db sqlx.Queryer, userID int64, limit, offset int) ([]UserSession, error) {
+	var sessions []UserSession
+	err := sqlx.Select(db, &sessions, `
+		select *
+		from user_session
+		where
+			user_id = $1
+			and expires_at > now()
+		order by updated_at desc, id desc
+		limit $2
+		offset $3`,
+		userID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return sessions, nil
+}
+
+// DeleteUserSession deletes (revokes) the session for the given id.
+func DeleteUserSession(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from user_session where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("user session deleted")
+	return nil
+}
+
+// DeleteUserSessions deletes (revokes) all sessions of the given user.
+func DeleteUserSessions(db sqlx.Execer, userID int64) error {
+	res, err := db.Exec("delete from user_session where user_id = $1", userID)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+
+	log.WithFields(log.Fields{
+		"user_id": userID,
+		"count":   ra,
+	}).Info("user sessions deleted")
+	return nil
+}
+
+// getUserToken returns a JWT token for the given user and session. The
+// token expires after the AccessTokenTTL, or when the session expires.
+func getUserToken(user User, s UserSession) (string, error) {
+	now := time.Now()
+	exp := now.Add(AccessTokenTTL)
+	if exp.After(s.ExpiresAt) {
+		exp = s.ExpiresAt
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss":      "lora-app-server",
+		"aud":      "lora-app-server",
+		"nbf":      now.Unix(),
+		"exp":      exp.Unix(),
+		"sub":      "user",
+		"username": user.Username,
+		"sid":      s.ID,
+	})
+
+	signed, err := token.SignedString(jwtsecret)
+	if err != nil {
+		return "", errors.Wrap(err, "get jwt signed string error")
+	}
+	return signed, nil
+}
+
+func newRefreshToken() (string, error) {
+	b := make([]byte, refreshTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashRefreshToken returns the SHA-256 hash of the given refresh token,
+// which is used to lookup the session.
+func hashRefreshToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
@@ -0,0 +1,127 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestUserSession(t *testing.T) {
+	conf := test.GetConfig()
+	SetUserSecret("DoWahDiddy")
+
+	Convey("Given a clean database with a user", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+
+		user := User{
+			Username:   "testuser",
+			IsActive:   true,
+			SessionTTL: 60,
+			Email:      "foo@bar.com",
+		}
+		user.ID, err = CreateUser(db, &user, "password123")
+		So(err, ShouldBeNil)
+
+		Convey("When creating a session", func() {
+			tokens, err := CreateUserSession(db, user, "127.0.0.1")
+			So(err, ShouldBeNil)
+			So(tokens.RefreshToken, ShouldNotEqual, "")
+
+			sessions, err := GetUserSessions(db, user.ID, 10, 0)
+			So(err, ShouldBeNil)
+			So(sessions, ShouldHaveLength, 1)
+			s := sessions[0]
+
+			Convey("Then the session has been created", func() {
+				So(s.RemoteAddr, ShouldEqual, "127.0.0.1")
+				So(s.ExpiresAt.Sub(s.CreatedAt), ShouldEqual, time.Hour)
+
+				count, err := GetUserSessionCount(db, user.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+			})
+
+			Convey("Then the JWT contains the session id and expires before the session", func() {
+				claims := jwt.MapClaims{}
+				_, err := jwt.ParseWithClaims(tokens.JWT, claims, func(*jwt.Token) (interface{}, error) {
+					return jwtsecret, nil
+				})
+				So(err, ShouldBeNil)
+				So(claims["sid"], ShouldEqual, float64(s.ID))
+				So(claims["username"], ShouldEqual, user.Username)
+				So(claims["exp"], ShouldBeLessThanOrEqualTo, float64(time.Now().Add(AccessTokenTTL).Unix()))
+			})
+
+			Convey("When refreshing the session", func() {
+				refreshed, err := RefreshUserSession(db, tokens.RefreshToken)
+				So(err, ShouldBeNil)
+				So(refreshed.JWT, ShouldNotEqual, "")
+
+				Convey("Then the refresh token has been rotated", func() {
+					So(refreshed.RefreshToken, ShouldNotEqual, tokens.RefreshToken)
+
+					_, err := RefreshUserSession(db, tokens.RefreshToken)
+					So(err, ShouldEqual, ErrInvalidRefreshToken)
+
+					_, err = RefreshUserSession(db, refreshed.RefreshToken)
+					So(err, ShouldBeNil)
+				})
+			})
+
+			Convey("When the session has expired", func() {
+				_, err := db.Exec("update user_session set expires_at = now() - interval '1 minute' where id = $1", s.ID)
+				So(err, ShouldBeNil)
+
+				Convey("Then it can not be refreshed and is not listed", func() {
+					_, err := RefreshUserSession(db, tokens.RefreshToken)
+					So(err, ShouldEqual, ErrInvalidRefreshToken)
+
+					count, err := GetUserSessionCount(db, user.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, 0)
+				})
+			})
+
+			Convey("When deleting the session", func() {
+				So(DeleteUserSession(db, s.ID), ShouldBeNil)
+
+				Convey("Then the session has been revoked", func() {
+					_, err := GetUserSession(db, s.ID)
+					So(err, ShouldEqual, ErrDoesNotExist)
+
+					_, err = RefreshUserSession(db, tokens.RefreshToken)
+					So(err, ShouldEqual, ErrInvalidRefreshToken)
+				})
+			})
+
+			Convey("When updating the password of the user", func() {
+				So(UpdatePassword(db, user.ID, "newpassword123"), ShouldBeNil)
+
+				Convey("Then all sessions have been revoked", func() {
+					_, err := GetUserSession(db, s.ID)
+					So(err, ShouldEqual, ErrDoesNotExist)
+				})
+			})
+
+			Convey("When deactivating the user", func() {
+				So(UpdateUser(db, UserUpdate{
+					ID:       user.ID,
+					Username: user.Username,
+					IsActive: false,
+					Email:    user.Email,
+				}), ShouldBeNil)
+
+				Convey("Then all sessions have been revoked", func() {
+					_, err := GetUserSession(db, s.ID)
+					So(err, ShouldEqual, ErrDoesNotExist)
+				})
+			})
+		})
+	})
+}
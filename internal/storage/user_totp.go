@@ -0,0 +1,407 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// TOTP parameters (RFC 6238), these are the defaults supported by all
+// authenticator apps.
+const (
+	totpIssuer     = "LoRa App Server"
+	totpSecretSize = 20
+	totpPeriod     = 30
+	totpDigits     = 6
+	totpSkew       = 1
+)
+
+const recoveryCodeCount = 10
+
+const (
+	secondFactorChallengeKeyTempl   = "lora:as:second-factor:%s"
+	secondFactorChallengeTTL        = 5 * time.Minute
+	secondFactorChallengeMaxAttempt = 5
+)
+
+// UserTOTP contains the TOTP (time-based one-time password) second factor
+// of a user. The TOTP is pending until it has been enabled using a valid
+// code.
+type UserTOTP struct {
+	UserID    int64     `db:"user_id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	Secret    string    `db:"secret"`
+	Enabled   bool      `db:"enabled"`
+	LastStep  int64     `db:"last_step"`
+}
+
+// ProvisioningURI returns the otpauth:// uri which can be used to create
+// a QR code for authenticator apps.
+func (t UserTOTP) ProvisioningURI(username string) string {
+	q := url.Values{}
+	q.Set("secret", t.Secret)
+	q.Set("issuer", totpIssuer)
+	q.Set("algorithm", "SHA1")
+	q.Set("digits", fmt.Sprintf("%d", totpDigits))
+	q.Set("period", fmt.Sprintf("%d", totpPeriod))
+
+	// authenticator apps do not decode + as space
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + username,
+		RawQuery: strings.Replace(q.Encode(), "+", "%20", -1),
+	}
+	return u.String()
+}
+
+// validateCode returns the time-step matching the given code. Codes of the
+// previous and next time-step are accepted to allow for clock drift, codes
+// of already used time-steps are rejected.
+func (t UserTOTP) validateCode(code string, now time.Time) (int64, bool) {
+	secret, err := base32.StdEncoding.DecodeString(t.Secret)
+	if err != nil {
+		return 0, false
+	}
+
+	step := now.Unix() / totpPeriod
+	for i := -totpSkew; i <= totpSkew; i++ {
+		s := step + int64(i)
+		if s > t.LastStep && hmac.Equal([]byte(getTOTPCode(secret, s)), []byte(code)) {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// getTOTPCode returns the code for the given secret and time-step.
+func getTOTPCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+
+	h := hmac.New(sha1.New, secret)
+	h.Write(msg[:])
+	sum := h.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, v%1000000)
+}
+
+// CreateUserTOTP creates a new (pending) TOTP secret for the given user,
+// replacing the previous pending secret.
+func CreateUserTOTP(db sqlx.Ext, userID int64) (UserTOTP, error) {
+	t, err := GetUserTOTP(db, userID)
+	if err != nil && err != ErrDoesNotExist {
+		return t, err
+	}
+	if t.Enabled {
+		return t, ErrUserTOTPAlreadyEnabled
+	}
+
+	b := make([]byte, totpSecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return t, errors.Wrap(err, "read random bytes error")
+	}
+
+	now := time.Now()
+	t = UserTOTP{
+		UserID:    userID,
+		CreatedAt: now,
+		UpdatedAt: now,
+		Secret:    base32.StdEncoding.EncodeToString(b),
+	}
+
+	_, err = db.Exec("delete from user_totp where user_id = $1", userID)
+	if err != nil {
+		return t, handlePSQLError(Delete, err, "delete error")
+	}
+
+	_, err = db.Exec(`
+		insert into user_totp (
+			user_id,
+			created_at,
+			updated_at,
+			secret,
+			enabled,
+			last_step
+		) values ($1, $2, $3, $4, $5, $6)`,
+		t.UserID,
+		t.CreatedAt,
+		t.UpdatedAt,
+		t.Secret,
+		t.Enabled,
+		t.LastStep,
+	)
+	if err != nil {
+		return t, handlePSQLError(Insert, err, "insert error")
+	}
+
+	log.WithField("user_id", userID).Info("user totp created")
+	return t, nil
+}
+
+// GetUserTOTP returns the TOTP of the given user.
+func GetUserTOTP(db sqlx.Queryer, userID int64) (UserTOTP, error) {
+	var t UserTOTP
+	err := sqlx.Get(db, &t, "select * from user_totp where user_id = $1", userID)
+	if err != nil {
+		return t, handlePSQLError(Select, err, "select error")
+	}
+	return t, nil
+}
+
+// EnableUserTOTP enables the pending TOTP of the given user, after
+// validating the given code. It returns the recovery codes, which can be
+// used (once) instead of a code. This is the only time the recovery codes
+// are available, as only their hashes are stored.
+func EnableUserTOTP(db sqlx.Ext, userID int64, code string) ([]string, error) {
+	t, err := GetUserTOTP(db, userID)
+	if err != nil {
+		return nil, err
+	}
+	if t.Enabled {
+		return nil, ErrUserTOTPAlreadyEnabled
+	}
+
+	step, ok := t.validateCode(code, time.Now())
+	if !ok {
+		return nil, ErrUserTOTPInvalidCode
+	}
+
+	_, err = db.Exec(`
+		update user_totp
+		set
+			enabled = true,
+			last_step = $2,
+			updated_at = $3
+		where user_id = $1`,
+		userID,
+		step,
+		time.Now(),
+	)
+	if err != nil {
+		return nil, handlePSQLError(Update, err, "update error")
+	}
+
+	var codes []string
+	for i := 0; i < recoveryCodeCount; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, errors.Wrap(err, "read random bytes error")
+		}
+		c := strings.ToLower(base32.StdEncoding.EncodeToString(b))
+		c = c[:4] + "-" + c[4:]
+
+		_, err = db.Exec(`
+			insert into user_totp_recovery_code (
+				user_id,
+				code_hash
+			) values ($1, $2)`,
+			userID,
+			hashRecoveryCode(c),
+		)
+		if err != nil {
+			return nil, handlePSQLError(Insert, err, "insert error")
+		}
+		codes = append(codes, c)
+	}
+
+	log.WithField("user_id", userID).Info("user totp enabled")
+	return codes, nil
+}
+
+// ValidateUserTOTP validates the given TOTP or recovery code of the given
+// user. Each code can only be used once.
+func ValidateUserTOTP(db sqlx.Ext, userID int64, code string) error {
+	t, err := GetUserTOTP(db, userID)
+	if err != nil {
+		if err == ErrDoesNotExist {
+			return ErrUserTOTPNotEnabled
+		}
+		return err
+	}
+	if !t.Enabled {
+		return ErrUserTOTPNotEnabled
+	}
+
+	if step, ok := t.validateCode(code, time.Now()); ok {
+		// the last_step condition prevents the re-use of the code by a
+		// concurrent request
+		res, err := db.Exec(`
+			update user_totp
+			set
+				last_step = $2,
+				updated_at = $3
+			where
+				user_id = $1
+				and last_step < $2`,
+			userID,
+			step,
+			time.Now(),
+		)
+		if err != nil {
+			return handlePSQLError(Update, err, "update error")
+		}
+		ra, err := res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "get rows affected error")
+		}
+		if ra == 0 {
+			return ErrUserTOTPInvalidCode
+		}
+		return nil
+	}
+
+	res, err := db.Exec("delete from user_totp_recovery_code where user_id = $1 and code_hash = $2", userID, hashRecoveryCode(code))
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrUserTOTPInvalidCode
+	}
+
+	log.WithField("user_id", userID).Info("user totp recovery code used")
+	return nil
+}
+
+// GetUserTOTPRecoveryCodeCount returns the number of unused recovery codes
+// of the given user.
+func GetUserTOTPRecoveryCodeCount(db sqlx.Queryer, userID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, "select count(*) from user_totp_recovery_code where user_id = $1", userID)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// DeleteUserTOTP deletes the TOTP and recovery codes of the given user.
+func DeleteUserTOTP(db sqlx.Execer, userID int64) error {
+	res, err := db.Exec("delete from user_totp where user_id = $1", userID)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("user_id", userID).Info("user totp deleted")
+	return nil
+}
+
+// GetSecondFactorRequiredForUser returns true when the user is member of
+// an organization which requires a second factor.
+func GetSecondFactorRequiredForUser(db sqlx.Queryer, userID int64) (bool, error) {
+	var required bool
+	err := sqlx.Get(db, &required, `
+		select exists (
+			select 1
+			from organization_user ou
+			inner join organization o
+				on o.id = ou.organization_id
+			where
+				ou.user_id = $1
+				and o.require_second_factor = true
+		)`,
+		userID,
+	)
+	if err != nil {
+		return false, handlePSQLError(Select, err, "select error")
+	}
+	return required, nil
+}
+
+// CreateSecondFactorChallenge creates a challenge token for the given user,
+// which must be completed with a second factor to obtain the JWT token.
+func CreateSecondFactorChallenge(p *redis.Pool, userID int64) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "read random bytes error")
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	key := fmt.Sprintf(secondFactorChallengeKeyTempl, token)
+
+	c := p.Get()
+	defer c.Close()
+
+	c.Send("MULTI")
+	c.Send("HSET", key, "user_id", userID)
+	c.Send("PEXPIRE", key, int64(secondFactorChallengeTTL/time.Millisecond))
+	if _, err := c.Do("EXEC"); err != nil {
+		return "", errors.Wrap(err, "create challenge error")
+	}
+
+	return token, nil
+}
+
+// GetSecondFactorChallengeUserID returns the user ID of the given
+// challenge token. Each call counts as an attempt, the challenge is removed
+// after too many attempts.
+func GetSecondFactorChallengeUserID(p *redis.Pool, token string) (int64, error) {
+	key := fmt.Sprintf(secondFactorChallengeKeyTempl, token)
+
+	c := p.Get()
+	defer c.Close()
+
+	userID, err := redis.Int64(c.Do("HGET", key, "user_id"))
+	if err != nil {
+		if err == redis.ErrNil {
+			return 0, ErrInvalidSecondFactorChallenge
+		}
+		return 0, errors.Wrap(err, "get challenge error")
+	}
+
+	attempts, err := redis.Int(c.Do("HINCRBY", key, "attempts", 1))
+	if err != nil {
+		return 0, errors.Wrap(err, "increment attempts error")
+	}
+	if attempts > secondFactorChallengeMaxAttempt {
+		if _, err := c.Do("DEL", key); err != nil {
+			return 0, errors.Wrap(err, "delete challenge error")
+		}
+		return 0, ErrInvalidSecondFactorChallenge
+	}
+
+	return userID, nil
+}
+
+// DeleteSecondFactorChallenge deletes the given challenge token.
+func DeleteSecondFactorChallenge(p *redis.Pool, token string) error {
+	c := p.Get()
+	defer c.Close()
+
+	if _, err := c.Do("DEL", fmt.Sprintf(secondFactorChallengeKeyTempl, token)); err != nil {
+		return errors.Wrap(err, "delete challenge error")
+	}
+	return nil
+}
+
+func hashRecoveryCode(code string) []byte {
+	code = strings.ToLower(strings.Replace(strings.TrimSpace(code), "-", "", -1))
+	h := sha256.Sum256([]byte(code))
+	return h[:]
+}
@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestGetTOTPCode(t *testing.T) {
+	Convey("Given the RFC 6238 test secret", t, func() {
+		secret := []byte("12345678901234567890")
+
+		Convey("Then the expected codes are returned", func() {
+			// RFC 6238 appendix B (SHA1), truncated to 6 digits
+			So(getTOTPCode(secret, 59/totpPeriod), ShouldEqual, "287082")
+			So(getTOTPCode(secret, 1111111109/totpPeriod), ShouldEqual, "081804")
+			So(getTOTPCode(secret, 1234567890/totpPeriod), ShouldEqual, "005924")
+		})
+	})
+}
+
+func TestUserTOTP(t *testing.T) {
+	conf := test.GetConfig()
+	p := NewRedisPool(conf.RedisURL)
+
+	Convey("Given a clean database with a user", t, func() {
+		db, err := OpenDatabase(conf.PostgresDSN)
+		So(err, ShouldBeNil)
+		test.MustResetDB(db)
+		test.MustFlushRedis(p)
+
+		user := User{
+			Username: "testuser",
+			Email:    "foo@bar.com",
+			IsActive: true,
+		}
+		_, err = CreateUser(db, &user, "password123")
+		So(err, ShouldBeNil)
+
+		getCode := func(totp UserTOTP, offset int64) string {
+			secret, err := base32.StdEncoding.DecodeString(totp.Secret)
+			So(err, ShouldBeNil)
+			return getTOTPCode(secret, time.Now().Unix()/totpPeriod+offset)
+		}
+
+		Convey("Then the user does not have a TOTP", func() {
+			_, err := GetUserTOTP(db, user.ID)
+			So(err, ShouldEqual, ErrDoesNotExist)
+
+			So(ValidateUserTOTP(db, user.ID, "123456"), ShouldEqual, ErrUserTOTPNotEnabled)
+		})
+
+		Convey("When creating a TOTP", func() {
+			totp, err := CreateUserTOTP(db, user.ID)
+			So(err, ShouldBeNil)
+			So(totp.Secret, ShouldHaveLength, 32)
+			So(totp.Enabled, ShouldBeFalse)
+
+			Convey("Then the provisioning uri contains the secret", func() {
+				u, err := url.Parse(totp.ProvisioningURI(user.Username))
+				So(err, ShouldBeNil)
+				So(u.Scheme, ShouldEqual, "otpauth")
+				So(u.Host, ShouldEqual, "totp")
+				So(u.Path, ShouldEqual, "/LoRa App Server:testuser")
+				So(u.Query().Get("secret"), ShouldEqual, totp.Secret)
+				So(u.Query().Get("issuer"), ShouldEqual, "LoRa App Server")
+			})
+
+			Convey("Then creating a TOTP again replaces the pending secret", func() {
+				totp2, err := CreateUserTOTP(db, user.ID)
+				So(err, ShouldBeNil)
+				So(totp2.Secret, ShouldNotEqual, totp.Secret)
+			})
+
+			Convey("Then enabling the TOTP with an invalid code fails", func() {
+				_, err := EnableUserTOTP(db, user.ID, "invalid")
+				So(err, ShouldEqual, ErrUserTOTPInvalidCode)
+			})
+
+			Convey("When enabling the TOTP with a valid code", func() {
+				codes, err := EnableUserTOTP(db, user.ID, getCode(totp, 0))
+				So(err, ShouldBeNil)
+				So(codes, ShouldHaveLength, recoveryCodeCount)
+
+				Convey("Then the TOTP is enabled", func() {
+					totp, err := GetUserTOTP(db, user.ID)
+					So(err, ShouldBeNil)
+					So(totp.Enabled, ShouldBeTrue)
+
+					count, err := GetUserTOTPRecoveryCodeCount(db, user.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, recoveryCodeCount)
+				})
+
+				Convey("Then creating a new TOTP fails", func() {
+					_, err := CreateUserTOTP(db, user.ID)
+					So(err, ShouldEqual, ErrUserTOTPAlreadyEnabled)
+				})
+
+				Convey("Then the used code can not be used again", func() {
+					So(ValidateUserTOTP(db, user.ID, getCode(totp, 0)), ShouldEqual, ErrUserTOTPInvalidCode)
+				})
+
+				Convey("Then the code of the next time-step is valid once", func() {
+					So(ValidateUserTOTP(db, user.ID, getCode(totp, 1)), ShouldBeNil)
+					So(ValidateUserTOTP(db, user.ID, getCode(totp, 1)), ShouldEqual, ErrUserTOTPInvalidCode)
+				})
+
+				Convey("Then a code outside the allowed time-steps is invalid", func() {
+					So(ValidateUserTOTP(db, user.ID, getCode(totp, 3)), ShouldEqual, ErrUserTOTPInvalidCode)
+				})
+
+				Convey("Then a recovery code is valid once", func() {
+					So(ValidateUserTOTP(db, user.ID, codes[0]), ShouldBeNil)
+					So(ValidateUserTOTP(db, user.ID, codes[0]), ShouldEqual, ErrUserTOTPInvalidCode)
+
+					count, err := GetUserTOTPRecoveryCodeCount(db, user.ID)
+					So(err, ShouldBeNil)
+					So(count, ShouldEqual, recoveryCodeCount-1)
+				})
+
+				Convey("When deleting the TOTP", func() {
+					So(DeleteUserTOTP(db, user.ID), ShouldBeNil)
+
+					Convey("Then the TOTP and recovery codes have been deleted", func() {
+						_, err := GetUserTOTP(db, user.ID)
+						So(err, ShouldEqual, ErrDoesNotExist)
+
+						count, err := GetUserTOTPRecoveryCodeCount(db, user.ID)
+						So(err, ShouldBeNil)
+						So(count, ShouldEqual, 0)
+					})
+				})
+			})
+		})
+
+		Convey("Given an organization which requires a second factor", func() {
+			org := Organization{
+				Name:                "test-org",
+				RequireSecondFactor: true,
+			}
+			So(CreateOrganization(db, &org), ShouldBeNil)
+
+			Convey("Then a second factor is not required when the user is not a member", func() {
+				required, err := GetSecondFactorRequiredForUser(db, user.ID)
+				So(err, ShouldBeNil)
+				So(required, ShouldBeFalse)
+			})
+
+			Convey("Then a second factor is required when the user is a member", func() {
+				So(CreateOrganizationUser(db, org.ID, user.ID, false), ShouldBeNil)
+
+				required, err := GetSecondFactorRequiredForUser(db, user.ID)
+				So(err, ShouldBeNil)
+				So(required, ShouldBeTrue)
+			})
+		})
+
+		Convey("When creating a second factor challenge", func() {
+			token, err := CreateSecondFactorChallenge(p, user.ID)
+			So(err, ShouldBeNil)
+
+			Convey("Then the user id is returned for the challenge", func() {
+				userID, err := GetSecondFactorChallengeUserID(p, token)
+				So(err, ShouldBeNil)
+				So(userID, ShouldEqual, user.ID)
+			})
+
+			Convey("Then the challenge is invalid after too many attempts", func() {
+				for i := 0; i < secondFactorChallengeMaxAttempt; i++ {
+					_, err := GetSecondFactorChallengeUserID(p, token)
+					So(err, ShouldBeNil)
+				}
+				_, err := GetSecondFactorChallengeUserID(p, token)
+				So(err, ShouldEqual, ErrInvalidSecondFactorChallenge)
+			})
+
+			Convey("Then the challenge is invalid after deleting it", func() {
+				So(DeleteSecondFactorChallenge(p, token), ShouldBeNil)
+				_, err := GetSecondFactorChallengeUserID(p, token)
+				So(err, ShouldEqual, ErrInvalidSecondFactorChallenge)
+			})
+		})
+	})
+}
@@ -0,0 +1,324 @@
+package storage
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Webhook defines an endpoint receiving the management events (e.g. a
+// device has been created or a gateway deleted) of an organization.
+type Webhook struct {
+	ID             int64     `db:"id"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+	OrganizationID int64     `db:"organization_id"`
+	Name           string    `db:"name"`
+	URL            string    `db:"url"`
+	Secret         string    `db:"secret"`
+}
+
+// Validate validates the webhook data.
+func (w Webhook) Validate() error {
+	if strings.TrimSpace(w.Name) == "" {
+		return ErrWebhookInvalidName
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrWebhookInvalidURL
+	}
+	if w.Secret == "" {
+		return ErrWebhookInvalidSecret
+	}
+	return nil
+}
+
+// WebhookDelivery defines a pending delivery of an event to a webhook.
+type WebhookDelivery struct {
+	ID            int64           `db:"id"`
+	CreatedAt     time.Time       `db:"created_at"`
+	WebhookID     int64           `db:"webhook_id"`
+	Event         json.RawMessage `db:"event"`
+	Attempts      int             `db:"attempts"`
+	NextAttemptAt time.Time       `db:"next_attempt_at"`
+	LastError     string          `db:"last_error"`
+}
+
+// CreateWebhook creates the given webhook.
+func CreateWebhook(db sqlx.Queryer, w *Webhook) error {
+	if err := w.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	err := sqlx.Get(db, &w.ID, `
+		insert into webhook (
+			created_at,
+			updated_at,
+			organization_id,
+			name,
+			url,
+			secret
+		) values ($1, $2, $3, $4, $5, $6)
+		returning id`,
+		now,
+		now,
+		w.OrganizationID,
+		w.Name,
+		w.URL,
+		w.Secret,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+
+	w.CreatedAt = now
+	w.UpdatedAt = now
+
+	log.WithFields(log.Fields{
+		"id":              w.ID,
+		"organization_id": w.OrganizationID,
+	}).Info("webhook created")
+	return nil
+}
+
+// GetWebhook returns the webhook for the given id.
+func GetWebhook(db sqlx.Queryer, id int64) (Webhook, error) {
+	var w Webhook
+	err := sqlx.Get(db, &w, "select * from webhook where id = $1", id)
+	if err != nil {
+		return w, handlePSQLError(Select, err, "select error")
+	}
+	return w, nil
+}
+
+// GetWebhooks returns the webhooks, optionally filtered on organization id
+// (use 0 to disable the filter).
+func GetWebhooks(db sqlx.Queryer, organizationID int64, limit, offset int) ([]Webhook, error) {
+	var webhooks []Webhook
+	err := sqlx.Select(db, &webhooks, `
+		select *
+		from webhook
+		where
+			($1 = 0 or organization_id = $1)
+		order by name
+		limit $2
+		offset $3`,
+		organizationID,
+		limit,
+		offset,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return webhooks, nil
+}
+
+// GetWebhookCount returns the total number of webhooks, optionally filtered
+// on organization id (use 0 to disable the filter).
+func GetWebhookCount(db sqlx.Queryer, organizationID int64) (int, error) {
+	var count int
+	err := sqlx.Get(db, &count, `
+		select count(*)
+		from webhook
+		where
+			($1 = 0 or organization_id = $1)`,
+		organizationID,
+	)
+	if err != nil {
+		return 0, handlePSQLError(Select, err, "select error")
+	}
+	return count, nil
+}
+
+// UpdateWebhook updates the given webhook.
+func UpdateWebhook(db sqlx.Execer, w *Webhook) error {
+	if err := w.Validate(); err != nil {
+		return errors.Wrap(err, "validate error")
+	}
+
+	now := time.Now()
+	res, err := db.Exec(`
+		update webhook
+		set
+			updated_at = $2,
+			name = $3,
+			url = $4,
+			secret = $5
+		where
+			id = $1`,
+		w.ID,
+		now,
+		w.Name,
+		w.URL,
+		w.Secret,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	w.UpdatedAt = now
+
+	log.WithField("id", w.ID).Info("webhook updated")
+	return nil
+}
+
+// DeleteWebhook deletes the webhook for the given id. Its pending
+// deliveries are deleted too.
+func DeleteWebhook(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from webhook where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+
+	log.WithField("id", id).Info("webhook deleted")
+	return nil
+}
+
+// CreateWebhookDeliveries creates a delivery of the given event for each
+// webhook of the given organization.
+func CreateWebhookDeliveries(db sqlx.Execer, organizationID int64, event json.RawMessage) error {
+	_, err := db.Exec(`
+		insert into webhook_delivery (
+			created_at,
+			webhook_id,
+			event,
+			next_attempt_at
+		)
+		select $1, id, $3, $1
+		from webhook
+		where organization_id = $2`,
+		time.Now(),
+		organizationID,
+		event,
+	)
+	if err != nil {
+		return handlePSQLError(Insert, err, "insert error")
+	}
+	return nil
+}
+
+// GetPendingWebhookDeliveries returns the given number of deliveries of
+// which the next attempt is due, oldest first. The returned rows are
+// locked, this function must be called within a transaction. Rows locked by
+// other transactions are skipped so that multiple instances can deliver
+// the events concurrently.
+func GetPendingWebhookDeliveries(db sqlx.Queryer, limit int) ([]WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+	err := sqlx.Select(db, &deliveries, `
+		select *
+		from webhook_delivery
+		where next_attempt_at <= $1
+		order by next_attempt_at, id
+		limit $2
+		for update skip locked`,
+		time.Now(),
+		limit,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Select, err, "select error")
+	}
+	return deliveries, nil
+}
+
+// ClaimWebhookDeliveries claims the given number of deliveries of which the
+// next attempt is due, oldest first, by moving their next attempt to the
+// given lease time. This allows the deliveries to be made outside a
+// transaction, without other instances delivering the same events. When
+// the result is not recorded before the lease expires (e.g. the instance
+// crashed), the delivery is attempted again.
+func ClaimWebhookDeliveries(db sqlx.Queryer, limit int, leaseUntil time.Time) ([]WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+	err := sqlx.Select(db, &deliveries, `
+		update webhook_delivery
+		set
+			next_attempt_at = $3
+		where
+			id in (
+				select id
+				from webhook_delivery
+				where next_attempt_at <= $1
+				order by next_attempt_at, id
+				limit $2
+				for update skip locked
+			)
+		returning *`,
+		time.Now(),
+		limit,
+		leaseUntil,
+	)
+	if err != nil {
+		return nil, handlePSQLError(Update, err, "update error")
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].ID < deliveries[j].ID
+	})
+
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery updates the attempts, next attempt and last error
+// of the given delivery.
+func UpdateWebhookDelivery(db sqlx.Execer, d *WebhookDelivery) error {
+	res, err := db.Exec(`
+		update webhook_delivery
+		set
+			attempts = $2,
+			next_attempt_at = $3,
+			last_error = $4
+		where
+			id = $1`,
+		d.ID,
+		d.Attempts,
+		d.NextAttemptAt,
+		d.LastError,
+	)
+	if err != nil {
+		return handlePSQLError(Update, err, "update error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+	return nil
+}
+
+// DeleteWebhookDelivery deletes the delivery for the given id.
+func DeleteWebhookDelivery(db sqlx.Execer, id int64) error {
+	res, err := db.Exec("delete from webhook_delivery where id = $1", id)
+	if err != nil {
+		return handlePSQLError(Delete, err, "delete error")
+	}
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "get rows affected error")
+	}
+	if ra == 0 {
+		return ErrDoesNotExist
+	}
+	return nil
+}
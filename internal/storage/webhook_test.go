@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+func TestWebhook(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+
+	Convey("Given a clean database with two organizations", t, func() {
+		test.MustResetDB(db)
+
+		org1 := Organization{
+			Name: "test-org-1",
+		}
+		So(CreateOrganization(db, &org1), ShouldBeNil)
+
+		org2 := Organization{
+			Name: "test-org-2",
+		}
+		So(CreateOrganization(db, &org2), ShouldBeNil)
+
+		Convey("When creating a webhook with invalid data", func() {
+			tests := []struct {
+				Webhook Webhook
+				Error   error
+			}{
+				{Webhook{OrganizationID: org1.ID, URL: "http://localhost/", Secret: "secret"}, ErrWebhookInvalidName},
+				{Webhook{OrganizationID: org1.ID, Name: "test", URL: "localhost", Secret: "secret"}, ErrWebhookInvalidURL},
+				{Webhook{OrganizationID: org1.ID, Name: "test", URL: "ftp://localhost/", Secret: "secret"}, ErrWebhookInvalidURL},
+				{Webhook{OrganizationID: org1.ID, Name: "test", URL: "http://localhost/"}, ErrWebhookInvalidSecret},
+			}
+
+			Convey("Then a validation error is returned", func() {
+				for _, tst := range tests {
+					err := CreateWebhook(db, &tst.Webhook)
+					So(errors.Cause(err), ShouldEqual, tst.Error)
+				}
+			})
+		})
+
+		Convey("When creating a webhook for each organization", func() {
+			w1 := Webhook{
+				OrganizationID: org1.ID,
+				Name:           "test-webhook-1",
+				URL:            "http://localhost/webhook-1",
+				Secret:         "secret-1",
+			}
+			So(CreateWebhook(db, &w1), ShouldBeNil)
+
+			w2 := Webhook{
+				OrganizationID: org2.ID,
+				Name:           "test-webhook-2",
+				URL:            "http://localhost/webhook-2",
+				Secret:         "secret-2",
+			}
+			So(CreateWebhook(db, &w2), ShouldBeNil)
+
+			Convey("Then it can be retrieved by id", func() {
+				w, err := GetWebhook(db, w1.ID)
+				So(err, ShouldBeNil)
+				So(w.OrganizationID, ShouldEqual, org1.ID)
+				So(w.Name, ShouldEqual, w1.Name)
+				So(w.URL, ShouldEqual, w1.URL)
+				So(w.Secret, ShouldEqual, w1.Secret)
+			})
+
+			Convey("Then the webhooks can be listed and counted", func() {
+				count, err := GetWebhookCount(db, 0)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 2)
+
+				webhooks, err := GetWebhooks(db, 0, 10, 0)
+				So(err, ShouldBeNil)
+				So(webhooks, ShouldHaveLength, 2)
+
+				count, err = GetWebhookCount(db, org1.ID)
+				So(err, ShouldBeNil)
+				So(count, ShouldEqual, 1)
+
+				webhooks, err = GetWebhooks(db, org1.ID, 10, 0)
+				So(err, ShouldBeNil)
+				So(webhooks, ShouldHaveLength, 1)
+				So(webhooks[0].ID, ShouldEqual, w1.ID)
+			})
+
+			Convey("Then it can be updated", func() {
+				w1.Name = "test-webhook-1-updated"
+				w1.URL = "https://localhost/webhook-1"
+				w1.Secret = "secret-1-updated"
+				So(UpdateWebhook(db, &w1), ShouldBeNil)
+
+				w, err := GetWebhook(db, w1.ID)
+				So(err, ShouldBeNil)
+				So(w.Name, ShouldEqual, w1.Name)
+				So(w.URL, ShouldEqual, w1.URL)
+				So(w.Secret, ShouldEqual, w1.Secret)
+			})
+
+			Convey("Then it can be deleted", func() {
+				So(DeleteWebhook(db, w1.ID), ShouldBeNil)
+				_, err := GetWebhook(db, w1.ID)
+				So(err, ShouldResemble, ErrDoesNotExist)
+				So(DeleteWebhook(db, w1.ID), ShouldResemble, ErrDoesNotExist)
+			})
+
+			Convey("When creating the deliveries of an event of the first organization", func() {
+				event := json.RawMessage(`{"type":"Device.Create"}`)
+				So(CreateWebhookDeliveries(db, org1.ID, event), ShouldBeNil)
+
+				Convey("Then only the webhook of the first organization has a pending delivery", func() {
+					deliveries, err := GetPendingWebhookDeliveries(db, 10)
+					So(err, ShouldBeNil)
+					So(deliveries, ShouldHaveLength, 1)
+					So(deliveries[0].WebhookID, ShouldEqual, w1.ID)
+					So(deliveries[0].Attempts, ShouldEqual, 0)
+
+					var e map[string]string
+					So(json.Unmarshal(deliveries[0].Event, &e), ShouldBeNil)
+					So(e["type"], ShouldEqual, "Device.Create")
+				})
+
+				Convey("When rescheduling the delivery", func() {
+					deliveries, err := GetPendingWebhookDeliveries(db, 10)
+					So(err, ShouldBeNil)
+					So(deliveries, ShouldHaveLength, 1)
+
+					d := deliveries[0]
+					d.Attempts = 1
+					d.NextAttemptAt = time.Now().Add(time.Minute)
+					d.LastError = "test error"
+					So(UpdateWebhookDelivery(db, &d), ShouldBeNil)
+
+					Convey("Then it is no longer pending", func() {
+						deliveries, err := GetPendingWebhookDeliveries(db, 10)
+						So(err, ShouldBeNil)
+						So(deliveries, ShouldHaveLength, 0)
+					})
+				})
+
+				Convey("When claiming the deliveries", func() {
+					deliveries, err := ClaimWebhookDeliveries(db, 10, time.Now().Add(time.Minute))
+					So(err, ShouldBeNil)
+					So(deliveries, ShouldHaveLength, 1)
+					So(deliveries[0].WebhookID, ShouldEqual, w1.ID)
+
+					Convey("Then they can not be claimed again until the lease expires", func() {
+						deliveries, err := ClaimWebhookDeliveries(db, 10, time.Now().Add(time.Minute))
+						So(err, ShouldBeNil)
+						So(deliveries, ShouldHaveLength, 0)
+
+						deliveries, err = GetPendingWebhookDeliveries(db, 10)
+						So(err, ShouldBeNil)
+						So(deliveries, ShouldHaveLength, 0)
+					})
+				})
+
+				Convey("Then the delivery can be deleted", func() {
+					deliveries, err := GetPendingWebhookDeliveries(db, 10)
+					So(err, ShouldBeNil)
+					So(deliveries, ShouldHaveLength, 1)
+
+					So(DeleteWebhookDelivery(db, deliveries[0].ID), ShouldBeNil)
+					deliveries, err = GetPendingWebhookDeliveries(db, 10)
+					So(err, ShouldBeNil)
+					So(deliveries, ShouldHaveLength, 0)
+				})
+
+				Convey("Then deleting the webhook deletes its deliveries", func() {
+					So(DeleteWebhook(db, w1.ID), ShouldBeNil)
+					deliveries, err := GetPendingWebhookDeliveries(db, 10)
+					So(err, ShouldBeNil)
+					So(deliveries, ShouldHaveLength, 0)
+				})
+			})
+		})
+	})
+}
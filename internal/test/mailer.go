@@ -0,0 +1,27 @@
+package test
+
+// MailerMessage contains an e-mail sent using the test Mailer.
+type MailerMessage struct {
+	To  string
+	Msg []byte
+}
+
+// Mailer is a test implementation of the mailer.Mailer interface, recording
+// the sent e-mails.
+type Mailer struct {
+	Messages []MailerMessage
+}
+
+// NewMailer creates a new test Mailer.
+func NewMailer() *Mailer {
+	return &Mailer{}
+}
+
+// SendMail records the given e-mail.
+func (m *Mailer) SendMail(to string, msg []byte) error {
+	m.Messages = append(m.Messages, MailerMessage{
+		To:  to,
+		Msg: msg,
+	})
+	return nil
+}
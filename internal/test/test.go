@@ -12,6 +12,7 @@ import (
 	"github.com/gusseleet/lora-app-server/internal/common"
 	"github.com/gusseleet/lora-app-server/internal/config"
 	"github.com/gusseleet/lora-app-server/internal/migrations"
+	"github.com/gusseleet/lora-app-server/internal/multicast"
 	"github.com/gusseleet/lora-app-server/internal/nsclient"
 	"github.com/brocaar/loraserver/api/ns"
 )
@@ -225,6 +226,7 @@ type NetworkServerClient struct {
 
 	CreateDeviceQueueItemChan     chan ns.CreateDeviceQueueItemRequest
 	CreateDeviceQueueItemResponse ns.CreateDeviceQueueItemResponse
+	CreateDeviceQueueItemError    error
 
 	FlushDeviceQueueForDevEUIChan     chan ns.FlushDeviceQueueForDevEUIRequest
 	FlushDeviceQueueForDevEUIResponse ns.FlushDeviceQueueForDevEUIResponse
@@ -520,7 +522,7 @@ func (n *NetworkServerClient) MigrateNodeToDeviceSession(ctx context.Context, in
 // CreateDeviceQueueItem method.
 func (n NetworkServerClient) CreateDeviceQueueItem(ctx context.Context, in *ns.CreateDeviceQueueItemRequest, opts ...grpc.CallOption) (*ns.CreateDeviceQueueItemResponse, error) {
 	n.CreateDeviceQueueItemChan <- *in
-	return &n.CreateDeviceQueueItemResponse, nil
+	return &n.CreateDeviceQueueItemResponse, n.CreateDeviceQueueItemError
 }
 
 // FlushDeviceQueueForDevEUI method.
@@ -550,3 +552,21 @@ func (n NetworkServerClient) StreamFrameLogsForGateway(ctx context.Context, in *
 func (n NetworkServerClient) StreamFrameLogsForDevice(ctx context.Context, in *ns.StreamFrameLogsForDeviceRequest, opts ...grpc.CallOption) (ns.NetworkServer_StreamFrameLogsForDeviceClient, error) {
 	panic("not implemented")
 }
+
+// MulticastSender is a multicast sender for testing.
+type MulticastSender struct {
+	SendMulticastChan chan multicast.Item
+}
+
+// NewMulticastSender returns a new MulticastSender.
+func NewMulticastSender() *MulticastSender {
+	return &MulticastSender{
+		SendMulticastChan: make(chan multicast.Item, 100),
+	}
+}
+
+// SendMulticast method.
+func (s *MulticastSender) SendMulticast(item multicast.Item) error {
+	s.SendMulticastChan <- item
+	return nil
+}
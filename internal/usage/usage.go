@@ -0,0 +1,76 @@
+// Package usage implements the metering of the uplinks, downlinks, joins
+// and active devices per application, used for billing.
+package usage
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+// csvHeader defines the columns of the CSV export.
+var csvHeader = []string{
+	"date",
+	"organization_id",
+	"organization_name",
+	"application_id",
+	"application_name",
+	"uplinks",
+	"uplink_bytes",
+	"downlinks",
+	"downlink_bytes",
+	"joins",
+	"active_devices",
+}
+
+// FlushLoop is a never returning function writing the usage counters to
+// the database at the configured interval.
+func FlushLoop() {
+	for {
+		time.Sleep(config.C.ApplicationServer.Usage.FlushInterval)
+		if err := storage.FlushUsage(config.C.PostgreSQL.DB, config.C.Redis.Pool); err != nil {
+			log.WithError(err).Error("flush usage error")
+		}
+	}
+}
+
+// WriteCSV writes the given usage records as CSV (including a header) to
+// the given writer.
+func WriteCSV(w io.Writer, records []storage.UsageRecord) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return errors.Wrap(err, "write csv error")
+	}
+
+	for _, r := range records {
+		err := cw.Write([]string{
+			r.Date.Format("2006-01-02"),
+			strconv.FormatInt(r.OrganizationID, 10),
+			r.OrganizationName,
+			strconv.FormatInt(r.ApplicationID, 10),
+			r.ApplicationName,
+			strconv.FormatInt(r.Uplinks, 10),
+			strconv.FormatInt(r.UplinkBytes, 10),
+			strconv.FormatInt(r.Downlinks, 10),
+			strconv.FormatInt(r.DownlinkBytes, 10),
+			strconv.FormatInt(r.Joins, 10),
+			strconv.FormatInt(r.ActiveDevices, 10),
+		})
+		if err != nil {
+			return errors.Wrap(err, "write csv error")
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return errors.Wrap(err, "write csv error")
+	}
+	return nil
+}
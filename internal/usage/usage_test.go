@@ -0,0 +1,38 @@
+package usage
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+func TestWriteCSV(t *testing.T) {
+	Convey("Given a set of usage records", t, func() {
+		records := []storage.UsageRecord{
+			{
+				Date:             time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC),
+				OrganizationID:   1,
+				OrganizationName: "test-org",
+				ApplicationID:    2,
+				ApplicationName:  "test, app",
+				Uplinks:          10,
+				UplinkBytes:      100,
+				Downlinks:        3,
+				DownlinkBytes:    12,
+				Joins:            1,
+				ActiveDevices:    5,
+			},
+		}
+
+		Convey("Then WriteCSV writes the expected CSV", func() {
+			var b bytes.Buffer
+			So(WriteCSV(&b, records), ShouldBeNil)
+			So(b.String(), ShouldEqual, "date,organization_id,organization_name,application_id,application_name,uplinks,uplink_bytes,downlinks,downlink_bytes,joins,active_devices\n"+
+				"2018-06-01,1,test-org,2,\"test, app\",10,100,3,12,1,5\n")
+		})
+	})
+}
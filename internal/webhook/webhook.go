@@ -0,0 +1,222 @@
+// Package webhook implements the delivery of the management events (e.g. a
+// device has been created or a gateway deleted) to the webhooks of an
+// organization.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+)
+
+// Headers set on each delivery.
+const (
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// deliveryBatchSize defines the max. number of deliveries to process per
+// batch.
+const deliveryBatchSize = 10
+
+// maxRetryInterval caps the exponential backoff between two attempts.
+const maxRetryInterval = time.Hour
+
+// deliveryLeaseMargin is added to the lease of the claimed deliveries, on
+// top of the max. duration of the requests of the batch.
+const deliveryLeaseMargin = time.Minute
+
+// Event defines a management event, sent as JSON to the webhooks.
+type Event struct {
+	// Type of the event, the API service and method, e.g. Device.Create.
+	Type           string                 `json:"type"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	OrganizationID int64                  `json:"organizationID"`
+	Target         storage.AuditLogTarget `json:"target"`
+	Username       string                 `json:"username,omitempty"`
+	APIKeyID       int64                  `json:"apiKeyID,omitempty"`
+	// Request contains the request of the API call, with the secrets
+	// redacted.
+	Request json.RawMessage `json:"request"`
+	// Changes contains the changed fields of an Update call, with the
+	// values of secrets redacted.
+	Changes json.RawMessage `json:"changes,omitempty"`
+}
+
+// EventType returns the event type for the given full gRPC method name,
+// e.g. /api.Device/Create returns Device.Create.
+func EventType(fullMethod string) string {
+	return strings.Replace(strings.TrimPrefix(fullMethod, "/api."), "/", ".", 1)
+}
+
+// Enqueue queues the given event for delivery to the webhooks of the
+// organization of the event.
+func Enqueue(db sqlx.Execer, e Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "marshal json error")
+	}
+
+	return storage.CreateWebhookDeliveries(db, e.OrganizationID, b)
+}
+
+// DeliveryLoop is a never returning function delivering the pending
+// events to the webhooks.
+func DeliveryLoop() {
+	for {
+		n, err := processDeliveryBatch(deliveryBatchSize)
+		if err != nil {
+			log.WithError(err).Error("process webhook delivery batch error")
+		}
+
+		if n == 0 {
+			time.Sleep(time.Second)
+		}
+	}
+}
+
+// processDeliveryBatch delivers the next batch of pending deliveries. It
+// returns the number of processed deliveries.
+//
+// The deliveries are claimed (leased) within a short transaction, the
+// events are then delivered outside any transaction and the result of each
+// delivery is recorded separately. This way a slow webhook does not hold a
+// transaction and a database error does not undo the result of the other
+// deliveries (which would deliver these events twice).
+func processDeliveryBatch(size int) (int, error) {
+	leaseUntil := time.Now().Add(time.Duration(size)*config.C.ApplicationServer.Webhook.Timeout + deliveryLeaseMargin)
+	deliveries, err := storage.ClaimWebhookDeliveries(config.C.PostgreSQL.DB, size, leaseUntil)
+	if err != nil {
+		return 0, errors.Wrap(err, "claim webhook deliveries error")
+	}
+
+	webhooks := make(map[int64]storage.Webhook)
+
+	for i := range deliveries {
+		d := &deliveries[i]
+
+		w, ok := webhooks[d.WebhookID]
+		if !ok {
+			w, err = storage.GetWebhook(config.C.PostgreSQL.DB, d.WebhookID)
+			if err != nil {
+				// the webhook (and its deliveries) has been deleted
+				if err != storage.ErrDoesNotExist {
+					log.WithField("webhook_id", d.WebhookID).WithError(err).Error("get webhook error")
+				}
+				continue
+			}
+			webhooks[d.WebhookID] = w
+		}
+
+		// the delivery does not exist when the webhook has been deleted in
+		// the meantime
+		if err := handleDelivery(config.C.PostgreSQL.DB, w, d); err != nil && err != storage.ErrDoesNotExist {
+			log.WithFields(log.Fields{
+				"webhook_id":  w.ID,
+				"delivery_id": d.ID,
+			}).WithError(err).Error("record webhook delivery result error")
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// handleDelivery attempts to deliver the given event. On success or after
+// the last attempt the delivery is removed, else the next attempt is
+// scheduled.
+func handleDelivery(db sqlx.Execer, w storage.Webhook, d *storage.WebhookDelivery) error {
+	d.Attempts++
+
+	logFields := log.Fields{
+		"webhook_id":  w.ID,
+		"delivery_id": d.ID,
+		"attempts":    d.Attempts,
+	}
+
+	sendErr := send(w, d)
+	if sendErr == nil {
+		log.WithFields(logFields).Info("webhook event delivered")
+		return storage.DeleteWebhookDelivery(db, d.ID)
+	}
+
+	if d.Attempts >= config.C.ApplicationServer.Webhook.MaxAttempts {
+		log.WithFields(logFields).WithError(sendErr).Error("webhook event delivery failed, event dropped")
+		return storage.DeleteWebhookDelivery(db, d.ID)
+	}
+
+	d.LastError = sendErr.Error()
+	d.NextAttemptAt = time.Now().Add(retryInterval(d.Attempts))
+	log.WithFields(logFields).WithError(sendErr).Warning("webhook event delivery failed, retrying")
+	return storage.UpdateWebhookDelivery(db, d)
+}
+
+// send POSTs the event of the given delivery to the webhook.
+func send(w storage.Webhook, d *storage.WebhookDelivery) error {
+	var e Event
+	if err := json.Unmarshal(d.Event, &e); err != nil {
+		return errors.Wrap(err, "unmarshal json error")
+	}
+
+	req, err := http.NewRequest("POST", w.URL, bytes.NewReader(d.Event))
+	if err != nil {
+		return errors.Wrap(err, "new request error")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, e.Type)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(SignatureHeader, Signature(w.Secret, d.Event))
+
+	client := http.Client{
+		Timeout: config.C.ApplicationServer.Webhook.Timeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "http request error")
+	}
+	defer resp.Body.Close()
+
+	// check that response is in 200 range
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("expected 2XX response, got: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Signature returns the signature of the given body, sent in the
+// SignatureHeader. This is the hex encoded HMAC-SHA256 of the body, using
+// the webhook secret as key, prefixed by "sha256=".
+func Signature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryInterval returns the interval before the next attempt, given the
+// number of failed attempts.
+func retryInterval(attempts int) time.Duration {
+	interval := config.C.ApplicationServer.Webhook.RetryInterval
+	for i := 1; i < attempts; i++ {
+		interval *= 2
+		if interval >= maxRetryInterval {
+			return maxRetryInterval
+		}
+	}
+	return interval
+}
@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/gusseleet/lora-app-server/internal/config"
+	"github.com/gusseleet/lora-app-server/internal/storage"
+	"github.com/gusseleet/lora-app-server/internal/test"
+)
+
+type testHTTPHandler struct {
+	requests   chan *http.Request
+	statusCode int
+}
+
+func (h *testHTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, _ := ioutil.ReadAll(r.Body)
+	r.Body = ioutil.NopCloser(bytes.NewReader(b))
+	h.requests <- r
+	w.WriteHeader(h.statusCode)
+}
+
+func TestEventType(t *testing.T) {
+	Convey("Given a set of tests", t, func() {
+		tests := []struct {
+			FullMethod string
+			Expected   string
+		}{
+			{"/api.Device/Create", "Device.Create"},
+			{"/api.Organization/UpdateUser", "Organization.UpdateUser"},
+		}
+
+		for _, tst := range tests {
+			So(EventType(tst.FullMethod), ShouldEqual, tst.Expected)
+		}
+	})
+}
+
+func TestRetryInterval(t *testing.T) {
+	Convey("Given a retry interval of 30 seconds", t, func() {
+		config.C.ApplicationServer.Webhook.RetryInterval = 30 * time.Second
+
+		Convey("Then the interval doubles after each attempt, up to an hour", func() {
+			So(retryInterval(1), ShouldEqual, 30*time.Second)
+			So(retryInterval(2), ShouldEqual, time.Minute)
+			So(retryInterval(3), ShouldEqual, 2*time.Minute)
+			So(retryInterval(20), ShouldEqual, time.Hour)
+		})
+	})
+}
+
+func TestDelivery(t *testing.T) {
+	conf := test.GetConfig()
+	db, err := storage.OpenDatabase(conf.PostgresDSN)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.C.PostgreSQL.DB = db
+	config.C.ApplicationServer.Webhook.Timeout = time.Second
+	config.C.ApplicationServer.Webhook.MaxAttempts = 2
+	config.C.ApplicationServer.Webhook.RetryInterval = time.Minute
+
+	Convey("Given a clean database, a test HTTP server and an organization with a webhook", t, func() {
+		test.MustResetDB(db)
+
+		h := testHTTPHandler{
+			requests:   make(chan *http.Request, 100),
+			statusCode: http.StatusOK,
+		}
+		server := httptest.NewServer(&h)
+		defer server.Close()
+
+		org := storage.Organization{
+			Name: "test-org",
+		}
+		So(storage.CreateOrganization(db, &org), ShouldBeNil)
+
+		w := storage.Webhook{
+			OrganizationID: org.ID,
+			Name:           "test-webhook",
+			URL:            server.URL,
+			Secret:         "secret",
+		}
+		So(storage.CreateWebhook(db, &w), ShouldBeNil)
+
+		e := Event{
+			Type:           "Device.Create",
+			CreatedAt:      time.Now(),
+			OrganizationID: org.ID,
+			Target:         storage.AuditLogTarget{"devEUI": "0102030405060708"},
+			Username:       "admin",
+			Request:        json.RawMessage(`{"devEUI":"0102030405060708"}`),
+		}
+		So(Enqueue(db, e), ShouldBeNil)
+
+		Convey("When processing the deliveries", func() {
+			n, err := processDeliveryBatch(10)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 1)
+
+			Convey("Then the signed event has been posted to the webhook", func() {
+				req := <-h.requests
+				So(req.Method, ShouldEqual, "POST")
+				So(req.Header.Get("Content-Type"), ShouldEqual, "application/json")
+				So(req.Header.Get(EventHeader), ShouldEqual, "Device.Create")
+				So(req.Header.Get(DeliveryHeader), ShouldNotEqual, "")
+
+				b, err := ioutil.ReadAll(req.Body)
+				So(err, ShouldBeNil)
+				So(req.Header.Get(SignatureHeader), ShouldEqual, Signature("secret", b))
+
+				var received Event
+				So(json.Unmarshal(b, &received), ShouldBeNil)
+				So(received.Type, ShouldEqual, e.Type)
+				So(received.OrganizationID, ShouldEqual, org.ID)
+				So(received.Target, ShouldResemble, e.Target)
+				So(received.Username, ShouldEqual, "admin")
+			})
+
+			Convey("Then the delivery has been removed", func() {
+				var count int
+				So(db.Get(&count, "select count(*) from webhook_delivery"), ShouldBeNil)
+				So(count, ShouldEqual, 0)
+			})
+		})
+
+		Convey("Given the delivery has been claimed by an other instance", func() {
+			deliveries, err := storage.ClaimWebhookDeliveries(db, 10, time.Now().Add(time.Minute))
+			So(err, ShouldBeNil)
+			So(deliveries, ShouldHaveLength, 1)
+
+			Convey("Then it is not delivered again", func() {
+				n, err := processDeliveryBatch(10)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 0)
+				So(h.requests, ShouldHaveLength, 0)
+			})
+		})
+
+		Convey("Given the webhook returns an error", func() {
+			h.statusCode = http.StatusInternalServerError
+
+			Convey("When processing the deliveries", func() {
+				n, err := processDeliveryBatch(10)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 1)
+				So(h.requests, ShouldHaveLength, 1)
+
+				Convey("Then the next attempt has been scheduled", func() {
+					var d storage.WebhookDelivery
+					So(db.Get(&d, "select * from webhook_delivery"), ShouldBeNil)
+					So(d.Attempts, ShouldEqual, 1)
+					So(d.LastError, ShouldEqual, "expected 2XX response, got: 500")
+					So(d.NextAttemptAt.After(time.Now()), ShouldBeTrue)
+
+					n, err := processDeliveryBatch(10)
+					So(err, ShouldBeNil)
+					So(n, ShouldEqual, 0)
+				})
+
+				Convey("When the last attempt fails", func() {
+					_, err := db.Exec("update webhook_delivery set next_attempt_at = now()")
+					So(err, ShouldBeNil)
+
+					n, err := processDeliveryBatch(10)
+					So(err, ShouldBeNil)
+					So(n, ShouldEqual, 1)
+					So(h.requests, ShouldHaveLength, 2)
+
+					Convey("Then the delivery has been removed", func() {
+						var count int
+						So(db.Get(&count, "select count(*) from webhook_delivery"), ShouldBeNil)
+						So(count, ShouldEqual, 0)
+					})
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,29 @@
+-- +migrate Up
+create table downlink_schedule (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    application_id bigint not null references application on delete cascade,
+    device_profile_id uuid references device_profile on delete cascade,
+    dev_eui bytea references device on delete cascade,
+    name varchar(100) not null,
+    cron varchar(100) not null,
+    f_port smallint not null,
+    confirmed boolean not null default false,
+    data bytea,
+    json_object jsonb,
+    next_run_at timestamp with time zone not null,
+    last_run_at timestamp with time zone
+);
+
+create index idx_downlink_schedule_application_id on downlink_schedule(application_id);
+create index idx_downlink_schedule_device_profile_id on downlink_schedule(device_profile_id);
+create index idx_downlink_schedule_dev_eui on downlink_schedule(dev_eui);
+create index idx_downlink_schedule_next_run_at on downlink_schedule(next_run_at);
+
+-- +migrate Down
+drop index idx_downlink_schedule_next_run_at;
+drop index idx_downlink_schedule_dev_eui;
+drop index idx_downlink_schedule_device_profile_id;
+drop index idx_downlink_schedule_application_id;
+drop table downlink_schedule;
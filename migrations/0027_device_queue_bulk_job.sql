@@ -0,0 +1,32 @@
+-- +migrate Up
+create table device_queue_bulk_job (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    application_id bigint not null references application on delete cascade,
+    device_profile_id uuid references device_profile on delete cascade,
+    reference varchar(100) not null,
+    confirmed boolean not null default false,
+    f_port smallint not null,
+    data bytea not null
+);
+
+create index idx_device_queue_bulk_job_application_id on device_queue_bulk_job(application_id);
+
+create table device_queue_bulk_job_device (
+    job_id bigint not null references device_queue_bulk_job on delete cascade,
+    dev_eui bytea not null references device on delete cascade,
+    updated_at timestamp with time zone not null,
+    status varchar(10) not null,
+    error text not null default '',
+
+    primary key(job_id, dev_eui)
+);
+
+create index idx_device_queue_bulk_job_device_status on device_queue_bulk_job_device(status);
+
+-- +migrate Down
+drop index idx_device_queue_bulk_job_device_status;
+drop table device_queue_bulk_job_device;
+
+drop index idx_device_queue_bulk_job_application_id;
+drop table device_queue_bulk_job;
@@ -0,0 +1,32 @@
+-- +migrate Up
+create table multicast_group (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    application_id bigint not null references application on delete cascade,
+    name varchar(100) not null,
+    mc_addr bytea not null,
+    mc_app_s_key bytea not null,
+    mc_nwk_s_key bytea not null,
+    f_cnt bigint not null default 0,
+    frequency integer not null,
+    dr smallint not null
+);
+
+create index idx_multicast_group_application_id on multicast_group(application_id);
+
+create table multicast_group_device (
+    multicast_group_id bigint not null references multicast_group on delete cascade,
+    dev_eui bytea not null references device on delete cascade,
+    created_at timestamp with time zone not null,
+
+    primary key(multicast_group_id, dev_eui)
+);
+
+create index idx_multicast_group_device_dev_eui on multicast_group_device(dev_eui);
+
+-- +migrate Down
+drop index idx_multicast_group_device_dev_eui;
+drop table multicast_group_device;
+drop index idx_multicast_group_application_id;
+drop table multicast_group;
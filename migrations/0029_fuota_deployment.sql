@@ -0,0 +1,46 @@
+-- +migrate Up
+create table fuota_deployment (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    application_id bigint not null references application on delete cascade,
+    multicast_group_id bigint not null references multicast_group on delete cascade,
+    name varchar(100) not null,
+    payload bytea not null,
+    frag_size smallint not null,
+    redundancy smallint not null,
+    multicast_timeout smallint not null,
+    unicast_timeout integer not null,
+    mc_key bytea not null,
+    state varchar(20) not null,
+    next_step_after timestamp with time zone not null
+);
+
+create index idx_fuota_deployment_application_id on fuota_deployment(application_id);
+create index idx_fuota_deployment_state_next_step_after on fuota_deployment(state, next_step_after);
+
+create table fuota_deployment_device (
+    fuota_deployment_id bigint not null references fuota_deployment on delete cascade,
+    dev_eui bytea not null references device on delete cascade,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    state varchar(20) not null,
+    error_message text not null default '',
+    mc_group_setup_completed_at timestamp with time zone,
+    frag_session_setup_completed_at timestamp with time zone,
+    mc_session_completed_at timestamp with time zone,
+    frag_status_completed_at timestamp with time zone,
+    nb_frag_received integer not null default 0,
+    missing_frag integer not null default 0,
+
+    primary key(fuota_deployment_id, dev_eui)
+);
+
+create index idx_fuota_deployment_device_dev_eui on fuota_deployment_device(dev_eui);
+
+-- +migrate Down
+drop index idx_fuota_deployment_device_dev_eui;
+drop table fuota_deployment_device;
+drop index idx_fuota_deployment_state_next_step_after;
+drop index idx_fuota_deployment_application_id;
+drop table fuota_deployment;
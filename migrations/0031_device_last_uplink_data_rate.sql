@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table device
+    add column last_uplink_data_rate varchar(20) not null default '';
+
+-- +migrate Down
+alter table device
+    drop column last_uplink_data_rate;
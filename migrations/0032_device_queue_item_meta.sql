@@ -0,0 +1,18 @@
+-- +migrate Up
+create table device_queue_item_meta (
+    dev_eui bytea not null references device on delete cascade,
+    f_cnt bigint not null,
+    created_at timestamp with time zone not null,
+    reference varchar(100) not null,
+    priority integer not null default 0,
+    dedup_key varchar(100) not null default '',
+    expires_at timestamp with time zone,
+
+    primary key(dev_eui, f_cnt)
+);
+
+create index idx_device_queue_item_meta_expires_at on device_queue_item_meta(expires_at);
+
+-- +migrate Down
+drop index idx_device_queue_item_meta_expires_at;
+drop table device_queue_item_meta;
@@ -0,0 +1,22 @@
+-- +migrate Up
+create table api_key (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    name varchar(100) not null,
+    organization_id bigint references organization on delete cascade,
+    application_id bigint references application on delete cascade,
+    key_hash bytea not null,
+    expires_at timestamp with time zone,
+
+    check ((organization_id is null) <> (application_id is null))
+);
+
+create unique index idx_api_key_key_hash on api_key(key_hash);
+create index idx_api_key_organization_id on api_key(organization_id);
+create index idx_api_key_application_id on api_key(application_id);
+
+-- +migrate Down
+drop index idx_api_key_application_id;
+drop index idx_api_key_organization_id;
+drop index idx_api_key_key_hash;
+drop table api_key;
@@ -0,0 +1,11 @@
+-- +migrate Up
+alter table "user"
+    add column external_id varchar(255);
+
+create unique index idx_user_external_id on "user"(external_id);
+
+-- +migrate Down
+drop index idx_user_external_id;
+
+alter table "user"
+    drop column external_id;
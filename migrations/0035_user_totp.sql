@@ -0,0 +1,25 @@
+-- +migrate Up
+create table user_totp (
+    user_id bigint primary key references "user" on delete cascade,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    secret varchar(32) not null,
+    enabled boolean not null default false,
+    last_step bigint not null default 0
+);
+
+create table user_totp_recovery_code (
+    user_id bigint not null references user_totp on delete cascade,
+    code_hash bytea not null,
+    primary key(user_id, code_hash)
+);
+
+alter table organization
+    add column require_second_factor boolean not null default false;
+
+-- +migrate Down
+alter table organization
+    drop column require_second_factor;
+
+drop table user_totp_recovery_code;
+drop table user_totp;
@@ -0,0 +1,23 @@
+-- +migrate Up
+alter table organization_user
+    add column is_device_admin boolean not null default false,
+    add column is_gateway_admin boolean not null default false,
+    add column is_integration_admin boolean not null default false,
+    add column is_viewer boolean not null default false;
+
+alter table application_user
+    add column is_device_admin boolean not null default false,
+    add column is_integration_admin boolean not null default false,
+    add column is_viewer boolean not null default false;
+
+-- +migrate Down
+alter table application_user
+    drop column is_viewer,
+    drop column is_integration_admin,
+    drop column is_device_admin;
+
+alter table organization_user
+    drop column is_viewer,
+    drop column is_integration_admin,
+    drop column is_gateway_admin,
+    drop column is_device_admin;
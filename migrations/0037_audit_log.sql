@@ -0,0 +1,20 @@
+-- +migrate Up
+create table audit_log (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    username varchar(100) not null,
+    api_key_id bigint,
+    organization_id bigint,
+    method varchar(100) not null,
+    target jsonb not null,
+    remote_addr varchar(100) not null,
+    request jsonb not null
+);
+
+create index idx_audit_log_created_at on audit_log(created_at);
+create index idx_audit_log_organization_id on audit_log(organization_id);
+
+-- +migrate Down
+drop index idx_audit_log_organization_id;
+drop index idx_audit_log_created_at;
+drop table audit_log;
@@ -0,0 +1,20 @@
+-- +migrate Up
+create table user_session (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    expires_at timestamp with time zone not null,
+    user_id bigint not null references "user" on delete cascade,
+    refresh_token_hash bytea not null,
+    remote_addr varchar(100) not null
+);
+
+create unique index idx_user_session_refresh_token_hash on user_session(refresh_token_hash);
+create index idx_user_session_user_id on user_session(user_id);
+create index idx_user_session_expires_at on user_session(expires_at);
+
+-- +migrate Down
+drop index idx_user_session_expires_at;
+drop index idx_user_session_user_id;
+drop index idx_user_session_refresh_token_hash;
+drop table user_session;
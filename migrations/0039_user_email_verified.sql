@@ -0,0 +1,10 @@
+-- +migrate Up
+alter table "user"
+    add column email_verified boolean not null default false;
+
+-- existing users were created by an admin or external identity provider
+update "user" set email_verified = true;
+
+-- +migrate Down
+alter table "user"
+    drop column email_verified;
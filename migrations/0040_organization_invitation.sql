@@ -0,0 +1,24 @@
+-- +migrate Up
+create table organization_invitation (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    expires_at timestamp with time zone not null,
+    organization_id bigint not null references organization on delete cascade,
+    email varchar(255) not null,
+    is_admin boolean not null,
+    is_device_admin boolean not null,
+    is_gateway_admin boolean not null,
+    is_integration_admin boolean not null,
+    is_viewer boolean not null,
+    token_hash bytea not null,
+    invited_by varchar(100) not null
+);
+
+create unique index idx_organization_invitation_token_hash on organization_invitation(token_hash);
+create unique index idx_organization_invitation_organization_id_email on organization_invitation(organization_id, lower(email));
+
+-- +migrate Down
+drop index idx_organization_invitation_organization_id_email;
+drop index idx_organization_invitation_token_hash;
+drop table organization_invitation;
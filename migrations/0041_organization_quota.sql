@@ -0,0 +1,17 @@
+-- +migrate Up
+alter table organization
+    add column max_applications integer not null default 0,
+    add column max_devices integer not null default 0,
+    add column max_gateways integer not null default 0,
+    add column max_device_profiles integer not null default 0,
+    add column max_integrations integer not null default 0,
+    add column max_uplinks_per_day integer not null default 0;
+
+-- +migrate Down
+alter table organization
+    drop column max_uplinks_per_day,
+    drop column max_integrations,
+    drop column max_device_profiles,
+    drop column max_gateways,
+    drop column max_devices,
+    drop column max_applications;
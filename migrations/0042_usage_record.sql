@@ -0,0 +1,22 @@
+-- +migrate Up
+-- application_id has no foreign key, the usage must be kept for billing
+-- when the application is deleted
+create table usage_record (
+    date date not null,
+    organization_id bigint not null references organization on delete cascade,
+    application_id bigint not null,
+    uplinks bigint not null default 0,
+    uplink_bytes bigint not null default 0,
+    downlinks bigint not null default 0,
+    downlink_bytes bigint not null default 0,
+    joins bigint not null default 0,
+    active_devices bigint not null default 0,
+
+    primary key (date, application_id)
+);
+
+create index idx_usage_record_organization_id_date on usage_record(organization_id, date);
+
+-- +migrate Down
+drop index idx_usage_record_organization_id_date;
+drop table usage_record;
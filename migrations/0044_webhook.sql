@@ -0,0 +1,33 @@
+-- +migrate Up
+create table webhook (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    organization_id bigint not null references organization on delete cascade,
+    name varchar(100) not null,
+    url text not null,
+    secret text not null
+);
+
+create index idx_webhook_organization_id on webhook(organization_id);
+
+create table webhook_delivery (
+    id bigserial primary key,
+    created_at timestamp with time zone not null,
+    webhook_id bigint not null references webhook on delete cascade,
+    event jsonb not null,
+    attempts integer not null default 0,
+    next_attempt_at timestamp with time zone not null,
+    last_error text not null default ''
+);
+
+create index idx_webhook_delivery_webhook_id on webhook_delivery(webhook_id);
+create index idx_webhook_delivery_next_attempt_at on webhook_delivery(next_attempt_at);
+
+-- +migrate Down
+drop index idx_webhook_delivery_next_attempt_at;
+drop index idx_webhook_delivery_webhook_id;
+drop table webhook_delivery;
+
+drop index idx_webhook_organization_id;
+drop table webhook;
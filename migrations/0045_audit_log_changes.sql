@@ -0,0 +1,7 @@
+-- +migrate Up
+alter table audit_log
+    add column changes jsonb not null default '{}';
+
+-- +migrate Down
+alter table audit_log
+    drop column changes;
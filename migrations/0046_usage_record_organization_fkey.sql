@@ -0,0 +1,15 @@
+-- +migrate Up
+-- like application_id, organization_id has no foreign key, the usage must be
+-- kept for billing when the organization is deleted
+alter table usage_record
+    drop constraint usage_record_organization_id_fkey;
+
+-- +migrate Down
+delete from usage_record ur
+where not exists (
+    select 1 from organization o where o.id = ur.organization_id
+);
+
+alter table usage_record
+    add constraint usage_record_organization_id_fkey
+    foreign key (organization_id) references organization on delete cascade;
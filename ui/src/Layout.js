@@ -8,6 +8,10 @@ import dispatcher from "./dispatcher";
 
 // users
 import Login from "./views/users/Login";
+import OIDCLogin from "./views/users/OIDCLogin";
+import RequestPasswordReset from "./views/users/RequestPasswordReset";
+import ResetPassword from "./views/users/ResetPassword";
+import VerifyEmail from "./views/users/VerifyEmail";
 import CreateUser from "./views/users/CreateUser";
 import UpdatePassword from "./views/users/UpdatePassword";
 import ListUsers from "./views/users/ListUsers";
@@ -18,6 +22,7 @@ import OrganizationRedirect from './views/organizations/OrganizationRedirect';
 import ListOrganizations from './views/organizations/ListOrganizations';
 import CreateOrganization from './views/organizations/CreateOrganization';
 import OrganizationLayout from './views/organizations/OrganizationLayout';
+import AcceptOrganizationInvitation from './views/organizations/AcceptOrganizationInvitation';
 
 // network-servers
 import ListNetworkServers from "./views/networkservers/ListNetworkServers";
@@ -52,6 +57,11 @@ class Layout extends Component {
             <Switch>
               <Route exact path="/" component={OrganizationRedirect} />
               <Route exact path="/login" component={Login} />
+              <Route exact path="/login/oidc" component={OIDCLogin} />
+              <Route exact path="/password-reset/request" component={RequestPasswordReset} />
+              <Route exact path="/password-reset" component={ResetPassword} />
+              <Route exact path="/verify-email" component={VerifyEmail} />
+              <Route exact path="/organization-invitations/accept" component={AcceptOrganizationInvitation} />
               <Route exact path="/users/create" component={CreateUser} />
               <Route exact path="/users/:userID/password" component={UpdatePassword} />
               <Route exact path="/users/:userID/edit" component={UpdateUser} />
@@ -181,6 +181,17 @@ function Decode(fPort, bytes) {
             of bytes.
           </p>
         </div>
+        <div className="form-group">
+          <label className="control-label">Disable organization integrations</label>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="disableOrganizationIntegrations" id="disableOrganizationIntegrations" checked={!!this.state.application.disableOrganizationIntegrations} onChange={this.onChange.bind(this, 'disableOrganizationIntegrations')} /> Disable organization integrations
+            </label>
+          </div>
+          <p className="help-block">
+            When checked, the integrations configured for the organization are not used for this application.
+          </p>
+        </div>
         <hr />
         <div className="btn-toolbar pull-right">
           <a className="btn btn-default" onClick={this.props.history.goBack}>Go back</a>
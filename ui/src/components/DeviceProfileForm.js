@@ -158,6 +158,7 @@ class DeviceProfileForm extends Component {
             <li role="presentation" className={(this.state.activeTab === "join" ? "active" : "")}><a onClick={this.changeTab} href="#join" aria-controls="join">Join (OTAA / ABP)</a></li>
           <li role="presentation" className={(this.state.activeTab === "classB" ? "active" : "")}><a onClick={this.changeTab} href="#classB" aria-controls="classB">Class-B</a></li>
             <li role="presentation" className={(this.state.activeTab === "classC" ? "active" : "")}><a onClick={this.changeTab} href="#classC" aria-controls="classC">Class-C</a></li>
+            <li role="presentation" className={(this.state.activeTab === "clockSync" ? "active" : "")}><a onClick={this.changeTab} href="#clockSync" aria-controls="clockSync">Clock sync</a></li>
           </ul>
           <hr />
           <form onSubmit={this.handleSubmit}>
@@ -342,6 +343,30 @@ class DeviceProfileForm extends Component {
                   </p>
                 </div>
               </div>
+              <div className={(this.state.activeTab === "clockSync" ? "" : "hidden")}>
+                <div className="form-group">
+                  <label className="control-label" htmlFor="clockSyncEnabled">Clock synchronization</label>
+                  <div className="checkbox">
+                    <label>
+                      <input type="checkbox" name="clockSyncEnabled" id="clockSyncEnabled" checked={!!this.state.deviceProfile.clockSyncEnabled} onChange={this.onChange.bind(this, 'clockSyncEnabled')} /> Enable clock synchronization
+                    </label>
+                  </div>
+                  <p className="help-block">
+                    Handle the Application Layer Clock Synchronization package (fPort 202) and answer the AppTimeReq of the devices.
+                  </p>
+                </div>
+                <div className={"form-group " + (this.state.deviceProfile.clockSyncEnabled === true ? "" : "hidden")}>
+                  <label className="control-label" htmlFor="clockSyncForwardUplinks">Forward clock synchronization uplinks</label>
+                  <div className="checkbox">
+                    <label>
+                      <input type="checkbox" name="clockSyncForwardUplinks" id="clockSyncForwardUplinks" checked={!!this.state.deviceProfile.clockSyncForwardUplinks} onChange={this.onChange.bind(this, 'clockSyncForwardUplinks')} /> Forward to integrations
+                    </label>
+                  </div>
+                  <p className="help-block">
+                    When checked, the handled fPort 202 uplinks are also forwarded to the integrations.
+                  </p>
+                </div>
+              </div>
             </fieldset>
             <hr />
             <div className={"btn-toolbar pull-right " + (this.state.isAdmin ? "" : "hidden")}>
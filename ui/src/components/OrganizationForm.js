@@ -39,7 +39,38 @@ class OrganizationForm extends Component {
     });
   }
 
+  onQuotaChange(field, e) {
+    let organization = this.state.organization;
+    if (typeof(organization.quota) === "undefined" || organization.quota === null) {
+      organization.quota = {};
+    }
+    organization.quota[field] = parseInt(e.target.value, 10) || 0;
+    this.setState({
+      organization: organization,
+    });
+  }
+
   render() {
+    const quota = this.state.organization.quota || {};
+    const usage = this.state.organization.usage || {};
+    const quotaFields = [
+      {field: "maxApplications", usage: "applications", label: "Max. applications"},
+      {field: "maxDevices", usage: "devices", label: "Max. devices"},
+      {field: "maxGateways", usage: "gateways", label: "Max. gateways"},
+      {field: "maxDeviceProfiles", usage: "deviceProfiles", label: "Max. device-profiles"},
+      {field: "maxIntegrations", usage: "integrations", label: "Max. integrations"},
+      {field: "maxUplinksPerDay", usage: "uplinksToday", label: "Max. uplinks per day"},
+    ];
+    const QuotaInputs = quotaFields.map((q) =>
+      <div className="form-group" key={q.field}>
+        <label className="control-label" htmlFor={q.field}>{q.label}</label>
+        <input className="form-control" id={q.field} type="number" min="0" value={quota[q.field] || 0} onChange={this.onQuotaChange.bind(this, q.field)} />
+        <p className={"help-block " + (typeof(this.state.organization.usage) === "undefined" ? "hidden" : "")}>
+          Currently used: {usage[q.usage] || 0}.
+        </p>
+      </div>
+    );
+
     return(
       <form onSubmit={this.handleSubmit}>
         <div className="form-group">
@@ -65,6 +96,13 @@ class OrganizationForm extends Component {
             Note that the usage of the gateways is not limited to this organization.
           </p>
         </div>
+        <fieldset className={this.state.showCanHaveGateways ? '' : 'hidden'}>
+          <legend>Quota</legend>
+          <p className="help-block">
+            The max. number of resources of this organization, 0 means unlimited.
+          </p>
+          {QuotaInputs}
+        </fieldset>
         <hr />
         <div className="btn-toolbar pull-right">
           <a className="btn btn-default" onClick={this.props.history.goBack}>Go back</a>
@@ -113,6 +113,52 @@ class OrganizationStore extends EventEmitter {
       })
       .catch(errorHandler);
   }
+
+  getInvitations(organizationID, pageSize, offset, callbackFunc) {
+    fetch("/api/organizations/"+organizationID+"/invitations?limit="+pageSize+"&offset="+offset, {headers: sessionStore.getHeader()})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        if(typeof(responseData.result) === "undefined") {
+          callbackFunc(0, []);
+        } else {
+          callbackFunc(responseData.totalCount, responseData.result);
+        }
+      })
+      .catch(errorHandler);
+  }
+
+  createInvitation(organizationID, invitation, callbackFunc) {
+    fetch("/api/organizations/"+organizationID+"/invitations", {method: "POST", body: JSON.stringify(invitation), headers: sessionStore.getHeader()})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        callbackFunc(responseData);
+      })
+      .catch(errorHandler);
+  }
+
+  resendInvitation(organizationID, invitationID, callbackFunc) {
+    fetch("/api/organizations/"+organizationID+"/invitations/"+invitationID+"/resend", {method: "POST", body: JSON.stringify({}), headers: sessionStore.getHeader()})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        this.emit("change");
+        callbackFunc(responseData);
+      })
+      .catch(errorHandler);
+  }
+
+  deleteInvitation(organizationID, invitationID, callbackFunc) {
+    fetch("/api/organizations/"+organizationID+"/invitations/"+invitationID, {method: "DELETE", headers: sessionStore.getHeader()})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        this.emit("change");
+        callbackFunc(responseData);
+      })
+      .catch(errorHandler);
+  }
 }
 
 const organizationStore = new OrganizationStore();
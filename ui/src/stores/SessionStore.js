@@ -20,9 +20,13 @@ class SessionStore extends EventEmitter {
     this.settings = {};
     this.branding = {};
 
+    this.refreshTimer = null;
+
     this.fetchBranding( () => {} );
 
-    if (this.getToken() !== "") {
+    if (this.getRefreshToken()) {
+      this.refreshToken(() => this.fetchProfile(() => {}));
+    } else if (this.getToken() !== "") {
       this.fetchProfile(() => {});
     } 
   }
@@ -35,6 +39,44 @@ class SessionStore extends EventEmitter {
     return localStorage.getItem("jwt");
   }
 
+  setRefreshToken(token) {
+    localStorage.setItem("refreshToken", token);
+  }
+
+  getRefreshToken() {
+    return localStorage.getItem("refreshToken");
+  }
+
+  // setTokens stores the tokens of the session and schedules the refresh
+  // of the JWT, one minute before it expires.
+  setTokens(jwt, refreshToken) {
+    this.setToken(jwt);
+    this.setRefreshToken(refreshToken || "");
+
+    clearTimeout(this.refreshTimer);
+    if (!refreshToken) {
+      return;
+    }
+
+    const claims = JSON.parse(atob(jwt.split(".")[1].replace(/-/g, "+").replace(/_/g, "/")));
+    const timeout = Math.max(claims.exp * 1000 - Date.now() - 60000, 0);
+    this.refreshTimer = setTimeout(() => this.refreshToken(() => {}), timeout);
+  }
+
+  refreshToken(callbackFunc) {
+    fetch("/api/internal/refresh-token", {method: "POST", body: JSON.stringify({refreshToken: this.getRefreshToken()})})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        this.setTokens(responseData.jwt, responseData.refreshToken);
+        callbackFunc();
+      })
+      .catch(() => {
+        // the session expired or has been revoked
+        this.clearSession();
+      });
+  }
+
   setOrganizationID(id) {
     localStorage.setItem("organizationID", id);
   }
@@ -77,17 +119,95 @@ class SessionStore extends EventEmitter {
     }
   }
 
-  login(login, callbackFunc) {
+  login(login, callbackFunc, secondFactorCallbackFunc) {
     fetch("/api/internal/login", {method: "POST", body: JSON.stringify(login)})
       .then(checkStatus)
       .then((response) => response.json())
       .then((responseData) => {
-        this.setToken(responseData.jwt);
+        if (responseData.secondFactorRequired) {
+          secondFactorCallbackFunc(responseData);
+          return;
+        }
+        this.setTokens(responseData.jwt, responseData.refreshToken);
+        this.fetchProfile(callbackFunc);
+      })
+      .catch(loginErrorHandler);
+  }
+
+  loginSecondFactor(login, callbackFunc) {
+    fetch("/api/internal/login/second-factor", {method: "POST", body: JSON.stringify(login)})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        this.setTokens(responseData.jwt, responseData.refreshToken);
+        this.fetchProfile(() => {
+          callbackFunc(responseData.recoveryCodes || []);
+        });
+      })
+      .catch(loginErrorHandler);
+  }
+
+  oidcLogin(login, callbackFunc) {
+    fetch("/api/internal/oidc/login", {method: "POST", body: JSON.stringify(login)})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        this.setTokens(responseData.jwt, responseData.refreshToken);
         this.fetchProfile(callbackFunc);
       })
       .catch(loginErrorHandler);
   }
 
+  requestPasswordReset(email, callbackFunc) {
+    fetch("/api/internal/password-reset/request", {method: "POST", body: JSON.stringify({email: email})})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        callbackFunc(responseData);
+      })
+      .catch(errorHandler);
+  }
+
+  resetPassword(token, password, callbackFunc) {
+    fetch("/api/internal/password-reset", {method: "POST", body: JSON.stringify({token: token, password: password})})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        callbackFunc(responseData);
+      })
+      .catch(errorHandler);
+  }
+
+  verifyEmail(token, callbackFunc) {
+    fetch("/api/internal/verify-email", {method: "POST", body: JSON.stringify({token: token})})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        callbackFunc(responseData);
+      })
+      .catch(errorHandler);
+  }
+
+  acceptOrganizationInvitation(token, user, callbackFunc) {
+    fetch("/api/internal/organization-invitations/accept", {method: "POST", body: JSON.stringify({token: token, username: user.username, password: user.password}), headers: this.getHeader()})
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        callbackFunc(responseData);
+      })
+      .catch(errorHandler);
+  }
+
+  getOIDCSettings(callbackFunc) {
+    fetch("/api/internal/oidc/settings")
+      .then(checkStatus)
+      .then((response) => response.json())
+      .then((responseData) => {
+        callbackFunc(responseData);
+      })
+      .catch(errorHandler);
+  }
+
   fetchProfile(callbackFunc) {
     fetch("/api/internal/profile", {headers: this.getHeader()})
       .then(checkStatus)
@@ -132,13 +252,24 @@ class SessionStore extends EventEmitter {
   }
 
   logout(callbackFunc) {
+    // the session is cleared, even when it could not be revoked
+    fetch("/api/internal/logout", {method: "POST", body: "{}", headers: this.getHeader()})
+      .catch(() => {})
+      .then(() => {
+        this.clearSession();
+        callbackFunc();
+      });
+  }
+
+  clearSession() {
+    clearTimeout(this.refreshTimer);
     localStorage.setItem("jwt", "");
+    localStorage.setItem("refreshToken", "");
     localStorage.setItem("organizationID", "");
     this.user = {};
     this.applications = [];
     this.settings = {};
     this.emit("change");
-    callbackFunc();
   }
 
   getUser() {
@@ -0,0 +1,102 @@
+import React, { Component } from 'react';
+import { Link, withRouter } from "react-router-dom";
+
+import SessionStore from "../../stores/SessionStore";
+
+
+class AcceptOrganizationInvitation extends Component {
+  constructor() {
+    super();
+
+    this.state = {
+      user: {},
+      loggedInUser: null,
+    };
+
+    this.onSubmit = this.onSubmit.bind(this);
+    this.onAccept = this.onAccept.bind(this);
+  }
+
+  componentDidMount() {
+    this.setState({
+      loggedInUser: SessionStore.getUser(),
+    });
+
+    SessionStore.on("change", () => {
+      this.setState({
+        loggedInUser: SessionStore.getUser(),
+      });
+    });
+  }
+
+  onChange(field, e) {
+    let user = this.state.user;
+    user[field] = e.target.value;
+    this.setState({user: user});
+  }
+
+  onSubmit(e) {
+    e.preventDefault();
+    this.accept(this.state.user, (responseData) => {
+      this.props.history.push("/login");
+    });
+  }
+
+  onAccept(e) {
+    e.preventDefault();
+    this.accept({}, (responseData) => {
+      this.props.history.push(`/organizations/${responseData.organizationID}`);
+    });
+  }
+
+  accept(user, callbackFunc) {
+    const query = new URLSearchParams(this.props.location.search);
+    SessionStore.acceptOrganizationInvitation(query.get("token"), user, callbackFunc);
+  }
+
+  render() {
+    return(
+      <div>
+        <ol className="breadcrumb">
+          <li><Link to="/login">Login</Link></li>
+          <li className="active">Accept organization invitation</li>
+        </ol>
+        <hr />
+        <div className={"panel panel-default " + (this.state.loggedInUser !== null && typeof(this.state.loggedInUser) !== "undefined" ? "" : "hidden")}>
+          <div className="panel-body">
+            <p>Accept the invitation with your current account ({this.state.loggedInUser ? this.state.loggedInUser.username : ""}).</p>
+            <div className="btn-toolbar pull-right">
+              <button type="button" className="btn btn-primary" onClick={this.onAccept}>Accept invitation</button>
+            </div>
+          </div>
+        </div>
+        <div className="panel panel-default">
+          <div className="panel-heading">
+            <h3 className="panel-title">Create a new account</h3>
+          </div>
+          <div className="panel-body">
+            <form onSubmit={this.onSubmit}>
+              <div className="form-group">
+                <label className="control-label" htmlFor="username">Username</label>
+                <input className="form-control" id="username" type="text" placeholder="username" required value={this.state.user.username || ''} onChange={this.onChange.bind(this, 'username')} />
+              </div>
+              <div className="form-group">
+                <label className="control-label" htmlFor="password">Password</label>
+                <input className="form-control" id="password" type="password" placeholder="password" required value={this.state.user.password || ''} onChange={this.onChange.bind(this, 'password')} />
+                <p className="help-block">
+                  The account is created for the e-mail address to which the invitation was sent.
+                </p>
+              </div>
+              <hr />
+              <div className="btn-toolbar pull-right">
+                <button type="submit" className="btn btn-primary">Create account and accept invitation</button>
+              </div>
+            </form>
+          </div>
+        </div>
+      </div>
+    );
+  }
+}
+
+export default withRouter(AcceptOrganizationInvitation);
@@ -94,6 +94,33 @@ class AssignUserForm extends Component {
             When checked, the user will be assigned admin permissions within the context of the organization.
           </p>
         </div>
+        <div className="form-group">
+          <label className="control-label">Roles</label>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isDeviceAdmin" id="isDeviceAdmin" checked={!!this.state.user.isDeviceAdmin} onChange={this.onChange.bind(this, 'isDeviceAdmin')} /> Is device manager
+            </label>
+          </div>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isGatewayAdmin" id="isGatewayAdmin" checked={!!this.state.user.isGatewayAdmin} onChange={this.onChange.bind(this, 'isGatewayAdmin')} /> Is gateway manager
+            </label>
+          </div>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isIntegrationAdmin" id="isIntegrationAdmin" checked={!!this.state.user.isIntegrationAdmin} onChange={this.onChange.bind(this, 'isIntegrationAdmin')} /> Is integration manager
+            </label>
+          </div>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isViewer" id="isViewer" checked={!!this.state.user.isViewer} onChange={this.onChange.bind(this, 'isViewer')} /> Is viewer
+            </label>
+          </div>
+          <p className="help-block">
+            Device, gateway and integration managers can manage the devices, gateways or application integrations of the organization.
+            Viewers have read-only access and can not see the device keys.
+          </p>
+        </div>
         <hr />
         <div className="btn-toolbar pull-right">
           <a className="btn btn-default" onClick={this.props.history.goBack}>Go back</a>
@@ -175,6 +202,84 @@ class CreateUserForm extends Component {
 }
 
 
+class InviteUserForm extends Component {
+  constructor() {
+    super();
+
+    this.state = {
+      invitation: {},
+    };
+
+    this.handleSubmit = this.handleSubmit.bind(this);
+  }
+
+  handleSubmit(e) {
+    e.preventDefault();
+    this.props.onSubmit(this.state.invitation);
+  }
+
+  onChange(field, e) {
+    let invitation = this.state.invitation;
+    if (e.target.type === "checkbox") {
+      invitation[field] = e.target.checked;
+    } else {
+      invitation[field] = e.target.value;
+    }
+    this.setState({invitation: invitation});
+  }
+
+  render() {
+    return(
+      <form onSubmit={this.handleSubmit}>
+        <div className="form-group">
+          <label className="control-label" htmlFor="email">E-mail address</label>
+          <input className="form-control" id="email" type="email" placeholder="e-mail address" required value={this.state.invitation.email || ''} onChange={this.onChange.bind(this, 'email')} />
+          <p className="help-block">
+            An invitation link will be sent to this e-mail address. The invited user can accept the invitation with an existing account or create a new account.
+          </p>
+        </div>
+        <div className="form-group">
+          <label className="control-label">Admin</label>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isAdmin" id="isAdmin" checked={!!this.state.invitation.isAdmin} onChange={this.onChange.bind(this, 'isAdmin')} /> Is organization admin
+            </label>
+          </div>
+        </div>
+        <div className="form-group">
+          <label className="control-label">Roles</label>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isDeviceAdmin" id="isDeviceAdmin" checked={!!this.state.invitation.isDeviceAdmin} onChange={this.onChange.bind(this, 'isDeviceAdmin')} /> Is device manager
+            </label>
+          </div>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isGatewayAdmin" id="isGatewayAdmin" checked={!!this.state.invitation.isGatewayAdmin} onChange={this.onChange.bind(this, 'isGatewayAdmin')} /> Is gateway manager
+            </label>
+          </div>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isIntegrationAdmin" id="isIntegrationAdmin" checked={!!this.state.invitation.isIntegrationAdmin} onChange={this.onChange.bind(this, 'isIntegrationAdmin')} /> Is integration manager
+            </label>
+          </div>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isViewer" id="isViewer" checked={!!this.state.invitation.isViewer} onChange={this.onChange.bind(this, 'isViewer')} /> Is viewer
+            </label>
+          </div>
+        </div>
+        <hr />
+        <div className="btn-toolbar pull-right">
+          <a className="btn btn-default" onClick={this.props.history.goBack}>Go back</a>
+          <button type="submit" className="btn btn-primary">Send invitation</button>
+        </div>
+      </form>
+    );
+  }
+}
+
+
 class CreateOrganizationUser extends Component {
   constructor() {
     super();
@@ -187,6 +292,7 @@ class CreateOrganizationUser extends Component {
     this.changeTab = this.changeTab.bind(this);
     this.handleAssign = this.handleAssign.bind(this);
     this.handleCreateAndAssign = this.handleCreateAndAssign.bind(this);
+    this.handleInvite = this.handleInvite.bind(this);
   }
 
   componentDidMount() {
@@ -222,6 +328,12 @@ class CreateOrganizationUser extends Component {
     });
   }
 
+  handleInvite(invitation) {
+    OrganizationStore.createInvitation(this.props.match.params.organizationID, invitation, (resp) => {
+      this.props.history.push(`/organizations/${this.props.match.params.organizationID}/users`);
+    });
+  }
+
   render() {
     return(
       <div className="panel panel-default">
@@ -229,6 +341,7 @@ class CreateOrganizationUser extends Component {
           <ul className="nav nav-tabs">
             <li role="presentation" className={(this.state.activeTab === "assign" ? 'active' : '') + " " + (this.state.displayAssignUser ? '' : 'hidden')}><a onClick={this.changeTab} href="#assign" aria-controls="assign">Assign existing user</a></li>
             <li role="presentation" className={(this.state.activeTab === "create" ? 'active' : '')}><a onClick={this.changeTab} href="#create" aria-controls="create">Create and assign user</a></li>
+            <li role="presentation" className={(this.state.activeTab === "invite" ? 'active' : '')}><a onClick={this.changeTab} href="#invite" aria-controls="invite">Invite user</a></li>
           </ul>
           <hr />
           <div className={(this.state.activeTab === "assign" ? '' : 'hidden')}>
@@ -237,6 +350,9 @@ class CreateOrganizationUser extends Component {
           <div className={(this.state.activeTab === "create" ? '' : 'hidden')}>
             <CreateUserForm history={this.props.history} onSubmit={this.handleCreateAndAssign} />
           </div>
+          <div className={(this.state.activeTab === "invite" ? '' : 'hidden')}>
+            <InviteUserForm history={this.props.history} onSubmit={this.handleInvite} />
+          </div>
         </div>
       </div>
     );
@@ -0,0 +1,104 @@
+import React, { Component } from 'react';
+
+import OrganizationStore from "../../stores/OrganizationStore";
+
+
+class OrganizationInvitationRow extends Component {
+  constructor() {
+    super();
+
+    this.onResend = this.onResend.bind(this);
+    this.onDelete = this.onDelete.bind(this);
+  }
+
+  onResend() {
+    OrganizationStore.resendInvitation(this.props.organizationID, this.props.invitation.invitationID, (responseData) => {});
+  }
+
+  onDelete() {
+    if (window.confirm("Are you sure you want to revoke this invitation?")) {
+      OrganizationStore.deleteInvitation(this.props.organizationID, this.props.invitation.invitationID, (responseData) => {});
+    }
+  }
+
+  render() {
+    const expired = new Date(this.props.invitation.expiresAt) < new Date();
+
+    return(
+      <tr>
+        <td>{this.props.invitation.email}</td>
+        <td>
+          <span className={"glyphicon glyphicon-" + (this.props.invitation.isAdmin ? 'ok' : 'remove')} aria-hidden="true"></span>
+        </td>
+        <td>{this.props.invitation.invitedBy}</td>
+        <td>{expired ? "expired" : new Date(this.props.invitation.expiresAt).toLocaleString()}</td>
+        <td>
+          <div className="btn-group pull-right">
+            <button type="button" className="btn btn-default btn-xs" onClick={this.onResend}>Resend</button>
+            <button type="button" className="btn btn-danger btn-xs" onClick={this.onDelete}>Revoke</button>
+          </div>
+        </td>
+      </tr>
+    );
+  }
+}
+
+
+class OrganizationInvitations extends Component {
+  constructor() {
+    super();
+
+    this.state = {
+      invitations: [],
+    };
+
+    this.updateInvitations = this.updateInvitations.bind(this);
+  }
+
+  componentDidMount() {
+    this.updateInvitations();
+    OrganizationStore.on("change", this.updateInvitations);
+  }
+
+  componentWillUnmount() {
+    OrganizationStore.removeListener("change", this.updateInvitations);
+  }
+
+  updateInvitations() {
+    OrganizationStore.getInvitations(this.props.organizationID, 100, 0, (totalCount, invitations) => {
+      this.setState({
+        invitations: invitations,
+      });
+    });
+  }
+
+  render() {
+    const InvitationRows = this.state.invitations.map((invitation, i) => <OrganizationInvitationRow key={invitation.invitationID} organizationID={this.props.organizationID} invitation={invitation} />);
+
+    return(
+      <div className={"panel panel-default " + (this.state.invitations.length > 0 ? "" : "hidden")}>
+        <div className="panel-heading">
+          <h3 className="panel-title">Pending invitations</h3>
+        </div>
+        <div className="panel-body">
+          <table className="table table-hover">
+            <thead>
+              <tr>
+                <th>E-mail address</th>
+                <th className="col-md-1">Admin</th>
+                <th>Invited by</th>
+                <th>Expires</th>
+                <th></th>
+              </tr>
+            </thead>
+            <tbody>
+              {InvitationRows}
+            </tbody>
+          </table>
+        </div>
+      </div>
+    );
+  }
+}
+
+export default OrganizationInvitations;
@@ -3,6 +3,7 @@ import { Link } from 'react-router-dom';
 
 import OrganizationStore from "../../stores/OrganizationStore";
 import Pagination from "../../components/Pagination";
+import OrganizationInvitations from "./OrganizationInvitations";
 
 
 class OrganizationUserRow extends Component {
@@ -83,6 +84,7 @@ class OrganizationUsers extends Component {
           </table>
         </div>
         <Pagination pages={this.state.pages} currentPage={this.state.pageNumber} pathname={`/organizations/${this.props.match.params.organizationID}/users`} />
+        <OrganizationInvitations organizationID={this.props.match.params.organizationID} />
       </div>
     );
   }
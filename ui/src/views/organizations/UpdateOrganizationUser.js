@@ -57,6 +57,33 @@ class UpdateOrganizationUserForm extends Component {
             When checked, the user will be assigned admin permissions within the context of the organization.
           </p>
         </div>
+        <div className="form-group">
+          <label className="control-label">Roles</label>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isDeviceAdmin" id="isDeviceAdmin" checked={!!this.state.user.isDeviceAdmin} onChange={this.onChange.bind(this, 'isDeviceAdmin')} /> Is device manager
+            </label>
+          </div>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isGatewayAdmin" id="isGatewayAdmin" checked={!!this.state.user.isGatewayAdmin} onChange={this.onChange.bind(this, 'isGatewayAdmin')} /> Is gateway manager
+            </label>
+          </div>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isIntegrationAdmin" id="isIntegrationAdmin" checked={!!this.state.user.isIntegrationAdmin} onChange={this.onChange.bind(this, 'isIntegrationAdmin')} /> Is integration manager
+            </label>
+          </div>
+          <div className="checkbox">
+            <label>
+              <input type="checkbox" name="isViewer" id="isViewer" checked={!!this.state.user.isViewer} onChange={this.onChange.bind(this, 'isViewer')} /> Is viewer
+            </label>
+          </div>
+          <p className="help-block">
+            Device, gateway and integration managers can manage the devices, gateways or application integrations of the organization.
+            Viewers have read-only access and can not see the device keys.
+          </p>
+        </div>
         <hr />
         <div className="btn-toolbar pull-right">
           <a className="btn btn-default" onClick={this.props.history.goBack}>Go back</a>
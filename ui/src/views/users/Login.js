@@ -1,5 +1,5 @@
 import React, { Component } from 'react';
-import { withRouter } from "react-router-dom";
+import { Link, withRouter } from "react-router-dom";
 import SessionStore from "../../stores/SessionStore";
 
 class Login extends Component {
@@ -9,9 +9,14 @@ class Login extends Component {
     this.state = {
       login: {},
       registration: null,
+      oidc: {},
+      secondFactor: null,
+      code: "",
+      recoveryCodes: [],
     };
 
     this.onSubmit = this.onSubmit.bind(this);
+    this.onSubmitSecondFactor = this.onSubmitSecondFactor.bind(this);
   }
 
   componentDidMount() {
@@ -20,6 +25,12 @@ class Login extends Component {
       registration: SessionStore.getRegistration(),
     });
 
+    SessionStore.getOIDCSettings((settings) => {
+      this.setState({
+        oidc: settings,
+      });
+    });
+
     SessionStore.on("change", () => {
       this.setState({
         registration: SessionStore.getRegistration(),
@@ -39,10 +50,85 @@ class Login extends Component {
     e.preventDefault(); 
     SessionStore.login(this.state.login, (token) => {
       this.props.history.push("/");
+    }, (secondFactor) => {
+      this.setState({
+        secondFactor: secondFactor,
+      });
     });
   }
 
+  onSubmitSecondFactor(e) {
+    e.preventDefault();
+    SessionStore.loginSecondFactor({
+      challengeToken: this.state.secondFactor.challengeToken,
+      code: this.state.code,
+    }, (recoveryCodes) => {
+      // the recovery codes are only returned once, when enrolling
+      if (recoveryCodes.length > 0) {
+        this.setState({
+          recoveryCodes: recoveryCodes,
+        });
+      } else {
+        this.props.history.push("/");
+      }
+    });
+  }
+
+  renderSecondFactor() {
+    if (this.state.recoveryCodes.length > 0) {
+      return(
+        <div>
+          <p>
+            Two-factor authentication has been enabled. Store the following recovery codes in a safe place.
+            Each code can be used once instead of an authentication code, in case you lose access to your authenticator app.
+          </p>
+          <pre>{this.state.recoveryCodes.join("\n")}</pre>
+          <hr />
+          <button type="button" className="btn btn-primary pull-right" onClick={() => this.props.history.push("/")}>Continue</button>
+        </div>
+      );
+    }
+
+    const enrollment = this.state.secondFactor.totpEnrollment;
+
+    return(
+      <form onSubmit={this.onSubmitSecondFactor}>
+        {enrollment && <div>
+          <p>
+            Your organization requires two-factor authentication. Add the following secret to your authenticator app,
+            or open the link on the device running the app, and enter the generated code below.
+          </p>
+          <pre>{enrollment.secret}</pre>
+          <p><a href={enrollment.provisioningURI}>{enrollment.provisioningURI}</a></p>
+        </div>}
+        <div className="form-group">
+          <label className="control-label" htmlFor="code">Authentication code</label>
+          <input className="form-control" id="code" type="text" autoComplete="off" placeholder="123456" required value={this.state.code} onChange={(e) => this.setState({code: e.target.value})} />
+          {!enrollment && <p className="help-block">Enter the code of your authenticator app, or one of your recovery codes.</p>}
+        </div>
+        <hr />
+        <button type="submit" className="btn btn-primary pull-right">Verify</button>
+      </form>
+    );
+  }
+
   render() {
+    if (this.state.secondFactor !== null) {
+      return(
+        <div>
+          <ol className="breadcrumb">
+            <li className="active">Login</li>
+          </ol>
+          <hr />
+          <div className="panel panel-default">
+            <div className="panel-body">
+              {this.renderSecondFactor()}
+            </div>
+          </div>
+        </div>
+      );
+    }
+
     return(
       <div>
         <ol className="breadcrumb">
@@ -59,10 +145,15 @@ class Login extends Component {
               <div className="form-group">
                 <label className="control-label" htmlFor="password">Password</label>
                 <input className="form-control" id="password" type="password" placeholder="password" value={this.state.login.password || ''} onChange={this.onChange.bind(this, 'password')} />
+                <p className="help-block"><Link to="/password-reset/request">Forgot your password?</Link></p>
               </div>
               <hr />
               <button type="submit" className="btn btn-primary pull-right">Login</button>
             </form>
+            {this.state.oidc.enabled && <div className="clearfix">
+              <hr />
+              <a href={this.state.oidc.loginURL} className="btn btn-default btn-block">{this.state.oidc.loginLabel}</a>
+            </div>}
             <div dangerouslySetInnerHTML={{ __html: (typeof(this.state.registration) === "undefined" ? "" : this.state.registration) }}/>
           </div>
         </div>
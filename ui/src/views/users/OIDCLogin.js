@@ -0,0 +1,44 @@
+import React, { Component } from 'react';
+import { withRouter } from "react-router-dom";
+import SessionStore from "../../stores/SessionStore";
+import dispatcher from "../../dispatcher";
+
+class OIDCLogin extends Component {
+  componentDidMount() {
+    const query = new URLSearchParams(this.props.location.search);
+
+    if (query.get("error") !== null) {
+      dispatcher.dispatch({
+        type: "CREATE_ERROR",
+        error: {
+          error: query.get("error_description") || query.get("error"),
+        },
+      });
+      this.props.history.push("/login");
+      return;
+    }
+
+    const login = {
+      code: query.get("code"),
+      state: query.get("state"),
+    };
+
+    SessionStore.oidcLogin(login, () => {
+      this.props.history.push("/");
+    });
+  }
+
+  render() {
+    return(
+      <div>
+        <ol className="breadcrumb">
+          <li className="active">Login</li>
+        </ol>
+        <hr />
+        <p>Logging in...</p>
+      </div>
+    );
+  }
+}
+
+export default withRouter(OIDCLogin);
@@ -0,0 +1,55 @@
+import React, { Component } from 'react';
+import { Link, withRouter } from "react-router-dom";
+import SessionStore from "../../stores/SessionStore";
+
+class RequestPasswordReset extends Component {
+  constructor() {
+    super();
+
+    this.state = {
+      email: "",
+      requested: false,
+    };
+
+    this.onSubmit = this.onSubmit.bind(this);
+  }
+
+  onSubmit(e) {
+    e.preventDefault();
+    SessionStore.requestPasswordReset(this.state.email, () => {
+      this.setState({
+        requested: true,
+      });
+    });
+  }
+
+  render() {
+    return(
+      <div>
+        <ol className="breadcrumb">
+          <li><Link to="/login">Login</Link></li>
+          <li className="active">Reset password</li>
+        </ol>
+        <hr />
+        <div className="panel panel-default">
+          <div className="panel-body">
+            {this.state.requested && <p>
+              When an account exists for this e-mail address, an e-mail has been sent containing a link to reset your password.
+            </p>}
+            {!this.state.requested && <form onSubmit={this.onSubmit}>
+              <div className="form-group">
+                <label className="control-label" htmlFor="email">E-mail address</label>
+                <input className="form-control" id="email" type="email" placeholder="e-mail address" required value={this.state.email} onChange={(e) => this.setState({email: e.target.value})} />
+                <p className="help-block">A link to reset your password will be sent to this e-mail address.</p>
+              </div>
+              <hr />
+              <button type="submit" className="btn btn-primary pull-right">Reset password</button>
+            </form>}
+          </div>
+        </div>
+      </div>
+    );
+  }
+}
+
+export default withRouter(RequestPasswordReset);
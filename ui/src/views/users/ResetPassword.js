@@ -0,0 +1,39 @@
+import React, { Component } from 'react';
+import { Link, withRouter } from "react-router-dom";
+import SessionStore from "../../stores/SessionStore";
+import PasswordForm from "../../components/PasswordForm";
+
+class ResetPassword extends Component {
+  constructor() {
+    super();
+
+    this.onSubmit = this.onSubmit.bind(this);
+  }
+
+  onSubmit(password) {
+    const query = new URLSearchParams(this.props.location.search);
+
+    SessionStore.resetPassword(query.get("token"), password.password, () => {
+      this.props.history.push("/login");
+    });
+  }
+
+  render() {
+    return(
+      <div>
+        <ol className="breadcrumb">
+          <li><Link to="/login">Login</Link></li>
+          <li className="active">Reset password</li>
+        </ol>
+        <hr />
+        <div className="panel panel-default">
+          <div className="panel-body">
+            <PasswordForm onSubmit={this.onSubmit} />
+          </div>
+        </div>
+      </div>
+    );
+  }
+}
+
+export default withRouter(ResetPassword);
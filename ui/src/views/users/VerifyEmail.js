@@ -0,0 +1,42 @@
+import React, { Component } from 'react';
+import { Link, withRouter } from "react-router-dom";
+import SessionStore from "../../stores/SessionStore";
+
+class VerifyEmail extends Component {
+  constructor() {
+    super();
+
+    this.state = {
+      verified: false,
+    };
+  }
+
+  componentDidMount() {
+    const query = new URLSearchParams(this.props.location.search);
+
+    SessionStore.verifyEmail(query.get("token"), () => {
+      this.setState({
+        verified: true,
+      });
+    });
+  }
+
+  render() {
+    return(
+      <div>
+        <ol className="breadcrumb">
+          <li><Link to="/login">Login</Link></li>
+          <li className="active">Verify e-mail address</li>
+        </ol>
+        <hr />
+        <div className="panel panel-default">
+          <div className="panel-body">
+            {this.state.verified ? <p>Your e-mail address has been verified, you can now <Link to="/login">login</Link>.</p> : <p>Verifying your e-mail address...</p>}
+          </div>
+        </div>
+      </div>
+    );
+  }
+}
+
+export default withRouter(VerifyEmail);